@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ApplicationUnhealthyError is returned when an instance of an application
+// crashes or goes down while its health is being monitored.
+type ApplicationUnhealthyError struct {
+	Name string
+}
+
+func (e ApplicationUnhealthyError) Error() string {
+	return fmt.Sprintf("Instances of application '%s' became unhealthy", e.Name)
+}
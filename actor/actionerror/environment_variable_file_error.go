@@ -0,0 +1,17 @@
+package actionerror
+
+import "fmt"
+
+// EnvironmentVariableFileError is returned when an env variable file cannot be
+// parsed.
+type EnvironmentVariableFileError struct {
+	Line   int
+	Reason string
+}
+
+func (e EnvironmentVariableFileError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("Invalid env variable file: %s", e.Reason)
+	}
+	return fmt.Sprintf("Invalid env variable file on line %d: %s", e.Line, e.Reason)
+}
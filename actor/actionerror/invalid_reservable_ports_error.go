@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidReservablePortsError is returned when a router group's reservable
+// ports are not a list of ports and port ranges.
+type InvalidReservablePortsError struct {
+	ReservablePorts string
+}
+
+func (e InvalidReservablePortsError) Error() string {
+	return fmt.Sprintf("Reservable ports '%s' are invalid. Use a comma-separated list of ports and port ranges between 1024 and 65535 that do not overlap, for example '1024-1199,1300'.", e.ReservablePorts)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidTokenSignatureError is returned when a token's signature does not
+// match any of the keys published by UAA.
+type InvalidTokenSignatureError struct {
+	Reason string
+}
+
+func (e InvalidTokenSignatureError) Error() string {
+	return fmt.Sprintf("Token signature is invalid: %s", e.Reason)
+}
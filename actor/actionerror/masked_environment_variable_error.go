@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// MaskedEnvironmentVariableError is returned when an env variable file has a
+// value that was masked when it was exported.
+type MaskedEnvironmentVariableError struct {
+	EnvironmentVariableName string
+}
+
+func (e MaskedEnvironmentVariableError) Error() string {
+	return fmt.Sprintf("Env variable %s has a masked value. Set its value in the file before importing it.", e.EnvironmentVariableName)
+}
@@ -0,0 +1,10 @@
+package actionerror
+
+// NoDeployedRevisionError is returned when none of an app's revisions are
+// currently deployed, for example because the app is stopped.
+type NoDeployedRevisionError struct {
+}
+
+func (NoDeployedRevisionError) Error() string {
+	return "No revision is currently deployed"
+}
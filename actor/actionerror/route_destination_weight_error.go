@@ -0,0 +1,23 @@
+package actionerror
+
+import "fmt"
+
+// RouteDestinationWeightError is returned when a destination weight would
+// leave a route's weights unable to add up to 100, with at least 1 for every
+// destination.
+type RouteDestinationWeightError struct {
+	Weight            int
+	OtherDestinations int
+}
+
+func (e RouteDestinationWeightError) Error() string {
+	if e.OtherDestinations == 0 {
+		return fmt.Sprintf("Weight %d is invalid: a route with a single destination must have a weight of 100.", e.Weight)
+	}
+	return fmt.Sprintf(
+		"Weight %d is invalid: the weights of a route's destinations must add up to 100, so with %d other destination(s) the weight must be between 1 and %d.",
+		e.Weight,
+		e.OtherDestinations,
+		100-e.OtherDestinations,
+	)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// RouterGroupNotTCPError is returned when a port operation is attempted on a
+// router group that does not route TCP traffic.
+type RouterGroupNotTCPError struct {
+	Name string
+}
+
+func (e RouterGroupNotTCPError) Error() string {
+	return fmt.Sprintf("Router group '%s' is not a TCP router group.", e.Name)
+}
@@ -0,0 +1,22 @@
+package actionerror
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RouterGroupPortsInUseError is returned when new reservable ports for a
+// router group leave out ports that TCP routes are using.
+type RouterGroupPortsInUseError struct {
+	Name  string
+	Ports []int
+}
+
+func (e RouterGroupPortsInUseError) Error() string {
+	ports := make([]string, 0, len(e.Ports))
+	for _, port := range e.Ports {
+		ports = append(ports, strconv.Itoa(port))
+	}
+	return fmt.Sprintf("Ports %s of router group '%s' are used by TCP routes and must stay reservable.", strings.Join(ports, ", "), e.Name)
+}
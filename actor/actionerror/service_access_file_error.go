@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// ServiceAccessFileError is returned when a service access file cannot be
+// parsed.
+type ServiceAccessFileError struct {
+	Reason string
+}
+
+func (e ServiceAccessFileError) Error() string {
+	return fmt.Sprintf("Invalid service access file: %s", e.Reason)
+}
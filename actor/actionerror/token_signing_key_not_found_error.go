@@ -0,0 +1,16 @@
+package actionerror
+
+import "fmt"
+
+// TokenSigningKeyNotFoundError is returned when UAA does not publish the key
+// a token claims to be signed with.
+type TokenSigningKeyNotFoundError struct {
+	KeyID string
+}
+
+func (e TokenSigningKeyNotFoundError) Error() string {
+	if e.KeyID == "" {
+		return "Token does not name a signing key and UAA publishes more than one."
+	}
+	return fmt.Sprintf("Token signing key '%s' is not published by UAA.", e.KeyID)
+}
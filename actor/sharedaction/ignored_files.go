@@ -0,0 +1,166 @@
+package sharedaction
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+const (
+	CFIgnoreRuleSource  = ".cfignore"
+	DefaultRuleSource   = "default"
+	TraceFileRuleSource = "trace file"
+)
+
+// IgnoredFile is a file that is left out of an upload, along with the ignore
+// rule that excluded it.
+type IgnoredFile struct {
+	Filename string
+	Rule     string
+	// RuleSource is where the rule came from: CFIgnoreRuleSource,
+	// DefaultRuleSource or TraceFileRuleSource.
+	RuleSource string
+}
+
+type ignoreRule struct {
+	line    string
+	source  string
+	negate  bool
+	matcher *ignore.GitIgnore
+}
+
+// ignoreRules evaluates ignore lines one at a time so that the rule
+// responsible for excluding a path can be reported. It gives the same result
+// as a GitIgnore compiled from the same lines.
+type ignoreRules []ignoreRule
+
+func (rules ignoreRules) add(source string, lines ...string) ignoreRules {
+	for _, line := range lines {
+		trimmed := strings.Trim(strings.TrimRight(line, "\r"), " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		rule := ignoreRule{line: trimmed, source: source}
+		pattern := trimmed
+		if strings.HasPrefix(pattern, "!") {
+			rule.negate = true
+			pattern = pattern[1:]
+		}
+
+		matcher, err := ignore.CompileIgnoreLines(pattern)
+		if err != nil {
+			continue
+		}
+		rule.matcher = matcher
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func (rules ignoreRules) match(path string) (ignoreRule, bool) {
+	var (
+		matched ignoreRule
+		ignored bool
+	)
+
+	for _, rule := range rules {
+		if !rule.matcher.MatchesPath(path) {
+			continue
+		}
+		if !rule.negate {
+			matched, ignored = rule, true
+		} else if ignored {
+			matched, ignored = ignoreRule{}, false
+		}
+	}
+
+	return matched, ignored
+}
+
+// GatherIgnoredArchiveFiles returns the files in an archive that
+// GatherArchiveResources leaves out, and the rule that excluded each one.
+func (actor Actor) GatherIgnoredArchiveFiles(archivePath string) ([]IgnoredFile, error) {
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	reader, err := actor.newArchiveReader(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := ignoreRules{}.add(DefaultRuleSource, DefaultIgnoreLines...)
+	for _, item := range reader.File {
+		if strings.HasSuffix(item.Name, ".cfignore") {
+			fileReader, err := item.Open()
+			if err != nil {
+				return nil, err
+			}
+			defer fileReader.Close()
+
+			raw, err := ioutil.ReadAll(fileReader)
+			if err != nil {
+				return nil, err
+			}
+			rules = rules.add(CFIgnoreRuleSource, strings.Split(string(raw), "\n")...)
+			break
+		}
+	}
+
+	var ignoredFiles []IgnoredFile
+	for _, archivedFile := range reader.File {
+		filename := filepath.ToSlash(archivedFile.Name)
+		if rule, ignored := rules.match(filename); ignored {
+			ignoredFiles = append(ignoredFiles, IgnoredFile{Filename: filename, Rule: rule.line, RuleSource: rule.source})
+		}
+	}
+
+	return ignoredFiles, nil
+}
+
+// GatherIgnoredDirectoryFiles returns the files in a directory that
+// GatherDirectoryResources leaves out, and the rule that excluded each one.
+func (actor Actor) GatherIgnoredDirectoryFiles(sourceDir string) ([]IgnoredFile, error) {
+	var rules ignoreRules
+
+	pathToCFIgnore := filepath.Join(sourceDir, ".cfignore")
+	if _, err := os.Stat(pathToCFIgnore); !os.IsNotExist(err) {
+		raw, err := ioutil.ReadFile(pathToCFIgnore)
+		if err != nil {
+			return nil, err
+		}
+		rules = rules.add(CFIgnoreRuleSource, strings.Split(string(raw), "\n")...)
+	}
+	rules = rules.add(DefaultRuleSource, DefaultIgnoreLines...)
+	rules = rules.add(TraceFileRuleSource, actor.traceFileIgnoreLines(sourceDir)...)
+
+	evalDir, err := filepath.EvalSymlinks(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var ignoredFiles []IgnoredFile
+	walkErr := filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(evalDir, fullPath)
+		if err != nil {
+			return err
+		}
+
+		if rule, ignored := rules.match(relPath); ignored {
+			ignoredFiles = append(ignoredFiles, IgnoredFile{Filename: filepath.ToSlash(relPath), Rule: rule.line, RuleSource: rule.source})
+		}
+		return nil
+	})
+
+	return ignoredFiles, walkErr
+}
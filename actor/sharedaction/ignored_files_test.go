@@ -0,0 +1,114 @@
+package sharedaction_test
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ignored file actions", func() {
+	var (
+		fakeConfig *sharedactionfakes.FakeConfig
+		actor      *Actor
+		srcDir     string
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(sharedactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig)
+
+		srcDir = GinkgoT().TempDir()
+		files := map[string]string{
+			".cfignore":                 "# build output\nnode_modules/\n*.log\n!keep.log\n",
+			"app.js":                    "console.log('hi')",
+			"debug.log":                 "some logs",
+			"keep.log":                  "important logs",
+			"node_modules/pkg/index.js": "module.exports = {}",
+		}
+		for name, contents := range files {
+			path := filepath.Join(srcDir, filepath.FromSlash(name))
+			Expect(os.MkdirAll(filepath.Dir(path), 0700)).To(Succeed())
+			Expect(os.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+		}
+	})
+
+	Describe("GatherIgnoredDirectoryFiles", func() {
+		It("returns each ignored file with the rule that excluded it", func() {
+			ignoredFiles, err := actor.GatherIgnoredDirectoryFiles(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ignoredFiles).To(ConsistOf(
+				IgnoredFile{Filename: ".cfignore", Rule: ".cfignore", RuleSource: DefaultRuleSource},
+				IgnoredFile{Filename: "debug.log", Rule: "*.log", RuleSource: CFIgnoreRuleSource},
+				IgnoredFile{Filename: "node_modules/pkg", Rule: "node_modules/", RuleSource: CFIgnoreRuleSource},
+				IgnoredFile{Filename: "node_modules/pkg/index.js", Rule: "node_modules/", RuleSource: CFIgnoreRuleSource},
+			))
+		})
+
+		It("agrees with GatherDirectoryResources", func() {
+			ignoredFiles, err := actor.GatherIgnoredDirectoryFiles(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+			resources, err := actor.GatherDirectoryResources(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, resource := range resources {
+				for _, ignoredFile := range ignoredFiles {
+					Expect(ignoredFile.Filename).ToNot(Equal(resource.Filename))
+				}
+			}
+			Expect(len(resources) + len(ignoredFiles)).To(Equal(7))
+		})
+
+		When("verbose logging writes to a file in the directory", func() {
+			BeforeEach(func() {
+				fakeConfig.VerboseReturns(true, []string{filepath.Join(srcDir, "trace.txt")})
+				Expect(os.WriteFile(filepath.Join(srcDir, "trace.txt"), nil, 0600)).To(Succeed())
+			})
+
+			It("reports the trace file as ignored", func() {
+				ignoredFiles, err := actor.GatherIgnoredDirectoryFiles(srcDir)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ignoredFiles).To(ContainElement(IgnoredFile{Filename: "trace.txt", Rule: "trace.txt", RuleSource: TraceFileRuleSource}))
+			})
+		})
+	})
+
+	Describe("GatherIgnoredArchiveFiles", func() {
+		var archivePath string
+
+		BeforeEach(func() {
+			archivePath = filepath.Join(GinkgoT().TempDir(), "app.zip")
+			archive, err := os.Create(archivePath)
+			Expect(err).ToNot(HaveOccurred())
+			writer := zip.NewWriter(archive)
+			for _, name := range []string{".cfignore", "app.js", "debug.log", "keep.log", "manifest.yml"} {
+				contents, err := os.ReadFile(filepath.Join(srcDir, name))
+				if os.IsNotExist(err) {
+					contents = nil
+				} else {
+					Expect(err).ToNot(HaveOccurred())
+				}
+				fileWriter, err := writer.Create(name)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = fileWriter.Write(contents)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(writer.Close()).To(Succeed())
+			Expect(archive.Close()).To(Succeed())
+		})
+
+		It("returns each ignored file with the rule that excluded it", func() {
+			ignoredFiles, err := actor.GatherIgnoredArchiveFiles(archivePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ignoredFiles).To(ConsistOf(
+				IgnoredFile{Filename: ".cfignore", Rule: ".cfignore", RuleSource: DefaultRuleSource},
+				IgnoredFile{Filename: "debug.log", Rule: "*.log", RuleSource: CFIgnoreRuleSource},
+				IgnoredFile{Filename: "manifest.yml", Rule: "manifest.yml", RuleSource: DefaultRuleSource},
+			))
+		})
+	})
+})
@@ -452,15 +452,7 @@ func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.G
 		"sourceDir":      sourceDir,
 	}).Debug("using ignore file")
 
-	additionalIgnoreLines := DefaultIgnoreLines
-
-	// If verbose logging has files in the current dir, ignore them
-	_, traceFiles := actor.Config.Verbose()
-	for _, traceFilePath := range traceFiles {
-		if relPath, err := filepath.Rel(sourceDir, traceFilePath); err == nil {
-			additionalIgnoreLines = append(additionalIgnoreLines, relPath)
-		}
-	}
+	additionalIgnoreLines := append(DefaultIgnoreLines, actor.traceFileIgnoreLines(sourceDir)...)
 
 	log.Debugf("ignore rules: %v", additionalIgnoreLines)
 
@@ -470,6 +462,19 @@ func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.G
 	return ignore.CompileIgnoreLines(additionalIgnoreLines...)
 }
 
+// traceFileIgnoreLines returns ignore rules for any verbose logging files
+// in sourceDir.
+func (actor Actor) traceFileIgnoreLines(sourceDir string) []string {
+	var lines []string
+	_, traceFiles := actor.Config.Verbose()
+	for _, traceFilePath := range traceFiles {
+		if relPath, err := filepath.Rel(sourceDir, traceFilePath); err == nil {
+			lines = append(lines, relPath)
+		}
+	}
+	return lines
+}
+
 func (Actor) findInResources(path string, filesToInclude []Resource) (Resource, bool) {
 	for _, resource := range filesToInclude {
 		if resource.Filename == filepath.ToSlash(path) {
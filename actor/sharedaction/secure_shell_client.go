@@ -9,5 +9,7 @@ type SecureShellClient interface {
 	Close() error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error
+	DynamicPortForward(dynamicPortForwardSpecs []clissh.DynamicPortForward) error
 	Wait() error
 }
@@ -1,6 +1,10 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 type TTYOption clissh.TTYRequest
 
@@ -13,16 +17,50 @@ const (
 
 type LocalPortForward clissh.LocalPortForward
 
+type RemotePortForward clissh.RemotePortForward
+
+type DynamicPortForward clissh.DynamicPortForward
+
 type SSHOptions struct {
-	Commands              []string
-	Username              string
-	Passcode              string
-	Endpoint              string
-	HostKeyFingerprint    string
-	SkipHostValidation    bool
-	SkipRemoteExecution   bool
-	TTYOption             TTYOption
-	LocalPortForwardSpecs []LocalPortForward
+	Commands                []string
+	Username                string
+	Passcode                string
+	Endpoint                string
+	HostKeyFingerprint      string
+	SkipHostValidation      bool
+	SkipRemoteExecution     bool
+	TTYOption               TTYOption
+	LocalPortForwardSpecs   []LocalPortForward
+	RemotePortForwardSpecs  []RemotePortForward
+	DynamicPortForwardSpecs []DynamicPortForward
+}
+
+// SSHCredentials are the one-time credentials used to open an SSH connection
+// to an application instance.
+type SSHCredentials struct {
+	Username           string
+	Passcode           string
+	Endpoint           string
+	HostKeyFingerprint string
+}
+
+// SSHTunnelOptions configures how ExecuteSecureShellTunnel re-establishes a
+// dropped connection.
+type SSHTunnelOptions struct {
+	// RefreshCredentials is called before every reconnect attempt. SSH codes
+	// can only be used once, so fresh credentials are needed each time.
+	RefreshCredentials func() (SSHCredentials, error)
+	// Reconnecting is called with the reason for a reconnect attempt and the
+	// delay before the attempt is made.
+	Reconnecting func(reason error, delay time.Duration)
+	// Reconnected is called once the tunnel is re-established.
+	Reconnected func()
+
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// MaxAttempts is the number of consecutive failed reconnect attempts after
+	// which the tunnel gives up. Zero retries forever.
+	MaxAttempts int
 }
 
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
@@ -37,6 +75,16 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 		return err
 	}
 
+	err = sshClient.RemotePortForward(convertActorToSSHPackageRemoteForwardingSpecs(sshOptions.RemotePortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.DynamicPortForward(convertActorToSSHPackageDynamicForwardingSpecs(sshOptions.DynamicPortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
 	if sshOptions.SkipRemoteExecution {
 		err = sshClient.Wait()
 	} else {
@@ -45,6 +93,85 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 	return err
 }
 
+// ExecuteSecureShellTunnel opens the port forwards described by sshOptions
+// without executing a remote command. Whenever the connection drops it fetches
+// new credentials and reconnects with exponential backoff. Local listeners stay
+// bound while the tunnel is reconnecting.
+func (actor Actor) ExecuteSecureShellTunnel(sshClient SecureShellClient, sshOptions SSHOptions, tunnelOptions SSHTunnelOptions) error {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	err = sshClient.LocalPortForward(convertActorToSSHPackageForwardingSpecs(sshOptions.LocalPortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	remoteSpecs := convertActorToSSHPackageRemoteForwardingSpecs(sshOptions.RemotePortForwardSpecs)
+	err = sshClient.RemotePortForward(remoteSpecs)
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.DynamicPortForward(convertActorToSSHPackageDynamicForwardingSpecs(sshOptions.DynamicPortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	for {
+		reason := sshClient.Wait()
+
+		err = reconnectSecureShell(sshClient, sshOptions.SkipHostValidation, remoteSpecs, tunnelOptions, reason)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func reconnectSecureShell(sshClient SecureShellClient, skipHostValidation bool, remoteSpecs []clissh.RemotePortForward, tunnelOptions SSHTunnelOptions, reason error) error {
+	delay := tunnelOptions.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		if tunnelOptions.Reconnecting != nil {
+			tunnelOptions.Reconnecting(reason, delay)
+		}
+		time.Sleep(delay)
+
+		reason = connectWithFreshCredentials(sshClient, skipHostValidation, remoteSpecs, tunnelOptions.RefreshCredentials)
+		if reason == nil {
+			if tunnelOptions.Reconnected != nil {
+				tunnelOptions.Reconnected()
+			}
+			return nil
+		}
+
+		if tunnelOptions.MaxAttempts > 0 && attempt >= tunnelOptions.MaxAttempts {
+			return reason
+		}
+
+		delay *= 2
+		if tunnelOptions.MaxBackoff > 0 && delay > tunnelOptions.MaxBackoff {
+			delay = tunnelOptions.MaxBackoff
+		}
+	}
+}
+
+func connectWithFreshCredentials(sshClient SecureShellClient, skipHostValidation bool, remoteSpecs []clissh.RemotePortForward, refreshCredentials func() (SSHCredentials, error)) error {
+	credentials, err := refreshCredentials()
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.Connect(credentials.Username, credentials.Passcode, credentials.Endpoint, credentials.HostKeyFingerprint, skipHostValidation)
+	if err != nil {
+		return err
+	}
+
+	return sshClient.RemotePortForward(remoteSpecs)
+}
+
 func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []clissh.LocalPortForward {
 	sshPackageSpecs := []clissh.LocalPortForward{}
 
@@ -54,3 +181,23 @@ func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []cl
 
 	return sshPackageSpecs
 }
+
+func convertActorToSSHPackageRemoteForwardingSpecs(actorSpecs []RemotePortForward) []clissh.RemotePortForward {
+	sshPackageSpecs := []clissh.RemotePortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.RemotePortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
+
+func convertActorToSSHPackageDynamicForwardingSpecs(actorSpecs []DynamicPortForward) []clissh.DynamicPortForward {
+	sshPackageSpecs := []clissh.DynamicPortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.DynamicPortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
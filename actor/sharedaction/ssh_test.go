@@ -2,6 +2,8 @@ package sharedaction_test
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
@@ -73,6 +75,12 @@ var _ = Describe("SSH Actions", func() {
 					{LocalAddress: "local-address-1", RemoteAddress: "remote-address-1"},
 					{LocalAddress: "local-address-2", RemoteAddress: "remote-address-2"},
 				}
+				sshOptions.RemotePortForwardSpecs = []RemotePortForward{
+					{RemoteAddress: "remote-address-3", LocalAddress: "local-address-3"},
+				}
+				sshOptions.DynamicPortForwardSpecs = []DynamicPortForward{
+					{LocalAddress: "local-address-4"},
+				}
 			})
 
 			AfterEach(func() {
@@ -89,6 +97,45 @@ var _ = Describe("SSH Actions", func() {
 				))
 			})
 
+			It("forwards the remote ports", func() {
+				Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(0)).To(Equal(
+					[]clissh.RemotePortForward{
+						{RemoteAddress: "remote-address-3", LocalAddress: "local-address-3"},
+					},
+				))
+			})
+
+			It("starts the dynamic port forwards", func() {
+				Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.DynamicPortForwardArgsForCall(0)).To(Equal(
+					[]clissh.DynamicPortForward{
+						{LocalAddress: "local-address-4"},
+					},
+				))
+			})
+
+			When("remote port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.RemotePortForwardReturns(errors.New("some-remote-forwarding-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-remote-forwarding-error"))
+					Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+				})
+			})
+
+			When("dynamic port forwarding fails", func() {
+				BeforeEach(func() {
+					fakeSecureShellClient.DynamicPortForwardReturns(errors.New("some-dynamic-forwarding-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-dynamic-forwarding-error"))
+				})
+			})
+
 			When("local port forwarding fails", func() {
 				BeforeEach(func() {
 					fakeSecureShellClient.LocalPortForwardReturns(errors.New("some-forwarding-error"))
@@ -163,4 +210,137 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("ExecuteSecureShellTunnel", func() {
+		var (
+			sshOptions    SSHOptions
+			tunnelOptions SSHTunnelOptions
+			executeErr    error
+
+			refreshCount   int
+			reconnectDelay []time.Duration
+			reconnected    int
+		)
+
+		BeforeEach(func() {
+			sshOptions = SSHOptions{
+				Username:           "some-user",
+				Passcode:           "some-passcode",
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				SkipHostValidation: true,
+				LocalPortForwardSpecs: []LocalPortForward{
+					{LocalAddress: "local-address-1", RemoteAddress: "remote-address-1"},
+				},
+				RemotePortForwardSpecs: []RemotePortForward{
+					{RemoteAddress: "remote-address-2", LocalAddress: "local-address-2"},
+				},
+			}
+
+			refreshCount = 0
+			reconnectDelay = nil
+			reconnected = 0
+			tunnelOptions = SSHTunnelOptions{
+				RefreshCredentials: func() (SSHCredentials, error) {
+					refreshCount++
+					return SSHCredentials{
+						Username:           "some-other-user",
+						Passcode:           fmt.Sprintf("passcode-%d", refreshCount),
+						Endpoint:           "some-endpoint",
+						HostKeyFingerprint: "some-fingerprint",
+					}, nil
+				},
+				Reconnecting: func(reason error, delay time.Duration) {
+					reconnectDelay = append(reconnectDelay, delay)
+				},
+				Reconnected: func() {
+					reconnected++
+				},
+				InitialBackoff: time.Millisecond,
+				MaxBackoff:     4 * time.Millisecond,
+				MaxAttempts:    4,
+			}
+
+			fakeSecureShellClient.WaitReturnsOnCall(0, errors.New("connection lost"))
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.ExecuteSecureShellTunnel(fakeSecureShellClient, sshOptions, tunnelOptions)
+		})
+
+		When("the initial connection fails", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error without reconnecting", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(refreshCount).To(Equal(0))
+			})
+		})
+
+		When("the connection drops and reconnecting keeps failing", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.ConnectStub = func(username string, _ string, _ string, _ string, _ bool) error {
+					if username == "some-user" {
+						return nil
+					}
+					return errors.New("instance not running")
+				}
+			})
+
+			It("fetches new credentials for each attempt with an increasing delay", func() {
+				Expect(executeErr).To(MatchError("instance not running"))
+				Expect(refreshCount).To(Equal(4))
+				Expect(reconnectDelay).To(Equal([]time.Duration{
+					time.Millisecond, 2 * time.Millisecond, 4 * time.Millisecond, 4 * time.Millisecond,
+				}))
+				Expect(reconnected).To(Equal(0))
+			})
+
+			It("binds the local listeners only once", func() {
+				Expect(fakeSecureShellClient.LocalPortForwardCallCount()).To(Equal(1))
+				Expect(fakeSecureShellClient.CloseCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the connection drops and reconnecting succeeds", func() {
+			BeforeEach(func() {
+				fakeSecureShellClient.WaitReturnsOnCall(1, errors.New("connection lost again"))
+				fakeSecureShellClient.ConnectReturnsOnCall(1, errors.New("instance not running"))
+				fakeSecureShellClient.ConnectReturnsOnCall(3, errors.New("stop"))
+				fakeSecureShellClient.ConnectReturnsOnCall(4, errors.New("stop"))
+				tunnelOptions.MaxAttempts = 2
+			})
+
+			It("reconnects with fresh credentials", func() {
+				Expect(executeErr).To(MatchError("stop"))
+				Expect(fakeSecureShellClient.ConnectCallCount()).To(Equal(5))
+
+				username, passcode, _, _, skipHostValidation := fakeSecureShellClient.ConnectArgsForCall(2)
+				Expect(username).To(Equal("some-other-user"))
+				Expect(passcode).To(Equal("passcode-2"))
+				Expect(skipHostValidation).To(BeTrue())
+			})
+
+			It("requests the remote forwards again on the new connection", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(2))
+				Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(1)).To(Equal(
+					[]clissh.RemotePortForward{
+						{RemoteAddress: "remote-address-2", LocalAddress: "local-address-2"},
+					},
+				))
+				Expect(fakeSecureShellClient.LocalPortForwardCallCount()).To(Equal(1))
+			})
+
+			It("resets the backoff after a successful reconnect", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(reconnected).To(Equal(1))
+				Expect(reconnectDelay).To(Equal([]time.Duration{
+					time.Millisecond, 2 * time.Millisecond, time.Millisecond, 2 * time.Millisecond,
+				}))
+			})
+		})
+	})
 })
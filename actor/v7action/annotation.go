@@ -0,0 +1,162 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+func (actor *Actor) GetApplicationAnnotations(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	return actor.extractAnnotations((*resources.Metadata)(resource.Metadata), warnings, err)
+}
+
+func (actor *Actor) GetBuildpackAnnotations(buildpackName string, buildpackStack string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetBuildpackByNameAndStack(buildpackName, buildpackStack)
+	return actor.extractAnnotations(resource.Metadata, warnings, err)
+}
+
+func (actor *Actor) GetDomainAnnotations(domainName string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetDomainByName(domainName)
+	return actor.extractAnnotations(resource.Metadata, warnings, err)
+}
+
+func (actor *Actor) GetOrganizationAnnotations(orgName string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetOrganizationByName(orgName)
+	return actor.extractAnnotations(resource.Metadata, warnings, err)
+}
+
+func (actor *Actor) GetRouteAnnotations(routeName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetRoute(routeName, spaceGUID)
+	return actor.extractAnnotations((*resources.Metadata)(resource.Metadata), warnings, err)
+}
+
+func (actor *Actor) GetServiceBrokerAnnotations(serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
+	serviceBroker, warnings, err := actor.GetServiceBrokerByName(serviceBrokerName)
+	return actor.extractAnnotations(serviceBroker.Metadata, warnings, err)
+}
+
+func (actor *Actor) GetServiceInstanceAnnotations(serviceInstanceName, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	return actor.extractAnnotations(serviceInstance.Metadata, warnings, err)
+}
+
+func (actor *Actor) GetServiceOfferingAnnotations(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
+	serviceOffering, warnings, err := actor.CloudControllerClient.GetServiceOfferingByNameAndBroker(serviceOfferingName, serviceBrokerName)
+	return actor.extractAnnotations(serviceOffering.Metadata, Warnings(warnings), actionerror.EnrichAPIErrors(err))
+}
+
+func (actor *Actor) GetServicePlanAnnotations(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, Warnings, error) {
+	servicePlan, warnings, err := actor.GetServicePlanByNameOfferingAndBroker(servicePlanName, serviceOfferingName, serviceBrokerName)
+	return actor.extractAnnotations(servicePlan.Metadata, warnings, err)
+}
+
+func (actor *Actor) GetSpaceAnnotations(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	return actor.extractAnnotations(resource.Metadata, warnings, err)
+}
+
+func (actor *Actor) GetStackAnnotations(stackName string) (map[string]types.NullString, Warnings, error) {
+	resource, warnings, err := actor.GetStackByName(stackName)
+	return actor.extractAnnotations(resource.Metadata, warnings, err)
+}
+
+func (actor *Actor) extractAnnotations(metadata *resources.Metadata, warnings Warnings, err error) (map[string]types.NullString, Warnings, error) {
+	var annotations map[string]types.NullString
+
+	if err != nil {
+		return annotations, warnings, err
+	}
+	if metadata != nil {
+		annotations = metadata.Annotations
+	}
+	return annotations, warnings, nil
+}
+
+func (actor *Actor) UpdateApplicationAnnotationsByApplicationName(appName string, spaceGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("app", app.GUID, resources.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateBuildpackAnnotationsByBuildpackNameAndStack(buildpackName string, stack string, annotations map[string]types.NullString) (Warnings, error) {
+	buildpack, warnings, err := actor.GetBuildpackByNameAndStack(buildpackName, stack)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("buildpack", buildpack.GUID, resources.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateDomainAnnotationsByDomainName(domainName string, annotations map[string]types.NullString) (Warnings, error) {
+	domain, warnings, err := actor.GetDomainByName(domainName)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("domain", domain.GUID, resources.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateOrganizationAnnotationsByOrganizationName(orgName string, annotations map[string]types.NullString) (Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("org", org.GUID, resources.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateRouteAnnotations(routeName string, spaceGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	route, warnings, err := actor.GetRoute(routeName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("route", route.GUID, resources.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateServiceBrokerAnnotationsByServiceBrokerName(serviceBrokerName string, annotations map[string]types.NullString) (Warnings, error) {
+	serviceBroker, warnings, err := actor.GetServiceBrokerByName(serviceBrokerName)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("service-broker", serviceBroker.GUID, resources.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateServiceInstanceAnnotations(serviceInstanceName, spaceGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	serviceInstance, warnings, err := actor.GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("service-instance", serviceInstance.GUID, resources.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateServiceOfferingAnnotations(serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (Warnings, error) {
+	serviceOffering, warnings, err := actor.CloudControllerClient.GetServiceOfferingByNameAndBroker(serviceOfferingName, serviceBrokerName)
+	if err != nil {
+		return Warnings(warnings), actionerror.EnrichAPIErrors(err)
+	}
+	return actor.updateResourceMetadata("service-offering", serviceOffering.GUID, resources.Metadata{Annotations: annotations}, Warnings(warnings))
+}
+
+func (actor *Actor) UpdateServicePlanAnnotations(servicePlanName string, serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (Warnings, error) {
+	servicePlan, warnings, err := actor.GetServicePlanByNameOfferingAndBroker(servicePlanName, serviceOfferingName, serviceBrokerName)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("service-plan", servicePlan.GUID, resources.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateSpaceAnnotationsBySpaceName(spaceName string, orgGUID string, annotations map[string]types.NullString) (Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("space", space.GUID, resources.Metadata{Annotations: annotations}, warnings)
+}
+
+func (actor *Actor) UpdateStackAnnotationsByStackName(stackName string, annotations map[string]types.NullString) (Warnings, error) {
+	stack, warnings, err := actor.GetStackByName(stackName)
+	if err != nil {
+		return warnings, err
+	}
+	return actor.updateResourceMetadata("stack", stack.GUID, resources.Metadata{Annotations: annotations}, warnings)
+}
@@ -0,0 +1,244 @@
+package v7action_test
+
+import (
+	"errors"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("annotations", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+		resourceName              string
+		spaceGUID                 string
+		annotations               map[string]types.NullString
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+		resourceName = "some-resource"
+		spaceGUID = "some-space-guid"
+		annotations = map[string]types.NullString{
+			"contact":     types.NewNullString("team@example.com"),
+			"description": types.NewNullString(strings.Repeat("a long annotation value ", 20)),
+			"obsolete":    types.NewNullString(),
+		}
+	})
+
+	Describe("UpdateApplicationAnnotationsByApplicationName", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateApplicationAnnotationsByApplicationName(resourceName, spaceGUID, annotations)
+		})
+
+		When("there are no client errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{GUID: "some-guid"}},
+					ccv3.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns(
+					"",
+					ccv3.Warnings{"set-app-annotations-warnings"},
+					nil,
+				)
+			})
+
+			It("gets the application", func() {
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{resourceName}},
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+				))
+			})
+
+			It("sets only the app annotations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(1))
+				resourceType, appGUID, sentMetadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+				Expect(resourceType).To(Equal("app"))
+				Expect(appGUID).To(Equal("some-guid"))
+				Expect(sentMetadata).To(Equal(resources.Metadata{Annotations: annotations}))
+			})
+
+			It("aggregates warnings", func() {
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2", "set-app-annotations-warnings"))
+			})
+		})
+
+		When("updating the metadata fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{GUID: "some-guid"}},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns(
+					"",
+					ccv3.Warnings{"set-app-annotations-warnings"},
+					errors.New("update-metadata-error"),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("update-metadata-error"))
+				Expect(warnings).To(ConsistOf("warning-1", "set-app-annotations-warnings"))
+			})
+		})
+	})
+
+	Describe("UpdateServiceBrokerAnnotationsByServiceBrokerName", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateServiceBrokerAnnotationsByServiceBrokerName(resourceName, annotations)
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetServiceBrokersReturns(
+				[]resources.ServiceBroker{{GUID: "some-broker-guid", Name: resourceName}},
+				ccv3.Warnings{"warning-1"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateResourceMetadataReturns(
+				"some-job-url",
+				ccv3.Warnings{"set-broker-annotations-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-job-warning"}, nil)
+		})
+
+		It("sets the annotations and waits for the job", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			resourceType, brokerGUID, sentMetadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+			Expect(resourceType).To(Equal("service-broker"))
+			Expect(brokerGUID).To(Equal("some-broker-guid"))
+			Expect(sentMetadata.Annotations).To(Equal(annotations))
+
+			Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+			Expect(warnings).To(ConsistOf("warning-1", "set-broker-annotations-warning", "poll-job-warning"))
+		})
+	})
+
+	Describe("UpdateServiceOfferingAnnotations", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateServiceOfferingAnnotations(resourceName, "some-broker", annotations)
+		})
+
+		When("the service offering name is ambiguous", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceOfferingByNameAndBrokerReturns(
+					resources.ServiceOffering{},
+					ccv3.Warnings{"warning-1"},
+					ccerror.ServiceOfferingNameAmbiguityError{ServiceOfferingName: resourceName},
+				)
+			})
+
+			It("returns an actor error and the warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServiceOfferingNameAmbiguityError{
+					ServiceOfferingNameAmbiguityError: ccerror.ServiceOfferingNameAmbiguityError{ServiceOfferingName: resourceName},
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetDomainAnnotations", func() {
+		JustBeforeEach(func() {
+			annotations, warnings, executeErr = actor.GetDomainAnnotations(resourceName)
+		})
+
+		When("the domain has labels and annotations", func() {
+			var expectedAnnotations map[string]types.NullString
+
+			BeforeEach(func() {
+				expectedAnnotations = map[string]types.NullString{"contact": types.NewNullString("team@example.com")}
+				fakeCloudControllerClient.GetDomainsReturns(
+					[]resources.Domain{{
+						GUID: "some-guid",
+						Metadata: &resources.Metadata{
+							Labels:      map[string]types.NullString{"env": types.NewNullString("prod")},
+							Annotations: expectedAnnotations,
+						},
+					}},
+					ccv3.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("returns only the annotations", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(annotations).To(Equal(expectedAnnotations))
+			})
+		})
+
+		When("the domain has no metadata", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(
+					[]resources.Domain{{GUID: "some-guid"}},
+					ccv3.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("returns an empty map", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(annotations).To(BeEmpty())
+			})
+		})
+
+		When("getting the domain fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(
+					nil,
+					ccv3.Warnings{"warning-1"},
+					errors.New("get-domains-error"),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-domains-error"))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("GetSpaceAnnotations", func() {
+		JustBeforeEach(func() {
+			annotations, warnings, executeErr = actor.GetSpaceAnnotations(resourceName, "some-org-guid")
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{
+					GUID:     "some-space-guid",
+					Metadata: &resources.Metadata{Annotations: map[string]types.NullString{"owner": types.NewNullString("team-a")}},
+				}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("returns the space annotations", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+			Expect(annotations).To(Equal(map[string]types.NullString{"owner": types.NewNullString("team-a")}))
+		})
+	})
+})
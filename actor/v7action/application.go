@@ -383,6 +383,45 @@ func (actor Actor) PollProcesses(processes []resources.Process, handleInstanceDe
 	return numStableProcesses == numProcesses, allWarnings, nil
 }
 
+// MonitorApplicationHealth checks the instances of an application's processes
+// until duration has passed. It returns an ApplicationUnhealthyError as soon
+// as an instance crashes or goes down.
+func (actor Actor) MonitorApplicationHealth(app resources.Application, duration time.Duration) (Warnings, error) {
+	var allWarnings Warnings
+	processes, warnings, err := actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	timer := actor.Clock.NewTimer(time.Millisecond)
+	defer timer.Stop()
+	timeout := actor.Clock.After(duration)
+
+	for {
+		select {
+		case <-timeout:
+			return allWarnings, nil
+		case <-timer.C():
+			for _, process := range processes {
+				instances, warnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+				allWarnings = append(allWarnings, warnings...)
+				if err != nil {
+					return allWarnings, err
+				}
+
+				for _, instance := range instances {
+					if instance.State == constant.ProcessInstanceCrashed || instance.State == constant.ProcessInstanceDown {
+						return allWarnings, actionerror.ApplicationUnhealthyError{Name: app.Name}
+					}
+				}
+			}
+
+			timer.Reset(actor.Config.PollingInterval())
+		}
+	}
+}
+
 // UpdateApplication updates the buildpacks on an application
 func (actor Actor) UpdateApplication(app resources.Application) (resources.Application, Warnings, error) {
 	ccApp := resources.Application{
@@ -1791,6 +1791,112 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("MonitorApplicationHealth", func() {
+		var (
+			app  resources.Application
+			done chan bool
+
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			done = make(chan bool)
+			fakeConfig.PollingIntervalReturns(1 * time.Second)
+			app = resources.Application{GUID: "some-guid", Name: "some-app"}
+
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]resources.Process{
+					{GUID: "process1", Type: "web"},
+					{GUID: "process2", Type: "worker"},
+				},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceRunning},
+					{State: constant.ProcessInstanceStarting},
+				},
+				ccv3.Warnings{"instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			go func() {
+				defer close(done)
+				warnings, executeErr = actor.MonitorApplicationHealth(app, 2*time.Second)
+				done <- true
+			}()
+		})
+
+		When("the instances stay healthy for the duration", func() {
+			It("checks every process and returns the warnings", func() {
+				fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+				Eventually(fakeCloudControllerClient.GetProcessInstancesCallCount).Should(Equal(2))
+
+				fakeClock.WaitForNWatchersAndIncrement(2*time.Second, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ContainElements("get-app-warning", "instances-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-guid"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("process1"))
+				Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(1)).To(Equal("process2"))
+			})
+		})
+
+		When("an instance crashes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturnsOnCall(1,
+					[]ccv3.ProcessInstance{
+						{State: constant.ProcessInstanceRunning},
+						{State: constant.ProcessInstanceCrashed},
+					},
+					ccv3.Warnings{"crashed-warning"},
+					nil,
+				)
+			})
+
+			It("returns an unhealthy error straight away", func() {
+				fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError(actionerror.ApplicationUnhealthyError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning", "instances-warning", "crashed-warning"))
+			})
+		})
+
+		When("getting the process instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"instances-warning"}, errors.New("instances-error"))
+			})
+
+			It("returns the error", func() {
+				fakeClock.WaitForNWatchersAndIncrement(1*time.Millisecond, 2)
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("instances-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning", "instances-warning"))
+			})
+		})
+
+		When("getting the application processes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"get-app-warning"}, errors.New("processes-error"))
+			})
+
+			It("returns the error", func() {
+				Eventually(done).Should(Receive(BeTrue()))
+
+				Expect(executeErr).To(MatchError("processes-error"))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+	})
+
 	Describe("PollProcesses", func() {
 		var (
 			processes               []resources.Process
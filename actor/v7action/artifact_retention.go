@@ -0,0 +1,278 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/versioncheck"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// ArtifactType is the kind of application artifact stored in the blobstore.
+type ArtifactType string
+
+const (
+	DropletArtifact ArtifactType = "droplet"
+	PackageArtifact ArtifactType = "package"
+)
+
+// ArtifactRetentionPolicy decides which of an application's droplets or
+// packages are kept. When both rules are set, an artifact is only removed if
+// it falls outside of both of them.
+type ArtifactRetentionPolicy struct {
+	// Keep is the number of most recently created artifacts to keep per
+	// application. Zero means no artifact is kept because of its position.
+	Keep int
+	// MaxAge keeps artifacts created within it. Zero means no artifact is kept
+	// because of its age.
+	MaxAge time.Duration
+}
+
+// StaleArtifact is a droplet or package that an ArtifactRetentionPolicy does
+// not keep.
+type StaleArtifact struct {
+	Type      ArtifactType
+	GUID      string
+	AppName   string
+	State     string
+	CreatedAt time.Time
+	// Size is the space the artifact's bits take up in the blobstore. It is
+	// only set by GetStaleArtifactSizes, and stays unset if the size could not
+	// be determined.
+	Size types.NullUint64
+	// HasBits is false for docker artifacts, artifacts that never had bits
+	// uploaded and artifacts whose bits have expired.
+	HasBits bool
+}
+
+type artifactCandidate struct {
+	artifact  StaleArtifact
+	protected bool
+}
+
+// GetStaleArtifacts returns the droplets or packages of the named application
+// that the policy does not keep, oldest last. When appName is empty every
+// application in the space is checked. The current droplet, droplets of
+// deployable revisions, the newest ready package and artifacts that are still
+// being processed are always kept.
+func (actor Actor) GetStaleArtifacts(artifactType ArtifactType, appName string, spaceGUID string, policy ArtifactRetentionPolicy) ([]StaleArtifact, Warnings, error) {
+	var (
+		allWarnings Warnings
+		apps        []resources.Application
+	)
+
+	if appName != "" {
+		app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		apps = []resources.Application{app}
+	} else {
+		var (
+			warnings Warnings
+			err      error
+		)
+		apps, warnings, err = actor.GetApplicationsBySpace(spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+	}
+
+	staleArtifacts := []StaleArtifact{}
+	for _, app := range apps {
+		var (
+			candidates []artifactCandidate
+			warnings   Warnings
+			err        error
+		)
+
+		if artifactType == DropletArtifact {
+			candidates, warnings, err = actor.getDropletCandidates(app)
+		} else {
+			candidates, warnings, err = actor.getPackageCandidates(app)
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		now := actor.Clock.Now()
+		for i, candidate := range candidates {
+			keptByPosition := policy.Keep > 0 && i < policy.Keep
+			keptByAge := policy.MaxAge > 0 && now.Sub(candidate.artifact.CreatedAt) <= policy.MaxAge
+			if candidate.protected || keptByPosition || keptByAge {
+				continue
+			}
+			staleArtifacts = append(staleArtifacts, candidate.artifact)
+		}
+	}
+
+	return staleArtifacts, allWarnings, nil
+}
+
+// GetStaleArtifactSizes looks up how much blobstore space each artifact takes
+// up, without downloading its bits.
+func (actor Actor) GetStaleArtifactSizes(artifacts []StaleArtifact) []StaleArtifact {
+	sized := make([]StaleArtifact, len(artifacts))
+	for i, artifact := range artifacts {
+		sized[i] = artifact
+		if !artifact.HasBits {
+			sized[i].Size = types.NullUint64{IsSet: true, Value: 0}
+			continue
+		}
+
+		var (
+			size uint64
+			err  error
+		)
+		if artifact.Type == DropletArtifact {
+			size, err = actor.CloudControllerClient.GetDropletBitsSize(artifact.GUID)
+		} else {
+			size, err = actor.CloudControllerClient.GetPackageBitsSize(artifact.GUID)
+		}
+		if err == nil {
+			sized[i].Size = types.NullUint64{IsSet: true, Value: size}
+		}
+	}
+	return sized
+}
+
+// DeleteStaleArtifact deletes a droplet or package and its bits.
+func (actor Actor) DeleteStaleArtifact(artifact StaleArtifact) (Warnings, error) {
+	var (
+		allWarnings Warnings
+		jobURL      ccv3.JobURL
+		warnings    ccv3.Warnings
+		err         error
+	)
+
+	if artifact.Type == DropletArtifact {
+		jobURL, warnings, err = actor.CloudControllerClient.DeleteDroplet(artifact.GUID)
+	} else {
+		jobURL, warnings, err = actor.CloudControllerClient.DeletePackage(artifact.GUID)
+	}
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, warnings...)
+
+	return allWarnings, err
+}
+
+func (actor Actor) getDropletCandidates(app resources.Application) ([]artifactCandidate, Warnings, error) {
+	var allWarnings Warnings
+
+	droplets, warnings, err := actor.CloudControllerClient.GetDroplets(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{app.GUID}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	if len(droplets) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	protected := map[string]bool{}
+
+	currentDroplet, warnings, err := actor.CloudControllerClient.GetApplicationDropletCurrent(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(ccerror.DropletNotFoundError); !ok {
+			return nil, allWarnings, err
+		}
+	}
+	protected[currentDroplet.GUID] = true
+
+	revisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	deployableReported, err := versioncheck.IsMinimumAPIVersionMet(actor.Config.APIVersion(), MinimumCCAPIVersionForDeployable)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	dropletStates := map[string]constant.DropletState{}
+	for _, droplet := range droplets {
+		dropletStates[droplet.GUID] = droplet.State
+	}
+	for _, revision := range revisions {
+		deployable := revision.Deployable
+		if !deployableReported {
+			deployable = dropletStates[revision.Droplet.GUID] == constant.DropletStaged
+		}
+		if deployable {
+			protected[revision.Droplet.GUID] = true
+		}
+	}
+
+	var candidates []artifactCandidate
+	for _, droplet := range droplets {
+		createdAt, _ := time.Parse(time.RFC3339, droplet.CreatedAt)
+		processing := droplet.State == constant.DropletAwaitingUpload || droplet.State == constant.DropletCopying
+		candidates = append(candidates, artifactCandidate{
+			artifact: StaleArtifact{
+				Type:      DropletArtifact,
+				GUID:      droplet.GUID,
+				AppName:   app.Name,
+				State:     string(droplet.State),
+				CreatedAt: createdAt,
+				HasBits:   droplet.State == constant.DropletStaged && droplet.Image == "",
+			},
+			protected: protected[droplet.GUID] || processing,
+		})
+	}
+
+	return candidates, allWarnings, nil
+}
+
+func (actor Actor) getPackageCandidates(app resources.Application) ([]artifactCandidate, Warnings, error) {
+	packages, warnings, err := actor.CloudControllerClient.GetPackages(
+		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{app.GUID}},
+		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var (
+		candidates       []artifactCandidate
+		newestReadyFound bool
+	)
+	for _, pkg := range packages {
+		createdAt, _ := time.Parse(time.RFC3339, pkg.CreatedAt)
+		processing := pkg.State == constant.PackageAwaitingUpload || pkg.State == constant.PackageProcessingUpload || pkg.State == constant.PackageCopying
+
+		// restage and scale stage the newest ready package
+		newestReady := pkg.State == constant.PackageReady && !newestReadyFound
+		if newestReady {
+			newestReadyFound = true
+		}
+
+		candidates = append(candidates, artifactCandidate{
+			artifact: StaleArtifact{
+				Type:      PackageArtifact,
+				GUID:      pkg.GUID,
+				AppName:   app.Name,
+				State:     string(pkg.State),
+				CreatedAt: createdAt,
+				HasBits:   pkg.State == constant.PackageReady && pkg.Type != constant.PackageTypeDocker,
+			},
+			protected: newestReady || processing,
+		})
+	}
+
+	return candidates, Warnings(warnings), nil
+}
@@ -0,0 +1,351 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/clock/fakeclock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Artifact Retention Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+		fakeClock                 *fakeclock.FakeClock
+		now                       time.Time
+	)
+
+	daysAgo := func(days int) string {
+		return now.Add(-time.Duration(days) * 24 * time.Hour).Format(time.RFC3339)
+	}
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, fakeConfig, _, _, _, fakeClock = NewTestActor()
+		now = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		fakeClock.Increment(now.Sub(fakeClock.Now()))
+		fakeConfig.APIVersionReturns("3.100.0")
+	})
+
+	Describe("GetStaleArtifacts", func() {
+		var (
+			artifactType ArtifactType
+			appName      string
+			policy       ArtifactRetentionPolicy
+
+			staleArtifacts []StaleArtifact
+			warnings       Warnings
+			executeErr     error
+		)
+
+		BeforeEach(func() {
+			appName = "some-app"
+			policy = ArtifactRetentionPolicy{Keep: 2}
+
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{Name: "some-app", GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-app-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			staleArtifacts, warnings, executeErr = actor.GetStaleArtifacts(artifactType, appName, "some-space-guid", policy)
+		})
+
+		When("looking for droplets", func() {
+			BeforeEach(func() {
+				artifactType = DropletArtifact
+
+				fakeCloudControllerClient.GetDropletsReturns(
+					[]resources.Droplet{
+						{GUID: "droplet-6", State: constant.DropletAwaitingUpload, CreatedAt: daysAgo(1)},
+						{GUID: "droplet-5", State: constant.DropletStaged, CreatedAt: daysAgo(2)},
+						{GUID: "droplet-4", State: constant.DropletStaged, CreatedAt: daysAgo(10)},
+						{GUID: "droplet-3", State: constant.DropletStaged, CreatedAt: daysAgo(20)},
+						{GUID: "droplet-2", State: constant.DropletFailed, CreatedAt: daysAgo(30)},
+						{GUID: "droplet-1", State: constant.DropletStaged, CreatedAt: daysAgo(40)},
+					},
+					ccv3.Warnings{"get-droplets-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+					resources.Droplet{GUID: "droplet-4"},
+					ccv3.Warnings{"get-current-droplet-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(
+					[]resources.Revision{
+						{Version: 2, Deployable: true, Droplet: resources.Droplet{GUID: "droplet-3"}},
+						{Version: 1, Deployable: false, Droplet: resources.Droplet{GUID: "droplet-1"}},
+					},
+					ccv3.Warnings{"get-revisions-warning"},
+					nil,
+				)
+			})
+
+			It("looks up the app's droplets, current droplet and revisions", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-droplets-warning", "get-current-droplet-warning", "get-revisions-warning"))
+
+				Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+				))
+				Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+				appGUID, _ := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+
+			It("keeps the newest droplets, the current droplet and droplets of deployable revisions", func() {
+				Expect(staleArtifacts).To(Equal([]StaleArtifact{
+					{Type: DropletArtifact, GUID: "droplet-2", AppName: "some-app", State: "FAILED", CreatedAt: now.Add(-30 * 24 * time.Hour)},
+					{Type: DropletArtifact, GUID: "droplet-1", AppName: "some-app", State: "STAGED", CreatedAt: now.Add(-40 * 24 * time.Hour), HasBits: true},
+				}))
+			})
+
+			When("a maximum age is given as well", func() {
+				BeforeEach(func() {
+					policy = ArtifactRetentionPolicy{Keep: 1, MaxAge: 35 * 24 * time.Hour}
+				})
+
+				It("only returns droplets outside of both rules", func() {
+					Expect(staleArtifacts).To(HaveLen(1))
+					Expect(staleArtifacts[0].GUID).To(Equal("droplet-1"))
+				})
+			})
+
+			When("only a maximum age is given", func() {
+				BeforeEach(func() {
+					policy = ArtifactRetentionPolicy{MaxAge: 5 * 24 * time.Hour}
+				})
+
+				It("returns every unprotected droplet older than it", func() {
+					var guids []string
+					for _, artifact := range staleArtifacts {
+						guids = append(guids, artifact.GUID)
+					}
+					Expect(guids).To(Equal([]string{"droplet-2", "droplet-1"}))
+				})
+			})
+
+			When("the Cloud Controller does not report whether revisions are deployable", func() {
+				BeforeEach(func() {
+					fakeConfig.APIVersionReturns("3.85.0")
+					fakeCloudControllerClient.GetApplicationRevisionsReturns(
+						[]resources.Revision{
+							{Version: 2, Droplet: resources.Droplet{GUID: "droplet-2"}},
+							{Version: 1, Droplet: resources.Droplet{GUID: "droplet-1"}},
+						},
+						nil,
+						nil,
+					)
+				})
+
+				It("keeps the droplets of revisions whose droplet is staged", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					var guids []string
+					for _, artifact := range staleArtifacts {
+						guids = append(guids, artifact.GUID)
+					}
+					Expect(guids).To(Equal([]string{"droplet-3", "droplet-2"}))
+				})
+			})
+
+			When("the app has no current droplet", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationDropletCurrentReturns(resources.Droplet{}, nil, ccerror.DropletNotFoundError{})
+				})
+
+				It("does not protect any droplet for it", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					var guids []string
+					for _, artifact := range staleArtifacts {
+						guids = append(guids, artifact.GUID)
+					}
+					Expect(guids).To(Equal([]string{"droplet-4", "droplet-2", "droplet-1"}))
+				})
+			})
+
+			When("getting the revisions fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"get-revisions-warning"}, errors.New("revisions-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("revisions-error"))
+					Expect(warnings).To(ContainElement("get-revisions-warning"))
+				})
+			})
+		})
+
+		When("looking for packages", func() {
+			BeforeEach(func() {
+				artifactType = PackageArtifact
+				policy = ArtifactRetentionPolicy{Keep: 1}
+
+				fakeCloudControllerClient.GetPackagesReturns(
+					[]resources.Package{
+						{GUID: "package-4", State: constant.PackageProcessingUpload, CreatedAt: daysAgo(1)},
+						{GUID: "package-3", State: constant.PackageFailed, CreatedAt: daysAgo(2)},
+						{GUID: "package-2", State: constant.PackageReady, Type: constant.PackageTypeBits, CreatedAt: daysAgo(3)},
+						{GUID: "package-1", State: constant.PackageReady, Type: constant.PackageTypeBits, CreatedAt: daysAgo(4)},
+					},
+					ccv3.Warnings{"get-packages-warning"},
+					nil,
+				)
+			})
+
+			It("keeps the newest packages, the newest ready package and packages being processed", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning", "get-packages-warning"))
+				Expect(staleArtifacts).To(Equal([]StaleArtifact{
+					{Type: PackageArtifact, GUID: "package-3", AppName: "some-app", State: "FAILED", CreatedAt: now.Add(-2 * 24 * time.Hour)},
+					{Type: PackageArtifact, GUID: "package-1", AppName: "some-app", State: "READY", CreatedAt: now.Add(-4 * 24 * time.Hour), HasBits: true},
+				}))
+			})
+		})
+
+		When("no app name is given", func() {
+			BeforeEach(func() {
+				appName = ""
+				artifactType = PackageArtifact
+				policy = ArtifactRetentionPolicy{Keep: 1}
+
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{
+						{Name: "app-1", GUID: "app-1-guid"},
+						{Name: "app-2", GUID: "app-2-guid"},
+					},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetPackagesReturnsOnCall(0, []resources.Package{
+					{GUID: "package-a2", State: constant.PackageReady, CreatedAt: daysAgo(1)},
+					{GUID: "package-a1", State: constant.PackageReady, CreatedAt: daysAgo(2)},
+				}, nil, nil)
+				fakeCloudControllerClient.GetPackagesReturnsOnCall(1, []resources.Package{
+					{GUID: "package-b2", State: constant.PackageReady, CreatedAt: daysAgo(1)},
+					{GUID: "package-b1", State: constant.PackageReady, CreatedAt: daysAgo(2)},
+				}, nil, nil)
+			})
+
+			It("checks every app in the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+				Expect(staleArtifacts).To(HaveLen(2))
+				Expect(staleArtifacts[0].AppName).To(Equal("app-1"))
+				Expect(staleArtifacts[0].GUID).To(Equal("package-a1"))
+				Expect(staleArtifacts[1].AppName).To(Equal("app-2"))
+				Expect(staleArtifacts[1].GUID).To(Equal("package-b1"))
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+			})
+		})
+	})
+
+	Describe("GetStaleArtifactSizes", func() {
+		var sized []StaleArtifact
+
+		JustBeforeEach(func() {
+			sized = actor.GetStaleArtifactSizes([]StaleArtifact{
+				{Type: DropletArtifact, GUID: "droplet-guid", HasBits: true},
+				{Type: PackageArtifact, GUID: "package-guid", HasBits: true},
+				{Type: DropletArtifact, GUID: "failed-droplet-guid"},
+			})
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetDropletBitsSizeReturns(2048, nil)
+			fakeCloudControllerClient.GetPackageBitsSizeReturns(0, errors.New("blobstore-error"))
+		})
+
+		It("looks up the size of artifacts with bits", func() {
+			Expect(fakeCloudControllerClient.GetDropletBitsSizeCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetDropletBitsSizeArgsForCall(0)).To(Equal("droplet-guid"))
+			Expect(fakeCloudControllerClient.GetPackageBitsSizeCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.GetPackageBitsSizeArgsForCall(0)).To(Equal("package-guid"))
+
+			Expect(sized[0].Size).To(Equal(types.NullUint64{IsSet: true, Value: 2048}))
+			Expect(sized[1].Size).To(Equal(types.NullUint64{}))
+			Expect(sized[2].Size).To(Equal(types.NullUint64{IsSet: true, Value: 0}))
+		})
+	})
+
+	Describe("DeleteStaleArtifact", func() {
+		var (
+			artifact   StaleArtifact
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteStaleArtifact(artifact)
+		})
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteDropletReturns("droplet-job-url", ccv3.Warnings{"delete-droplet-warning"}, nil)
+			fakeCloudControllerClient.DeletePackageReturns("package-job-url", ccv3.Warnings{"delete-package-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		When("the artifact is a droplet", func() {
+			BeforeEach(func() {
+				artifact = StaleArtifact{Type: DropletArtifact, GUID: "droplet-guid"}
+			})
+
+			It("deletes the droplet and waits for the job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-droplet-warning", "poll-warning"))
+				Expect(fakeCloudControllerClient.DeleteDropletArgsForCall(0)).To(Equal("droplet-guid"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("droplet-job-url")))
+			})
+		})
+
+		When("the artifact is a package", func() {
+			BeforeEach(func() {
+				artifact = StaleArtifact{Type: PackageArtifact, GUID: "package-guid"}
+			})
+
+			It("deletes the package and waits for the job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("delete-package-warning", "poll-warning"))
+				Expect(fakeCloudControllerClient.DeletePackageArgsForCall(0)).To(Equal("package-guid"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("package-job-url")))
+			})
+
+			When("the delete fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.DeletePackageReturns("", ccv3.Warnings{"delete-package-warning"}, errors.New("delete-error"))
+				})
+
+				It("returns the error without polling", func() {
+					Expect(executeErr).To(MatchError("delete-error"))
+					Expect(warnings).To(ConsistOf("delete-package-warning"))
+					Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -12,6 +12,12 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
+// ClientKeyFileCredential is the credentials key holding the path to a
+// private key for private_key_jwt client authentication. When it is present,
+// Authenticate signs a client assertion with the key instead of sending a
+// client secret, and remembers the key so that the token can be refreshed.
+const ClientKeyFileCredential = "client_key_file"
+
 type defaultAuthActor struct {
 	config    Config
 	uaaClient UAAClient
@@ -25,8 +31,20 @@ func NewDefaultAuthActor(config Config, uaaClient UAAClient) AuthActor {
 }
 
 func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) error {
-	if grantType == constant.GrantTypePassword && actor.config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
-		return actionerror.PasswordGrantTypeLogoutRequiredError{}
+	if grantType == constant.GrantTypePassword {
+		currentGrantType := actor.config.UAAGrantType()
+		if currentGrantType != "" && currentGrantType != string(constant.GrantTypePassword) {
+			return actionerror.PasswordGrantTypeLogoutRequiredError{}
+		}
+	}
+
+	keyFile := credentials[ClientKeyFileCredential]
+	if keyFile != "" {
+		var err error
+		credentials, err = actor.clientAssertionCredentials(credentials, keyFile)
+		if err != nil {
+			return err
+		}
 	}
 
 	actor.config.UnsetOrganizationAndSpaceInformation()
@@ -43,15 +61,36 @@ func (actor defaultAuthActor) Authenticate(credentials map[string]string, origin
 		actor.config.SetUAAGrantType("")
 	} else {
 		actor.config.SetUAAGrantType(string(grantType))
-	}
-
-	if grantType == constant.GrantTypeClientCredentials {
+		// the client secret is not stored; only a client that signed an
+		// assertion with a key file can authenticate again on refresh
 		actor.config.SetUAAClientCredentials(credentials["client_id"], "")
+		actor.config.SetUAAClientKeyFile(keyFile)
 	}
 
 	return nil
 }
 
+// clientAssertionCredentials returns a copy of credentials in which the
+// client key file has been replaced by a client assertion signed with it.
+func (actor defaultAuthActor) clientAssertionCredentials(credentials map[string]string, keyFile string) (map[string]string, error) {
+	assertion, err := actor.uaaClient.ClientAssertion(credentials["client_id"], keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	signedCredentials := map[string]string{
+		"client_assertion":      assertion,
+		"client_assertion_type": constant.ClientAssertionTypeJWTBearer,
+	}
+	for key, value := range credentials {
+		if key != ClientKeyFileCredential && key != "client_secret" {
+			signedCredentials[key] = value
+		}
+	}
+
+	return signedCredentials, nil
+}
+
 func (actor defaultAuthActor) GetLoginPrompts() (map[string]coreconfig.AuthPrompt, error) {
 	rawPrompts, err := actor.uaaClient.GetLoginPrompts()
 	if err != nil {
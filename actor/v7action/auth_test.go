@@ -92,6 +92,17 @@ var _ = Describe("Default Auth Actions", func() {
 						Expect(fakeConfig.UAAGrantTypeCallCount()).To(Equal(1))
 					})
 				})
+
+				When("a previous user authenticated with an assertion grant type", func() {
+					BeforeEach(func() {
+						fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypeJWTBearer))
+					})
+
+					It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+						Expect(actualErr).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+						Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+					})
+				})
 			})
 
 			When("the grant type is not password", func() {
@@ -106,6 +117,58 @@ var _ = Describe("Default Auth Actions", func() {
 					Expect(clientSecret).To(BeEmpty())
 					Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
 					Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal(string(constant.GrantTypeClientCredentials)))
+					Expect(fakeConfig.SetUAAClientKeyFileCallCount()).To(Equal(1))
+					Expect(fakeConfig.SetUAAClientKeyFileArgsForCall(0)).To(BeEmpty())
+				})
+
+				When("a client key file is provided", func() {
+					BeforeEach(func() {
+						grantType = constant.GrantTypeJWTBearer
+						creds = map[string]string{
+							"client_id":             "some-client-id",
+							"assertion":             "some-assertion",
+							ClientKeyFileCredential: "/some/client-key.pem",
+						}
+						fakeUAAClient.ClientAssertionReturns("some-client-assertion", nil)
+					})
+
+					It("authenticates the client with an assertion signed by the key", func() {
+						Expect(actualErr).NotTo(HaveOccurred())
+
+						Expect(fakeUAAClient.ClientAssertionCallCount()).To(Equal(1))
+						clientID, keyFile := fakeUAAClient.ClientAssertionArgsForCall(0)
+						Expect(clientID).To(Equal("some-client-id"))
+						Expect(keyFile).To(Equal("/some/client-key.pem"))
+
+						uaaCredentials, _, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+						Expect(passedGrantType).To(Equal(constant.GrantTypeJWTBearer))
+						Expect(uaaCredentials).To(Equal(map[string]string{
+							"client_id":             "some-client-id",
+							"assertion":             "some-assertion",
+							"client_assertion":      "some-client-assertion",
+							"client_assertion_type": constant.ClientAssertionTypeJWTBearer,
+						}))
+					})
+
+					It("stores the client id and key file without a secret", func() {
+						client, clientSecret := fakeConfig.SetUAAClientCredentialsArgsForCall(0)
+						Expect(client).To(Equal("some-client-id"))
+						Expect(clientSecret).To(BeEmpty())
+						Expect(fakeConfig.SetUAAClientKeyFileArgsForCall(0)).To(Equal("/some/client-key.pem"))
+						Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal(string(constant.GrantTypeJWTBearer)))
+					})
+
+					When("signing the assertion fails", func() {
+						BeforeEach(func() {
+							fakeUAAClient.ClientAssertionReturns("", errors.New("bad key"))
+						})
+
+						It("returns the error without authenticating", func() {
+							Expect(actualErr).To(MatchError("bad key"))
+							Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(0))
+							Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(0))
+						})
+					})
 				})
 			})
 
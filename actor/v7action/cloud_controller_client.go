@@ -46,11 +46,13 @@ type CloudControllerClient interface {
 	DeleteApplicationProcessInstance(appGUID string, processType string, instanceIndex int) (ccv3.Warnings, error)
 	DeleteBuildpack(buildpackGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteDomain(domainGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	DeleteDroplet(dropletGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteIsolationSegment(guid string) (ccv3.Warnings, error)
 	DeleteIsolationSegmentOrganization(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error)
 	DeleteOrganization(orgGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteOrganizationQuota(quotaGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteOrphanedRoutes(spaceGUID string) (ccv3.JobURL, ccv3.Warnings, error)
+	DeletePackage(packageGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteRole(roleGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteRoute(routeGUID string) (ccv3.JobURL, ccv3.Warnings, error)
 	DeleteRouteBinding(guid string) (ccv3.JobURL, ccv3.Warnings, error)
@@ -82,6 +84,7 @@ type CloudControllerClient interface {
 	GetDomain(GUID string) (resources.Domain, ccv3.Warnings, error)
 	GetDomains(query ...ccv3.Query) ([]resources.Domain, ccv3.Warnings, error)
 	GetDroplet(guid string) (resources.Droplet, ccv3.Warnings, error)
+	GetDropletBitsSize(dropletGUID string) (uint64, error)
 	GetDroplets(query ...ccv3.Query) ([]resources.Droplet, ccv3.Warnings, error)
 	GetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName) (resources.EnvironmentVariables, ccv3.Warnings, error)
 	GetEvents(query ...ccv3.Query) ([]ccv3.Event, ccv3.Warnings, error)
@@ -98,16 +101,20 @@ type CloudControllerClient interface {
 	GetOrganizationQuota(quotaGUID string) (resources.OrganizationQuota, ccv3.Warnings, error)
 	GetOrganizationQuotas(query ...ccv3.Query) ([]resources.OrganizationQuota, ccv3.Warnings, error)
 	GetOrganizations(query ...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error)
+	GetOrganizationUsageSummary(orgGUID string) (resources.UsageSummary, ccv3.Warnings, error)
 	GetPackage(guid string) (resources.Package, ccv3.Warnings, error)
+	GetPackageBitsSize(packageGUID string) (uint64, error)
 	GetPackages(query ...ccv3.Query) ([]resources.Package, ccv3.Warnings, error)
 	GetPackageDroplets(packageGUID string, query ...ccv3.Query) ([]resources.Droplet, ccv3.Warnings, error)
 	GetProcess(processGUID string) (resources.Process, ccv3.Warnings, error)
 	GetProcesses(query ...ccv3.Query) ([]resources.Process, ccv3.Warnings, error)
 	GetProcessInstances(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error)
 	GetProcessSidecars(processGUID string) ([]resources.Sidecar, ccv3.Warnings, error)
+	GetRevisionEnvironmentVariables(revisionGUID string) (resources.EnvironmentVariables, ccv3.Warnings, error)
 	GetRoles(query ...ccv3.Query) ([]resources.Role, ccv3.IncludedResources, ccv3.Warnings, error)
 	GetRouteBindings(query ...ccv3.Query) ([]resources.RouteBinding, ccv3.IncludedResources, ccv3.Warnings, error)
 	GetRouteDestinations(routeGUID string) ([]resources.RouteDestination, ccv3.Warnings, error)
+	GetRouteSharedSpaces(routeGUID string) ([]string, ccv3.Warnings, error)
 	GetRoutes(query ...ccv3.Query) ([]resources.Route, ccv3.Warnings, error)
 	GetRunningSecurityGroups(spaceGUID string, queries ...ccv3.Query) ([]resources.SecurityGroup, ccv3.Warnings, error)
 	GetSecurityGroups(query ...ccv3.Query) ([]resources.SecurityGroup, ccv3.Warnings, error)
@@ -132,6 +139,7 @@ type CloudControllerClient interface {
 	GetSpaceQuota(spaceQuotaGUID string) (resources.SpaceQuota, ccv3.Warnings, error)
 	GetSpaces(query ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error)
 	GetSpaceQuotas(query ...ccv3.Query) ([]resources.SpaceQuota, ccv3.Warnings, error)
+	GetSpaceUsageSummary(spaceGUID string) (resources.UsageSummary, ccv3.Warnings, error)
 	GetSSHEnabled(appGUID string) (ccv3.SSHEnabled, ccv3.Warnings, error)
 	GetAppFeature(appGUID string, featureName string) (resources.ApplicationFeature, ccv3.Warnings, error)
 	GetStacks(query ...ccv3.Query) ([]resources.Stack, ccv3.Warnings, error)
@@ -146,6 +154,7 @@ type CloudControllerClient interface {
 	PollJobForState(jobURL ccv3.JobURL, state constant.JobState) (ccv3.Warnings, error)
 	PollJobToEventStream(jobURL ccv3.JobURL) chan ccv3.PollJobEvent
 	PurgeServiceOffering(serviceOfferingGUID string) (ccv3.Warnings, error)
+	ReplaceRouteDestinations(routeGUID string, destinations []resources.RouteDestination) (ccv3.Warnings, error)
 	ResourceMatch(resources []ccv3.Resource) ([]ccv3.Resource, ccv3.Warnings, error)
 	RootResponse() (ccv3.Info, ccv3.Warnings, error)
 	SetApplicationDroplet(appGUID string, dropletGUID string) (resources.Relationship, ccv3.Warnings, error)
@@ -21,6 +21,7 @@ type Config interface {
 	SetTargetInformation(args configv3.TargetInformationArgs)
 	SetTokenInformation(accessToken string, refreshToken string, token string)
 	SetUAAClientCredentials(client string, clientSecret string)
+	SetUAAClientKeyFile(keyFile string)
 	SetUAAGrantType(grantType string)
 	SkipSSLValidation() bool
 	StagingTimeout() time.Duration
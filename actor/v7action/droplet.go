@@ -48,6 +48,12 @@ func (actor Actor) SetApplicationDroplet(appGUID string, dropletGUID string) (Wa
 
 // GetApplicationDroplets returns the list of droplets that belong to application.
 func (actor Actor) GetApplicationDroplets(appName string, spaceGUID string) ([]resources.Droplet, Warnings, error) {
+	return actor.GetApplicationDropletsWithLabelSelector(appName, spaceGUID, "")
+}
+
+// GetApplicationDropletsWithLabelSelector returns the list of droplets that
+// belong to application and match the label selector.
+func (actor Actor) GetApplicationDropletsWithLabelSelector(appName string, spaceGUID string, labelSelector string) ([]resources.Droplet, Warnings, error) {
 	allWarnings := Warnings{}
 	application, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	allWarnings = append(allWarnings, warnings...)
@@ -55,10 +61,15 @@ func (actor Actor) GetApplicationDroplets(appName string, spaceGUID string) ([]r
 		return nil, allWarnings, err
 	}
 
-	droplets, apiWarnings, err := actor.CloudControllerClient.GetDroplets(
-		ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{application.GUID}},
-		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
-	)
+	queries := []ccv3.Query{
+		{Key: ccv3.AppGUIDFilter, Values: []string{application.GUID}},
+		{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+	}
+	if len(labelSelector) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}})
+	}
+
+	droplets, apiWarnings, err := actor.CloudControllerClient.GetDroplets(queries...)
 	actorWarnings := Warnings(apiWarnings)
 	allWarnings = append(allWarnings, actorWarnings...)
 	if err != nil {
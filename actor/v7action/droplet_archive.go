@@ -0,0 +1,250 @@
+package v7action
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+var dropletBuildpackConfigRegexp = regexp.MustCompile(`^deps/(\d+)/config\.yml$`)
+
+// DropletArchive is the contents of a droplet tgz, as returned by
+// DownloadCurrentDropletByAppName or saved by download-droplet.
+type DropletArchive struct {
+	StagingInfo DropletStagingInfo
+	// Buildpacks are the buildpacks that supplied dependencies to the
+	// droplet, in the order they ran.
+	Buildpacks []DropletBuildpack
+	// Files are sorted by path.
+	Files []DropletFile
+}
+
+// DropletStagingInfo is the staging_info.yml written by the buildpack
+// lifecycle.
+type DropletStagingInfo struct {
+	DetectedBuildpack string `yaml:"detected_buildpack"`
+	StartCommand      string `yaml:"start_command"`
+}
+
+type DropletBuildpack struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+type DropletFile struct {
+	Path       string
+	Mode       os.FileMode
+	Size       int64
+	SHA1       string
+	LinkTarget string
+}
+
+type DropletFileChange string
+
+const (
+	// DropletFileAdded files are only in the other droplet.
+	DropletFileAdded DropletFileChange = "added"
+	// DropletFileRemoved files are only in the original droplet.
+	DropletFileRemoved DropletFileChange = "removed"
+	// DropletFileModified files differ in content, mode or link target.
+	DropletFileModified DropletFileChange = "modified"
+)
+
+type DropletFileDiff struct {
+	Path     string
+	Change   DropletFileChange
+	Original DropletFile
+	Other    DropletFile
+}
+
+// ReadDropletArchive lists the files in a droplet and reads its staging info.
+func (actor Actor) ReadDropletArchive(rawDroplet []byte) (DropletArchive, error) {
+	var (
+		archive    DropletArchive
+		buildpacks = map[int]DropletBuildpack{}
+	)
+
+	err := walkDroplet(rawDroplet, func(header *tar.Header, name string, reader io.Reader) error {
+		file := DropletFile{
+			Path:       name,
+			Mode:       header.FileInfo().Mode(),
+			LinkTarget: header.Linkname,
+		}
+
+		if header.Typeflag == tar.TypeReg {
+			isStagingInfo := name == "staging_info.yml"
+			isBuildpackConfig := dropletBuildpackConfigRegexp.MatchString(name)
+
+			// only the small config files are kept; everything else is hashed
+			// as it is read
+			var contents bytes.Buffer
+			hash := sha1.New()
+			writer := io.Writer(hash)
+			if isStagingInfo || isBuildpackConfig {
+				writer = io.MultiWriter(hash, &contents)
+			}
+
+			size, err := io.Copy(writer, reader)
+			if err != nil {
+				return err
+			}
+			file.Size = size
+			file.SHA1 = fmt.Sprintf("%x", hash.Sum(nil))
+
+			switch {
+			case isStagingInfo:
+				if err := yaml.Unmarshal(contents.Bytes(), &archive.StagingInfo); err != nil {
+					return fmt.Errorf("reading staging_info.yml: %s", err)
+				}
+			case isBuildpackConfig:
+				var buildpack DropletBuildpack
+				if err := yaml.Unmarshal(contents.Bytes(), &buildpack); err == nil && buildpack.Name != "" {
+					index, _ := strconv.Atoi(dropletBuildpackConfigRegexp.FindStringSubmatch(name)[1])
+					buildpacks[index] = buildpack
+				}
+			}
+		}
+
+		archive.Files = append(archive.Files, file)
+		return nil
+	})
+	if err != nil {
+		return DropletArchive{}, err
+	}
+
+	var indexes []int
+	for index := range buildpacks {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	for _, index := range indexes {
+		archive.Buildpacks = append(archive.Buildpacks, buildpacks[index])
+	}
+
+	sort.Slice(archive.Files, func(i, j int) bool {
+		return archive.Files[i].Path < archive.Files[j].Path
+	})
+
+	return archive, nil
+}
+
+// DiffDropletArchives compares two droplets file by file. The differences
+// describe how to get from original to other and are sorted by path.
+func (actor Actor) DiffDropletArchives(original DropletArchive, other DropletArchive) []DropletFileDiff {
+	otherFiles := map[string]DropletFile{}
+	for _, file := range other.Files {
+		otherFiles[file.Path] = file
+	}
+
+	var diffs []DropletFileDiff
+	for _, file := range original.Files {
+		otherFile, ok := otherFiles[file.Path]
+		delete(otherFiles, file.Path)
+
+		switch {
+		case !ok:
+			diffs = append(diffs, DropletFileDiff{Path: file.Path, Change: DropletFileRemoved, Original: file})
+		case file.SHA1 != otherFile.SHA1 || file.Mode != otherFile.Mode || file.LinkTarget != otherFile.LinkTarget:
+			diffs = append(diffs, DropletFileDiff{Path: file.Path, Change: DropletFileModified, Original: file, Other: otherFile})
+		}
+	}
+
+	for _, file := range otherFiles {
+		diffs = append(diffs, DropletFileDiff{Path: file.Path, Change: DropletFileAdded, Other: file})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+	return diffs
+}
+
+// ExtractDropletFiles writes the regular files in a droplet that match any of
+// the patterns to destination and returns their paths within the droplet.
+// A pattern is a path or glob as understood by path.Match; files in a
+// matching directory are extracted as well.
+func (actor Actor) ExtractDropletFiles(rawDroplet []byte, patterns []string, destination string) ([]string, error) {
+	var extracted []string
+
+	err := walkDroplet(rawDroplet, func(header *tar.Header, name string, reader io.Reader) error {
+		if header.Typeflag != tar.TypeReg || !dropletPathMatches(name, patterns) {
+			return nil
+		}
+
+		target := filepath.Join(destination, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+
+		file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, header.FileInfo().Mode().Perm())
+		if err != nil {
+			return err
+		}
+		if _, err := io.Copy(file, reader); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+
+		extracted = append(extracted, name)
+		return nil
+	})
+
+	return extracted, err
+}
+
+func walkDroplet(rawDroplet []byte, walkFunc func(header *tar.Header, name string, reader io.Reader) error) error {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(rawDroplet))
+	if err != nil {
+		return fmt.Errorf("droplet is not a gzipped tar file: %s", err)
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("droplet is not a gzipped tar file: %s", err)
+		}
+
+		// cleaning the name as an absolute path drops any ".." that would
+		// lead outside of the droplet
+		name := strings.Trim(path.Clean("/"+header.Name), "/")
+		if name == "" {
+			continue
+		}
+
+		if err := walkFunc(header, name, tarReader); err != nil {
+			return err
+		}
+	}
+}
+
+func dropletPathMatches(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.Trim(path.Clean("/"+pattern), "/")
+		for candidate := name; candidate != "."; candidate = path.Dir(candidate) {
+			if matched, _ := path.Match(pattern, candidate); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
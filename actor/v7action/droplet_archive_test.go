@@ -0,0 +1,179 @@
+package v7action_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type dropletEntry struct {
+	name     string
+	contents string
+	mode     int64
+	typeflag byte
+	linkname string
+}
+
+func buildDroplet(entries ...dropletEntry) []byte {
+	var buffer bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buffer)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for _, entry := range entries {
+		header := &tar.Header{
+			Name:     entry.name,
+			Mode:     entry.mode,
+			Typeflag: entry.typeflag,
+			Linkname: entry.linkname,
+		}
+		if header.Typeflag == 0 {
+			header.Typeflag = tar.TypeReg
+		}
+		if header.Mode == 0 {
+			header.Mode = 0644
+		}
+		if header.Typeflag == tar.TypeReg {
+			header.Size = int64(len(entry.contents))
+		}
+		Expect(tarWriter.WriteHeader(header)).To(Succeed())
+		if header.Typeflag == tar.TypeReg {
+			_, err := tarWriter.Write([]byte(entry.contents))
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+	return buffer.Bytes()
+}
+
+var _ = Describe("Droplet Archive Actions", func() {
+	var (
+		actor      *Actor
+		rawDroplet []byte
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(nil, nil, nil, nil, nil, nil)
+		rawDroplet = buildDroplet(
+			dropletEntry{name: "./", typeflag: tar.TypeDir, mode: 0755},
+			dropletEntry{name: "./staging_info.yml", contents: `{"detected_buildpack":"ruby","start_command":"bundle exec rackup"}`},
+			dropletEntry{name: "./app/", typeflag: tar.TypeDir, mode: 0755},
+			dropletEntry{name: "./app/config/app.yml", contents: "port: 8080"},
+			dropletEntry{name: "./app/bin/run", contents: "#!/bin/sh", mode: 0755},
+			dropletEntry{name: "./app/current", typeflag: tar.TypeSymlink, linkname: "bin", mode: 0777},
+			dropletEntry{name: "./deps/1/config.yml", contents: "name: ruby\nversion: 1.10.0\n"},
+			dropletEntry{name: "./deps/0/config.yml", contents: "name: nodejs\nversion: 1.8.2\n"},
+		)
+	})
+
+	Describe("ReadDropletArchive", func() {
+		It("lists the files sorted by path", func() {
+			archive, err := actor.ReadDropletArchive(rawDroplet)
+			Expect(err).ToNot(HaveOccurred())
+
+			var paths []string
+			for _, file := range archive.Files {
+				paths = append(paths, file.Path)
+			}
+			Expect(paths).To(Equal([]string{
+				"app",
+				"app/bin/run",
+				"app/config/app.yml",
+				"app/current",
+				"deps/0/config.yml",
+				"deps/1/config.yml",
+				"staging_info.yml",
+			}))
+
+			Expect(archive.Files[1].Size).To(BeNumerically("==", len("#!/bin/sh")))
+			Expect(archive.Files[1].Mode.Perm()).To(Equal(os.FileMode(0755)))
+			Expect(archive.Files[1].SHA1).To(HaveLen(40))
+			Expect(archive.Files[3].LinkTarget).To(Equal("bin"))
+			Expect(archive.Files[3].Mode & os.ModeSymlink).ToNot(BeZero())
+		})
+
+		It("reads the staging info and the buildpacks in the order they ran", func() {
+			archive, err := actor.ReadDropletArchive(rawDroplet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(archive.StagingInfo).To(Equal(DropletStagingInfo{DetectedBuildpack: "ruby", StartCommand: "bundle exec rackup"}))
+			Expect(archive.Buildpacks).To(Equal([]DropletBuildpack{
+				{Name: "nodejs", Version: "1.8.2"},
+				{Name: "ruby", Version: "1.10.0"},
+			}))
+		})
+
+		When("the droplet is not a tgz", func() {
+			It("returns an error", func() {
+				_, err := actor.ReadDropletArchive([]byte("not a droplet"))
+				Expect(err).To(MatchError(ContainSubstring("droplet is not a gzipped tar file")))
+			})
+		})
+	})
+
+	Describe("DiffDropletArchives", func() {
+		It("returns added, removed and modified files", func() {
+			original, err := actor.ReadDropletArchive(rawDroplet)
+			Expect(err).ToNot(HaveOccurred())
+
+			other, err := actor.ReadDropletArchive(buildDroplet(
+				dropletEntry{name: "./staging_info.yml", contents: `{"detected_buildpack":"ruby","start_command":"bundle exec rackup"}`},
+				dropletEntry{name: "./app/", typeflag: tar.TypeDir, mode: 0755},
+				dropletEntry{name: "./app/config/app.yml", contents: "port: 9090"},
+				dropletEntry{name: "./app/bin/run", contents: "#!/bin/sh", mode: 0644},
+				dropletEntry{name: "./app/current", typeflag: tar.TypeSymlink, linkname: "bin", mode: 0777},
+				dropletEntry{name: "./app/new.txt", contents: "new"},
+				dropletEntry{name: "./deps/0/config.yml", contents: "name: nodejs\nversion: 1.8.2\n"},
+			))
+			Expect(err).ToNot(HaveOccurred())
+
+			diffs := actor.DiffDropletArchives(original, other)
+			var changes []string
+			for _, diff := range diffs {
+				changes = append(changes, string(diff.Change)+" "+diff.Path)
+			}
+			Expect(changes).To(Equal([]string{
+				"modified app/bin/run",
+				"modified app/config/app.yml",
+				"added app/new.txt",
+				"removed deps/1/config.yml",
+			}))
+			Expect(diffs[0].Original.Mode.Perm()).To(Equal(os.FileMode(0755)))
+			Expect(diffs[0].Other.Mode.Perm()).To(Equal(os.FileMode(0644)))
+		})
+	})
+
+	Describe("ExtractDropletFiles", func() {
+		var destination string
+
+		BeforeEach(func() {
+			destination = GinkgoT().TempDir()
+		})
+
+		It("extracts the files matching a glob or inside a matching directory", func() {
+			extracted, err := actor.ExtractDropletFiles(rawDroplet, []string{"./app/config", "deps/*/config.yml"}, destination)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(extracted).To(ConsistOf("app/config/app.yml", "deps/0/config.yml", "deps/1/config.yml"))
+
+			contents, err := os.ReadFile(filepath.Join(destination, "app", "config", "app.yml"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("port: 8080"))
+			Expect(filepath.Join(destination, "app", "bin", "run")).ToNot(BeAnExistingFile())
+		})
+
+		It("keeps entries that try to leave the droplet inside the destination", func() {
+			extracted, err := actor.ExtractDropletFiles(buildDroplet(
+				dropletEntry{name: "../../escape.txt", contents: "gotcha"},
+			), []string{"*"}, destination)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(extracted).To(ConsistOf("escape.txt"))
+			Expect(filepath.Join(destination, "escape.txt")).To(BeAnExistingFile())
+		})
+	})
+})
@@ -485,6 +485,39 @@ var _ = Describe("Droplet Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationDropletsWithLabelSelector", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "some-app-guid"}},
+				ccv3.Warnings{"get-applications-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetDropletsReturns(
+				[]resources.Droplet{{GUID: "some-droplet-guid"}},
+				ccv3.Warnings{"get-application-droplets-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+				resources.Droplet{GUID: "some-droplet-guid"},
+				ccv3.Warnings{"get-current-droplet-warning"},
+				nil,
+			)
+		})
+
+		It("filters the droplets by label", func() {
+			droplets, warnings, err := actor.GetApplicationDropletsWithLabelSelector("some-app-name", "some-space-guid", "env=prod")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-applications-warning", "get-application-droplets-warning", "get-current-droplet-warning"))
+			Expect(droplets).To(Equal([]resources.Droplet{{GUID: "some-droplet-guid", IsCurrent: true}}))
+
+			Expect(fakeCloudControllerClient.GetDropletsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.CreatedAtDescendingOrder}},
+				ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+			))
+		})
+	})
+
 	Describe("GetCurrentDropletByApplication", func() {
 		var (
 			appGUID string
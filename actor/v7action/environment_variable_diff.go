@@ -0,0 +1,123 @@
+package v7action
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+type EnvironmentVariableChange string
+
+const (
+	// EnvironmentVariableAdded variables are only set on the other app.
+	EnvironmentVariableAdded EnvironmentVariableChange = "added"
+	// EnvironmentVariableRemoved variables are only set on the original app.
+	EnvironmentVariableRemoved EnvironmentVariableChange = "removed"
+	// EnvironmentVariableChanged variables are set to different values.
+	EnvironmentVariableChanged EnvironmentVariableChange = "changed"
+)
+
+type EnvironmentVariableDiff struct {
+	Name   string
+	Change EnvironmentVariableChange
+	// Values that are not strings are shown as JSON.
+	OriginalValue string
+	OtherValue    string
+}
+
+// EnvironmentVariableGroupsDiff holds the differences between the env
+// variables that two apps get, by group.
+type EnvironmentVariableGroupsDiff struct {
+	EnvironmentVariables []EnvironmentVariableDiff
+	// System diffs never have values, since they contain service credentials.
+	System  []EnvironmentVariableDiff
+	Running []EnvironmentVariableDiff
+	Staging []EnvironmentVariableDiff
+}
+
+// DiffEnvironmentVariableGroups compares the user-provided and system-provided
+// env variables and the running and staging env variable groups of two apps,
+// key by key. VCAP_SERVICES is compared per service binding. The differences
+// describe how to get from original to other and are sorted by name.
+func (actor *Actor) DiffEnvironmentVariableGroups(original EnvironmentVariableGroups, other EnvironmentVariableGroups) EnvironmentVariableGroupsDiff {
+	return EnvironmentVariableGroupsDiff{
+		EnvironmentVariables: diffEnvironmentVariables(original.EnvironmentVariables, other.EnvironmentVariables),
+		System:               diffSystemEnvironmentVariables(original.System, other.System),
+		Running:              diffEnvironmentVariables(original.Running, other.Running),
+		Staging:              diffEnvironmentVariables(original.Staging, other.Staging),
+	}
+}
+
+func diffEnvironmentVariables(original map[string]interface{}, other map[string]interface{}) []EnvironmentVariableDiff {
+	var diffs []EnvironmentVariableDiff
+	for name, value := range original {
+		otherValue, ok := other[name]
+		switch {
+		case !ok:
+			diffs = append(diffs, EnvironmentVariableDiff{Name: name, Change: EnvironmentVariableRemoved, OriginalValue: envVarValueString(value)})
+		case !reflect.DeepEqual(value, otherValue):
+			diffs = append(diffs, EnvironmentVariableDiff{Name: name, Change: EnvironmentVariableChanged, OriginalValue: envVarValueString(value), OtherValue: envVarValueString(otherValue)})
+		}
+	}
+
+	for name, value := range other {
+		if _, ok := original[name]; !ok {
+			diffs = append(diffs, EnvironmentVariableDiff{Name: name, Change: EnvironmentVariableAdded, OtherValue: envVarValueString(value)})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Name < diffs[j].Name
+	})
+	return diffs
+}
+
+func diffSystemEnvironmentVariables(original map[string]interface{}, other map[string]interface{}) []EnvironmentVariableDiff {
+	diffs := diffEnvironmentVariables(flattenSystemEnvironmentVariables(original), flattenSystemEnvironmentVariables(other))
+	for i := range diffs {
+		diffs[i].OriginalValue = ""
+		diffs[i].OtherValue = ""
+	}
+	return diffs
+}
+
+// flattenSystemEnvironmentVariables replaces VCAP_SERVICES with one entry per
+// service binding, named after its service offering and instance, so that a
+// single added binding does not show up as a change of all of VCAP_SERVICES.
+func flattenSystemEnvironmentVariables(system map[string]interface{}) map[string]interface{} {
+	flattened := map[string]interface{}{}
+	for name, value := range system {
+		services, ok := value.(map[string]interface{})
+		if name != "VCAP_SERVICES" || !ok {
+			flattened[name] = value
+			continue
+		}
+
+		for offering, bindings := range services {
+			bindingList, ok := bindings.([]interface{})
+			if !ok {
+				flattened[name+" "+offering] = bindings
+				continue
+			}
+			for _, binding := range bindingList {
+				instanceName := ""
+				if bindingMap, ok := binding.(map[string]interface{}); ok {
+					instanceName, _ = bindingMap["name"].(string)
+				}
+				flattened[name+" "+offering+"/"+instanceName] = binding
+			}
+		}
+	}
+	return flattened
+}
+
+func envVarValueString(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+	rawValue, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(rawValue)
+}
@@ -0,0 +1,90 @@
+package v7action_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DiffEnvironmentVariableGroups", func() {
+	var (
+		actor *Actor
+
+		original EnvironmentVariableGroups
+		other    EnvironmentVariableGroups
+		diff     EnvironmentVariableGroupsDiff
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(nil, nil, nil, nil, nil, nil)
+
+		original = EnvironmentVariableGroups{
+			EnvironmentVariables: map[string]interface{}{
+				"LOG_LEVEL": "info",
+				"OLD_VAR":   "old",
+				"SAME":      "same",
+				"LIMITS":    map[string]interface{}{"max": float64(3)},
+			},
+			System: map[string]interface{}{
+				"VCAP_SERVICES": map[string]interface{}{
+					"p-mysql": []interface{}{
+						map[string]interface{}{"name": "db", "credentials": map[string]interface{}{"password": "secret-1"}},
+						map[string]interface{}{"name": "old-db", "credentials": map[string]interface{}{"password": "secret-2"}},
+					},
+					"p-redis": []interface{}{
+						map[string]interface{}{"name": "cache", "credentials": map[string]interface{}{"password": "secret-3"}},
+					},
+				},
+			},
+			Running: map[string]interface{}{"RUNNING_VAR": "a"},
+		}
+		other = EnvironmentVariableGroups{
+			EnvironmentVariables: map[string]interface{}{
+				"LOG_LEVEL": "debug",
+				"NEW_VAR":   "new",
+				"SAME":      "same",
+				"LIMITS":    map[string]interface{}{"max": float64(5)},
+			},
+			System: map[string]interface{}{
+				"VCAP_SERVICES": map[string]interface{}{
+					"p-mysql": []interface{}{
+						map[string]interface{}{"name": "db", "credentials": map[string]interface{}{"password": "rotated"}},
+					},
+					"p-redis": []interface{}{
+						map[string]interface{}{"name": "cache", "credentials": map[string]interface{}{"password": "secret-3"}},
+					},
+					"p-rabbitmq": []interface{}{
+						map[string]interface{}{"name": "queue", "credentials": map[string]interface{}{"password": "secret-4"}},
+					},
+				},
+			},
+			Running: map[string]interface{}{"RUNNING_VAR": "a"},
+			Staging: map[string]interface{}{"STAGING_VAR": true},
+		}
+	})
+
+	JustBeforeEach(func() {
+		diff = actor.DiffEnvironmentVariableGroups(original, other)
+	})
+
+	It("returns the added, removed and changed variables of each group sorted by name", func() {
+		Expect(diff.EnvironmentVariables).To(Equal([]EnvironmentVariableDiff{
+			{Name: "LIMITS", Change: EnvironmentVariableChanged, OriginalValue: `{"max":3}`, OtherValue: `{"max":5}`},
+			{Name: "LOG_LEVEL", Change: EnvironmentVariableChanged, OriginalValue: "info", OtherValue: "debug"},
+			{Name: "NEW_VAR", Change: EnvironmentVariableAdded, OtherValue: "new"},
+			{Name: "OLD_VAR", Change: EnvironmentVariableRemoved, OriginalValue: "old"},
+		}))
+		Expect(diff.Running).To(BeEmpty())
+		Expect(diff.Staging).To(Equal([]EnvironmentVariableDiff{
+			{Name: "STAGING_VAR", Change: EnvironmentVariableAdded, OtherValue: "true"},
+		}))
+	})
+
+	It("compares VCAP_SERVICES per service binding without keeping the values", func() {
+		Expect(diff.System).To(Equal([]EnvironmentVariableDiff{
+			{Name: "VCAP_SERVICES p-mysql/db", Change: EnvironmentVariableChanged},
+			{Name: "VCAP_SERVICES p-mysql/old-db", Change: EnvironmentVariableRemoved},
+			{Name: "VCAP_SERVICES p-rabbitmq/queue", Change: EnvironmentVariableAdded},
+		}))
+	})
+})
@@ -0,0 +1,264 @@
+package v7action
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// EnvironmentVariableFileFormat is the layout of an env variable file.
+type EnvironmentVariableFileFormat string
+
+const (
+	// DotEnvFormat files have one KEY=VALUE pair per line.
+	DotEnvFormat EnvironmentVariableFileFormat = "dotenv"
+	// YAMLEnvFormat files are a YAML map of names to values.
+	YAMLEnvFormat EnvironmentVariableFileFormat = "yaml"
+)
+
+// MaskedEnvironmentVariableValue replaces the values of secret-looking env
+// variables in exported files.
+const MaskedEnvironmentVariableValue = "[PRIVATE DATA HIDDEN]"
+
+var (
+	envVarNameRegexp       = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+	envVarURLSecretRegexp  = regexp.MustCompile(`://[^/@\s]*:[^/@\s]+@`)
+	envVarSecretNameTokens = []string{"PASSWORD", "PASSWD", "SECRET", "TOKEN", "CREDENTIAL", "PRIVATE", "API_KEY", "APIKEY", "ACCESS_KEY", "AUTH"}
+)
+
+// EnvironmentVariableFileFormatForPath picks the format of an env variable
+// file from its extension. Files that do not end in .yml or .yaml are read as
+// .env files.
+func EnvironmentVariableFileFormatForPath(path string) EnvironmentVariableFileFormat {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		return YAMLEnvFormat
+	default:
+		return DotEnvFormat
+	}
+}
+
+// IsSecretEnvironmentVariable reports whether an env variable looks like it
+// holds a credential, either because of its name or because its value is a URL
+// with a password in it.
+func IsSecretEnvironmentVariable(name string, value string) bool {
+	upperName := strings.ToUpper(name)
+	for _, token := range envVarSecretNameTokens {
+		if strings.Contains(upperName, token) {
+			return true
+		}
+	}
+	return envVarURLSecretRegexp.MatchString(value)
+}
+
+// ParseEnvironmentVariableFile reads the env variables in a .env or YAML
+// file. Values that were masked on export are rejected, so that they are
+// never set on an app.
+func (actor *Actor) ParseEnvironmentVariableFile(format EnvironmentVariableFileFormat, rawFile []byte) (resources.EnvironmentVariables, error) {
+	var (
+		values map[string]string
+		err    error
+	)
+	if format == YAMLEnvFormat {
+		values, err = parseYAMLEnvFile(rawFile)
+	} else {
+		values, err = parseDotEnvFile(rawFile)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	envVars := resources.EnvironmentVariables{}
+	for name, value := range values {
+		if value == MaskedEnvironmentVariableValue {
+			return nil, actionerror.MaskedEnvironmentVariableError{EnvironmentVariableName: name}
+		}
+		envVars[name] = types.FilteredString{IsSet: true, Value: value}
+	}
+	return envVars, nil
+}
+
+// MarshalEnvironmentVariableFile writes env variables as a .env or YAML file,
+// sorted by name. Unless revealSecrets is set, the values of secret-looking
+// variables are masked; their names are returned.
+func (actor *Actor) MarshalEnvironmentVariableFile(format EnvironmentVariableFileFormat, envVars resources.EnvironmentVariables, revealSecrets bool) ([]byte, []string, error) {
+	var names []string
+	for name := range envVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var masked []string
+	values := make(map[string]string, len(envVars))
+	for _, name := range names {
+		value := envVars[name].Value
+		if !revealSecrets && IsSecretEnvironmentVariable(name, value) {
+			value = MaskedEnvironmentVariableValue
+			masked = append(masked, name)
+		}
+		values[name] = value
+	}
+
+	if format == YAMLEnvFormat {
+		if len(values) == 0 {
+			return []byte("{}\n"), masked, nil
+		}
+		rawFile, err := yaml.Marshal(values)
+		return rawFile, masked, err
+	}
+
+	var rawFile bytes.Buffer
+	for _, name := range names {
+		fmt.Fprintf(&rawFile, "%s=%s\n", name, quoteDotEnvValue(values[name]))
+	}
+	return rawFile.Bytes(), masked, nil
+}
+
+// SetEnvironmentVariablesByApplicationNameAndSpace sets all of the given env
+// variables on an application in a single request. When prune is set, the
+// application's other user-provided env variables are removed in the same
+// request, and their names are returned. The application must be restarted
+// for changes to take effect.
+func (actor *Actor) SetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string, envVars resources.EnvironmentVariables, prune bool) ([]string, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	newEnvVars := resources.EnvironmentVariables{}
+	for name, value := range envVars {
+		newEnvVars[name] = value
+	}
+
+	var removed []string
+	if prune {
+		envGroups, getWarnings, getErr := actor.CloudControllerClient.GetApplicationEnvironment(app.GUID)
+		warnings = append(warnings, getWarnings...)
+		if getErr != nil {
+			return nil, warnings, getErr
+		}
+
+		for name := range envGroups.EnvironmentVariables {
+			if _, ok := envVars[name]; !ok {
+				newEnvVars[name] = types.FilteredString{IsSet: false}
+				removed = append(removed, name)
+			}
+		}
+		sort.Strings(removed)
+	}
+
+	if len(newEnvVars) == 0 {
+		return removed, warnings, nil
+	}
+
+	_, patchWarnings, patchErr := actor.CloudControllerClient.UpdateApplicationEnvironmentVariables(app.GUID, newEnvVars)
+	warnings = append(warnings, patchWarnings...)
+	if patchErr != nil {
+		return nil, warnings, patchErr
+	}
+
+	return removed, warnings, nil
+}
+
+func parseDotEnvFile(rawFile []byte) (map[string]string, error) {
+	values := map[string]string{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(rawFile))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+
+		name, value, found := strings.Cut(line, "=")
+		name = strings.TrimSpace(name)
+		if !found {
+			return nil, actionerror.EnvironmentVariableFileError{Line: lineNumber, Reason: "expected NAME=VALUE"}
+		}
+		if !envVarNameRegexp.MatchString(name) {
+			return nil, actionerror.EnvironmentVariableFileError{Line: lineNumber, Reason: fmt.Sprintf("invalid name '%s'", name)}
+		}
+
+		value, err := unquoteDotEnvValue(strings.TrimSpace(value))
+		if err != nil {
+			return nil, actionerror.EnvironmentVariableFileError{Line: lineNumber, Reason: err.Error()}
+		}
+		values[name] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+func unquoteDotEnvValue(value string) (string, error) {
+	switch {
+	case strings.HasPrefix(value, `"`):
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return "", fmt.Errorf("invalid quoted value %s", value)
+		}
+		return unquoted, nil
+	case strings.HasPrefix(value, "'"):
+		if len(value) < 2 || !strings.HasSuffix(value, "'") {
+			return "", fmt.Errorf("invalid quoted value %s", value)
+		}
+		return value[1 : len(value)-1], nil
+	default:
+		// unquoted values end at an inline comment
+		if i := strings.Index(value, " #"); i >= 0 {
+			value = strings.TrimSpace(value[:i])
+		}
+		return value, nil
+	}
+}
+
+func quoteDotEnvValue(value string) string {
+	if value == "" || strings.ContainsAny(value, " \t\r\n\"'#\\$`") {
+		return strconv.Quote(value)
+	}
+	return value
+}
+
+func parseYAMLEnvFile(rawFile []byte) (map[string]string, error) {
+	var rawValues map[string]interface{}
+	err := yaml.Unmarshal(rawFile, &rawValues)
+	if err != nil {
+		return nil, actionerror.EnvironmentVariableFileError{Reason: "expected a map of env variable names to values"}
+	}
+
+	values := map[string]string{}
+	for name, rawValue := range rawValues {
+		if !envVarNameRegexp.MatchString(name) {
+			return nil, actionerror.EnvironmentVariableFileError{Reason: fmt.Sprintf("invalid name '%s'", name)}
+		}
+
+		switch rawValue := rawValue.(type) {
+		case string:
+			values[name] = rawValue
+		case int, int64, uint64, float64, bool:
+			values[name] = fmt.Sprint(rawValue)
+		case nil:
+			values[name] = ""
+		default:
+			return nil, actionerror.EnvironmentVariableFileError{Reason: fmt.Sprintf("value of '%s' must be a string, number or boolean", name)}
+		}
+	}
+
+	return values, nil
+}
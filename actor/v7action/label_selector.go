@@ -0,0 +1,154 @@
+package v7action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+)
+
+// LabelSelectorScope limits a label selector search to an organization or a
+// space. The zero value searches the whole foundation. Only apps, routes,
+// service instances and spaces can be scoped.
+type LabelSelectorScope struct {
+	OrganizationGUID string
+	SpaceGUID        string
+}
+
+// MetadataResource is a resource that matched a label selector.
+type MetadataResource struct {
+	GUID string
+	Name string
+}
+
+// GetResourcesByLabelSelector returns every resource of the given type that
+// matches labelSelector within scope.
+func (actor *Actor) GetResourcesByLabelSelector(resourceType string, labelSelector string, scope LabelSelectorScope) ([]MetadataResource, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}},
+	}
+
+	var (
+		matches  []MetadataResource
+		warnings ccv3.Warnings
+		err      error
+	)
+
+	switch resourceType {
+	case "app":
+		var apps []resources.Application
+		apps, warnings, err = actor.CloudControllerClient.GetApplications(append(queries, scope.queries()...)...)
+		for _, app := range apps {
+			matches = append(matches, MetadataResource{GUID: app.GUID, Name: app.Name})
+		}
+	case "buildpack":
+		var buildpacks []resources.Buildpack
+		buildpacks, warnings, err = actor.CloudControllerClient.GetBuildpacks(queries...)
+		for _, buildpack := range buildpacks {
+			matches = append(matches, MetadataResource{GUID: buildpack.GUID, Name: buildpack.Name})
+		}
+	case "domain":
+		var domains []resources.Domain
+		domains, warnings, err = actor.CloudControllerClient.GetDomains(queries...)
+		for _, domain := range domains {
+			matches = append(matches, MetadataResource{GUID: domain.GUID, Name: domain.Name})
+		}
+	case "org":
+		var orgs []resources.Organization
+		orgs, warnings, err = actor.CloudControllerClient.GetOrganizations(queries...)
+		for _, org := range orgs {
+			matches = append(matches, MetadataResource{GUID: org.GUID, Name: org.Name})
+		}
+	case "route":
+		var routes []resources.Route
+		routes, warnings, err = actor.CloudControllerClient.GetRoutes(append(queries, scope.queries()...)...)
+		for _, route := range routes {
+			matches = append(matches, MetadataResource{GUID: route.GUID, Name: route.URL})
+		}
+	case "service-broker":
+		var brokers []resources.ServiceBroker
+		brokers, warnings, err = actor.CloudControllerClient.GetServiceBrokers(queries...)
+		for _, broker := range brokers {
+			matches = append(matches, MetadataResource{GUID: broker.GUID, Name: broker.Name})
+		}
+	case "service-instance":
+		var instances []resources.ServiceInstance
+		instances, _, warnings, err = actor.CloudControllerClient.GetServiceInstances(append(queries, scope.queries()...)...)
+		for _, instance := range instances {
+			matches = append(matches, MetadataResource{GUID: instance.GUID, Name: instance.Name})
+		}
+	case "service-offering":
+		var offerings []resources.ServiceOffering
+		offerings, warnings, err = actor.CloudControllerClient.GetServiceOfferings(queries...)
+		for _, offering := range offerings {
+			matches = append(matches, MetadataResource{GUID: offering.GUID, Name: offering.Name})
+		}
+	case "service-plan":
+		var plans []resources.ServicePlan
+		plans, warnings, err = actor.CloudControllerClient.GetServicePlans(queries...)
+		for _, plan := range plans {
+			matches = append(matches, MetadataResource{GUID: plan.GUID, Name: plan.Name})
+		}
+	case "space":
+		if scope.OrganizationGUID != "" {
+			queries = append(queries, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{scope.OrganizationGUID}})
+		}
+		var spaces []resources.Space
+		spaces, _, warnings, err = actor.CloudControllerClient.GetSpaces(queries...)
+		for _, space := range spaces {
+			matches = append(matches, MetadataResource{GUID: space.GUID, Name: space.Name})
+		}
+	case "stack":
+		var stacks []resources.Stack
+		stacks, warnings, err = actor.CloudControllerClient.GetStacks(queries...)
+		for _, stack := range stacks {
+			matches = append(matches, MetadataResource{GUID: stack.GUID, Name: stack.Name})
+		}
+	default:
+		return nil, nil, fmt.Errorf("unsupported resource type of '%s'", resourceType)
+	}
+
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	return matches, Warnings(warnings), nil
+}
+
+// UpdateResourcesMetadata applies the same metadata update to each of the
+// given resources. The Cloud Controller only updates the metadata of one
+// resource per request, so the resources are updated one at a time in batches
+// of batcher.BatchSize, and batchUpdated is called with the number of
+// resources updated so far after each batch. It stops at the first failure.
+func (actor *Actor) UpdateResourcesMetadata(resourceType string, resourceGUIDs []string, metadata resources.Metadata, batchUpdated func(updated int)) (Warnings, error) {
+	updated := 0
+	warnings, err := batcher.RequestByGUID(resourceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		var batchWarnings ccv3.Warnings
+		for _, guid := range guids {
+			warnings, err := actor.updateResourceMetadata(resourceType, guid, metadata, nil)
+			batchWarnings = append(batchWarnings, warnings...)
+			if err != nil {
+				return batchWarnings, err
+			}
+			updated++
+		}
+
+		if batchUpdated != nil {
+			batchUpdated(updated)
+		}
+		return batchWarnings, nil
+	})
+
+	return Warnings(warnings), err
+}
+
+func (scope LabelSelectorScope) queries() []ccv3.Query {
+	switch {
+	case scope.SpaceGUID != "":
+		return []ccv3.Query{{Key: ccv3.SpaceGUIDFilter, Values: []string{scope.SpaceGUID}}}
+	case scope.OrganizationGUID != "":
+		return []ccv3.Query{{Key: ccv3.OrganizationGUIDFilter, Values: []string{scope.OrganizationGUID}}}
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package v7action_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/batcher"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("label selector actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetResourcesByLabelSelector", func() {
+		var (
+			resourceType string
+			scope        LabelSelectorScope
+			matches      []MetadataResource
+		)
+
+		BeforeEach(func() {
+			scope = LabelSelectorScope{}
+		})
+
+		JustBeforeEach(func() {
+			matches, warnings, executeErr = actor.GetResourcesByLabelSelector(resourceType, "env=dev", scope)
+		})
+
+		When("the resource type is app", func() {
+			BeforeEach(func() {
+				resourceType = "app"
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]resources.Application{{GUID: "app-1-guid", Name: "app-1"}, {GUID: "app-2-guid", Name: "app-2"}},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			When("scoped to a space", func() {
+				BeforeEach(func() {
+					scope = LabelSelectorScope{OrganizationGUID: "some-org-guid", SpaceGUID: "some-space-guid"}
+				})
+
+				It("filters by label selector and space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-apps-warning"))
+					Expect(matches).To(Equal([]MetadataResource{
+						{GUID: "app-1-guid", Name: "app-1"},
+						{GUID: "app-2-guid", Name: "app-2"},
+					}))
+
+					Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=dev"}},
+						ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+					))
+				})
+			})
+
+			When("scoped to an org", func() {
+				BeforeEach(func() {
+					scope = LabelSelectorScope{OrganizationGUID: "some-org-guid"}
+				})
+
+				It("filters by label selector and org", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=dev"}},
+						ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+					))
+				})
+			})
+
+			When("not scoped", func() {
+				It("filters by label selector only", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=dev"}},
+					))
+				})
+			})
+
+			When("the client errors", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, errors.New("get-apps-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("get-apps-error"))
+					Expect(warnings).To(ConsistOf("get-apps-warning"))
+					Expect(matches).To(BeEmpty())
+				})
+			})
+		})
+
+		When("the resource type is route", func() {
+			BeforeEach(func() {
+				resourceType = "route"
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]resources.Route{{GUID: "route-guid", URL: "host.example.com/path"}},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+			})
+
+			It("names routes by URL", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+				Expect(matches).To(Equal([]MetadataResource{{GUID: "route-guid", Name: "host.example.com/path"}}))
+			})
+		})
+
+		When("the resource type is space", func() {
+			BeforeEach(func() {
+				resourceType = "space"
+				scope = LabelSelectorScope{OrganizationGUID: "some-org-guid"}
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]resources.Space{{GUID: "space-guid", Name: "some-space"}},
+					ccv3.IncludedResources{},
+					ccv3.Warnings{"get-spaces-warning"},
+					nil,
+				)
+			})
+
+			It("filters by label selector and org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-spaces-warning"))
+				Expect(matches).To(Equal([]MetadataResource{{GUID: "space-guid", Name: "some-space"}}))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=dev"}},
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+				))
+			})
+		})
+
+		When("the resource type is stack", func() {
+			BeforeEach(func() {
+				resourceType = "stack"
+				scope = LabelSelectorScope{OrganizationGUID: "ignored-org-guid"}
+				fakeCloudControllerClient.GetStacksReturns(
+					[]resources.Stack{{GUID: "stack-guid", Name: "cflinuxfs4"}},
+					ccv3.Warnings{"get-stacks-warning"},
+					nil,
+				)
+			})
+
+			It("ignores the scope", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(matches).To(Equal([]MetadataResource{{GUID: "stack-guid", Name: "cflinuxfs4"}}))
+				Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=dev"}},
+				))
+			})
+		})
+
+		When("the resource type is not supported", func() {
+			BeforeEach(func() {
+				resourceType = "droplet"
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError("unsupported resource type of 'droplet'"))
+			})
+		})
+	})
+
+	Describe("UpdateResourcesMetadata", func() {
+		var (
+			guids    []string
+			metadata resources.Metadata
+			progress []int
+		)
+
+		BeforeEach(func() {
+			guids = []string{"guid-0", "guid-1", "guid-2"}
+			metadata = resources.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("dev")}}
+			progress = nil
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateResourcesMetadata("service-broker", guids, metadata, func(updated int) {
+				progress = append(progress, updated)
+			})
+		})
+
+		When("every update succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateResourceMetadataReturns("some-job-url", ccv3.Warnings{"update-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+			})
+
+			It("updates each resource and polls its job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(HaveLen(2 * len(guids)))
+
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(len(guids)))
+				resourceType, guid, payload := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(2)
+				Expect(resourceType).To(Equal("service-broker"))
+				Expect(guid).To(Equal("guid-2"))
+				Expect(payload).To(Equal(metadata))
+
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(len(guids)))
+				Expect(progress).To(Equal([]int{3}))
+			})
+
+			When("there are more resources than fit in a batch", func() {
+				BeforeEach(func() {
+					guids = nil
+					for i := 0; i < batcher.BatchSize+1; i++ {
+						guids = append(guids, fmt.Sprintf("guid-%d", i))
+					}
+				})
+
+				It("reports the progress after each batch", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(batcher.BatchSize + 1))
+					Expect(progress).To(Equal([]int{batcher.BatchSize, batcher.BatchSize + 1}))
+				})
+			})
+		})
+
+		When("an update fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateResourceMetadataReturns("", ccv3.Warnings{"update-warning"}, nil)
+				fakeCloudControllerClient.UpdateResourceMetadataReturnsOnCall(1, "", ccv3.Warnings{"failed-warning"}, errors.New("update-error"))
+			})
+
+			It("stops and returns the error with the warnings so far", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(warnings).To(Equal(Warnings{"update-warning", "failed-warning"}))
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(2))
+				Expect(progress).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,321 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// QuotaResource is a resource that a push or scale adds to and that org and
+// space quotas limit.
+type QuotaResource string
+
+const (
+	QuotaResourceMemory       QuotaResource = "memory"
+	QuotaResourceAppInstances QuotaResource = "app instances"
+	QuotaResourceRoutes       QuotaResource = "routes"
+	QuotaResourceLogRate      QuotaResource = "log rate"
+)
+
+// QuotaChange is how much a push or scale adds to the usage of each
+// quota-limited resource. Values are negative when it frees up resources.
+// Memory is in megabytes and log rate in bytes per second.
+type QuotaChange struct {
+	MemoryInMB        int
+	AppInstances      int
+	Routes            int
+	LogRateLimitInBPS int
+	// UnlimitedLogRate is set when a started process will run without a log
+	// rate limit, which no log rate limit allows.
+	UnlimitedLogRate bool
+}
+
+// Add returns the sum of both changes.
+func (c QuotaChange) Add(other QuotaChange) QuotaChange {
+	return QuotaChange{
+		MemoryInMB:        c.MemoryInMB + other.MemoryInMB,
+		AppInstances:      c.AppInstances + other.AppInstances,
+		Routes:            c.Routes + other.Routes,
+		LogRateLimitInBPS: c.LogRateLimitInBPS + other.LogRateLimitInBPS,
+		UnlimitedLogRate:  c.UnlimitedLogRate || other.UnlimitedLogRate,
+	}
+}
+
+// ProjectedQuotaUsage is the usage of a resource under an org or space quota
+// once a push or scale is done.
+type ProjectedQuotaUsage struct {
+	// Scope is "org" or "space".
+	Scope     string
+	QuotaName string
+	Resource  QuotaResource
+	Current   int
+	Change    int
+	Limit     int
+	// Unlimited is set when the change needs an unlimited log rate.
+	Unlimited bool
+}
+
+// Projected returns the usage once the change is done.
+func (u ProjectedQuotaUsage) Projected() int {
+	return u.Current + u.Change
+}
+
+// Exceeded returns true when the change takes the usage over the limit.
+// Changes that free up resources never exceed the limit, even when the usage
+// is already over it.
+func (u ProjectedQuotaUsage) Exceeded() bool {
+	return u.Unlimited || (u.Change > 0 && u.Projected() > u.Limit)
+}
+
+// QuotaCheck is the projected usage of each limited resource that a push or
+// scale changes, under both the org and the space quota.
+type QuotaCheck []ProjectedQuotaUsage
+
+// Exceeded returns true when the change takes any resource over its limit.
+func (c QuotaCheck) Exceeded() bool {
+	for _, usage := range c {
+		if usage.Exceeded() {
+			return true
+		}
+	}
+	return false
+}
+
+// GetApplicationQuotaChange returns how much running the app with the given
+// processes adds to the quota usage of its space. The processes only need the
+// fields that change; the others keep their current values. The app GUID is
+// empty for an app that does not exist yet, in which case memory and log rate
+// limits left to the Cloud Controller's defaults are not counted. started is
+// false when the app will not run after the change.
+func (actor Actor) GetApplicationQuotaChange(app resources.Application, processes []resources.Process, started bool) (QuotaChange, Warnings, error) {
+	var (
+		allWarnings Warnings
+		current     []resources.Process
+	)
+
+	if app.GUID != "" {
+		var ccWarnings ccv3.Warnings
+		var err error
+		current, ccWarnings, err = actor.CloudControllerClient.GetApplicationProcesses(app.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return QuotaChange{}, allWarnings, err
+		}
+	}
+
+	var currentUsage, projectedUsage QuotaChange
+	if app.State == constant.ApplicationStarted {
+		for _, process := range current {
+			currentUsage = currentUsage.Add(processQuotaUsage(process))
+		}
+	}
+
+	if started {
+		for _, process := range mergeProcessChanges(current, processes) {
+			projectedUsage = projectedUsage.Add(processQuotaUsage(process))
+		}
+	}
+
+	return QuotaChange{
+		MemoryInMB:        projectedUsage.MemoryInMB - currentUsage.MemoryInMB,
+		AppInstances:      projectedUsage.AppInstances - currentUsage.AppInstances,
+		LogRateLimitInBPS: projectedUsage.LogRateLimitInBPS - currentUsage.LogRateLimitInBPS,
+		UnlimitedLogRate:  projectedUsage.UnlimitedLogRate,
+	}, allWarnings, nil
+}
+
+// CheckQuotaChange projects the usage of the org and the space once the change
+// is done, and compares it with the limits of their quotas. Resources that the
+// change does not touch or that a quota does not limit are left out.
+func (actor Actor) CheckQuotaChange(orgGUID string, spaceGUID string, change QuotaChange) (QuotaCheck, Warnings, error) {
+	org, ccWarnings, err := actor.CloudControllerClient.GetOrganization(orgGUID)
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgQuota, ccWarnings, err := actor.CloudControllerClient.GetOrganizationQuota(org.QuotaGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgSummary, ccWarnings, err := actor.CloudControllerClient.GetOrganizationUsageSummary(orgGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgCheck, warnings, err := actor.checkQuotaChange("org", orgQuota.Quota, orgSummary, change,
+		ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spaces, _, ccWarnings, err := actor.CloudControllerClient.GetSpaces(ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{spaceGUID}})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(spaces) == 0 || spaces[0].Relationships[constant.RelationshipTypeQuota].GUID == "" {
+		return orgCheck, allWarnings, nil
+	}
+
+	spaceQuota, ccWarnings, err := actor.CloudControllerClient.GetSpaceQuota(spaces[0].Relationships[constant.RelationshipTypeQuota].GUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spaceSummary, ccWarnings, err := actor.CloudControllerClient.GetSpaceUsageSummary(spaceGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spaceCheck, warnings, err := actor.checkQuotaChange("space", spaceQuota.Quota, spaceSummary, change,
+		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	return append(orgCheck, spaceCheck...), allWarnings, nil
+}
+
+func (actor Actor) checkQuotaChange(scope string, quota resources.Quota, summary resources.UsageSummary, change QuotaChange, filter ccv3.Query) (QuotaCheck, Warnings, error) {
+	var check QuotaCheck
+	add := func(resource QuotaResource, limit *types.NullInt, current int, change int) {
+		if change == 0 || limit == nil || !limit.IsSet {
+			return
+		}
+		check = append(check, ProjectedQuotaUsage{
+			Scope:     scope,
+			QuotaName: quota.Name,
+			Resource:  resource,
+			Current:   current,
+			Change:    change,
+			Limit:     limit.Value,
+		})
+	}
+
+	add(QuotaResourceMemory, quota.Apps.TotalMemory, summary.MemoryInMB, change.MemoryInMB)
+	add(QuotaResourceAppInstances, quota.Apps.TotalAppInstances, summary.StartedInstances, change.AppInstances)
+	add(QuotaResourceRoutes, quota.Routes.TotalRoutes, summary.Routes, change.Routes)
+
+	logRateLimit := quota.Apps.TotalLogVolume
+	if logRateLimit == nil || !logRateLimit.IsSet || (change.LogRateLimitInBPS == 0 && !change.UnlimitedLogRate) {
+		return check, nil, nil
+	}
+
+	// usage summaries do not include the log rate, so it is added up from the
+	// processes of the started apps
+	currentLogRate, warnings, err := actor.getStartedLogRate(filter)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	check = append(check, ProjectedQuotaUsage{
+		Scope:     scope,
+		QuotaName: quota.Name,
+		Resource:  QuotaResourceLogRate,
+		Current:   currentLogRate,
+		Change:    change.LogRateLimitInBPS,
+		Limit:     logRateLimit.Value,
+		Unlimited: change.UnlimitedLogRate,
+	})
+
+	return check, warnings, nil
+}
+
+func (actor Actor) getStartedLogRate(filter ccv3.Query) (int, Warnings, error) {
+	perPage := ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}}
+
+	apps, ccWarnings, err := actor.CloudControllerClient.GetApplications(filter, perPage)
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return 0, allWarnings, err
+	}
+
+	startedApps := map[string]bool{}
+	for _, app := range apps {
+		if app.State == constant.ApplicationStarted {
+			startedApps[app.GUID] = true
+		}
+	}
+
+	processes, ccWarnings, err := actor.CloudControllerClient.GetProcesses(filter, perPage)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return 0, allWarnings, err
+	}
+
+	logRate := 0
+	for _, process := range processes {
+		if startedApps[process.AppGUID] {
+			logRate += processQuotaUsage(process).LogRateLimitInBPS
+		}
+	}
+
+	return logRate, allWarnings, nil
+}
+
+// mergeProcessChanges applies the changed fields of the processes to the
+// current processes of the same type. New processes start with one instance
+// for web and none for the other types, like the Cloud Controller does.
+func mergeProcessChanges(current []resources.Process, changes []resources.Process) []resources.Process {
+	merged := make([]resources.Process, len(current))
+	copy(merged, current)
+
+	for _, change := range changes {
+		index := -1
+		for i, process := range merged {
+			if process.Type == change.Type {
+				index = i
+				break
+			}
+		}
+
+		if index == -1 {
+			process := resources.Process{Type: change.Type, Instances: types.NullInt{IsSet: true}}
+			if change.Type == constant.ProcessTypeWeb {
+				process.Instances.Value = 1
+			}
+			merged = append(merged, process)
+			index = len(merged) - 1
+		}
+
+		if change.Instances.IsSet {
+			merged[index].Instances = change.Instances
+		}
+		if change.MemoryInMB.IsSet {
+			merged[index].MemoryInMB = change.MemoryInMB
+		}
+		if change.LogRateLimitInBPS.IsSet {
+			merged[index].LogRateLimitInBPS = change.LogRateLimitInBPS
+		}
+	}
+
+	return merged
+}
+
+func processQuotaUsage(process resources.Process) QuotaChange {
+	instances := process.Instances.Value
+	usage := QuotaChange{
+		AppInstances: instances,
+		MemoryInMB:   instances * int(process.MemoryInMB.Value),
+	}
+
+	if process.LogRateLimitInBPS.IsSet {
+		if process.LogRateLimitInBPS.Value < 0 {
+			usage.UnlimitedLogRate = instances > 0
+		} else {
+			usage.LogRateLimitInBPS = instances * process.LogRateLimitInBPS.Value
+		}
+	}
+
+	return usage
+}
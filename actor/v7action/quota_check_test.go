@@ -0,0 +1,271 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quota Check Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	limitPtr := func(value int) *types.NullInt {
+		return &types.NullInt{IsSet: true, Value: value}
+	}
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("QuotaCheck.Exceeded", func() {
+		It("only fails changes that add to a usage over the limit", func() {
+			Expect(QuotaCheck{{Current: 8, Change: 2, Limit: 10}}.Exceeded()).To(BeFalse())
+			Expect(QuotaCheck{{Current: 8, Change: 3, Limit: 10}}.Exceeded()).To(BeTrue())
+			Expect(QuotaCheck{{Current: 12, Change: -1, Limit: 10}}.Exceeded()).To(BeFalse())
+			Expect(QuotaCheck{{Current: 0, Change: 0, Limit: 10, Unlimited: true}}.Exceeded()).To(BeTrue())
+		})
+	})
+
+	Describe("GetApplicationQuotaChange", func() {
+		var (
+			app       resources.Application
+			processes []resources.Process
+			started   bool
+			change    QuotaChange
+		)
+
+		BeforeEach(func() {
+			app = resources.Application{GUID: "app-guid", State: constant.ApplicationStarted}
+			started = true
+			processes = []resources.Process{{
+				Type:      constant.ProcessTypeWeb,
+				Instances: types.NullInt{IsSet: true, Value: 4},
+			}}
+
+			fakeCloudControllerClient.GetApplicationProcessesReturns(
+				[]resources.Process{
+					{
+						Type:              constant.ProcessTypeWeb,
+						Instances:         types.NullInt{IsSet: true, Value: 2},
+						MemoryInMB:        types.NullUint64{IsSet: true, Value: 256},
+						LogRateLimitInBPS: types.NullInt{IsSet: true, Value: 1024},
+					},
+					{
+						Type:       "worker",
+						Instances:  types.NullInt{IsSet: true, Value: 1},
+						MemoryInMB: types.NullUint64{IsSet: true, Value: 128},
+					},
+				},
+				ccv3.Warnings{"processes-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			change, warnings, executeErr = actor.GetApplicationQuotaChange(app, processes, started)
+		})
+
+		It("returns what the changed processes add", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("processes-warning"))
+			Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("app-guid"))
+
+			Expect(change).To(Equal(QuotaChange{MemoryInMB: 512, AppInstances: 2, LogRateLimitInBPS: 2048}))
+		})
+
+		When("the app is stopped and will be started", func() {
+			BeforeEach(func() {
+				app.State = constant.ApplicationStopped
+			})
+
+			It("counts all of its processes", func() {
+				Expect(change).To(Equal(QuotaChange{MemoryInMB: 1152, AppInstances: 5, LogRateLimitInBPS: 4096}))
+			})
+		})
+
+		When("the app will not run after the change", func() {
+			BeforeEach(func() {
+				started = false
+			})
+
+			It("frees up what the app uses", func() {
+				Expect(change).To(Equal(QuotaChange{MemoryInMB: -640, AppInstances: -3, LogRateLimitInBPS: -2048}))
+			})
+		})
+
+		When("a process will run without a log rate limit", func() {
+			BeforeEach(func() {
+				processes[0].LogRateLimitInBPS = types.NullInt{IsSet: true, Value: -1}
+			})
+
+			It("flags the unlimited log rate", func() {
+				Expect(change.UnlimitedLogRate).To(BeTrue())
+				Expect(change.LogRateLimitInBPS).To(Equal(-2048))
+			})
+		})
+
+		When("the app does not exist yet", func() {
+			BeforeEach(func() {
+				app = resources.Application{Name: "new-app"}
+				processes = []resources.Process{
+					{Type: constant.ProcessTypeWeb, MemoryInMB: types.NullUint64{IsSet: true, Value: 512}},
+					{Type: "worker", MemoryInMB: types.NullUint64{IsSet: true, Value: 512}},
+				}
+			})
+
+			It("starts web with one instance and other processes with none", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(0))
+				Expect(change).To(Equal(QuotaChange{MemoryInMB: 512, AppInstances: 1}))
+			})
+		})
+
+		When("getting the processes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessesReturns(nil, ccv3.Warnings{"processes-warning"}, errors.New("processes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("processes-error"))
+				Expect(warnings).To(ConsistOf("processes-warning"))
+			})
+		})
+	})
+
+	Describe("CheckQuotaChange", func() {
+		var (
+			change QuotaChange
+			check  QuotaCheck
+		)
+
+		BeforeEach(func() {
+			change = QuotaChange{MemoryInMB: 512, AppInstances: 2, Routes: 1, LogRateLimitInBPS: 1024}
+
+			fakeCloudControllerClient.GetOrganizationReturns(
+				resources.Organization{GUID: "org-guid", QuotaGUID: "org-quota-guid"},
+				ccv3.Warnings{"org-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationQuotaReturns(
+				resources.OrganizationQuota{Quota: resources.Quota{
+					Name:   "org-quota",
+					Apps:   resources.AppLimit{TotalMemory: limitPtr(2048), TotalAppInstances: &types.NullInt{}},
+					Routes: resources.RouteLimit{TotalRoutes: limitPtr(10)},
+				}},
+				ccv3.Warnings{"org-quota-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationUsageSummaryReturns(
+				resources.UsageSummary{MemoryInMB: 1792, StartedInstances: 6, Routes: 3},
+				ccv3.Warnings{"org-usage-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{
+					GUID: "space-guid",
+					Relationships: resources.Relationships{
+						constant.RelationshipTypeQuota: resources.Relationship{GUID: "space-quota-guid"},
+					},
+				}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"space-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceQuotaReturns(
+				resources.SpaceQuota{Quota: resources.Quota{
+					Name: "space-quota",
+					Apps: resources.AppLimit{TotalAppInstances: limitPtr(8), TotalLogVolume: limitPtr(4096)},
+				}},
+				ccv3.Warnings{"space-quota-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceUsageSummaryReturns(
+				resources.UsageSummary{StartedInstances: 4},
+				ccv3.Warnings{"space-usage-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{
+					{GUID: "started-app-guid", State: constant.ApplicationStarted},
+					{GUID: "stopped-app-guid", State: constant.ApplicationStopped},
+				},
+				ccv3.Warnings{"apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetProcessesReturns(
+				[]resources.Process{
+					{AppGUID: "started-app-guid", Instances: types.NullInt{IsSet: true, Value: 2}, LogRateLimitInBPS: types.NullInt{IsSet: true, Value: 1024}},
+					{AppGUID: "stopped-app-guid", Instances: types.NullInt{IsSet: true, Value: 2}, LogRateLimitInBPS: types.NullInt{IsSet: true, Value: 1024}},
+				},
+				ccv3.Warnings{"processes-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			check, warnings, executeErr = actor.CheckQuotaChange("org-guid", "space-guid", change)
+		})
+
+		It("projects the usage under the org and space quotas", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"org-warning", "org-quota-warning", "org-usage-warning", "space-warning",
+				"space-quota-warning", "space-usage-warning", "apps-warning", "processes-warning",
+			))
+
+			Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("org-quota-guid"))
+			Expect(fakeCloudControllerClient.GetSpaceQuotaArgsForCall(0)).To(Equal("space-quota-guid"))
+			Expect(fakeCloudControllerClient.GetProcessesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+
+			Expect(check).To(Equal(QuotaCheck{
+				{Scope: "org", QuotaName: "org-quota", Resource: QuotaResourceMemory, Current: 1792, Change: 512, Limit: 2048},
+				{Scope: "org", QuotaName: "org-quota", Resource: QuotaResourceRoutes, Current: 3, Change: 1, Limit: 10},
+				{Scope: "space", QuotaName: "space-quota", Resource: QuotaResourceAppInstances, Current: 4, Change: 2, Limit: 8},
+				{Scope: "space", QuotaName: "space-quota", Resource: QuotaResourceLogRate, Current: 2048, Change: 1024, Limit: 4096},
+			}))
+			Expect(check.Exceeded()).To(BeTrue())
+		})
+
+		When("the space has no space quota", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns([]resources.Space{{GUID: "space-guid"}}, ccv3.IncludedResources{}, nil, nil)
+			})
+
+			It("only checks the org quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetSpaceQuotaCallCount()).To(Equal(0))
+				Expect(check).To(HaveLen(2))
+				Expect(check[0].Scope).To(Equal("org"))
+				Expect(check[1].Scope).To(Equal("org"))
+			})
+		})
+
+		When("getting the org usage summary fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationUsageSummaryReturns(resources.UsageSummary{}, ccv3.Warnings{"org-usage-warning"}, errors.New("usage-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("usage-error"))
+				Expect(warnings).To(ConsistOf("org-warning", "org-quota-warning", "org-usage-warning"))
+			})
+		})
+	})
+})
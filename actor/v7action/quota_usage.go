@@ -0,0 +1,293 @@
+package v7action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// QuotaUsage is the usage of a quota-limited resource against its limit.
+type QuotaUsage struct {
+	Used int
+	// Limit is not set when the quota does not limit the resource.
+	Limit types.NullInt
+}
+
+// Percent returns the percentage of the limit that is used, rounded down. It
+// returns false when the resource is not limited.
+func (u QuotaUsage) Percent() (int, bool) {
+	if !u.Limit.IsSet {
+		return 0, false
+	}
+	if u.Limit.Value <= 0 {
+		if u.Used > 0 {
+			return 100, true
+		}
+		return 0, true
+	}
+	return u.Used * 100 / u.Limit.Value, true
+}
+
+// Exceeded returns true when more is used than the limit allows.
+func (u QuotaUsage) Exceeded() bool {
+	return u.Limit.IsSet && u.Used > u.Limit.Value
+}
+
+// QuotaUsageReport compares the usage of an org or a space with the limits of
+// its quota. Memory is in megabytes.
+type QuotaUsageReport struct {
+	Name string
+	// QuotaName is empty for a space without a space quota.
+	QuotaName string
+
+	Memory           QuotaUsage
+	AppInstances     QuotaUsage
+	Routes           QuotaUsage
+	ReservedPorts    QuotaUsage
+	ServiceInstances QuotaUsage
+	PaidServicePlans QuotaUsage
+}
+
+func (r QuotaUsageReport) usages() []QuotaUsage {
+	return []QuotaUsage{r.Memory, r.AppInstances, r.Routes, r.ReservedPorts, r.ServiceInstances, r.PaidServicePlans}
+}
+
+// NearLimit returns true when any resource uses at least threshold percent of
+// its limit. Resources with a limit of 0 only count once they are used.
+func (r QuotaUsageReport) NearLimit(threshold int) bool {
+	for _, usage := range r.usages() {
+		if usage.Limit.IsSet && usage.Limit.Value <= 0 && usage.Used == 0 {
+			continue
+		}
+		if percent, limited := usage.Percent(); limited && percent >= threshold {
+			return true
+		}
+	}
+	return false
+}
+
+// Exceeded returns true when any resource uses more than its limit.
+func (r QuotaUsageReport) Exceeded() bool {
+	for _, usage := range r.usages() {
+		if usage.Exceeded() {
+			return true
+		}
+	}
+	return false
+}
+
+// OrganizationQuotaUsage is the quota usage of an org and of each of its
+// spaces.
+type OrganizationQuotaUsage struct {
+	QuotaUsageReport
+	Spaces []QuotaUsageReport
+}
+
+// GetOrganizationQuotaUsage compares the usage of the org and its spaces with
+// their org and space quotas.
+func (actor Actor) GetOrganizationQuotaUsage(orgGUID string) (OrganizationQuotaUsage, Warnings, error) {
+	org, ccWarnings, err := actor.CloudControllerClient.GetOrganization(orgGUID)
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	quota, ccWarnings, err := actor.CloudControllerClient.GetOrganizationQuota(org.QuotaGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	summary, ccWarnings, err := actor.CloudControllerClient.GetOrganizationUsageSummary(orgGUID)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	paidBySpace, paidByOrg, warnings, err := actor.getPaidServiceInstanceCounts(ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	spaces, _, ccWarnings, err := actor.CloudControllerClient.GetSpaces(ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	spaceQuotas, ccWarnings, err := actor.CloudControllerClient.GetSpaceQuotas(ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return OrganizationQuotaUsage{}, allWarnings, err
+	}
+
+	spaceQuotasBySpace := map[string]resources.SpaceQuota{}
+	for _, spaceQuota := range spaceQuotas {
+		for _, spaceGUID := range spaceQuota.SpaceGUIDs {
+			spaceQuotasBySpace[spaceGUID] = spaceQuota
+		}
+	}
+
+	usage := OrganizationQuotaUsage{
+		QuotaUsageReport: newQuotaUsageReport(org.Name, &quota.Quota, summary, paidByOrg[orgGUID]),
+	}
+
+	for _, space := range spaces {
+		spaceSummary, ccWarnings, err := actor.CloudControllerClient.GetSpaceUsageSummary(space.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return OrganizationQuotaUsage{}, allWarnings, err
+		}
+
+		var spaceQuota *resources.Quota
+		if q, ok := spaceQuotasBySpace[space.GUID]; ok {
+			spaceQuota = &q.Quota
+		}
+		usage.Spaces = append(usage.Spaces, newQuotaUsageReport(space.Name, spaceQuota, spaceSummary, paidBySpace[space.GUID]))
+	}
+	sortQuotaUsageReports(usage.Spaces)
+
+	return usage, allWarnings, nil
+}
+
+// GetOrganizationsQuotaUsage compares the usage of every org with its org
+// quota.
+func (actor Actor) GetOrganizationsQuotaUsage() ([]QuotaUsageReport, Warnings, error) {
+	orgs, ccWarnings, err := actor.CloudControllerClient.GetOrganizations()
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	quotas, ccWarnings, err := actor.CloudControllerClient.GetOrganizationQuotas()
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	quotasByGUID := map[string]resources.OrganizationQuota{}
+	for _, quota := range quotas {
+		quotasByGUID[quota.GUID] = quota
+	}
+
+	_, paidByOrg, warnings, err := actor.getPaidServiceInstanceCounts()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var reports []QuotaUsageReport
+	for _, org := range orgs {
+		summary, ccWarnings, err := actor.CloudControllerClient.GetOrganizationUsageSummary(org.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		var quota *resources.Quota
+		if q, ok := quotasByGUID[org.QuotaGUID]; ok {
+			quota = &q.Quota
+		}
+		reports = append(reports, newQuotaUsageReport(org.Name, quota, summary, paidByOrg[org.GUID]))
+	}
+	sortQuotaUsageReports(reports)
+
+	return reports, allWarnings, nil
+}
+
+// getPaidServiceInstanceCounts counts the managed service instances on plans
+// that are not free, by space GUID and by org GUID.
+func (actor Actor) getPaidServiceInstanceCounts(query ...ccv3.Query) (map[string]int, map[string]int, Warnings, error) {
+	query = append(query,
+		ccv3.Query{Key: ccv3.TypeFilter, Values: []string{string(resources.ManagedServiceInstance)}},
+		ccv3.Query{Key: ccv3.FieldsSpace, Values: []string{"guid", "relationships.organization"}},
+	)
+	instances, included, ccWarnings, err := actor.CloudControllerClient.GetServiceInstances(query...)
+	allWarnings := Warnings(ccWarnings)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	var planGUIDs []string
+	seen := map[string]bool{}
+	for _, instance := range instances {
+		if instance.ServicePlanGUID != "" && !seen[instance.ServicePlanGUID] {
+			seen[instance.ServicePlanGUID] = true
+			planGUIDs = append(planGUIDs, instance.ServicePlanGUID)
+		}
+	}
+
+	freePlans := map[string]bool{}
+	ccWarnings, err = batcher.RequestByGUID(planGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		plans, warnings, err := actor.CloudControllerClient.GetServicePlans(ccv3.Query{Key: ccv3.GUIDFilter, Values: guids})
+		for _, plan := range plans {
+			freePlans[plan.GUID] = plan.Free
+		}
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return nil, nil, allWarnings, err
+	}
+
+	orgBySpace := map[string]string{}
+	for _, space := range included.Spaces {
+		orgBySpace[space.GUID] = space.Relationships[constant.RelationshipTypeOrganization].GUID
+	}
+
+	bySpace := map[string]int{}
+	byOrg := map[string]int{}
+	for _, instance := range instances {
+		if free, ok := freePlans[instance.ServicePlanGUID]; !ok || free {
+			continue
+		}
+		bySpace[instance.SpaceGUID]++
+		byOrg[orgBySpace[instance.SpaceGUID]]++
+	}
+
+	return bySpace, byOrg, allWarnings, nil
+}
+
+func newQuotaUsageReport(name string, quota *resources.Quota, summary resources.UsageSummary, paidServiceInstances int) QuotaUsageReport {
+	report := QuotaUsageReport{
+		Name:             name,
+		Memory:           QuotaUsage{Used: summary.MemoryInMB},
+		AppInstances:     QuotaUsage{Used: summary.StartedInstances},
+		Routes:           QuotaUsage{Used: summary.Routes},
+		ReservedPorts:    QuotaUsage{Used: summary.ReservedPorts},
+		ServiceInstances: QuotaUsage{Used: summary.ServiceInstances},
+		PaidServicePlans: QuotaUsage{Used: paidServiceInstances},
+	}
+	if quota == nil {
+		return report
+	}
+
+	report.QuotaName = quota.Name
+	report.Memory.Limit = quotaLimit(quota.Apps.TotalMemory)
+	report.AppInstances.Limit = quotaLimit(quota.Apps.TotalAppInstances)
+	report.Routes.Limit = quotaLimit(quota.Routes.TotalRoutes)
+	report.ReservedPorts.Limit = quotaLimit(quota.Routes.TotalReservedPorts)
+	report.ServiceInstances.Limit = quotaLimit(quota.Services.TotalServiceInstances)
+	if quota.Services.PaidServicePlans != nil && !*quota.Services.PaidServicePlans {
+		report.PaidServicePlans.Limit = types.NullInt{IsSet: true, Value: 0}
+	}
+
+	return report
+}
+
+func quotaLimit(limit *types.NullInt) types.NullInt {
+	if limit == nil {
+		return types.NullInt{}
+	}
+	return *limit
+}
+
+func sortQuotaUsageReports(reports []QuotaUsageReport) {
+	sort.Slice(reports, func(i, j int) bool { return sorting.LessIgnoreCase(reports[i].Name, reports[j].Name) })
+}
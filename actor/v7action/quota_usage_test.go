@@ -0,0 +1,272 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Quota Usage Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	limit := func(value int) types.NullInt {
+		return types.NullInt{IsSet: true, Value: value}
+	}
+
+	limitPtr := func(value int) *types.NullInt {
+		l := limit(value)
+		return &l
+	}
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		paidAllowed := false
+		fakeCloudControllerClient.GetOrganizationQuotaReturns(
+			resources.OrganizationQuota{Quota: resources.Quota{
+				Name: "org-quota",
+				Apps: resources.AppLimit{TotalMemory: limitPtr(2048), TotalAppInstances: limitPtr(10)},
+				Routes: resources.RouteLimit{
+					TotalRoutes:        limitPtr(5),
+					TotalReservedPorts: &types.NullInt{IsSet: false},
+				},
+				Services: resources.ServiceLimit{
+					TotalServiceInstances: limitPtr(4),
+					PaidServicePlans:      &paidAllowed,
+				},
+			}},
+			ccv3.Warnings{"quota-warning"},
+			nil,
+		)
+
+		fakeCloudControllerClient.GetServiceInstancesReturns(
+			[]resources.ServiceInstance{
+				{GUID: "paid-instance-guid", SpaceGUID: "space-guid-1", ServicePlanGUID: "paid-plan-guid"},
+				{GUID: "free-instance-guid", SpaceGUID: "space-guid-1", ServicePlanGUID: "free-plan-guid"},
+			},
+			ccv3.IncludedResources{Spaces: []resources.Space{{
+				GUID: "space-guid-1",
+				Relationships: resources.Relationships{
+					constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-guid"},
+				},
+			}}},
+			ccv3.Warnings{"instances-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServicePlansReturns(
+			[]resources.ServicePlan{{GUID: "paid-plan-guid"}, {GUID: "free-plan-guid", Free: true}},
+			ccv3.Warnings{"plans-warning"},
+			nil,
+		)
+	})
+
+	Describe("QuotaUsage", func() {
+		It("returns the percentage of the limit used", func() {
+			percent, limited := QuotaUsage{Used: 3, Limit: limit(4)}.Percent()
+			Expect(limited).To(BeTrue())
+			Expect(percent).To(Equal(75))
+
+			_, limited = QuotaUsage{Used: 3}.Percent()
+			Expect(limited).To(BeFalse())
+
+			percent, _ = QuotaUsage{Used: 1, Limit: limit(0)}.Percent()
+			Expect(percent).To(Equal(100))
+		})
+
+		It("knows when the limit is exceeded", func() {
+			Expect(QuotaUsage{Used: 5, Limit: limit(4)}.Exceeded()).To(BeTrue())
+			Expect(QuotaUsage{Used: 4, Limit: limit(4)}.Exceeded()).To(BeFalse())
+			Expect(QuotaUsage{Used: 5}.Exceeded()).To(BeFalse())
+		})
+	})
+
+	Describe("QuotaUsageReport.NearLimit", func() {
+		It("ignores unused resources with a limit of 0", func() {
+			report := QuotaUsageReport{
+				Memory:           QuotaUsage{Used: 70, Limit: limit(100)},
+				PaidServicePlans: QuotaUsage{Used: 0, Limit: limit(0)},
+			}
+			Expect(report.NearLimit(80)).To(BeFalse())
+			Expect(report.NearLimit(70)).To(BeTrue())
+
+			report.PaidServicePlans.Used = 1
+			Expect(report.NearLimit(80)).To(BeTrue())
+			Expect(report.Exceeded()).To(BeTrue())
+		})
+	})
+
+	Describe("GetOrganizationQuotaUsage", func() {
+		var usage OrganizationQuotaUsage
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationReturns(
+				resources.Organization{GUID: "org-guid", Name: "some-org", QuotaGUID: "org-quota-guid"},
+				ccv3.Warnings{"org-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationUsageSummaryReturns(
+				resources.UsageSummary{StartedInstances: 4, MemoryInMB: 1024, Routes: 5, ServiceInstances: 2, ReservedPorts: 1},
+				ccv3.Warnings{"org-usage-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{GUID: "space-guid-2", Name: "space-b"}, {GUID: "space-guid-1", Name: "space-a"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"spaces-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceQuotasReturns(
+				[]resources.SpaceQuota{{
+					Quota:      resources.Quota{Name: "space-quota", Apps: resources.AppLimit{TotalMemory: limitPtr(512)}},
+					SpaceGUIDs: []string{"space-guid-1"},
+				}},
+				ccv3.Warnings{"space-quotas-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceUsageSummaryStub = func(spaceGUID string) (resources.UsageSummary, ccv3.Warnings, error) {
+				if spaceGUID == "space-guid-1" {
+					return resources.UsageSummary{MemoryInMB: 512, StartedInstances: 2}, ccv3.Warnings{"space-usage-warning"}, nil
+				}
+				return resources.UsageSummary{MemoryInMB: 512, StartedInstances: 2}, nil, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			usage, warnings, executeErr = actor.GetOrganizationQuotaUsage("org-guid")
+		})
+
+		It("compares the org and its spaces with their quotas", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"org-warning", "quota-warning", "org-usage-warning", "instances-warning", "plans-warning",
+				"spaces-warning", "space-quotas-warning", "space-usage-warning",
+			))
+
+			Expect(fakeCloudControllerClient.GetOrganizationQuotaArgsForCall(0)).To(Equal("org-quota-guid"))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"managed"}},
+				ccv3.Query{Key: ccv3.FieldsSpace, Values: []string{"guid", "relationships.organization"}},
+			))
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"paid-plan-guid", "free-plan-guid"}},
+			))
+
+			Expect(usage.Name).To(Equal("some-org"))
+			Expect(usage.QuotaName).To(Equal("org-quota"))
+			Expect(usage.Memory).To(Equal(QuotaUsage{Used: 1024, Limit: limit(2048)}))
+			Expect(usage.AppInstances).To(Equal(QuotaUsage{Used: 4, Limit: limit(10)}))
+			Expect(usage.Routes).To(Equal(QuotaUsage{Used: 5, Limit: limit(5)}))
+			Expect(usage.ReservedPorts).To(Equal(QuotaUsage{Used: 1}))
+			Expect(usage.ServiceInstances).To(Equal(QuotaUsage{Used: 2, Limit: limit(4)}))
+			Expect(usage.PaidServicePlans).To(Equal(QuotaUsage{Used: 1, Limit: limit(0)}))
+
+			Expect(usage.Spaces).To(HaveLen(2))
+			Expect(usage.Spaces[0].Name).To(Equal("space-a"))
+			Expect(usage.Spaces[0].QuotaName).To(Equal("space-quota"))
+			Expect(usage.Spaces[0].Memory).To(Equal(QuotaUsage{Used: 512, Limit: limit(512)}))
+			Expect(usage.Spaces[0].PaidServicePlans).To(Equal(QuotaUsage{Used: 1}))
+			Expect(usage.Spaces[1].Name).To(Equal("space-b"))
+			Expect(usage.Spaces[1].QuotaName).To(BeEmpty())
+			Expect(usage.Spaces[1].Memory).To(Equal(QuotaUsage{Used: 512}))
+		})
+
+		When("getting a space usage summary fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceUsageSummaryStub = nil
+				fakeCloudControllerClient.GetSpaceUsageSummaryReturns(resources.UsageSummary{}, ccv3.Warnings{"space-usage-warning"}, errors.New("usage-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("usage-error"))
+				Expect(warnings).To(ContainElement("space-usage-warning"))
+			})
+		})
+
+		When("getting the org fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationReturns(resources.Organization{}, ccv3.Warnings{"org-warning"}, errors.New("org-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("org-error"))
+				Expect(warnings).To(ConsistOf("org-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationsQuotaUsage", func() {
+		var reports []QuotaUsageReport
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]resources.Organization{
+					{GUID: "org-guid", Name: "some-org", QuotaGUID: "org-quota-guid"},
+					{GUID: "other-org-guid", Name: "another-org", QuotaGUID: "unknown-quota-guid"},
+				},
+				ccv3.Warnings{"orgs-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationQuotasReturns(
+				[]resources.OrganizationQuota{{Quota: resources.Quota{
+					GUID: "org-quota-guid",
+					Name: "org-quota",
+					Apps: resources.AppLimit{TotalMemory: limitPtr(2048)},
+				}}},
+				ccv3.Warnings{"quotas-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationUsageSummaryReturns(
+				resources.UsageSummary{MemoryInMB: 1024},
+				ccv3.Warnings{"usage-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			reports, warnings, executeErr = actor.GetOrganizationsQuotaUsage()
+		})
+
+		It("compares every org with its quota", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("orgs-warning", "quotas-warning", "instances-warning", "plans-warning", "usage-warning", "usage-warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.TypeFilter, Values: []string{"managed"}},
+				ccv3.Query{Key: ccv3.FieldsSpace, Values: []string{"guid", "relationships.organization"}},
+			))
+
+			Expect(reports).To(HaveLen(2))
+			Expect(reports[0].Name).To(Equal("another-org"))
+			Expect(reports[0].QuotaName).To(BeEmpty())
+			Expect(reports[1].Name).To(Equal("some-org"))
+			Expect(reports[1].Memory).To(Equal(QuotaUsage{Used: 1024, Limit: limit(2048)}))
+			Expect(reports[1].PaidServicePlans.Used).To(Equal(1))
+		})
+
+		When("getting the service plans fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv3.Warnings{"plans-warning"}, errors.New("plans-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("plans-error"))
+				Expect(warnings).To(ConsistOf("orgs-warning", "quotas-warning", "instances-warning", "plans-warning"))
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/versioncheck"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
@@ -12,6 +13,15 @@ import (
 
 const MinimumCCAPIVersionForDeployable = "3.86.0"
 
+// RevisionDetails is a revision together with the droplet and environment
+// variables it deploys.
+type RevisionDetails struct {
+	Revision resources.Revision
+	// Droplet only has its GUID set when the droplet has been deleted.
+	Droplet              resources.Droplet
+	EnvironmentVariables resources.EnvironmentVariables
+}
+
 // GetRevisionsByApplicationNameAndSpace returns revisions for application.
 func (actor *Actor) GetRevisionsByApplicationNameAndSpace(appName string, spaceGUID string) ([]resources.Revision, Warnings, error) {
 	var warnings Warnings
@@ -103,3 +113,50 @@ func (actor Actor) GetApplicationRevisionsDeployed(appGUID string) ([]resources.
 
 	return revisions, Warnings(warnings), nil
 }
+
+// GetCurrentRevision returns the most recent of the app's deployed revisions.
+func (actor Actor) GetCurrentRevision(appGUID string) (resources.Revision, Warnings, error) {
+	revisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisionsDeployed(appGUID)
+	if err != nil {
+		return resources.Revision{}, Warnings(warnings), err
+	}
+
+	if len(revisions) == 0 {
+		return resources.Revision{}, Warnings(warnings), actionerror.NoDeployedRevisionError{}
+	}
+
+	current := revisions[0]
+	for _, revision := range revisions[1:] {
+		if revision.Version > current.Version {
+			current = revision
+		}
+	}
+	return current, Warnings(warnings), nil
+}
+
+// GetRevisionDetails looks up the droplet and environment variables of a
+// revision.
+func (actor Actor) GetRevisionDetails(revision resources.Revision) (RevisionDetails, Warnings, error) {
+	var allWarnings Warnings
+	details := RevisionDetails{Revision: revision}
+
+	droplet, warnings, err := actor.CloudControllerClient.GetDroplet(revision.Droplet.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	switch err.(type) {
+	case nil:
+		details.Droplet = droplet
+	case ccerror.DropletNotFoundError:
+		details.Droplet = resources.Droplet{GUID: revision.Droplet.GUID}
+	default:
+		return RevisionDetails{}, allWarnings, err
+	}
+
+	envVars, warnings, err := actor.CloudControllerClient.GetRevisionEnvironmentVariables(revision.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return RevisionDetails{}, allWarnings, err
+	}
+	details.EnvironmentVariables = envVars
+
+	return details, allWarnings, nil
+}
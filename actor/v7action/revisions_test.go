@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/resources"
@@ -397,4 +398,117 @@ var _ = Describe("Revisions Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetCurrentRevision", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+			revision                  resources.Revision
+			executeErr                error
+			warnings                  Warnings
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+		})
+
+		JustBeforeEach(func() {
+			revision, warnings, executeErr = actor.GetCurrentRevision("some-app-guid")
+		})
+
+		When("several revisions are deployed during a deployment", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsDeployedReturns(
+					[]resources.Revision{{GUID: "revision-2", Version: 2}, {GUID: "revision-3", Version: 3}},
+					ccv3.Warnings{"some-revisions-warning"},
+					nil,
+				)
+			})
+
+			It("returns the most recent one", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-revisions-warning"))
+				Expect(revision.GUID).To(Equal("revision-3"))
+				Expect(fakeCloudControllerClient.GetApplicationRevisionsDeployedArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("no revision is deployed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsDeployedReturns(nil, ccv3.Warnings{"some-revisions-warning"}, nil)
+			})
+
+			It("returns a NoDeployedRevisionError", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoDeployedRevisionError{}))
+				Expect(warnings).To(ConsistOf("some-revisions-warning"))
+			})
+		})
+	})
+
+	Describe("GetRevisionDetails", func() {
+		var (
+			actor                     *Actor
+			fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+			revision                  resources.Revision
+			details                   RevisionDetails
+			executeErr                error
+			warnings                  Warnings
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+			actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+			revision = resources.Revision{GUID: "some-revision-guid", Version: 4, Droplet: resources.Droplet{GUID: "some-droplet-guid"}}
+
+			fakeCloudControllerClient.GetDropletReturns(
+				resources.Droplet{GUID: "some-droplet-guid", State: constant.DropletStaged, Stack: "cflinuxfs4"},
+				ccv3.Warnings{"droplet-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRevisionEnvironmentVariablesReturns(
+				resources.EnvironmentVariables{"SOME_VAR": {Value: "some-value", IsSet: true}},
+				ccv3.Warnings{"env-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			details, warnings, executeErr = actor.GetRevisionDetails(revision)
+		})
+
+		It("returns the revision with its droplet and environment variables", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("droplet-warning", "env-warning"))
+			Expect(fakeCloudControllerClient.GetDropletArgsForCall(0)).To(Equal("some-droplet-guid"))
+			Expect(fakeCloudControllerClient.GetRevisionEnvironmentVariablesArgsForCall(0)).To(Equal("some-revision-guid"))
+			Expect(details).To(Equal(RevisionDetails{
+				Revision:             revision,
+				Droplet:              resources.Droplet{GUID: "some-droplet-guid", State: constant.DropletStaged, Stack: "cflinuxfs4"},
+				EnvironmentVariables: resources.EnvironmentVariables{"SOME_VAR": {Value: "some-value", IsSet: true}},
+			}))
+		})
+
+		When("the droplet has been deleted", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDropletReturns(resources.Droplet{}, ccv3.Warnings{"droplet-warning"}, ccerror.DropletNotFoundError{})
+			})
+
+			It("only keeps the droplet GUID", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(details.Droplet).To(Equal(resources.Droplet{GUID: "some-droplet-guid"}))
+			})
+		})
+
+		When("getting the environment variables fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRevisionEnvironmentVariablesReturns(nil, ccv3.Warnings{"env-warning"}, errors.New("env-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("env-error"))
+				Expect(warnings).To(ConsistOf("droplet-warning", "env-warning"))
+			})
+		})
+	})
 })
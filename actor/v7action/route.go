@@ -59,8 +59,9 @@ func (actor Actor) GetRouteDestinations(routeGUID string) ([]resources.RouteDest
 	var actorDestinations []resources.RouteDestination
 	for _, dst := range destinations {
 		actorDestinations = append(actorDestinations, resources.RouteDestination{
-			GUID: dst.GUID,
-			App:  resources.RouteDestinationApp(dst.App),
+			GUID:   dst.GUID,
+			App:    resources.RouteDestinationApp(dst.App),
+			Weight: dst.Weight,
 		})
 	}
 
@@ -0,0 +1,123 @@
+package v7action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// MapRouteWithWeight maps the app's web process to the route so that it gets
+// weight percent of the route's traffic. The route's other destinations share
+// the rest of the traffic; see UpdateDestinationWeight.
+func (actor Actor) MapRouteWithWeight(route resources.Route, appGUID string, destinationProtocol string, weight int) (Warnings, error) {
+	destination := resources.RouteDestination{
+		App:      resources.RouteDestinationApp{GUID: appGUID},
+		Protocol: destinationProtocol,
+	}
+	destination.App.Process.Type = constant.ProcessTypeWeb
+
+	destinations := append(append([]resources.RouteDestination{}, route.Destinations...), destination)
+	err := setRouteDestinationWeight(destinations, len(destinations)-1, weight)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings, err := actor.CloudControllerClient.ReplaceRouteDestinations(route.GUID, destinations)
+	return Warnings(warnings), err
+}
+
+// UpdateDestinationWeight gives the destination weight percent of the route's
+// traffic. The rest is shared by the route's other destinations in proportion
+// to their current weights, or equally when they are not weighted yet, so
+// that the weights still add up to 100.
+func (actor Actor) UpdateDestinationWeight(route resources.Route, destinationGUID string, weight int) (Warnings, error) {
+	destinations := append([]resources.RouteDestination{}, route.Destinations...)
+
+	index := -1
+	for i, destination := range destinations {
+		if destination.GUID == destinationGUID {
+			index = i
+		}
+	}
+	if index == -1 {
+		return nil, actionerror.RouteDestinationNotFoundError{RouteGUID: route.GUID}
+	}
+
+	err := setRouteDestinationWeight(destinations, index, weight)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings, err := actor.CloudControllerClient.ReplaceRouteDestinations(route.GUID, destinations)
+	return Warnings(warnings), err
+}
+
+func setRouteDestinationWeight(destinations []resources.RouteDestination, index int, weight int) error {
+	others := len(destinations) - 1
+	remainder := 100 - weight
+	if (others == 0 && weight != 100) || weight < 1 || remainder < others {
+		return actionerror.RouteDestinationWeightError{Weight: weight, OtherDestinations: others}
+	}
+	destinations[index].Weight = types.NullInt{IsSet: true, Value: weight}
+
+	allWeighted := true
+	for i, destination := range destinations {
+		if i != index && (!destination.Weight.IsSet || destination.Weight.Value < 1) {
+			allWeighted = false
+		}
+	}
+
+	var otherIndexes []int
+	shares := map[int]int{}
+	totalShares := 0
+	for i, destination := range destinations {
+		if i == index {
+			continue
+		}
+		otherIndexes = append(otherIndexes, i)
+		shares[i] = 1
+		if allWeighted {
+			shares[i] = destination.Weight.Value
+		}
+		totalShares += shares[i]
+	}
+
+	// split the remainder by largest remainder, so the weights add up exactly
+	weights := map[int]int{}
+	assigned := 0
+	for _, i := range otherIndexes {
+		weights[i] = remainder * shares[i] / totalShares
+		assigned += weights[i]
+	}
+	byFraction := append([]int{}, otherIndexes...)
+	sort.SliceStable(byFraction, func(a, b int) bool {
+		return remainder*shares[byFraction[a]]%totalShares > remainder*shares[byFraction[b]]%totalShares
+	})
+	for n := 0; assigned < remainder; n++ {
+		weights[byFraction[n%len(byFraction)]]++
+		assigned++
+	}
+
+	// every destination needs a weight of at least 1
+	for _, i := range otherIndexes {
+		if weights[i] > 0 {
+			continue
+		}
+		largest := otherIndexes[0]
+		for _, j := range otherIndexes {
+			if weights[j] > weights[largest] {
+				largest = j
+			}
+		}
+		weights[largest]--
+		weights[i] = 1
+	}
+
+	for _, i := range otherIndexes {
+		destinations[i].Weight = types.NullInt{IsSet: true, Value: weights[i]}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Destination Weight Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	destination := func(guid string, appGUID string, weight int) resources.RouteDestination {
+		dst := resources.RouteDestination{GUID: guid, App: resources.RouteDestinationApp{GUID: appGUID}}
+		dst.App.Process.Type = "web"
+		if weight != 0 {
+			dst.Weight = types.NullInt{IsSet: true, Value: weight}
+		}
+		return dst
+	}
+
+	replacedWeights := func() map[string]int {
+		Expect(fakeCloudControllerClient.ReplaceRouteDestinationsCallCount()).To(Equal(1))
+		routeGUID, destinations := fakeCloudControllerClient.ReplaceRouteDestinationsArgsForCall(0)
+		Expect(routeGUID).To(Equal("route-guid"))
+
+		weights := map[string]int{}
+		for _, dst := range destinations {
+			Expect(dst.Weight.IsSet).To(BeTrue())
+			weights[dst.App.GUID] = dst.Weight.Value
+		}
+		return weights
+	}
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		fakeCloudControllerClient.ReplaceRouteDestinationsReturns(ccv3.Warnings{"replace-warning"}, nil)
+	})
+
+	Describe("MapRouteWithWeight", func() {
+		var (
+			route  resources.Route
+			weight int
+		)
+
+		BeforeEach(func() {
+			route = resources.Route{
+				GUID:         "route-guid",
+				Destinations: []resources.RouteDestination{destination("destination-guid-1", "app-guid-1", 0)},
+			}
+			weight = 20
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.MapRouteWithWeight(route, "app-guid-2", "http2", weight)
+		})
+
+		It("adds the app and gives the rest of the traffic to the other destination", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("replace-warning"))
+
+			Expect(replacedWeights()).To(Equal(map[string]int{"app-guid-1": 80, "app-guid-2": 20}))
+
+			_, destinations := fakeCloudControllerClient.ReplaceRouteDestinationsArgsForCall(0)
+			Expect(destinations[0].GUID).To(Equal("destination-guid-1"))
+			Expect(destinations[1].App.Process.Type).To(Equal("web"))
+			Expect(destinations[1].Protocol).To(Equal("http2"))
+		})
+
+		It("does not change the route that was passed in", func() {
+			Expect(route.Destinations).To(HaveLen(1))
+			Expect(route.Destinations[0].Weight.IsSet).To(BeFalse())
+		})
+
+		When("the other destinations are not weighted", func() {
+			BeforeEach(func() {
+				route.Destinations = append(route.Destinations,
+					destination("destination-guid-3", "app-guid-3", 0),
+					destination("destination-guid-4", "app-guid-4", 0),
+				)
+				weight = 1
+			})
+
+			It("shares the rest of the traffic equally", func() {
+				Expect(replacedWeights()).To(Equal(map[string]int{"app-guid-1": 33, "app-guid-2": 1, "app-guid-3": 33, "app-guid-4": 33}))
+			})
+		})
+
+		When("the route has no destinations", func() {
+			BeforeEach(func() {
+				route.Destinations = nil
+			})
+
+			It("requires a weight of 100", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteDestinationWeightError{Weight: 20, OtherDestinations: 0}))
+				Expect(fakeCloudControllerClient.ReplaceRouteDestinationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the weight leaves no traffic for the other destinations", func() {
+			BeforeEach(func() {
+				weight = 100
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteDestinationWeightError{Weight: 100, OtherDestinations: 1}))
+				Expect(fakeCloudControllerClient.ReplaceRouteDestinationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("replacing the destinations fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ReplaceRouteDestinationsReturns(ccv3.Warnings{"replace-warning"}, errors.New("replace-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("replace-error"))
+				Expect(warnings).To(ConsistOf("replace-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateDestinationWeight", func() {
+		var (
+			route           resources.Route
+			destinationGUID string
+			weight          int
+		)
+
+		BeforeEach(func() {
+			route = resources.Route{
+				GUID: "route-guid",
+				Destinations: []resources.RouteDestination{
+					destination("destination-guid-1", "app-guid-1", 50),
+					destination("destination-guid-2", "app-guid-2", 30),
+					destination("destination-guid-3", "app-guid-3", 20),
+				},
+			}
+			destinationGUID = "destination-guid-1"
+			weight = 40
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateDestinationWeight(route, destinationGUID, weight)
+		})
+
+		It("shares the rest of the traffic in proportion to the current weights", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("replace-warning"))
+			Expect(replacedWeights()).To(Equal(map[string]int{"app-guid-1": 40, "app-guid-2": 36, "app-guid-3": 24}))
+		})
+
+		When("a proportional share rounds down to 0", func() {
+			BeforeEach(func() {
+				route.Destinations = []resources.RouteDestination{
+					destination("destination-guid-1", "app-guid-1", 1),
+					destination("destination-guid-2", "app-guid-2", 98),
+					destination("destination-guid-3", "app-guid-3", 1),
+				}
+				weight = 97
+			})
+
+			It("keeps every destination at a weight of at least 1", func() {
+				Expect(replacedWeights()).To(Equal(map[string]int{"app-guid-1": 97, "app-guid-2": 2, "app-guid-3": 1}))
+			})
+		})
+
+		When("there are too many other destinations for the weight", func() {
+			BeforeEach(func() {
+				weight = 99
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteDestinationWeightError{Weight: 99, OtherDestinations: 2}))
+				Expect(executeErr.Error()).To(Equal("Weight 99 is invalid: the weights of a route's destinations must add up to 100, so with 2 other destination(s) the weight must be between 1 and 98."))
+			})
+		})
+
+		When("the destination is not on the route", func() {
+			BeforeEach(func() {
+				destinationGUID = "other-destination-guid"
+			})
+
+			It("returns a RouteDestinationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteDestinationNotFoundError{RouteGUID: "route-guid"}))
+				Expect(fakeCloudControllerClient.ReplaceRouteDestinationsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
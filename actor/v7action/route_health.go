@@ -0,0 +1,139 @@
+package v7action
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util"
+)
+
+// RouteDestinationHealth is the instance health of the process that a route
+// destination sends traffic to.
+type RouteDestinationHealth struct {
+	Destination resources.RouteDestination
+	App         resources.Application
+	// Instances is empty when the app has no process of the destination's
+	// type.
+	Instances ProcessInstances
+}
+
+// RunningInstances returns the number of instances that can take traffic.
+func (health RouteDestinationHealth) RunningInstances() int {
+	return health.countInstances(constant.ProcessInstanceRunning)
+}
+
+// CrashedInstances returns the number of crashed instances.
+func (health RouteDestinationHealth) CrashedInstances() int {
+	return health.countInstances(constant.ProcessInstanceCrashed)
+}
+
+func (health RouteDestinationHealth) countInstances(state constant.ProcessInstanceState) int {
+	count := 0
+	for _, instance := range health.Instances {
+		if instance.State == state {
+			count++
+		}
+	}
+	return count
+}
+
+// RouteProbe is the result of an HTTP request to a route.
+type RouteProbe struct {
+	URL        string
+	StatusCode int
+	Status     string
+	Latency    time.Duration
+	// CertificateExpiry is zero when the route was not probed over HTTPS.
+	CertificateExpiry time.Time
+}
+
+// GetRouteDestinationsHealth returns the instance health of every destination
+// of the route, in the order the destinations are returned by the API.
+func (actor Actor) GetRouteDestinationsHealth(route resources.Route) ([]RouteDestinationHealth, Warnings, error) {
+	destinations, allWarnings, err := actor.GetRouteDestinations(route.GUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	if len(destinations) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	route.Destinations = destinations
+	appMap, warnings, err := actor.GetApplicationMapForRoute(route)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var healths []RouteDestinationHealth
+	for _, destination := range destinations {
+		processType := destination.App.Process.Type
+		if processType == "" {
+			processType = constant.ProcessTypeWeb
+		}
+
+		health := RouteDestinationHealth{
+			Destination: destination,
+			App:         appMap[destination.App.GUID],
+		}
+
+		process, ccWarnings, err := actor.CloudControllerClient.GetApplicationProcessByType(destination.App.GUID, processType)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if _, ok := err.(ccerror.ProcessNotFoundError); ok {
+			healths = append(healths, health)
+			continue
+		}
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		instances, ccWarnings, err := actor.CloudControllerClient.GetProcessInstances(process.GUID)
+		allWarnings = append(allWarnings, ccWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		health.Instances = instances
+
+		healths = append(healths, health)
+	}
+
+	return healths, allWarnings, nil
+}
+
+// ProbeRoute makes a GET request to the URL and reports the response status,
+// how long the response took to arrive and, for HTTPS, when the certificate
+// presented by the route expires. Redirects are not followed.
+func (actor Actor) ProbeRoute(url string, timeout time.Duration, skipSSLValidation bool) (RouteProbe, error) {
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: util.NewTLSConfig(nil, skipSSLValidation),
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	start := time.Now()
+	response, err := client.Get(url)
+	if err != nil {
+		return RouteProbe{}, err
+	}
+	defer response.Body.Close()
+
+	probe := RouteProbe{
+		URL:        url,
+		StatusCode: response.StatusCode,
+		Status:     response.Status,
+		Latency:    time.Since(start),
+	}
+	if response.TLS != nil && len(response.TLS.PeerCertificates) > 0 {
+		probe.CertificateExpiry = response.TLS.PeerCertificates[0].NotAfter
+	}
+
+	return probe, nil
+}
@@ -0,0 +1,251 @@
+package v7action_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Health Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetRouteDestinationsHealth", func() {
+		var (
+			healths    []RouteDestinationHealth
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			webDestination := resources.RouteDestination{GUID: "destination-guid-1", App: resources.RouteDestinationApp{GUID: "app-guid-1"}}
+			webDestination.App.Process.Type = "web"
+			workerDestination := resources.RouteDestination{GUID: "destination-guid-2", App: resources.RouteDestinationApp{GUID: "app-guid-2"}}
+			workerDestination.App.Process.Type = "worker"
+
+			fakeCloudControllerClient.GetRouteDestinationsReturns(
+				[]resources.RouteDestination{webDestination, workerDestination},
+				ccv3.Warnings{"get-destinations-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{
+					{GUID: "app-guid-1", Name: "app-1"},
+					{GUID: "app-guid-2", Name: "app-2"},
+				},
+				ccv3.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationProcessByTypeStub = func(appGUID string, processType string) (resources.Process, ccv3.Warnings, error) {
+				return resources.Process{GUID: appGUID + "-" + processType}, ccv3.Warnings{"get-process-warning"}, nil
+			}
+			fakeCloudControllerClient.GetProcessInstancesStub = func(processGUID string) ([]ccv3.ProcessInstance, ccv3.Warnings, error) {
+				if processGUID == "app-guid-1-web" {
+					return []ccv3.ProcessInstance{
+						{Index: 0, State: constant.ProcessInstanceRunning},
+						{Index: 1, State: constant.ProcessInstanceCrashed},
+						{Index: 2, State: constant.ProcessInstanceStarting},
+					}, ccv3.Warnings{"get-instances-warning"}, nil
+				}
+				return []ccv3.ProcessInstance{
+					{Index: 0, State: constant.ProcessInstanceRunning},
+				}, ccv3.Warnings{"get-instances-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			healths, warnings, executeErr = actor.GetRouteDestinationsHealth(resources.Route{GUID: "route-guid"})
+		})
+
+		It("returns the instance health of each destination's process", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"get-destinations-warning",
+				"get-apps-warning",
+				"get-process-warning",
+				"get-instances-warning",
+				"get-process-warning",
+				"get-instances-warning",
+			))
+
+			Expect(fakeCloudControllerClient.GetRouteDestinationsArgsForCall(0)).To(Equal("route-guid"))
+
+			Expect(healths).To(HaveLen(2))
+			Expect(healths[0].App.Name).To(Equal("app-1"))
+			Expect(healths[0].Destination.GUID).To(Equal("destination-guid-1"))
+			Expect(healths[0].Instances).To(HaveLen(3))
+			Expect(healths[0].RunningInstances()).To(Equal(1))
+			Expect(healths[0].CrashedInstances()).To(Equal(1))
+			Expect(healths[1].App.Name).To(Equal("app-2"))
+			Expect(healths[1].RunningInstances()).To(Equal(1))
+			Expect(healths[1].CrashedInstances()).To(Equal(0))
+
+			Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(2))
+			appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(1)
+			Expect(appGUID).To(Equal("app-guid-2"))
+			Expect(processType).To(Equal("worker"))
+			Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(1)).To(Equal("app-guid-2-worker"))
+		})
+
+		When("the route has no destinations", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteDestinationsReturns(nil, ccv3.Warnings{"get-destinations-warning"}, nil)
+			})
+
+			It("returns no healths", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-destinations-warning"))
+				Expect(healths).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("an app does not have the destination's process type", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationProcessByTypeReturns(resources.Process{}, ccv3.Warnings{"get-process-warning"}, ccerror.ProcessNotFoundError{})
+				fakeCloudControllerClient.GetApplicationProcessByTypeStub = nil
+			})
+
+			It("returns the destination without instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(healths).To(HaveLen(2))
+				Expect(healths[0].Instances).To(BeEmpty())
+				Expect(healths[0].RunningInstances()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the destinations fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteDestinationsReturns(nil, ccv3.Warnings{"get-destinations-warning"}, errors.New("get-destinations-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-destinations-error"))
+				Expect(warnings).To(ConsistOf("get-destinations-warning"))
+			})
+		})
+
+		When("getting the instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesStub = nil
+				fakeCloudControllerClient.GetProcessInstancesReturns(nil, ccv3.Warnings{"get-instances-warning"}, errors.New("get-instances-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-instances-error"))
+				Expect(warnings).To(ConsistOf("get-destinations-warning", "get-apps-warning", "get-process-warning", "get-instances-warning"))
+			})
+		})
+	})
+
+	Describe("ProbeRoute", func() {
+		var (
+			server            *httptest.Server
+			skipSSLValidation bool
+
+			probe      RouteProbe
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			skipSSLValidation = false
+		})
+
+		JustBeforeEach(func() {
+			probe, executeErr = actor.ProbeRoute(server.URL+"/some-path", time.Second, skipSSLValidation)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		When("the route is served over HTTP", func() {
+			BeforeEach(func() {
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != "/some-path" {
+						w.WriteHeader(http.StatusNotFound)
+						return
+					}
+					w.WriteHeader(http.StatusTeapot)
+				}))
+			})
+
+			It("returns the response status and latency", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(probe.URL).To(Equal(server.URL + "/some-path"))
+				Expect(probe.StatusCode).To(Equal(http.StatusTeapot))
+				Expect(probe.Status).To(Equal("418 I'm a teapot"))
+				Expect(probe.Latency).To(BeNumerically(">", 0))
+				Expect(probe.CertificateExpiry).To(BeZero())
+			})
+		})
+
+		When("the route redirects", func() {
+			BeforeEach(func() {
+				server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					http.Redirect(w, r, "/elsewhere", http.StatusFound)
+				}))
+			})
+
+			It("does not follow the redirect", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(probe.StatusCode).To(Equal(http.StatusFound))
+			})
+		})
+
+		When("the route is served over HTTPS", func() {
+			BeforeEach(func() {
+				server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				}))
+			})
+
+			When("skipping SSL validation", func() {
+				BeforeEach(func() {
+					skipSSLValidation = true
+				})
+
+				It("returns when the certificate expires", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(probe.StatusCode).To(Equal(http.StatusOK))
+					Expect(probe.CertificateExpiry).To(Equal(server.Certificate().NotAfter))
+				})
+			})
+
+			When("the certificate is not trusted", func() {
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(ContainSubstring("certificate")))
+				})
+			})
+		})
+
+		When("the route cannot be reached", func() {
+			BeforeEach(func() {
+				server = httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+				server.Close()
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,268 @@
+package v7action
+
+import (
+	"sort"
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// sharedSpacesRequestLimit is the number of routes whose shared spaces are
+// requested at the same time.
+const sharedSpacesRequestLimit = 5
+
+// SharedRouteSummary is a route that is shared with spaces other than the one
+// it belongs to.
+type SharedRouteSummary struct {
+	RouteSummary
+	// SharedSpaces are the names of the spaces the route is shared with, as
+	// "org/space".
+	SharedSpaces []string
+}
+
+// HostnameCollision is a hostname that is used by routes on more than one
+// domain.
+type HostnameCollision struct {
+	Hostname string
+	Routes   []RouteSummary
+}
+
+// RouteInventory sorts the routes of an org, or of every org, into the groups
+// that need an operator's attention.
+type RouteInventory struct {
+	Routes             []RouteSummary
+	UnmappedRoutes     []RouteSummary
+	SharedRoutes       []SharedRouteSummary
+	RouteServiceRoutes []RouteSummary
+	HostnameCollisions []HostnameCollision
+}
+
+// UnmappedRoutesToDelete splits the unmapped routes into the ones that can be
+// cleaned up and the ones to keep. A route is kept when it has the keepLabel
+// label, whatever its value, or when it is bound to a route service.
+func (inventory RouteInventory) UnmappedRoutesToDelete(keepLabel string) ([]RouteSummary, []RouteSummary) {
+	var toDelete, toKeep []RouteSummary
+	for _, route := range inventory.UnmappedRoutes {
+		if route.ServiceInstanceName != "" || hasLabel(route.Route, keepLabel) {
+			toKeep = append(toKeep, route)
+			continue
+		}
+		toDelete = append(toDelete, route)
+	}
+	return toDelete, toKeep
+}
+
+// GetRouteInventory returns the route inventory of the org. When orgGUID is
+// empty, the inventory covers the routes of every org.
+func (actor Actor) GetRouteInventory(orgGUID string) (RouteInventory, Warnings, error) {
+	var (
+		routes      []resources.Route
+		allWarnings Warnings
+		err         error
+	)
+
+	if orgGUID == "" {
+		var ccWarnings ccv3.Warnings
+		routes, ccWarnings, err = actor.CloudControllerClient.GetRoutes(ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}})
+		allWarnings = Warnings(ccWarnings)
+	} else {
+		routes, allWarnings, err = actor.GetRoutesByOrg(orgGUID, "")
+	}
+	if err != nil {
+		return RouteInventory{}, allWarnings, err
+	}
+
+	summaries, warnings, err := actor.GetRouteSummaries(routes)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return RouteInventory{}, allWarnings, err
+	}
+
+	inventory := RouteInventory{Routes: summaries}
+	for _, summary := range summaries {
+		if len(summary.Destinations) == 0 {
+			inventory.UnmappedRoutes = append(inventory.UnmappedRoutes, summary)
+		}
+		if summary.ServiceInstanceName != "" {
+			inventory.RouteServiceRoutes = append(inventory.RouteServiceRoutes, summary)
+		}
+	}
+
+	inventory.SharedRoutes, warnings, err = actor.getSharedRouteSummaries(summaries)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return RouteInventory{}, allWarnings, err
+	}
+
+	inventory.HostnameCollisions = findHostnameCollisions(summaries)
+
+	return inventory, allWarnings, nil
+}
+
+// DeleteRoutes deletes the routes one at a time and stops at the first one
+// that cannot be deleted.
+func (actor Actor) DeleteRoutes(routeGUIDs []string) (Warnings, error) {
+	var allWarnings Warnings
+
+	for _, routeGUID := range routeGUIDs {
+		jobURL, warnings, err := actor.CloudControllerClient.DeleteRoute(routeGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		warnings, err = actor.CloudControllerClient.PollJob(jobURL)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) getSharedRouteSummaries(summaries []RouteSummary) ([]SharedRouteSummary, Warnings, error) {
+	sharedSpaceGUIDsByRoute, allWarnings, err := actor.getRoutesSharedSpaceGUIDs(summaries)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var sharedSpaceGUIDs []string
+	seen := map[string]bool{}
+	for _, summary := range summaries {
+		for _, spaceGUID := range sharedSpaceGUIDsByRoute[summary.GUID] {
+			if !seen[spaceGUID] {
+				seen[spaceGUID] = true
+				sharedSpaceGUIDs = append(sharedSpaceGUIDs, spaceGUID)
+			}
+		}
+	}
+
+	if len(sharedSpaceGUIDs) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	var (
+		spaces []resources.Space
+		orgs   []resources.Organization
+	)
+	warnings, err := batcher.RequestByGUID(sharedSpaceGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		batch, included, warnings, err := actor.CloudControllerClient.GetSpaces(
+			ccv3.Query{Key: ccv3.GUIDFilter, Values: guids},
+			ccv3.Query{Key: ccv3.Include, Values: []string{"organization"}},
+		)
+		spaces = append(spaces, batch...)
+		orgs = append(orgs, included.Organizations...)
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgNames := map[string]string{}
+	for _, org := range orgs {
+		orgNames[org.GUID] = org.Name
+	}
+	spaceNames := map[string]string{}
+	for _, space := range spaces {
+		spaceNames[space.GUID] = orgNames[space.Relationships[constant.RelationshipTypeOrganization].GUID] + "/" + space.Name
+	}
+
+	var shared []SharedRouteSummary
+	for _, summary := range summaries {
+		spaceGUIDs := sharedSpaceGUIDsByRoute[summary.GUID]
+		if len(spaceGUIDs) == 0 {
+			continue
+		}
+
+		names := make([]string, 0, len(spaceGUIDs))
+		for _, spaceGUID := range spaceGUIDs {
+			names = append(names, spaceNames[spaceGUID])
+		}
+		sort.Strings(names)
+
+		shared = append(shared, SharedRouteSummary{RouteSummary: summary, SharedSpaces: names})
+	}
+
+	return shared, allWarnings, nil
+}
+
+// getRoutesSharedSpaceGUIDs returns the GUIDs of the spaces each route is
+// shared with. The CC API only lists shared spaces one route at a time, so up
+// to sharedSpacesRequestLimit routes are requested at once.
+func (actor Actor) getRoutesSharedSpaceGUIDs(summaries []RouteSummary) (map[string][]string, Warnings, error) {
+	type sharedSpacesResult struct {
+		spaceGUIDs []string
+		warnings   ccv3.Warnings
+		err        error
+	}
+
+	results := make([]sharedSpacesResult, len(summaries))
+	limit := make(chan struct{}, sharedSpacesRequestLimit)
+	var wg sync.WaitGroup
+	for i, summary := range summaries {
+		wg.Add(1)
+		limit <- struct{}{}
+		go func(i int, routeGUID string) {
+			defer wg.Done()
+			defer func() { <-limit }()
+			spaceGUIDs, warnings, err := actor.CloudControllerClient.GetRouteSharedSpaces(routeGUID)
+			results[i] = sharedSpacesResult{spaceGUIDs: spaceGUIDs, warnings: warnings, err: err}
+		}(i, summary.GUID)
+	}
+	wg.Wait()
+
+	var allWarnings Warnings
+	sharedSpaceGUIDsByRoute := map[string][]string{}
+	for i, result := range results {
+		allWarnings = append(allWarnings, result.warnings...)
+		if result.err != nil {
+			return nil, allWarnings, result.err
+		}
+		sharedSpaceGUIDsByRoute[summaries[i].GUID] = result.spaceGUIDs
+	}
+
+	return sharedSpaceGUIDsByRoute, allWarnings, nil
+}
+
+// findHostnameCollisions returns the hostnames that routes use on more than
+// one domain, in hostname order.
+func findHostnameCollisions(summaries []RouteSummary) []HostnameCollision {
+	routesByHostname := map[string][]RouteSummary{}
+	domainsByHostname := map[string]map[string]bool{}
+	for _, summary := range summaries {
+		if summary.Host == "" {
+			continue
+		}
+		routesByHostname[summary.Host] = append(routesByHostname[summary.Host], summary)
+		if domainsByHostname[summary.Host] == nil {
+			domainsByHostname[summary.Host] = map[string]bool{}
+		}
+		domainsByHostname[summary.Host][summary.DomainName] = true
+	}
+
+	var collisions []HostnameCollision
+	for hostname, routes := range routesByHostname {
+		if len(domainsByHostname[hostname]) < 2 {
+			continue
+		}
+		sort.Slice(routes, func(i, j int) bool { return sorting.LessIgnoreCase(routes[i].URL, routes[j].URL) })
+		collisions = append(collisions, HostnameCollision{Hostname: hostname, Routes: routes})
+	}
+	sort.Slice(collisions, func(i, j int) bool { return sorting.LessIgnoreCase(collisions[i].Hostname, collisions[j].Hostname) })
+
+	return collisions
+}
+
+func hasLabel(route resources.Route, label string) bool {
+	if label == "" || route.Metadata == nil {
+		return false
+	}
+	_, ok := route.Metadata.Labels[label]
+	return ok
+}
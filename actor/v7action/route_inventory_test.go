@@ -0,0 +1,262 @@
+package v7action_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Inventory Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetRouteInventory", func() {
+		var (
+			orgGUID   string
+			inventory RouteInventory
+		)
+
+		BeforeEach(func() {
+			orgGUID = "org-guid"
+
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.Route{
+					{GUID: "unmapped-route-guid", SpaceGUID: "space-guid", Host: "api", URL: "api.example.com", CreatedAt: "2024-01-01T00:00:00Z"},
+					{
+						GUID:         "mapped-route-guid",
+						SpaceGUID:    "space-guid",
+						Host:         "api",
+						URL:          "api.example.org",
+						Destinations: []resources.RouteDestination{{App: resources.RouteDestinationApp{GUID: "app-guid"}}},
+					},
+					{
+						GUID:         "tcp-route-guid",
+						SpaceGUID:    "space-guid",
+						URL:          "tcp.example.com:1024",
+						Port:         1024,
+						Destinations: []resources.RouteDestination{{App: resources.RouteDestinationApp{GUID: "app-guid"}}},
+					},
+				},
+				ccv3.Warnings{"routes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturnsOnCall(0,
+				[]resources.Space{{GUID: "space-guid", Name: "some-space"}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"spaces-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "app-guid", Name: "some-app"}},
+				ccv3.Warnings{"apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRouteBindingsReturns(
+				[]resources.RouteBinding{{RouteGUID: "mapped-route-guid", ServiceInstanceGUID: "service-instance-guid"}},
+				ccv3.IncludedResources{ServiceInstances: []resources.ServiceInstance{{GUID: "service-instance-guid", Name: "some-route-service"}}},
+				ccv3.Warnings{"bindings-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRouteSharedSpacesStub = func(routeGUID string) ([]string, ccv3.Warnings, error) {
+				if routeGUID == "tcp-route-guid" {
+					return []string{"other-space-guid"}, ccv3.Warnings{"shared-spaces-warning"}, nil
+				}
+				return nil, nil, nil
+			}
+			fakeCloudControllerClient.GetSpacesReturnsOnCall(1,
+				[]resources.Space{{
+					GUID: "other-space-guid",
+					Name: "other-space",
+					Relationships: resources.Relationships{
+						constant.RelationshipTypeOrganization: resources.Relationship{GUID: "other-org-guid"},
+					},
+				}},
+				ccv3.IncludedResources{Organizations: []resources.Organization{{GUID: "other-org-guid", Name: "other-org"}}},
+				ccv3.Warnings{"shared-spaces-lookup-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			inventory, warnings, executeErr = actor.GetRouteInventory(orgGUID)
+		})
+
+		It("sorts the org's routes into the inventory", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("routes-warning", "spaces-warning", "apps-warning", "bindings-warning", "shared-spaces-warning", "shared-spaces-lookup-warning"))
+
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+			))
+
+			Expect(inventory.Routes).To(HaveLen(3))
+
+			Expect(inventory.UnmappedRoutes).To(HaveLen(1))
+			Expect(inventory.UnmappedRoutes[0].GUID).To(Equal("unmapped-route-guid"))
+			Expect(inventory.UnmappedRoutes[0].SpaceName).To(Equal("some-space"))
+			Expect(inventory.UnmappedRoutes[0].CreatedAt).To(Equal("2024-01-01T00:00:00Z"))
+
+			Expect(inventory.RouteServiceRoutes).To(HaveLen(1))
+			Expect(inventory.RouteServiceRoutes[0].ServiceInstanceName).To(Equal("some-route-service"))
+
+			Expect(inventory.SharedRoutes).To(HaveLen(1))
+			Expect(inventory.SharedRoutes[0].GUID).To(Equal("tcp-route-guid"))
+			Expect(inventory.SharedRoutes[0].SharedSpaces).To(Equal([]string{"other-org/other-space"}))
+
+			Expect(inventory.HostnameCollisions).To(HaveLen(1))
+			Expect(inventory.HostnameCollisions[0].Hostname).To(Equal("api"))
+			Expect(inventory.HostnameCollisions[0].Routes).To(HaveLen(2))
+			Expect(inventory.HostnameCollisions[0].Routes[0].URL).To(Equal("api.example.com"))
+			Expect(inventory.HostnameCollisions[0].Routes[1].URL).To(Equal("api.example.org"))
+		})
+
+		When("no org is given", func() {
+			BeforeEach(func() {
+				orgGUID = ""
+			})
+
+			It("gets the routes of every org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			})
+		})
+
+		When("there are more routes than shared spaces are requested for at once", func() {
+			var (
+				lock        sync.Mutex
+				inFlight    int
+				maxInFlight int
+			)
+
+			BeforeEach(func() {
+				var routes []resources.Route
+				for i := 0; i < 12; i++ {
+					routes = append(routes, resources.Route{GUID: fmt.Sprintf("route-guid-%d", i), SpaceGUID: "space-guid"})
+				}
+				fakeCloudControllerClient.GetRoutesReturns(routes, nil, nil)
+
+				inFlight, maxInFlight = 0, 0
+				fakeCloudControllerClient.GetRouteSharedSpacesStub = func(routeGUID string) ([]string, ccv3.Warnings, error) {
+					lock.Lock()
+					inFlight++
+					if inFlight > maxInFlight {
+						maxInFlight = inFlight
+					}
+					lock.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					lock.Lock()
+					inFlight--
+					lock.Unlock()
+					return nil, ccv3.Warnings{routeGUID + "-warning"}, nil
+				}
+			})
+
+			It("requests them in parallel for a limited number of routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetRouteSharedSpacesCallCount()).To(Equal(12))
+				Expect(maxInFlight).To(BeNumerically(">", 1))
+				Expect(maxInFlight).To(BeNumerically("<=", 5))
+				Expect(warnings).To(ContainElements("route-guid-0-warning", "route-guid-11-warning"))
+			})
+		})
+
+		When("getting the shared spaces fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRouteSharedSpacesStub = nil
+				fakeCloudControllerClient.GetRouteSharedSpacesReturns(nil, ccv3.Warnings{"shared-spaces-warning"}, errors.New("shared-spaces-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("shared-spaces-error"))
+				Expect(warnings).To(ContainElement("shared-spaces-warning"))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"routes-warning"}, errors.New("routes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("routes-error"))
+				Expect(warnings).To(ConsistOf("routes-warning"))
+			})
+		})
+	})
+
+	Describe("RouteInventory.UnmappedRoutesToDelete", func() {
+		It("keeps routes with the keep label or a route service", func() {
+			labeled := RouteSummary{Route: resources.Route{GUID: "labeled-guid", Metadata: &resources.Metadata{
+				Labels: map[string]types.NullString{"keep": types.NewNullString("")},
+			}}}
+			bound := RouteSummary{Route: resources.Route{GUID: "bound-guid"}, ServiceInstanceName: "some-route-service"}
+			orphan := RouteSummary{Route: resources.Route{GUID: "orphan-guid"}}
+
+			inventory := RouteInventory{UnmappedRoutes: []RouteSummary{labeled, bound, orphan}}
+
+			toDelete, toKeep := inventory.UnmappedRoutesToDelete("keep")
+			Expect(toDelete).To(Equal([]RouteSummary{orphan}))
+			Expect(toKeep).To(Equal([]RouteSummary{labeled, bound}))
+
+			toDelete, _ = inventory.UnmappedRoutesToDelete("other-label")
+			Expect(toDelete).To(Equal([]RouteSummary{labeled, orphan}))
+		})
+	})
+
+	Describe("DeleteRoutes", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteRouteReturns("job-url", ccv3.Warnings{"delete-warning"}, nil)
+			fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteRoutes([]string{"route-guid-1", "route-guid-2"})
+		})
+
+		It("deletes every route", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("delete-warning", "poll-warning", "delete-warning", "poll-warning"))
+
+			Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(0)).To(Equal("route-guid-1"))
+			Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(1)).To(Equal("route-guid-2"))
+			Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("job-url")))
+		})
+
+		When("a deletion fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-warning"}, errors.New("job-error"))
+			})
+
+			It("stops and returns the error", func() {
+				Expect(executeErr).To(MatchError("job-error"))
+				Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(1))
+				Expect(warnings).To(ConsistOf("delete-warning", "poll-warning"))
+			})
+		})
+	})
+})
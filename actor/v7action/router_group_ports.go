@@ -0,0 +1,422 @@
+package v7action
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+const (
+	minReservablePort  = 1024
+	maxReservablePort  = 65535
+	routerGroupTypeTCP = "tcp"
+)
+
+// PortRange is an inclusive range of ports.
+type PortRange struct {
+	Start int
+	End   int
+}
+
+// Size returns the number of ports in the range.
+func (r PortRange) Size() int {
+	return r.End - r.Start + 1
+}
+
+func (r PortRange) String() string {
+	if r.Start == r.End {
+		return strconv.Itoa(r.Start)
+	}
+	return fmt.Sprintf("%d-%d", r.Start, r.End)
+}
+
+// RouterGroupPort is a port of a TCP router group that a route is using.
+type RouterGroupPort struct {
+	Port      int
+	Route     resources.Route
+	SpaceName string
+	OrgName   string
+}
+
+// RouterGroupQuotaReservation is an org or space quota that reserves route
+// ports, together with the number of orgs or spaces it applies to.
+type RouterGroupQuotaReservation struct {
+	QuotaName     string
+	SpaceQuota    bool
+	ReservedPorts int
+	AppliedTo     int
+}
+
+// RouterGroupPortUsage reports how the reservable ports of a TCP router group
+// are used.
+type RouterGroupPortUsage struct {
+	RouterGroup       RouterGroup
+	ReservablePorts   []PortRange
+	UsedPorts         []RouterGroupPort
+	QuotaReservations []RouterGroupQuotaReservation
+	// ReservedPorts is the number of ports that org quotas reserve on top of
+	// the ports already in use. Space quotas are carved out of their org's
+	// quota, so they do not add to it.
+	ReservedPorts int
+	// UnusedPorts are the reservable ports that no route is using, including
+	// the ones that quotas reserve.
+	UnusedPorts []PortRange
+}
+
+// TotalPorts returns the number of reservable ports.
+func (u RouterGroupPortUsage) TotalPorts() int {
+	return countPorts(u.ReservablePorts)
+}
+
+// FreePorts returns the number of ports that are neither in use nor reserved
+// by quotas.
+func (u RouterGroupPortUsage) FreePorts() int {
+	free := countPorts(u.UnusedPorts) - u.ReservedPorts
+	if free < 0 {
+		return 0
+	}
+	return free
+}
+
+// ParseReservablePorts parses the reservable ports of a router group, a
+// comma-separated list of ports and port ranges such as "1024-1199,1300".
+// The ranges are returned in order.
+func ParseReservablePorts(reservablePorts string) ([]PortRange, error) {
+	invalid := actionerror.InvalidReservablePortsError{ReservablePorts: reservablePorts}
+
+	var ranges []PortRange
+	for _, part := range strings.Split(reservablePorts, ",") {
+		bounds := strings.SplitN(strings.TrimSpace(part), "-", 2)
+
+		start, err := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, invalid
+		}
+		end := start
+		if len(bounds) == 2 {
+			end, err = strconv.Atoi(strings.TrimSpace(bounds[1]))
+			if err != nil {
+				return nil, invalid
+			}
+		}
+
+		if start < minReservablePort || end > maxReservablePort || start > end {
+			return nil, invalid
+		}
+		ranges = append(ranges, PortRange{Start: start, End: end})
+	}
+
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Start < ranges[j].Start })
+	for i := 1; i < len(ranges); i++ {
+		if ranges[i].Start <= ranges[i-1].End {
+			return nil, invalid
+		}
+	}
+
+	return ranges, nil
+}
+
+// GetRouterGroupPortUsage reports which reservable ports of the TCP router
+// group are used by routes, how many are reserved by org and space quotas and
+// which are free.
+func (actor Actor) GetRouterGroupPortUsage(routerGroupName string) (RouterGroupPortUsage, Warnings, error) {
+	routerGroup, err := actor.getTCPRouterGroup(routerGroupName)
+	if err != nil {
+		return RouterGroupPortUsage{}, nil, err
+	}
+
+	ranges, err := ParseReservablePorts(routerGroup.ReservablePorts)
+	if err != nil {
+		return RouterGroupPortUsage{}, nil, err
+	}
+
+	routes, allWarnings, err := actor.getRouterGroupRoutes(routerGroup.GUID)
+	if err != nil {
+		return RouterGroupPortUsage{}, allWarnings, err
+	}
+
+	usedPorts, warnings, err := actor.getRouterGroupPorts(routes)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return RouterGroupPortUsage{}, allWarnings, err
+	}
+
+	reservations, warnings, err := actor.getRoutePortQuotaReservations()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return RouterGroupPortUsage{}, allWarnings, err
+	}
+
+	reserved := 0
+	for _, reservation := range reservations {
+		if !reservation.SpaceQuota {
+			reserved += reservation.ReservedPorts * reservation.AppliedTo
+		}
+	}
+	reserved -= len(usedPorts)
+	if reserved < 0 {
+		reserved = 0
+	}
+
+	ports := make([]int, 0, len(usedPorts))
+	for _, usedPort := range usedPorts {
+		ports = append(ports, usedPort.Port)
+	}
+
+	return RouterGroupPortUsage{
+		RouterGroup:       routerGroup,
+		ReservablePorts:   ranges,
+		UsedPorts:         usedPorts,
+		QuotaReservations: reservations,
+		ReservedPorts:     reserved,
+		UnusedPorts:       subtractPorts(ranges, ports),
+	}, allWarnings, nil
+}
+
+// UpdateRouterGroupReservablePorts replaces the reservable ports of the TCP
+// router group. Ports that routes are using must stay reservable.
+func (actor Actor) UpdateRouterGroupReservablePorts(routerGroupName string, reservablePorts string) (RouterGroup, Warnings, error) {
+	ranges, err := ParseReservablePorts(reservablePorts)
+	if err != nil {
+		return RouterGroup{}, nil, err
+	}
+
+	routerGroup, err := actor.getTCPRouterGroup(routerGroupName)
+	if err != nil {
+		return RouterGroup{}, nil, err
+	}
+
+	routes, warnings, err := actor.getRouterGroupRoutes(routerGroup.GUID)
+	if err != nil {
+		return RouterGroup{}, warnings, err
+	}
+
+	var outsidePorts []int
+	for _, route := range routes {
+		if !portInRanges(route.Port, ranges) {
+			outsidePorts = append(outsidePorts, route.Port)
+		}
+	}
+	if len(outsidePorts) > 0 {
+		sort.Ints(outsidePorts)
+		return RouterGroup{}, warnings, actionerror.RouterGroupPortsInUseError{Name: routerGroupName, Ports: outsidePorts}
+	}
+
+	routerGroup.ReservablePorts = formatPortRanges(ranges)
+	updatedRouterGroup, err := actor.RoutingClient.UpdateRouterGroup(router.RouterGroup(routerGroup))
+	if err != nil {
+		return RouterGroup{}, warnings, err
+	}
+
+	return RouterGroup(updatedRouterGroup), warnings, nil
+}
+
+func (actor Actor) getTCPRouterGroup(routerGroupName string) (RouterGroup, error) {
+	routerGroup, err := actor.GetRouterGroupByName(routerGroupName)
+	if err != nil {
+		return RouterGroup{}, err
+	}
+
+	if routerGroup.Type != routerGroupTypeTCP {
+		return RouterGroup{}, actionerror.RouterGroupNotTCPError{Name: routerGroupName}
+	}
+
+	return routerGroup, nil
+}
+
+// getRouterGroupRoutes returns the routes of the domains that use the router
+// group, in port order.
+func (actor Actor) getRouterGroupRoutes(routerGroupGUID string) ([]resources.Route, Warnings, error) {
+	domains, warnings, err := actor.CloudControllerClient.GetDomains()
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var domainGUIDs []string
+	for _, domain := range domains {
+		if domain.RouterGroup == routerGroupGUID {
+			domainGUIDs = append(domainGUIDs, domain.GUID)
+		}
+	}
+	if len(domainGUIDs) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	routes, warnings, err := actor.CloudControllerClient.GetRoutes(ccv3.Query{
+		Key:    ccv3.DomainGUIDFilter,
+		Values: domainGUIDs,
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var tcpRoutes []resources.Route
+	for _, route := range routes {
+		if route.Port != 0 {
+			tcpRoutes = append(tcpRoutes, route)
+		}
+	}
+	sort.SliceStable(tcpRoutes, func(i, j int) bool { return tcpRoutes[i].Port < tcpRoutes[j].Port })
+
+	return tcpRoutes, allWarnings, nil
+}
+
+func (actor Actor) getRouterGroupPorts(routes []resources.Route) ([]RouterGroupPort, Warnings, error) {
+	if len(routes) == 0 {
+		return nil, nil, nil
+	}
+
+	spaceGUIDs := map[string]bool{}
+	var guids []string
+	for _, route := range routes {
+		if !spaceGUIDs[route.SpaceGUID] {
+			spaceGUIDs[route.SpaceGUID] = true
+			guids = append(guids, route.SpaceGUID)
+		}
+	}
+
+	spaces, included, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: guids},
+		ccv3.Query{Key: ccv3.Include, Values: []string{"organization"}},
+	)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	orgNames := map[string]string{}
+	for _, org := range included.Organizations {
+		orgNames[org.GUID] = org.Name
+	}
+	spacesByGUID := map[string]resources.Space{}
+	for _, space := range spaces {
+		spacesByGUID[space.GUID] = space
+	}
+
+	var ports []RouterGroupPort
+	for _, route := range routes {
+		space := spacesByGUID[route.SpaceGUID]
+		ports = append(ports, RouterGroupPort{
+			Port:      route.Port,
+			Route:     route,
+			SpaceName: space.Name,
+			OrgName:   orgNames[space.Relationships[constant.RelationshipTypeOrganization].GUID],
+		})
+	}
+
+	return ports, Warnings(warnings), nil
+}
+
+// getRoutePortQuotaReservations returns the org and space quotas that reserve
+// route ports. Quotas that do not limit reserved ports are left out.
+func (actor Actor) getRoutePortQuotaReservations() ([]RouterGroupQuotaReservation, Warnings, error) {
+	orgQuotas, warnings, err := actor.CloudControllerClient.GetOrganizationQuotas()
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgs, warnings, err := actor.CloudControllerClient.GetOrganizations()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spaceQuotas, warnings, err := actor.CloudControllerClient.GetSpaceQuotas()
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	orgsByQuota := map[string]int{}
+	for _, org := range orgs {
+		orgsByQuota[org.QuotaGUID]++
+	}
+
+	var reservations []RouterGroupQuotaReservation
+	for _, quota := range orgQuotas {
+		if reservedPorts := quotaReservedPorts(quota.Quota); reservedPorts > 0 {
+			reservations = append(reservations, RouterGroupQuotaReservation{
+				QuotaName:     quota.Name,
+				ReservedPorts: reservedPorts,
+				AppliedTo:     orgsByQuota[quota.GUID],
+			})
+		}
+	}
+	for _, quota := range spaceQuotas {
+		if reservedPorts := quotaReservedPorts(quota.Quota); reservedPorts > 0 {
+			reservations = append(reservations, RouterGroupQuotaReservation{
+				QuotaName:     quota.Name,
+				SpaceQuota:    true,
+				ReservedPorts: reservedPorts,
+				AppliedTo:     len(quota.SpaceGUIDs),
+			})
+		}
+	}
+
+	return reservations, allWarnings, nil
+}
+
+func quotaReservedPorts(quota resources.Quota) int {
+	if quota.Routes.TotalReservedPorts == nil || !quota.Routes.TotalReservedPorts.IsSet {
+		return 0
+	}
+	return quota.Routes.TotalReservedPorts.Value
+}
+
+func portInRanges(port int, ranges []PortRange) bool {
+	for _, r := range ranges {
+		if port >= r.Start && port <= r.End {
+			return true
+		}
+	}
+	return false
+}
+
+// subtractPorts returns the ranges with the given ports taken out.
+func subtractPorts(ranges []PortRange, ports []int) []PortRange {
+	sortedPorts := append([]int{}, ports...)
+	sort.Ints(sortedPorts)
+
+	var remaining []PortRange
+	for _, r := range ranges {
+		start := r.Start
+		for _, port := range sortedPorts {
+			if port < start || port > r.End {
+				continue
+			}
+			if port > start {
+				remaining = append(remaining, PortRange{Start: start, End: port - 1})
+			}
+			start = port + 1
+		}
+		if start <= r.End {
+			remaining = append(remaining, PortRange{Start: start, End: r.End})
+		}
+	}
+	return remaining
+}
+
+func countPorts(ranges []PortRange) int {
+	count := 0
+	for _, r := range ranges {
+		count += r.Size()
+	}
+	return count
+}
+
+func formatPortRanges(ranges []PortRange) string {
+	parts := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		parts = append(parts, r.String())
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,257 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/router"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Router Group Port Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeRoutingClient         *v7actionfakes.FakeRoutingClient
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	reservedPorts := func(ports int) resources.RouteLimit {
+		return resources.RouteLimit{TotalReservedPorts: &types.NullInt{IsSet: true, Value: ports}}
+	}
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		fakeRoutingClient = new(v7actionfakes.FakeRoutingClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, fakeRoutingClient, nil)
+
+		fakeRoutingClient.GetRouterGroupByNameReturns(router.RouterGroup{
+			GUID:            "router-group-guid",
+			Name:            "default-tcp",
+			ReservablePorts: "1024-1033,2000",
+			Type:            "tcp",
+		}, nil)
+
+		fakeCloudControllerClient.GetDomainsReturns(
+			[]resources.Domain{
+				{GUID: "tcp-domain-guid", RouterGroup: "router-group-guid"},
+				{GUID: "http-domain-guid"},
+			},
+			ccv3.Warnings{"domains-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetRoutesReturns(
+			[]resources.Route{
+				{GUID: "route-guid-2", Port: 2000, SpaceGUID: "space-guid"},
+				{GUID: "route-guid-1", Port: 1025, SpaceGUID: "space-guid"},
+			},
+			ccv3.Warnings{"routes-warning"},
+			nil,
+		)
+	})
+
+	Describe("ParseReservablePorts", func() {
+		It("parses ports and port ranges in order", func() {
+			ranges, err := ParseReservablePorts("2000, 1024-1199")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ranges).To(Equal([]PortRange{{Start: 1024, End: 1199}, {Start: 2000, End: 2000}}))
+		})
+
+		DescribeTable("rejects invalid reservable ports",
+			func(reservablePorts string) {
+				_, err := ParseReservablePorts(reservablePorts)
+				Expect(err).To(MatchError(actionerror.InvalidReservablePortsError{ReservablePorts: reservablePorts}))
+			},
+			Entry("empty", ""),
+			Entry("not a number", "some-port"),
+			Entry("below 1024", "80-1100"),
+			Entry("above 65535", "65000-65536"),
+			Entry("reversed range", "1100-1024"),
+			Entry("overlapping ranges", "1024-1100,1100-1200"),
+		)
+	})
+
+	Describe("GetRouterGroupPortUsage", func() {
+		var usage RouterGroupPortUsage
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{
+					GUID: "space-guid",
+					Name: "some-space",
+					Relationships: resources.Relationships{
+						constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-guid"},
+					},
+				}},
+				ccv3.IncludedResources{Organizations: []resources.Organization{{GUID: "org-guid", Name: "some-org"}}},
+				ccv3.Warnings{"spaces-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationQuotasReturns(
+				[]resources.OrganizationQuota{
+					{Quota: resources.Quota{GUID: "org-quota-guid", Name: "tcp-quota", Routes: reservedPorts(3)}},
+					{Quota: resources.Quota{GUID: "default-quota-guid", Name: "default"}},
+				},
+				ccv3.Warnings{"org-quotas-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]resources.Organization{
+					{GUID: "org-guid", QuotaGUID: "org-quota-guid"},
+					{GUID: "other-org-guid", QuotaGUID: "org-quota-guid"},
+					{GUID: "default-org-guid", QuotaGUID: "default-quota-guid"},
+				},
+				ccv3.Warnings{"orgs-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpaceQuotasReturns(
+				[]resources.SpaceQuota{
+					{Quota: resources.Quota{Name: "space-tcp-quota", Routes: reservedPorts(1)}, SpaceGUIDs: []string{"space-guid"}},
+				},
+				ccv3.Warnings{"space-quotas-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			usage, warnings, executeErr = actor.GetRouterGroupPortUsage("default-tcp")
+		})
+
+		It("reports the used, reserved and free ports", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("domains-warning", "routes-warning", "spaces-warning", "org-quotas-warning", "orgs-warning", "space-quotas-warning"))
+
+			Expect(fakeRoutingClient.GetRouterGroupByNameArgsForCall(0)).To(Equal("default-tcp"))
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"tcp-domain-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"space-guid"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"organization"}},
+			))
+
+			Expect(usage.RouterGroup.Name).To(Equal("default-tcp"))
+			Expect(usage.TotalPorts()).To(Equal(11))
+			Expect(usage.UsedPorts).To(HaveLen(2))
+			Expect(usage.UsedPorts[0].Port).To(Equal(1025))
+			Expect(usage.UsedPorts[0].SpaceName).To(Equal("some-space"))
+			Expect(usage.UsedPorts[0].OrgName).To(Equal("some-org"))
+			Expect(usage.UsedPorts[1].Port).To(Equal(2000))
+
+			Expect(usage.QuotaReservations).To(Equal([]RouterGroupQuotaReservation{
+				{QuotaName: "tcp-quota", ReservedPorts: 3, AppliedTo: 2},
+				{QuotaName: "space-tcp-quota", SpaceQuota: true, ReservedPorts: 1, AppliedTo: 1},
+			}))
+			Expect(usage.ReservedPorts).To(Equal(4))
+			Expect(usage.UnusedPorts).To(Equal([]PortRange{{Start: 1024, End: 1024}, {Start: 1026, End: 1033}}))
+			Expect(usage.FreePorts()).To(Equal(5))
+		})
+
+		When("no domain uses the router group", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(nil, ccv3.Warnings{"domains-warning"}, nil)
+			})
+
+			It("reports every port as unused", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+				Expect(usage.UsedPorts).To(BeEmpty())
+				Expect(usage.UnusedPorts).To(Equal([]PortRange{{Start: 1024, End: 1033}, {Start: 2000, End: 2000}}))
+			})
+		})
+
+		When("the router group is not a TCP router group", func() {
+			BeforeEach(func() {
+				fakeRoutingClient.GetRouterGroupByNameReturns(router.RouterGroup{Name: "default-tcp", Type: "http"}, nil)
+			})
+
+			It("returns a RouterGroupNotTCPError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouterGroupNotTCPError{Name: "default-tcp"}))
+			})
+		})
+
+		When("getting the quotas fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationQuotasReturns(nil, ccv3.Warnings{"org-quotas-warning"}, errors.New("quota-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("quota-error"))
+				Expect(warnings).To(ConsistOf("domains-warning", "routes-warning", "spaces-warning", "org-quotas-warning"))
+			})
+		})
+	})
+
+	Describe("UpdateRouterGroupReservablePorts", func() {
+		var (
+			reservablePorts string
+			routerGroup     RouterGroup
+		)
+
+		BeforeEach(func() {
+			reservablePorts = "2000, 1024-1100"
+			fakeRoutingClient.UpdateRouterGroupReturns(router.RouterGroup{Name: "default-tcp", ReservablePorts: "1024-1100,2000"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			routerGroup, warnings, executeErr = actor.UpdateRouterGroupReservablePorts("default-tcp", reservablePorts)
+		})
+
+		It("updates the router group with the normalized ports", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("domains-warning", "routes-warning"))
+			Expect(routerGroup.ReservablePorts).To(Equal("1024-1100,2000"))
+
+			Expect(fakeRoutingClient.UpdateRouterGroupCallCount()).To(Equal(1))
+			Expect(fakeRoutingClient.UpdateRouterGroupArgsForCall(0)).To(Equal(router.RouterGroup{
+				GUID:            "router-group-guid",
+				Name:            "default-tcp",
+				ReservablePorts: "1024-1100,2000",
+				Type:            "tcp",
+			}))
+		})
+
+		When("routes use ports outside the new ranges", func() {
+			BeforeEach(func() {
+				reservablePorts = "3000-3100"
+			})
+
+			It("returns a RouterGroupPortsInUseError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouterGroupPortsInUseError{Name: "default-tcp", Ports: []int{1025, 2000}}))
+				Expect(fakeRoutingClient.UpdateRouterGroupCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the ports are invalid", func() {
+			BeforeEach(func() {
+				reservablePorts = "80"
+			})
+
+			It("returns an error before looking up the router group", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidReservablePortsError{ReservablePorts: "80"}))
+				Expect(fakeRoutingClient.GetRouterGroupByNameCallCount()).To(Equal(0))
+			})
+		})
+
+		When("updating the router group fails", func() {
+			BeforeEach(func() {
+				fakeRoutingClient.UpdateRouterGroupReturns(router.RouterGroup{}, errors.New("update-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(warnings).To(ConsistOf("domains-warning", "routes-warning"))
+			})
+		})
+	})
+})
@@ -7,4 +7,5 @@ import "code.cloudfoundry.org/cli/api/router"
 type RoutingClient interface {
 	GetRouterGroups() ([]router.RouterGroup, error)
 	GetRouterGroupByName(name string) (router.RouterGroup, error)
+	UpdateRouterGroup(routerGroup router.RouterGroup) (router.RouterGroup, error)
 }
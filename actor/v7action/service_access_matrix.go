@@ -0,0 +1,279 @@
+package v7action
+
+import (
+	"fmt"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// ServiceAccessLevel is how an org can see a service plan.
+type ServiceAccessLevel string
+
+const (
+	// ServiceAccessPublic plans are visible in every org.
+	ServiceAccessPublic ServiceAccessLevel = "public"
+	// ServiceAccessOrganization plans are visible in the orgs they are enabled
+	// for.
+	ServiceAccessOrganization ServiceAccessLevel = "org"
+	// ServiceAccessSpace plans come from a space-scoped broker and are only
+	// visible in its space.
+	ServiceAccessSpace ServiceAccessLevel = "space"
+	// ServiceAccessDisabled plans are not visible in the org.
+	ServiceAccessDisabled ServiceAccessLevel = "disabled"
+)
+
+// ServiceAccessMatrixRow is the access of every org to a service plan.
+type ServiceAccessMatrixRow struct {
+	BrokerName          string
+	ServiceOfferingName string
+	ServicePlanName     string
+	VisibilityType      resources.ServicePlanVisibilityType
+	// AccessByOrganization holds the access of the orgs that can see the plan,
+	// by org name.
+	AccessByOrganization map[string]ServiceAccessLevel
+}
+
+// AccessFor returns the access of the org to the plan.
+func (row ServiceAccessMatrixRow) AccessFor(orgName string) ServiceAccessLevel {
+	if access, ok := row.AccessByOrganization[orgName]; ok {
+		return access
+	}
+	return ServiceAccessDisabled
+}
+
+// ServiceAccessMatrix is the access of every org to every service plan.
+type ServiceAccessMatrix struct {
+	OrganizationNames []string
+	Plans             []ServiceAccessMatrixRow
+}
+
+// DesiredServiceAccess is an entry of a service access file, which sets who
+// can see a service plan.
+type DesiredServiceAccess struct {
+	Broker   string `yaml:"broker"`
+	Offering string `yaml:"offering"`
+	Plan     string `yaml:"plan"`
+	// Access is "public", "orgs" or "none".
+	Access string   `yaml:"access"`
+	Orgs   []string `yaml:"orgs,omitempty"`
+}
+
+type serviceAccessFile struct {
+	ServiceAccess []DesiredServiceAccess `yaml:"service_access"`
+}
+
+// ServiceAccessChange is a call to EnableServiceAccess or
+// DisableServiceAccess that brings a plan to its desired access.
+type ServiceAccessChange struct {
+	Enable              bool
+	BrokerName          string
+	ServiceOfferingName string
+	ServicePlanName     string
+	// OrganizationName is empty when the change is for all orgs.
+	OrganizationName string
+}
+
+// GetServiceAccessMatrix returns the access of every org to the service plans
+// of the offering and broker. Empty names match every offering or broker.
+func (actor *Actor) GetServiceAccessMatrix(offeringName, brokerName string) (ServiceAccessMatrix, Warnings, error) {
+	orgs, allWarnings, err := actor.GetOrganizations("")
+	if err != nil {
+		return ServiceAccessMatrix{}, allWarnings, err
+	}
+
+	offerings, warnings, err := actor.getServiceOfferings(offeringName, brokerName)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServiceAccessMatrix{}, allWarnings, err
+	}
+
+	plans, ccWarnings, err := actor.CloudControllerClient.GetServicePlansWithSpaceAndOrganization(buildPlansFilterForGet(offeringName, brokerName, "")...)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceAccessMatrix{}, allWarnings, err
+	}
+
+	matrix := ServiceAccessMatrix{}
+	for _, org := range orgs {
+		matrix.OrganizationNames = append(matrix.OrganizationNames, org.Name)
+	}
+
+	for _, plan := range plans {
+		offering, ok := offerings[plan.ServiceOfferingGUID]
+		if !ok {
+			continue
+		}
+
+		row := ServiceAccessMatrixRow{
+			BrokerName:           offering.brokerName,
+			ServiceOfferingName:  offering.offeringName,
+			ServicePlanName:      plan.Name,
+			VisibilityType:       plan.VisibilityType,
+			AccessByOrganization: map[string]ServiceAccessLevel{},
+		}
+
+		switch plan.VisibilityType {
+		case resources.ServicePlanVisibilityPublic:
+			for _, name := range matrix.OrganizationNames {
+				row.AccessByOrganization[name] = ServiceAccessPublic
+			}
+		case resources.ServicePlanVisibilityOrganization:
+			visibility, ccWarnings, err := actor.CloudControllerClient.GetServicePlanVisibility(plan.GUID)
+			allWarnings = append(allWarnings, ccWarnings...)
+			if err != nil {
+				return ServiceAccessMatrix{}, allWarnings, err
+			}
+			for _, org := range visibility.Organizations {
+				row.AccessByOrganization[org.Name] = ServiceAccessOrganization
+			}
+		case resources.ServicePlanVisibilitySpace:
+			row.AccessByOrganization[plan.OrganizationName] = ServiceAccessSpace
+		}
+
+		matrix.Plans = append(matrix.Plans, row)
+	}
+
+	sort.Slice(matrix.Plans, func(i, j int) bool {
+		if matrix.Plans[i].BrokerName != matrix.Plans[j].BrokerName {
+			return matrix.Plans[i].BrokerName < matrix.Plans[j].BrokerName
+		}
+		if matrix.Plans[i].ServiceOfferingName != matrix.Plans[j].ServiceOfferingName {
+			return matrix.Plans[i].ServiceOfferingName < matrix.Plans[j].ServiceOfferingName
+		}
+		return matrix.Plans[i].ServicePlanName < matrix.Plans[j].ServicePlanName
+	})
+
+	return matrix, allWarnings, nil
+}
+
+// ParseServiceAccessFile reads the desired access of service plans from a
+// YAML file with a service_access list.
+func (actor *Actor) ParseServiceAccessFile(rawFile []byte) ([]DesiredServiceAccess, error) {
+	var file serviceAccessFile
+	err := yaml.UnmarshalStrict(rawFile, &file)
+	if err != nil {
+		return nil, actionerror.ServiceAccessFileError{Reason: err.Error()}
+	}
+
+	seen := map[string]bool{}
+	for i, entry := range file.ServiceAccess {
+		if entry.Broker == "" || entry.Offering == "" || entry.Plan == "" {
+			return nil, actionerror.ServiceAccessFileError{Reason: fmt.Sprintf("entry %d must set broker, offering and plan", i+1)}
+		}
+
+		key := entry.Broker + "/" + entry.Offering + "/" + entry.Plan
+		if seen[key] {
+			return nil, actionerror.ServiceAccessFileError{Reason: fmt.Sprintf("plan %s of service offering %s from broker %s is listed more than once", entry.Plan, entry.Offering, entry.Broker)}
+		}
+		seen[key] = true
+
+		switch entry.Access {
+		case "public", "none":
+			if len(entry.Orgs) > 0 {
+				return nil, actionerror.ServiceAccessFileError{Reason: fmt.Sprintf("entry %d can only list orgs with access 'orgs'", i+1)}
+			}
+		case "orgs":
+			if len(entry.Orgs) == 0 {
+				return nil, actionerror.ServiceAccessFileError{Reason: fmt.Sprintf("entry %d must list the orgs to enable the plan for", i+1)}
+			}
+		default:
+			return nil, actionerror.ServiceAccessFileError{Reason: fmt.Sprintf("entry %d has access '%s'; use 'public', 'orgs' or 'none'", i+1, entry.Access)}
+		}
+	}
+
+	return file.ServiceAccess, nil
+}
+
+// GetServiceAccessChanges compares the desired access of the plans with their
+// current access and returns the changes that make them match, in the order
+// they have to be made. Plans that are not listed are left alone.
+func (actor *Actor) GetServiceAccessChanges(desired []DesiredServiceAccess) ([]ServiceAccessChange, Warnings, error) {
+	matrix, warnings, err := actor.GetServiceAccessMatrix("", "")
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	orgExists := map[string]bool{}
+	for _, name := range matrix.OrganizationNames {
+		orgExists[name] = true
+	}
+
+	var changes []ServiceAccessChange
+	for _, entry := range desired {
+		row, ok := findServiceAccessRow(matrix, entry)
+		if !ok {
+			return nil, warnings, actionerror.ServicePlanNotFoundError{
+				PlanName:          entry.Plan,
+				OfferingName:      entry.Offering,
+				ServiceBrokerName: entry.Broker,
+			}
+		}
+
+		if row.VisibilityType == resources.ServicePlanVisibilitySpace {
+			return nil, warnings, actionerror.ServicePlanVisibilityTypeError{}
+		}
+
+		change := func(enable bool, orgName string) ServiceAccessChange {
+			return ServiceAccessChange{
+				Enable:              enable,
+				BrokerName:          entry.Broker,
+				ServiceOfferingName: entry.Offering,
+				ServicePlanName:     entry.Plan,
+				OrganizationName:    orgName,
+			}
+		}
+
+		switch entry.Access {
+		case "public":
+			if row.VisibilityType != resources.ServicePlanVisibilityPublic {
+				changes = append(changes, change(true, ""))
+			}
+		case "none":
+			if row.VisibilityType != resources.ServicePlanVisibilityAdmin {
+				changes = append(changes, change(false, ""))
+			}
+		case "orgs":
+			desiredOrgs := map[string]bool{}
+			for _, orgName := range entry.Orgs {
+				if !orgExists[orgName] {
+					return nil, warnings, actionerror.OrganizationNotFoundError{Name: orgName}
+				}
+				desiredOrgs[orgName] = true
+			}
+
+			// org-level access cannot be changed while the plan is public
+			if row.VisibilityType == resources.ServicePlanVisibilityPublic {
+				changes = append(changes, change(false, ""))
+			}
+
+			for _, orgName := range entry.Orgs {
+				if row.AccessFor(orgName) != ServiceAccessOrganization {
+					changes = append(changes, change(true, orgName))
+				}
+			}
+
+			if row.VisibilityType == resources.ServicePlanVisibilityOrganization {
+				for _, orgName := range matrix.OrganizationNames {
+					if row.AccessFor(orgName) == ServiceAccessOrganization && !desiredOrgs[orgName] {
+						changes = append(changes, change(false, orgName))
+					}
+				}
+			}
+		}
+	}
+
+	return changes, warnings, nil
+}
+
+func findServiceAccessRow(matrix ServiceAccessMatrix, entry DesiredServiceAccess) (ServiceAccessMatrixRow, bool) {
+	for _, row := range matrix.Plans {
+		if row.BrokerName == entry.Broker && row.ServiceOfferingName == entry.Offering && row.ServicePlanName == entry.Plan {
+			return row, true
+		}
+	}
+	return ServiceAccessMatrixRow{}, false
+}
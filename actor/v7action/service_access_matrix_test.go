@@ -0,0 +1,254 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Access Matrix Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+
+		fakeCloudControllerClient.GetOrganizationsReturns(
+			[]resources.Organization{{Name: "org-a"}, {Name: "org-b"}, {Name: "org-c"}},
+			ccv3.Warnings{"orgs-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServiceOfferingsReturns(
+			[]resources.ServiceOffering{
+				{GUID: "db-guid", Name: "db", ServiceBrokerName: "broker"},
+				{GUID: "cache-guid", Name: "cache", ServiceBrokerName: "other-broker"},
+			},
+			ccv3.Warnings{"offerings-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServicePlansWithSpaceAndOrganizationReturns(
+			[]ccv3.ServicePlanWithSpaceAndOrganization{
+				{GUID: "small-guid", Name: "small", VisibilityType: resources.ServicePlanVisibilityPublic, ServiceOfferingGUID: "db-guid"},
+				{GUID: "large-guid", Name: "large", VisibilityType: resources.ServicePlanVisibilityOrganization, ServiceOfferingGUID: "db-guid"},
+				{GUID: "legacy-guid", Name: "legacy", VisibilityType: resources.ServicePlanVisibilityAdmin, ServiceOfferingGUID: "db-guid"},
+				{GUID: "local-guid", Name: "local", VisibilityType: resources.ServicePlanVisibilitySpace, ServiceOfferingGUID: "cache-guid", OrganizationName: "org-c", SpaceName: "space"},
+			},
+			ccv3.Warnings{"plans-warning"},
+			nil,
+		)
+		fakeCloudControllerClient.GetServicePlanVisibilityReturns(
+			resources.ServicePlanVisibility{
+				Type:          resources.ServicePlanVisibilityOrganization,
+				Organizations: []resources.ServicePlanVisibilityDetail{{Name: "org-a"}, {Name: "org-b"}},
+			},
+			ccv3.Warnings{"visibility-warning"},
+			nil,
+		)
+	})
+
+	Describe("GetServiceAccessMatrix", func() {
+		var matrix ServiceAccessMatrix
+
+		JustBeforeEach(func() {
+			matrix, warnings, executeErr = actor.GetServiceAccessMatrix("", "broker")
+		})
+
+		It("returns the access of every org to every plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("orgs-warning", "offerings-warning", "plans-warning", "visibility-warning"))
+
+			Expect(fakeCloudControllerClient.GetServicePlansWithSpaceAndOrganizationArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceBrokerNamesFilter, Values: []string{"broker"}},
+			))
+			Expect(fakeCloudControllerClient.GetServicePlanVisibilityArgsForCall(0)).To(Equal("large-guid"))
+
+			Expect(matrix.OrganizationNames).To(Equal([]string{"org-a", "org-b", "org-c"}))
+			Expect(matrix.Plans).To(HaveLen(4))
+
+			large, legacy, small, cache := matrix.Plans[0], matrix.Plans[1], matrix.Plans[2], matrix.Plans[3]
+			Expect(cache.ServicePlanName).To(Equal("local"))
+			Expect(cache.AccessFor("org-a")).To(Equal(ServiceAccessDisabled))
+			Expect(cache.AccessFor("org-c")).To(Equal(ServiceAccessSpace))
+
+			Expect(large.ServicePlanName).To(Equal("large"))
+			Expect(large.AccessFor("org-a")).To(Equal(ServiceAccessOrganization))
+			Expect(large.AccessFor("org-b")).To(Equal(ServiceAccessOrganization))
+			Expect(large.AccessFor("org-c")).To(Equal(ServiceAccessDisabled))
+
+			Expect(legacy.AccessFor("org-a")).To(Equal(ServiceAccessDisabled))
+
+			Expect(small.BrokerName).To(Equal("broker"))
+			Expect(small.ServiceOfferingName).To(Equal("db"))
+			Expect(small.AccessFor("org-c")).To(Equal(ServiceAccessPublic))
+		})
+
+		When("getting a plan visibility fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlanVisibilityReturns(resources.ServicePlanVisibility{}, ccv3.Warnings{"visibility-warning"}, errors.New("visibility-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("visibility-error"))
+				Expect(warnings).To(ContainElement("visibility-warning"))
+			})
+		})
+	})
+
+	Describe("ParseServiceAccessFile", func() {
+		var (
+			rawFile string
+			desired []DesiredServiceAccess
+		)
+
+		JustBeforeEach(func() {
+			desired, executeErr = actor.ParseServiceAccessFile([]byte(rawFile))
+		})
+
+		When("the file is valid", func() {
+			BeforeEach(func() {
+				rawFile = `---
+service_access:
+- broker: broker
+  offering: db
+  plan: small
+  access: public
+- broker: broker
+  offering: db
+  plan: large
+  access: orgs
+  orgs: [org-a, org-c]
+`
+			})
+
+			It("returns the desired access of each plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(desired).To(Equal([]DesiredServiceAccess{
+					{Broker: "broker", Offering: "db", Plan: "small", Access: "public"},
+					{Broker: "broker", Offering: "db", Plan: "large", Access: "orgs", Orgs: []string{"org-a", "org-c"}},
+				}))
+			})
+		})
+
+		DescribeTable("invalid files",
+			func(rawFile string, reason string) {
+				_, err := actor.ParseServiceAccessFile([]byte(rawFile))
+				Expect(err).To(MatchError(actionerror.ServiceAccessFileError{Reason: reason}))
+			},
+			Entry("missing plan",
+				"service_access:\n- {broker: b, offering: o, access: public}\n",
+				"entry 1 must set broker, offering and plan"),
+			Entry("unknown access",
+				"service_access:\n- {broker: b, offering: o, plan: p, access: private}\n",
+				"entry 1 has access 'private'; use 'public', 'orgs' or 'none'"),
+			Entry("orgs without access orgs",
+				"service_access:\n- {broker: b, offering: o, plan: p, access: none, orgs: [org-a]}\n",
+				"entry 1 can only list orgs with access 'orgs'"),
+			Entry("access orgs without orgs",
+				"service_access:\n- {broker: b, offering: o, plan: p, access: orgs}\n",
+				"entry 1 must list the orgs to enable the plan for"),
+			Entry("duplicate plan",
+				"service_access:\n- {broker: b, offering: o, plan: p, access: none}\n- {broker: b, offering: o, plan: p, access: public}\n",
+				"plan p of service offering o from broker b is listed more than once"),
+		)
+
+		When("the file has unknown fields", func() {
+			BeforeEach(func() {
+				rawFile = "service_access:\n- {broker: b, offering: o, plan: p, access: public, visibility: all}\n"
+			})
+
+			It("returns a ServiceAccessFileError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(actionerror.ServiceAccessFileError{}))
+			})
+		})
+	})
+
+	Describe("GetServiceAccessChanges", func() {
+		var (
+			desired []DesiredServiceAccess
+			changes []ServiceAccessChange
+		)
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.GetServiceAccessChanges(desired)
+		})
+
+		When("the plans need to change", func() {
+			BeforeEach(func() {
+				desired = []DesiredServiceAccess{
+					{Broker: "broker", Offering: "db", Plan: "small", Access: "orgs", Orgs: []string{"org-b"}},
+					{Broker: "broker", Offering: "db", Plan: "large", Access: "orgs", Orgs: []string{"org-b", "org-c"}},
+					{Broker: "broker", Offering: "db", Plan: "legacy", Access: "public"},
+				}
+			})
+
+			It("returns the changes in the order to make them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("orgs-warning", "offerings-warning", "plans-warning", "visibility-warning"))
+				Expect(changes).To(Equal([]ServiceAccessChange{
+					{Enable: false, BrokerName: "broker", ServiceOfferingName: "db", ServicePlanName: "small"},
+					{Enable: true, BrokerName: "broker", ServiceOfferingName: "db", ServicePlanName: "small", OrganizationName: "org-b"},
+					{Enable: true, BrokerName: "broker", ServiceOfferingName: "db", ServicePlanName: "large", OrganizationName: "org-c"},
+					{Enable: false, BrokerName: "broker", ServiceOfferingName: "db", ServicePlanName: "large", OrganizationName: "org-a"},
+					{Enable: true, BrokerName: "broker", ServiceOfferingName: "db", ServicePlanName: "legacy"},
+				}))
+			})
+		})
+
+		When("the plans already have the desired access", func() {
+			BeforeEach(func() {
+				desired = []DesiredServiceAccess{
+					{Broker: "broker", Offering: "db", Plan: "small", Access: "public"},
+					{Broker: "broker", Offering: "db", Plan: "large", Access: "orgs", Orgs: []string{"org-b", "org-a"}},
+					{Broker: "broker", Offering: "db", Plan: "legacy", Access: "none"},
+				}
+			})
+
+			It("returns no changes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(BeEmpty())
+			})
+		})
+
+		When("a plan does not exist", func() {
+			BeforeEach(func() {
+				desired = []DesiredServiceAccess{{Broker: "broker", Offering: "db", Plan: "huge", Access: "public"}}
+			})
+
+			It("returns a ServicePlanNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServicePlanNotFoundError{PlanName: "huge", OfferingName: "db", ServiceBrokerName: "broker"}))
+			})
+		})
+
+		When("an org does not exist", func() {
+			BeforeEach(func() {
+				desired = []DesiredServiceAccess{{Broker: "broker", Offering: "db", Plan: "large", Access: "orgs", Orgs: []string{"org-z"}}}
+			})
+
+			It("returns an OrganizationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "org-z"}))
+			})
+		})
+
+		When("a plan is space-scoped", func() {
+			BeforeEach(func() {
+				desired = []DesiredServiceAccess{{Broker: "other-broker", Offering: "cache", Plan: "local", Access: "public"}}
+			})
+
+			It("returns a ServicePlanVisibilityTypeError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ServicePlanVisibilityTypeError{}))
+			})
+		})
+	})
+})
@@ -7,8 +7,13 @@ import (
 	"code.cloudfoundry.org/cli/util/railway"
 )
 
-func (actor Actor) GetServiceBrokers() ([]resources.ServiceBroker, Warnings, error) {
-	serviceBrokers, warnings, err := actor.CloudControllerClient.GetServiceBrokers()
+func (actor Actor) GetServiceBrokers(labelSelector string) ([]resources.ServiceBroker, Warnings, error) {
+	var queries []ccv3.Query
+	if len(labelSelector) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}})
+	}
+
+	serviceBrokers, warnings, err := actor.CloudControllerClient.GetServiceBrokers(queries...)
 	if err != nil {
 		return nil, Warnings(warnings), err
 	}
@@ -0,0 +1,314 @@
+package v7action
+
+import (
+	"fmt"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/servicebroker"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"github.com/blang/semver/v4"
+)
+
+var cliFriendlyName = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// CatalogProblemSeverity is "error" for problems that make the Cloud
+// Controller reject the catalog, and "warning" for problems that go against a
+// recommendation of the Open Service Broker API.
+type CatalogProblemSeverity string
+
+const (
+	CatalogProblemError   CatalogProblemSeverity = "error"
+	CatalogProblemWarning CatalogProblemSeverity = "warning"
+)
+
+// CatalogProblem is a field of a broker catalog that does not follow the Open
+// Service Broker API.
+type CatalogProblem struct {
+	Severity CatalogProblemSeverity
+	// Field is the path to the field in the catalog, such as
+	// services[0].plans[1].id.
+	Field   string
+	Message string
+}
+
+type CatalogProblems []CatalogProblem
+
+// Errors returns the problems that are errors.
+func (problems CatalogProblems) Errors() CatalogProblems {
+	var errors CatalogProblems
+	for _, problem := range problems {
+		if problem.Severity == CatalogProblemError {
+			errors = append(errors, problem)
+		}
+	}
+	return errors
+}
+
+// CatalogChangeType is how a catalog changes an offering or plan registered
+// in the Cloud Controller.
+type CatalogChangeType string
+
+const (
+	CatalogChangeAdded   CatalogChangeType = "added"
+	CatalogChangeRemoved CatalogChangeType = "removed"
+	CatalogChangeRenamed CatalogChangeType = "renamed"
+)
+
+// CatalogChange is a service offering or plan that updating a broker with its
+// catalog adds, removes or renames.
+type CatalogChange struct {
+	Type         CatalogChangeType
+	OfferingName string
+	// PlanName is empty when the offering itself changes.
+	PlanName string
+	// OldName is the registered name of a renamed offering or plan.
+	OldName string
+	// ServiceInstances is the number of service instances of a removed plan.
+	ServiceInstances int
+}
+
+// ServiceBrokerCatalogDiff is what updating a broker with a catalog changes.
+type ServiceBrokerCatalogDiff struct {
+	// BrokerRegistered is false when no broker has the name yet, in which case
+	// every offering and plan of the catalog is added.
+	BrokerRegistered bool
+	Changes          []CatalogChange
+}
+
+// RemovedPlansWithInstances returns the removed plans that still have
+// service instances.
+func (diff ServiceBrokerCatalogDiff) RemovedPlansWithInstances() []CatalogChange {
+	var changes []CatalogChange
+	for _, change := range diff.Changes {
+		if change.Type == CatalogChangeRemoved && change.PlanName != "" && change.ServiceInstances > 0 {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// ValidateServiceBrokerCatalog checks the catalog against the Open Service
+// Broker API.
+func (Actor) ValidateServiceBrokerCatalog(catalog servicebroker.Catalog) CatalogProblems {
+	var problems CatalogProblems
+	add := func(severity CatalogProblemSeverity, field string, message string) {
+		problems = append(problems, CatalogProblem{Severity: severity, Field: field, Message: message})
+	}
+
+	if len(catalog.Services) == 0 {
+		add(CatalogProblemWarning, "services", "the catalog has no services")
+	}
+
+	serviceIDs := map[string]bool{}
+	serviceNames := map[string]bool{}
+	planIDs := map[string]bool{}
+
+	for i, service := range catalog.Services {
+		field := fmt.Sprintf("services[%d]", i)
+
+		switch {
+		case service.ID == "":
+			add(CatalogProblemError, field+".id", "is required")
+		case serviceIDs[service.ID]:
+			add(CatalogProblemError, field+".id", fmt.Sprintf("%q is used by another service", service.ID))
+		}
+		serviceIDs[service.ID] = true
+
+		switch {
+		case service.Name == "":
+			add(CatalogProblemError, field+".name", "is required")
+		case serviceNames[service.Name]:
+			add(CatalogProblemError, field+".name", fmt.Sprintf("%q is used by another service", service.Name))
+		case !cliFriendlyName.MatchString(service.Name):
+			add(CatalogProblemWarning, field+".name", fmt.Sprintf("%q should be lowercase with no spaces", service.Name))
+		}
+		serviceNames[service.Name] = true
+
+		if service.Description == "" {
+			add(CatalogProblemError, field+".description", "is required")
+		}
+		if service.Bindable == nil {
+			add(CatalogProblemError, field+".bindable", "is required")
+		}
+
+		if client := service.DashboardClient; client != nil {
+			if client.ID == "" {
+				add(CatalogProblemError, field+".dashboard_client.id", "is required")
+			}
+			if client.Secret == "" {
+				add(CatalogProblemError, field+".dashboard_client.secret", "is required")
+			}
+			if client.RedirectURI == "" {
+				add(CatalogProblemError, field+".dashboard_client.redirect_uri", "is required")
+			}
+		}
+
+		if len(service.Plans) == 0 {
+			add(CatalogProblemError, field+".plans", "the service has no plans")
+		}
+
+		planNames := map[string]bool{}
+		for j, plan := range service.Plans {
+			planField := fmt.Sprintf("%s.plans[%d]", field, j)
+
+			switch {
+			case plan.ID == "":
+				add(CatalogProblemError, planField+".id", "is required")
+			case planIDs[plan.ID]:
+				add(CatalogProblemError, planField+".id", fmt.Sprintf("%q is used by another plan", plan.ID))
+			}
+			planIDs[plan.ID] = true
+
+			switch {
+			case plan.Name == "":
+				add(CatalogProblemError, planField+".name", "is required")
+			case planNames[plan.Name]:
+				add(CatalogProblemError, planField+".name", fmt.Sprintf("%q is used by another plan of the service", plan.Name))
+			case !cliFriendlyName.MatchString(plan.Name):
+				add(CatalogProblemWarning, planField+".name", fmt.Sprintf("%q should be lowercase with no spaces", plan.Name))
+			}
+			planNames[plan.Name] = true
+
+			if plan.Description == "" {
+				add(CatalogProblemError, planField+".description", "is required")
+			}
+
+			if plan.MaintenanceInfo != nil {
+				if _, err := semver.Parse(plan.MaintenanceInfo.Version); err != nil {
+					add(CatalogProblemError, planField+".maintenance_info.version", fmt.Sprintf("%q is not a semantic version", plan.MaintenanceInfo.Version))
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+// DiffServiceBrokerCatalog compares the catalog with the service offerings
+// and plans registered for the broker, matching them by their catalog IDs. It
+// counts the service instances of the plans that the catalog removes.
+func (actor Actor) DiffServiceBrokerCatalog(serviceBrokerName string, catalog servicebroker.Catalog) (ServiceBrokerCatalogDiff, Warnings, error) {
+	broker, allWarnings, err := actor.GetServiceBrokerByName(serviceBrokerName)
+	if err != nil {
+		if _, ok := err.(actionerror.ServiceBrokerNotFoundError); !ok {
+			return ServiceBrokerCatalogDiff{}, allWarnings, err
+		}
+		return ServiceBrokerCatalogDiff{Changes: diffCatalog(catalog, nil, nil, nil)}, allWarnings, nil
+	}
+
+	brokerFilter := ccv3.Query{Key: ccv3.ServiceBrokerGUIDsFilter, Values: []string{broker.GUID}}
+
+	offerings, ccWarnings, err := actor.CloudControllerClient.GetServiceOfferings(brokerFilter)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceBrokerCatalogDiff{}, allWarnings, err
+	}
+
+	plans, ccWarnings, err := actor.CloudControllerClient.GetServicePlans(brokerFilter)
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceBrokerCatalogDiff{}, allWarnings, err
+	}
+
+	var removedPlanGUIDs []string
+	for _, plan := range plans {
+		if !catalogHasPlan(catalog, plan.BrokerCatalogID) {
+			removedPlanGUIDs = append(removedPlanGUIDs, plan.GUID)
+		}
+	}
+
+	instanceCounts := map[string]int{}
+	ccWarnings, err = batcher.RequestByGUID(removedPlanGUIDs, func(guids []string) (ccv3.Warnings, error) {
+		instances, _, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+			ccv3.Query{Key: ccv3.ServicePlanGUIDsFilter, Values: guids},
+			ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+		)
+		for _, instance := range instances {
+			instanceCounts[instance.ServicePlanGUID]++
+		}
+		return warnings, err
+	})
+	allWarnings = append(allWarnings, ccWarnings...)
+	if err != nil {
+		return ServiceBrokerCatalogDiff{}, allWarnings, err
+	}
+
+	return ServiceBrokerCatalogDiff{
+		BrokerRegistered: true,
+		Changes:          diffCatalog(catalog, offerings, plans, instanceCounts),
+	}, allWarnings, nil
+}
+
+// diffCatalog compares the catalog with the registered offerings and plans.
+// instanceCounts holds the number of service instances by plan GUID.
+func diffCatalog(catalog servicebroker.Catalog, offerings []resources.ServiceOffering, plans []resources.ServicePlan, instanceCounts map[string]int) []CatalogChange {
+	offeringsByID := map[string]resources.ServiceOffering{}
+	for _, offering := range offerings {
+		offeringsByID[offering.BrokerCatalogID] = offering
+	}
+	plansByID := map[string]resources.ServicePlan{}
+	for _, plan := range plans {
+		plansByID[plan.BrokerCatalogID] = plan
+	}
+
+	var changes []CatalogChange
+	servicesInCatalog := map[string]bool{}
+	plansInCatalog := map[string]bool{}
+
+	for _, service := range catalog.Services {
+		servicesInCatalog[service.ID] = true
+		offering, registered := offeringsByID[service.ID]
+		switch {
+		case !registered:
+			changes = append(changes, CatalogChange{Type: CatalogChangeAdded, OfferingName: service.Name})
+		case offering.Name != service.Name:
+			changes = append(changes, CatalogChange{Type: CatalogChangeRenamed, OfferingName: service.Name, OldName: offering.Name})
+		}
+
+		for _, plan := range service.Plans {
+			plansInCatalog[plan.ID] = true
+			registeredPlan, registered := plansByID[plan.ID]
+			switch {
+			case !registered:
+				changes = append(changes, CatalogChange{Type: CatalogChangeAdded, OfferingName: service.Name, PlanName: plan.Name})
+			case registeredPlan.Name != plan.Name:
+				changes = append(changes, CatalogChange{Type: CatalogChangeRenamed, OfferingName: service.Name, PlanName: plan.Name, OldName: registeredPlan.Name})
+			}
+		}
+	}
+
+	offeringNames := map[string]string{}
+	for _, offering := range offerings {
+		offeringNames[offering.GUID] = offering.Name
+		if !servicesInCatalog[offering.BrokerCatalogID] {
+			changes = append(changes, CatalogChange{Type: CatalogChangeRemoved, OfferingName: offering.Name})
+		}
+	}
+
+	for _, plan := range plans {
+		if !plansInCatalog[plan.BrokerCatalogID] {
+			changes = append(changes, CatalogChange{
+				Type:             CatalogChangeRemoved,
+				OfferingName:     offeringNames[plan.ServiceOfferingGUID],
+				PlanName:         plan.Name,
+				ServiceInstances: instanceCounts[plan.GUID],
+			})
+		}
+	}
+
+	return changes
+}
+
+func catalogHasPlan(catalog servicebroker.Catalog, planID string) bool {
+	for _, service := range catalog.Services {
+		for _, plan := range service.Plans {
+			if plan.ID == planID {
+				return true
+			}
+		}
+	}
+	return false
+}
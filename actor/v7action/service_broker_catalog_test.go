@@ -0,0 +1,261 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/servicebroker"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Broker Catalog Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+
+		bindable = true
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("ValidateServiceBrokerCatalog", func() {
+		var (
+			catalog  servicebroker.Catalog
+			problems CatalogProblems
+		)
+
+		BeforeEach(func() {
+			catalog = servicebroker.Catalog{
+				Services: []servicebroker.CatalogService{{
+					ID:          "service-id",
+					Name:        "service-name",
+					Description: "service description",
+					Bindable:    &bindable,
+					Plans: []servicebroker.CatalogPlan{{
+						ID:              "plan-id",
+						Name:            "plan-name",
+						Description:     "plan description",
+						MaintenanceInfo: &servicebroker.CatalogMaintenanceInfo{Version: "1.0.0"},
+					}},
+				}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			problems = actor.ValidateServiceBrokerCatalog(catalog)
+		})
+
+		It("finds no problems in a valid catalog", func() {
+			Expect(problems).To(BeEmpty())
+			Expect(problems.Errors()).To(BeEmpty())
+		})
+
+		When("required fields are missing", func() {
+			BeforeEach(func() {
+				catalog.Services[0].Description = ""
+				catalog.Services[0].Bindable = nil
+				catalog.Services[0].DashboardClient = &servicebroker.CatalogDashboardClient{ID: "client-id"}
+				catalog.Services[0].Plans[0].ID = ""
+				catalog.Services = append(catalog.Services, servicebroker.CatalogService{
+					ID:          "other-service-id",
+					Name:        "Other Service",
+					Description: "other service description",
+					Bindable:    &bindable,
+				})
+			})
+
+			It("returns an error for each of them", func() {
+				Expect(problems).To(Equal(CatalogProblems{
+					{Severity: CatalogProblemError, Field: "services[0].description", Message: "is required"},
+					{Severity: CatalogProblemError, Field: "services[0].bindable", Message: "is required"},
+					{Severity: CatalogProblemError, Field: "services[0].dashboard_client.secret", Message: "is required"},
+					{Severity: CatalogProblemError, Field: "services[0].dashboard_client.redirect_uri", Message: "is required"},
+					{Severity: CatalogProblemError, Field: "services[0].plans[0].id", Message: "is required"},
+					{Severity: CatalogProblemWarning, Field: "services[1].name", Message: `"Other Service" should be lowercase with no spaces`},
+					{Severity: CatalogProblemError, Field: "services[1].plans", Message: "the service has no plans"},
+				}))
+				Expect(problems.Errors()).To(HaveLen(6))
+			})
+		})
+
+		When("IDs and names are used twice", func() {
+			BeforeEach(func() {
+				catalog.Services[0].Plans = append(catalog.Services[0].Plans, servicebroker.CatalogPlan{
+					ID:              "plan-id",
+					Name:            "plan-name",
+					Description:     "plan description",
+					MaintenanceInfo: &servicebroker.CatalogMaintenanceInfo{Version: "v2"},
+				})
+				catalog.Services = append(catalog.Services, catalog.Services[0])
+			})
+
+			It("returns an error for each duplicate", func() {
+				Expect(problems).To(ContainElements(
+					CatalogProblem{Severity: CatalogProblemError, Field: "services[0].plans[1].id", Message: `"plan-id" is used by another plan`},
+					CatalogProblem{Severity: CatalogProblemError, Field: "services[0].plans[1].name", Message: `"plan-name" is used by another plan of the service`},
+					CatalogProblem{Severity: CatalogProblemError, Field: "services[0].plans[1].maintenance_info.version", Message: `"v2" is not a semantic version`},
+					CatalogProblem{Severity: CatalogProblemError, Field: "services[1].id", Message: `"service-id" is used by another service`},
+					CatalogProblem{Severity: CatalogProblemError, Field: "services[1].name", Message: `"service-name" is used by another service`},
+				))
+			})
+		})
+	})
+
+	Describe("DiffServiceBrokerCatalog", func() {
+		var (
+			catalog    servicebroker.Catalog
+			diff       ServiceBrokerCatalogDiff
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			catalog = servicebroker.Catalog{
+				Services: []servicebroker.CatalogService{
+					{
+						ID:   "kept-service-id",
+						Name: "renamed-service",
+						Plans: []servicebroker.CatalogPlan{
+							{ID: "kept-plan-id", Name: "kept-plan"},
+							{ID: "renamed-plan-id", Name: "new-plan-name"},
+							{ID: "added-plan-id", Name: "added-plan"},
+						},
+					},
+					{
+						ID:    "added-service-id",
+						Name:  "added-service",
+						Plans: []servicebroker.CatalogPlan{{ID: "added-service-plan-id", Name: "added-service-plan"}},
+					},
+				},
+			}
+
+			fakeCloudControllerClient.GetServiceBrokersReturns(
+				[]resources.ServiceBroker{{GUID: "broker-guid", Name: "broker"}},
+				ccv3.Warnings{"broker-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceOfferingsReturns(
+				[]resources.ServiceOffering{
+					{GUID: "kept-offering-guid", Name: "old-service-name", BrokerCatalogID: "kept-service-id"},
+					{GUID: "removed-offering-guid", Name: "removed-service", BrokerCatalogID: "removed-service-id"},
+				},
+				ccv3.Warnings{"offerings-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]resources.ServicePlan{
+					{GUID: "kept-plan-guid", Name: "kept-plan", BrokerCatalogID: "kept-plan-id", ServiceOfferingGUID: "kept-offering-guid"},
+					{GUID: "renamed-plan-guid", Name: "old-plan-name", BrokerCatalogID: "renamed-plan-id", ServiceOfferingGUID: "kept-offering-guid"},
+					{GUID: "removed-plan-guid", Name: "removed-plan", BrokerCatalogID: "removed-plan-id", ServiceOfferingGUID: "kept-offering-guid"},
+					{GUID: "removed-service-plan-guid", Name: "removed-service-plan", BrokerCatalogID: "removed-service-plan-id", ServiceOfferingGUID: "removed-offering-guid"},
+				},
+				ccv3.Warnings{"plans-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{
+					{GUID: "instance-1-guid", ServicePlanGUID: "removed-plan-guid"},
+					{GUID: "instance-2-guid", ServicePlanGUID: "removed-plan-guid"},
+				},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"instances-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			diff, warnings, executeErr = actor.DiffServiceBrokerCatalog("broker", catalog)
+		})
+
+		It("compares the catalog with the registered offerings and plans", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("broker-warning", "offerings-warning", "plans-warning", "instances-warning"))
+
+			Expect(fakeCloudControllerClient.GetServiceOfferingsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceBrokerGUIDsFilter, Values: []string{"broker-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServiceBrokerGUIDsFilter, Values: []string{"broker-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.ServicePlanGUIDsFilter, Values: []string{"removed-plan-guid", "removed-service-plan-guid"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+
+			Expect(diff).To(Equal(ServiceBrokerCatalogDiff{
+				BrokerRegistered: true,
+				Changes: []CatalogChange{
+					{Type: CatalogChangeRenamed, OfferingName: "renamed-service", OldName: "old-service-name"},
+					{Type: CatalogChangeRenamed, OfferingName: "renamed-service", PlanName: "new-plan-name", OldName: "old-plan-name"},
+					{Type: CatalogChangeAdded, OfferingName: "renamed-service", PlanName: "added-plan"},
+					{Type: CatalogChangeAdded, OfferingName: "added-service"},
+					{Type: CatalogChangeAdded, OfferingName: "added-service", PlanName: "added-service-plan"},
+					{Type: CatalogChangeRemoved, OfferingName: "removed-service"},
+					{Type: CatalogChangeRemoved, OfferingName: "old-service-name", PlanName: "removed-plan", ServiceInstances: 2},
+					{Type: CatalogChangeRemoved, OfferingName: "removed-service", PlanName: "removed-service-plan"},
+				},
+			}))
+
+			Expect(diff.RemovedPlansWithInstances()).To(Equal([]CatalogChange{
+				{Type: CatalogChangeRemoved, OfferingName: "old-service-name", PlanName: "removed-plan", ServiceInstances: 2},
+			}))
+		})
+
+		When("no plans are removed", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, nil, nil)
+			})
+
+			It("does not look for service instances", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeCloudControllerClient.GetServiceInstancesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the broker is not registered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBrokersReturns(nil, ccv3.Warnings{"broker-warning"}, nil)
+			})
+
+			It("adds every offering and plan", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("broker-warning"))
+				Expect(diff.BrokerRegistered).To(BeFalse())
+				Expect(diff.Changes).To(HaveLen(6))
+				for _, change := range diff.Changes {
+					Expect(change.Type).To(Equal(CatalogChangeAdded))
+				}
+				Expect(fakeCloudControllerClient.GetServiceOfferingsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the service plans fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv3.Warnings{"plans-warning"}, errors.New("plans-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("plans-error"))
+				Expect(warnings).To(ConsistOf("broker-warning", "offerings-warning", "plans-warning"))
+			})
+		})
+
+		When("getting the service instances fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceInstancesReturns(nil, ccv3.IncludedResources{}, ccv3.Warnings{"instances-warning"}, errors.New("instances-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("instances-error"))
+				Expect(warnings).To(ContainElement("instances-warning"))
+			})
+		})
+	})
+})
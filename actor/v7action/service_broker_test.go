@@ -28,10 +28,15 @@ var _ = Describe("Service Broker Actions", func() {
 			serviceBrokers []resources.ServiceBroker
 			warnings       Warnings
 			executionError error
+			labelSelector  string
 		)
 
+		BeforeEach(func() {
+			labelSelector = ""
+		})
+
 		JustBeforeEach(func() {
-			serviceBrokers, warnings, executionError = actor.GetServiceBrokers()
+			serviceBrokers, warnings, executionError = actor.GetServiceBrokers(labelSelector)
 		})
 
 		When("the cloud controller request is successful", func() {
@@ -60,6 +65,19 @@ var _ = Describe("Service Broker Actions", func() {
 					))
 					Expect(warnings).To(ConsistOf("some-service-broker-warning"))
 					Expect(fakeCloudControllerClient.GetServiceBrokersCallCount()).To(Equal(1))
+					Expect(fakeCloudControllerClient.GetServiceBrokersArgsForCall(0)).To(BeEmpty())
+				})
+			})
+
+			When("a label selector is provided", func() {
+				BeforeEach(func() {
+					labelSelector = "env=prod"
+				})
+
+				It("filters the service brokers by label", func() {
+					Expect(fakeCloudControllerClient.GetServiceBrokersArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+					))
 				})
 			})
 		})
@@ -27,23 +27,28 @@ type planDetails struct {
 	plan, offering, broker string
 }
 
-func (actor Actor) GetServiceInstancesForSpace(spaceGUID string, omitApps bool) ([]ServiceInstance, Warnings, error) {
+func (actor Actor) GetServiceInstancesForSpace(spaceGUID string, labelSelector string, omitApps bool) ([]ServiceInstance, Warnings, error) {
 	var (
 		instances []resources.ServiceInstance
 		bindings  []resources.ServiceCredentialBinding
 		included  ccv3.IncludedResources
 	)
 
+	queries := []ccv3.Query{
+		{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		{Key: ccv3.FieldsServicePlan, Values: []string{"guid", "name", "relationships.service_offering"}},
+		{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"guid", "name", "relationships.service_broker"}},
+		{Key: ccv3.FieldsServicePlanServiceOfferingServiceBroker, Values: []string{"guid", "name"}},
+		{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+		{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+	}
+	if len(labelSelector) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}})
+	}
+
 	warnings, err := railway.Sequentially(
 		func() (warnings ccv3.Warnings, err error) {
-			instances, included, warnings, err = actor.CloudControllerClient.GetServiceInstances(
-				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
-				ccv3.Query{Key: ccv3.FieldsServicePlan, Values: []string{"guid", "name", "relationships.service_offering"}},
-				ccv3.Query{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"guid", "name", "relationships.service_broker"}},
-				ccv3.Query{Key: ccv3.FieldsServicePlanServiceOfferingServiceBroker, Values: []string{"guid", "name"}},
-				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
-				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
-			)
+			instances, included, warnings, err = actor.CloudControllerClient.GetServiceInstances(queries...)
 			return
 		},
 		func() (warnings ccv3.Warnings, err error) {
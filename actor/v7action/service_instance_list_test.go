@@ -162,15 +162,17 @@ var _ = Describe("Service Instance List Action", func() {
 			serviceInstances []ServiceInstance
 			warnings         Warnings
 			executionError   error
+			labelSelector    string
 			omitApps         bool
 		)
 
 		BeforeEach(func() {
+			labelSelector = ""
 			omitApps = false
 		})
 
 		JustBeforeEach(func() {
-			serviceInstances, warnings, executionError = actor.GetServiceInstancesForSpace(spaceGUID, omitApps)
+			serviceInstances, warnings, executionError = actor.GetServiceInstancesForSpace(spaceGUID, labelSelector, omitApps)
 		})
 
 		It("makes the correct call to get service instances", func() {
@@ -200,6 +202,18 @@ var _ = Describe("Service Instance List Action", func() {
 			))
 		})
 
+		When("a label selector is provided", func() {
+			BeforeEach(func() {
+				labelSelector = "env=prod"
+			})
+
+			It("filters the service instances by label", func() {
+				Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ContainElement(
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env=prod"}},
+				))
+			})
+		})
+
 		When("omit apps is set to true", func() {
 			BeforeEach(func() {
 				omitApps = true
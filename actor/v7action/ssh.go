@@ -22,6 +22,21 @@ func (actor Actor) GetSSHPasscode() (string, error) {
 // back the SSH authentication information for the SSH session.
 func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
 	appName string, spaceGUID string, processType string, processIndex uint,
+) (SSHAuthentication, Warnings, error) {
+	return actor.getSecureShellConfiguration(appName, spaceGUID, processType, processIndex, false)
+}
+
+// GetSecureShellConfigurationForRunningInstance returns back the SSH
+// authentication information for the given process instance when it is
+// running, and for the first running instance of the process otherwise.
+func (actor Actor) GetSecureShellConfigurationForRunningInstance(
+	appName string, spaceGUID string, processType string, preferredIndex uint,
+) (SSHAuthentication, Warnings, error) {
+	return actor.getSecureShellConfiguration(appName, spaceGUID, processType, preferredIndex, true)
+}
+
+func (actor Actor) getSecureShellConfiguration(
+	appName string, spaceGUID string, processType string, processIndex uint, anyRunningInstance bool,
 ) (SSHAuthentication, Warnings, error) {
 	var allWarnings Warnings
 
@@ -56,7 +71,7 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 		return SSHAuthentication{}, allWarnings, actionerror.ApplicationNotStartedError{Name: appName}
 	}
 
-	username, processWarnings, err := actor.getUsername(application, processType, processIndex)
+	username, processWarnings, err := actor.getUsername(application, processType, processIndex, anyRunningInstance)
 	allWarnings = append(allWarnings, processWarnings...)
 	if err != nil {
 		return SSHAuthentication{}, allWarnings, err
@@ -70,7 +85,7 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 	}, allWarnings, err
 }
 
-func (actor Actor) getUsername(application resources.Application, processType string, processIndex uint) (string, Warnings, error) {
+func (actor Actor) getUsername(application resources.Application, processType string, processIndex uint, anyRunningInstance bool) (string, Warnings, error) {
 	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
 	if err != nil {
 		return "", processWarnings, err
@@ -96,6 +111,15 @@ func (actor Actor) getUsername(application resources.Application, processType st
 		}
 	}
 
+	if anyRunningInstance && !processInstance.Running() {
+		for _, instance := range processSummary.InstanceDetails {
+			if instance.Running() {
+				processInstance = instance
+				break
+			}
+		}
+	}
+
 	if processInstance == (ProcessInstance{}) {
 		return "", processWarnings, actionerror.ProcessInstanceNotFoundError{ProcessType: processType, InstanceIndex: processIndex}
 	}
@@ -104,5 +128,5 @@ func (actor Actor) getUsername(application resources.Application, processType st
 		return "", processWarnings, actionerror.ProcessInstanceNotRunningError{ProcessType: processType, InstanceIndex: processIndex}
 	}
 
-	return fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex), processWarnings, nil
+	return fmt.Sprintf("cf:%s/%d", processSummary.GUID, processInstance.Index), processWarnings, nil
 }
@@ -267,4 +267,76 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellConfigurationForRunningInstance", func() {
+		var sshAuth SSHAuthentication
+
+		BeforeEach(func() {
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-access-oauth-client")
+			fakeCloudControllerClient.GetInfoReturns(ccv3.Info{
+				Links: ccv3.InfoLinks{
+					AppSSH: resources.APILink{
+						HREF: "some-app-ssh-endpoint",
+						Meta: resources.APILinkMeta{HostKeyFingerprint: "some-app-ssh-fingerprint"},
+					},
+				},
+			}, nil, nil)
+			fakeUAAClient.GetSSHPasscodeReturns("some-ssh-passcode", nil)
+			fakeCloudControllerClient.GetApplicationsReturns([]resources.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]resources.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			sshAuth, warnings, executeErr = actor.GetSecureShellConfigurationForRunningInstance("some-app", "some-space-guid", "some-process-type", 1)
+		})
+
+		When("the preferred instance is running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceRunning, Index: 0},
+					{State: constant.ProcessInstanceRunning, Index: 1},
+				}, ccv3.Warnings{"some-instance-warnings"}, nil)
+			})
+
+			It("uses the preferred instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+				Expect(sshAuth).To(Equal(SSHAuthentication{
+					Endpoint:           "some-app-ssh-endpoint",
+					HostKeyFingerprint: "some-app-ssh-fingerprint",
+					Passcode:           "some-ssh-passcode",
+					Username:           "cf:some-process-guid/1",
+				}))
+			})
+		})
+
+		When("the preferred instance is not running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceStarting, Index: 0},
+					{State: constant.ProcessInstanceCrashed, Index: 1},
+					{State: constant.ProcessInstanceRunning, Index: 2},
+				}, nil, nil)
+			})
+
+			It("uses the first running instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(sshAuth.Username).To(Equal("cf:some-process-guid/2"))
+			})
+		})
+
+		When("no instance is running", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceStarting, Index: 0},
+					{State: constant.ProcessInstanceCrashed, Index: 1},
+				}, nil, nil)
+			})
+
+			It("returns a ProcessInstanceNotRunningError for the preferred instance", func() {
+				Expect(executeErr).To(MatchError(actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 1}))
+			})
+		})
+	})
 })
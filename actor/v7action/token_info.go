@@ -0,0 +1,152 @@
+package v7action
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"github.com/SermoDigital/jose/crypto"
+	"github.com/SermoDigital/jose/jws"
+	"github.com/SermoDigital/jose/jwt"
+)
+
+// TokenInfo is the decoded contents of a UAA issued token.
+type TokenInfo struct {
+	Issuer    string
+	ClientID  string
+	UserName  string
+	UserID    string
+	Origin    string
+	GrantType string
+	Scopes    []string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+
+	// KeyID and Algorithm come from the token header and identify the key the
+	// token was signed with.
+	KeyID     string
+	Algorithm string
+}
+
+// Expired returns true if the token expired before now.
+func (info TokenInfo) Expired(now time.Time) bool {
+	return !info.ExpiresAt.IsZero() && !info.ExpiresAt.After(now)
+}
+
+// TimeRemaining returns how long the token is valid for after now, or zero if
+// it has expired or has no expiry.
+func (info TokenInfo) TimeRemaining(now time.Time) time.Duration {
+	if info.ExpiresAt.IsZero() || info.Expired(now) {
+		return 0
+	}
+	return info.ExpiresAt.Sub(now)
+}
+
+// DecodeToken decodes a token without validating its signature or expiry. It
+// returns an error for tokens that are not JWTs, such as the opaque refresh
+// tokens UAA issues by default.
+func (actor Actor) DecodeToken(token string) (TokenInfo, error) {
+	parsedToken, err := parseToken(token)
+	if err != nil {
+		return TokenInfo{}, err
+	}
+
+	claims := parsedToken.Claims()
+	info := TokenInfo{
+		Issuer:    stringClaim(claims, "iss"),
+		ClientID:  stringClaim(claims, "client_id"),
+		UserName:  stringClaim(claims, "user_name"),
+		UserID:    stringClaim(claims, "user_id"),
+		Origin:    stringClaim(claims, "origin"),
+		GrantType: stringClaim(claims, "grant_type"),
+	}
+
+	if scopes, ok := claims.Get("scope").([]interface{}); ok {
+		for _, scope := range scopes {
+			if scopeString, isString := scope.(string); isString {
+				info.Scopes = append(info.Scopes, scopeString)
+			}
+		}
+		sort.Strings(info.Scopes)
+	}
+
+	if issuedAt, ok := claims.IssuedAt(); ok {
+		info.IssuedAt = issuedAt
+	}
+	if expiresAt, ok := claims.Expiration(); ok {
+		info.ExpiresAt = expiresAt
+	}
+
+	if signed, ok := parsedToken.(jws.JWS); ok {
+		info.KeyID, _ = signed.Protected().Get("kid").(string)
+		info.Algorithm, _ = signed.Protected().Get("alg").(string)
+	}
+
+	return info, nil
+}
+
+// ValidateTokenSignature checks that the token was signed by one of the keys
+// published by UAA. It does not check whether the token has expired.
+func (actor Actor) ValidateTokenSignature(token string) error {
+	parsedToken, err := parseToken(token)
+	if err != nil {
+		return err
+	}
+
+	signed, ok := parsedToken.(jws.JWS)
+	if !ok {
+		return actionerror.InvalidTokenSignatureError{Reason: "token is not signed"}
+	}
+
+	keyID, _ := signed.Protected().Get("kid").(string)
+	algorithm, _ := signed.Protected().Get("alg").(string)
+
+	method, ok := tokenSigningMethods[algorithm]
+	if !ok {
+		return actionerror.InvalidTokenSignatureError{Reason: "unsupported signing algorithm '" + algorithm + "'"}
+	}
+
+	keys, err := actor.UAAClient.ListTokenKeys()
+	if err != nil {
+		return err
+	}
+
+	var matchingKeys []string
+	for _, key := range keys {
+		if keyID == "" || key.KeyID == keyID {
+			matchingKeys = append(matchingKeys, key.Value)
+		}
+	}
+	if len(matchingKeys) == 0 || (keyID == "" && len(matchingKeys) > 1) {
+		return actionerror.TokenSigningKeyNotFoundError{KeyID: keyID}
+	}
+
+	publicKey, err := crypto.ParseRSAPublicKeyFromPEM([]byte(matchingKeys[0]))
+	if err != nil {
+		return actionerror.InvalidTokenSignatureError{Reason: "unable to parse signing key: " + err.Error()}
+	}
+
+	err = signed.Verify(publicKey, method)
+	if err != nil {
+		return actionerror.InvalidTokenSignatureError{Reason: err.Error()}
+	}
+	return nil
+}
+
+// tokenSigningMethods are the algorithms UAA can be configured to sign tokens
+// with.
+var tokenSigningMethods = map[string]crypto.SigningMethod{
+	"RS256": crypto.SigningMethodRS256,
+	"RS384": crypto.SigningMethodRS384,
+	"RS512": crypto.SigningMethodRS512,
+}
+
+func parseToken(token string) (jwt.JWT, error) {
+	return jws.ParseJWT([]byte(strings.TrimPrefix(token, "bearer ")))
+}
+
+func stringClaim(claims jwt.Claims, name string) string {
+	value, _ := claims.Get(name).(string)
+	return value
+}
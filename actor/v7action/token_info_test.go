@@ -0,0 +1,172 @@
+package v7action_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"github.com/SermoDigital/jose/crypto"
+	"github.com/SermoDigital/jose/jws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Token Info Actions", func() {
+	var (
+		actor         *Actor
+		fakeUAAClient *v7actionfakes.FakeUAAClient
+
+		signingKey *rsa.PrivateKey
+		expiresAt  time.Time
+		issuedAt   time.Time
+	)
+
+	buildSignedToken := func(keyID string, key *rsa.PrivateKey) string {
+		claims := jws.Claims{}
+		claims.SetIssuer("https://uaa.example.com/oauth/token")
+		claims.SetIssuedAt(issuedAt)
+		claims.SetExpiration(expiresAt)
+		claims.Set("client_id", "cf")
+		claims.Set("user_name", "some-user")
+		claims.Set("user_id", "some-user-guid")
+		claims.Set("origin", "uaa")
+		claims.Set("grant_type", "password")
+		claims.Set("scope", []string{"openid", "cloud_controller.read"})
+
+		token := jws.NewJWT(claims, crypto.SigningMethodRS256)
+		if keyID != "" {
+			token.(jws.JWS).Protected().Set("kid", keyID)
+		}
+		tokenBytes, err := token.Serialize(key)
+		Expect(err).NotTo(HaveOccurred())
+		return "bearer " + string(tokenBytes)
+	}
+
+	publicKeyPEM := func(key *rsa.PrivateKey) string {
+		der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+		return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	}
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v7actionfakes.FakeUAAClient)
+		actor = NewActor(nil, nil, nil, fakeUAAClient, nil, nil)
+
+		var err error
+		signingKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+
+		issuedAt = time.Unix(1700000000, 0)
+		expiresAt = issuedAt.Add(time.Hour)
+	})
+
+	Describe("DecodeToken", func() {
+		It("returns the claims of the token", func() {
+			info, err := actor.DecodeToken(buildSignedToken("key-1", signingKey))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info).To(Equal(TokenInfo{
+				Issuer:    "https://uaa.example.com/oauth/token",
+				ClientID:  "cf",
+				UserName:  "some-user",
+				UserID:    "some-user-guid",
+				Origin:    "uaa",
+				GrantType: "password",
+				Scopes:    []string{"cloud_controller.read", "openid"},
+				IssuedAt:  issuedAt,
+				ExpiresAt: expiresAt,
+				KeyID:     "key-1",
+				Algorithm: "RS256",
+			}))
+		})
+
+		It("returns an error for an opaque token", func() {
+			_, err := actor.DecodeToken("some-opaque-token-r")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("TokenInfo", func() {
+		It("reports the time remaining until expiry", func() {
+			info := TokenInfo{ExpiresAt: expiresAt}
+			Expect(info.Expired(issuedAt)).To(BeFalse())
+			Expect(info.TimeRemaining(issuedAt)).To(Equal(time.Hour))
+
+			Expect(info.Expired(expiresAt.Add(time.Second))).To(BeTrue())
+			Expect(info.TimeRemaining(expiresAt.Add(time.Second))).To(BeZero())
+		})
+	})
+
+	Describe("ValidateTokenSignature", func() {
+		var (
+			token string
+			err   error
+		)
+
+		BeforeEach(func() {
+			token = buildSignedToken("key-1", signingKey)
+			fakeUAAClient.ListTokenKeysReturns([]uaa.TokenKey{
+				{KeyID: "key-1", Algorithm: "RS256", KeyType: "RSA", Value: publicKeyPEM(signingKey)},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			err = actor.ValidateTokenSignature(token)
+		})
+
+		When("the token is signed by a published key", func() {
+			It("succeeds", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeUAAClient.ListTokenKeysCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the token was signed by a different key", func() {
+			BeforeEach(func() {
+				otherKey, keyErr := rsa.GenerateKey(rand.Reader, 2048)
+				Expect(keyErr).NotTo(HaveOccurred())
+				token = buildSignedToken("key-1", otherKey)
+			})
+
+			It("returns an InvalidTokenSignatureError", func() {
+				Expect(err).To(BeAssignableToTypeOf(actionerror.InvalidTokenSignatureError{}))
+			})
+		})
+
+		When("the signing key is not published", func() {
+			BeforeEach(func() {
+				token = buildSignedToken("key-2", signingKey)
+			})
+
+			It("returns a TokenSigningKeyNotFoundError", func() {
+				Expect(err).To(MatchError(actionerror.TokenSigningKeyNotFoundError{KeyID: "key-2"}))
+			})
+		})
+
+		When("the token does not name a key and UAA publishes one", func() {
+			BeforeEach(func() {
+				token = buildSignedToken("", signingKey)
+			})
+
+			It("validates against that key", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("listing the token keys fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.ListTokenKeysReturns(nil, errors.New("uaa unavailable"))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError("uaa unavailable"))
+			})
+		})
+	})
+})
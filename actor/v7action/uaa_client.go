@@ -9,11 +9,13 @@ import (
 
 type UAAClient interface {
 	Authenticate(credentials map[string]string, origin string, grantType constant.GrantType) (string, string, error)
+	ClientAssertion(clientID string, keyFile string) (string, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
 	DeleteUser(userGuid string) (uaa.User, error)
 	GetAPIVersion() (string, error)
 	GetLoginPrompts() (map[string][]string, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
+	ListTokenKeys() ([]uaa.TokenKey, error)
 	ListUsers(userName, origin string) ([]uaa.User, error)
 	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
 	UpdatePassword(userGUID string, oldPassword string, newPassword string) error
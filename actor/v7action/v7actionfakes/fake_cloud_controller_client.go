@@ -502,6 +502,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	DeleteDropletStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteDropletMutex       sync.RWMutex
+	deleteDropletArgsForCall []struct {
+		arg1 string
+	}
+	deleteDropletReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteDropletReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteIsolationSegmentStub        func(string) (ccv3.Warnings, error)
 	deleteIsolationSegmentMutex       sync.RWMutex
 	deleteIsolationSegmentArgsForCall []struct {
@@ -574,6 +589,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	DeletePackageStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deletePackageMutex       sync.RWMutex
+	deletePackageArgsForCall []struct {
+		arg1 string
+	}
+	deletePackageReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deletePackageReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteRoleStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
 	deleteRoleMutex       sync.RWMutex
 	deleteRoleArgsForCall []struct {
@@ -1075,6 +1105,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDropletBitsSizeStub        func(string) (uint64, error)
+	getDropletBitsSizeMutex       sync.RWMutex
+	getDropletBitsSizeArgsForCall []struct {
+		arg1 string
+	}
+	getDropletBitsSizeReturns struct {
+		result1 uint64
+		result2 error
+	}
+	getDropletBitsSizeReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
 	GetDropletsStub        func(...ccv3.Query) ([]resources.Droplet, ccv3.Warnings, error)
 	getDropletsMutex       sync.RWMutex
 	getDropletsArgsForCall []struct {
@@ -1300,6 +1343,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetOrganizationUsageSummaryStub        func(string) (resources.UsageSummary, ccv3.Warnings, error)
+	getOrganizationUsageSummaryMutex       sync.RWMutex
+	getOrganizationUsageSummaryArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationUsageSummaryReturns struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getOrganizationUsageSummaryReturnsOnCall map[int]struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -1330,6 +1388,19 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetPackageBitsSizeStub        func(string) (uint64, error)
+	getPackageBitsSizeMutex       sync.RWMutex
+	getPackageBitsSizeArgsForCall []struct {
+		arg1 string
+	}
+	getPackageBitsSizeReturns struct {
+		result1 uint64
+		result2 error
+	}
+	getPackageBitsSizeReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
 	GetPackageDropletsStub        func(string, ...ccv3.Query) ([]resources.Droplet, ccv3.Warnings, error)
 	getPackageDropletsMutex       sync.RWMutex
 	getPackageDropletsArgsForCall []struct {
@@ -1421,6 +1492,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetRevisionEnvironmentVariablesStub        func(string) (resources.EnvironmentVariables, ccv3.Warnings, error)
+	getRevisionEnvironmentVariablesMutex       sync.RWMutex
+	getRevisionEnvironmentVariablesArgsForCall []struct {
+		arg1 string
+	}
+	getRevisionEnvironmentVariablesReturns struct {
+		result1 resources.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRevisionEnvironmentVariablesReturnsOnCall map[int]struct {
+		result1 resources.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetRolesStub        func(...ccv3.Query) ([]resources.Role, ccv3.IncludedResources, ccv3.Warnings, error)
 	getRolesMutex       sync.RWMutex
 	getRolesArgsForCall []struct {
@@ -1470,6 +1556,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetRouteSharedSpacesStub        func(string) ([]string, ccv3.Warnings, error)
+	getRouteSharedSpacesMutex       sync.RWMutex
+	getRouteSharedSpacesArgsForCall []struct {
+		arg1 string
+	}
+	getRouteSharedSpacesReturns struct {
+		result1 []string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRouteSharedSpacesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetRoutesStub        func(...ccv3.Query) ([]resources.Route, ccv3.Warnings, error)
 	getRoutesMutex       sync.RWMutex
 	getRoutesArgsForCall []struct {
@@ -1855,6 +1956,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetSpaceUsageSummaryStub        func(string) (resources.UsageSummary, ccv3.Warnings, error)
+	getSpaceUsageSummaryMutex       sync.RWMutex
+	getSpaceUsageSummaryArgsForCall []struct {
+		arg1 string
+	}
+	getSpaceUsageSummaryReturns struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getSpaceUsageSummaryReturnsOnCall map[int]struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpacesStub        func(...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error)
 	getSpacesMutex       sync.RWMutex
 	getSpacesArgsForCall []struct {
@@ -2046,6 +2162,20 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	ReplaceRouteDestinationsStub        func(string, []resources.RouteDestination) (ccv3.Warnings, error)
+	replaceRouteDestinationsMutex       sync.RWMutex
+	replaceRouteDestinationsArgsForCall []struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}
+	replaceRouteDestinationsReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	replaceRouteDestinationsReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	ResourceMatchStub        func([]ccv3.Resource) ([]ccv3.Resource, ccv3.Warnings, error)
 	resourceMatchMutex       sync.RWMutex
 	resourceMatchArgsForCall []struct {
@@ -4889,6 +5019,72 @@ func (fake *FakeCloudControllerClient) DeleteDomainReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeleteDroplet(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteDropletMutex.Lock()
+	ret, specificReturn := fake.deleteDropletReturnsOnCall[len(fake.deleteDropletArgsForCall)]
+	fake.deleteDropletArgsForCall = append(fake.deleteDropletArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteDroplet", []interface{}{arg1})
+	fake.deleteDropletMutex.Unlock()
+	if fake.DeleteDropletStub != nil {
+		return fake.DeleteDropletStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deleteDropletReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletCallCount() int {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	return len(fake.deleteDropletArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deleteDropletMutex.Lock()
+	defer fake.deleteDropletMutex.Unlock()
+	fake.DeleteDropletStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletArgsForCall(i int) string {
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
+	argsForCall := fake.deleteDropletArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteDropletMutex.Lock()
+	defer fake.deleteDropletMutex.Unlock()
+	fake.DeleteDropletStub = nil
+	fake.deleteDropletReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteDropletReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteDropletMutex.Lock()
+	defer fake.deleteDropletMutex.Unlock()
+	fake.DeleteDropletStub = nil
+	if fake.deleteDropletReturnsOnCall == nil {
+		fake.deleteDropletReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteDropletReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteIsolationSegment(arg1 string) (ccv3.Warnings, error) {
 	fake.deleteIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.deleteIsolationSegmentReturnsOnCall[len(fake.deleteIsolationSegmentArgsForCall)]
@@ -5214,6 +5410,72 @@ func (fake *FakeCloudControllerClient) DeleteOrphanedRoutesReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) DeletePackage(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deletePackageMutex.Lock()
+	ret, specificReturn := fake.deletePackageReturnsOnCall[len(fake.deletePackageArgsForCall)]
+	fake.deletePackageArgsForCall = append(fake.deletePackageArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeletePackage", []interface{}{arg1})
+	fake.deletePackageMutex.Unlock()
+	if fake.DeletePackageStub != nil {
+		return fake.DeletePackageStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deletePackageReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCallCount() int {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	return len(fake.deletePackageArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deletePackageMutex.Lock()
+	defer fake.deletePackageMutex.Unlock()
+	fake.DeletePackageStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageArgsForCall(i int) string {
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
+	argsForCall := fake.deletePackageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deletePackageMutex.Lock()
+	defer fake.deletePackageMutex.Unlock()
+	fake.DeletePackageStub = nil
+	fake.deletePackageReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeletePackageReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deletePackageMutex.Lock()
+	defer fake.deletePackageMutex.Unlock()
+	fake.DeletePackageStub = nil
+	if fake.deletePackageReturnsOnCall == nil {
+		fake.deletePackageReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deletePackageReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteRole(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
 	fake.deleteRoleMutex.Lock()
 	ret, specificReturn := fake.deleteRoleReturnsOnCall[len(fake.deleteRoleArgsForCall)]
@@ -7402,6 +7664,69 @@ func (fake *FakeCloudControllerClient) GetDropletReturnsOnCall(i int, result1 re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetDropletBitsSize(arg1 string) (uint64, error) {
+	fake.getDropletBitsSizeMutex.Lock()
+	ret, specificReturn := fake.getDropletBitsSizeReturnsOnCall[len(fake.getDropletBitsSizeArgsForCall)]
+	fake.getDropletBitsSizeArgsForCall = append(fake.getDropletBitsSizeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetDropletBitsSize", []interface{}{arg1})
+	fake.getDropletBitsSizeMutex.Unlock()
+	if fake.GetDropletBitsSizeStub != nil {
+		return fake.GetDropletBitsSizeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getDropletBitsSizeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetDropletBitsSizeCallCount() int {
+	fake.getDropletBitsSizeMutex.RLock()
+	defer fake.getDropletBitsSizeMutex.RUnlock()
+	return len(fake.getDropletBitsSizeArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDropletBitsSizeCalls(stub func(string) (uint64, error)) {
+	fake.getDropletBitsSizeMutex.Lock()
+	defer fake.getDropletBitsSizeMutex.Unlock()
+	fake.GetDropletBitsSizeStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetDropletBitsSizeArgsForCall(i int) string {
+	fake.getDropletBitsSizeMutex.RLock()
+	defer fake.getDropletBitsSizeMutex.RUnlock()
+	argsForCall := fake.getDropletBitsSizeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetDropletBitsSizeReturns(result1 uint64, result2 error) {
+	fake.getDropletBitsSizeMutex.Lock()
+	defer fake.getDropletBitsSizeMutex.Unlock()
+	fake.GetDropletBitsSizeStub = nil
+	fake.getDropletBitsSizeReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetDropletBitsSizeReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.getDropletBitsSizeMutex.Lock()
+	defer fake.getDropletBitsSizeMutex.Unlock()
+	fake.GetDropletBitsSizeStub = nil
+	if fake.getDropletBitsSizeReturnsOnCall == nil {
+		fake.getDropletBitsSizeReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.getDropletBitsSizeReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) GetDroplets(arg1 ...ccv3.Query) ([]resources.Droplet, ccv3.Warnings, error) {
 	fake.getDropletsMutex.Lock()
 	ret, specificReturn := fake.getDropletsReturnsOnCall[len(fake.getDropletsArgsForCall)]
@@ -8378,6 +8703,72 @@ func (fake *FakeCloudControllerClient) GetOrganizationQuotasReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummary(arg1 string) (resources.UsageSummary, ccv3.Warnings, error) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	ret, specificReturn := fake.getOrganizationUsageSummaryReturnsOnCall[len(fake.getOrganizationUsageSummaryArgsForCall)]
+	fake.getOrganizationUsageSummaryArgsForCall = append(fake.getOrganizationUsageSummaryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationUsageSummary", []interface{}{arg1})
+	fake.getOrganizationUsageSummaryMutex.Unlock()
+	if fake.GetOrganizationUsageSummaryStub != nil {
+		return fake.GetOrganizationUsageSummaryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationUsageSummaryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryCallCount() int {
+	fake.getOrganizationUsageSummaryMutex.RLock()
+	defer fake.getOrganizationUsageSummaryMutex.RUnlock()
+	return len(fake.getOrganizationUsageSummaryArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryCalls(stub func(string) (resources.UsageSummary, ccv3.Warnings, error)) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	defer fake.getOrganizationUsageSummaryMutex.Unlock()
+	fake.GetOrganizationUsageSummaryStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryArgsForCall(i int) string {
+	fake.getOrganizationUsageSummaryMutex.RLock()
+	defer fake.getOrganizationUsageSummaryMutex.RUnlock()
+	argsForCall := fake.getOrganizationUsageSummaryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryReturns(result1 resources.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	defer fake.getOrganizationUsageSummaryMutex.Unlock()
+	fake.GetOrganizationUsageSummaryStub = nil
+	fake.getOrganizationUsageSummaryReturns = struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationUsageSummaryReturnsOnCall(i int, result1 resources.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationUsageSummaryMutex.Lock()
+	defer fake.getOrganizationUsageSummaryMutex.Unlock()
+	fake.GetOrganizationUsageSummaryStub = nil
+	if fake.getOrganizationUsageSummaryReturnsOnCall == nil {
+		fake.getOrganizationUsageSummaryReturnsOnCall = make(map[int]struct {
+			result1 resources.UsageSummary
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationUsageSummaryReturnsOnCall[i] = struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(arg1 ...ccv3.Query) ([]resources.Organization, ccv3.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -8510,6 +8901,69 @@ func (fake *FakeCloudControllerClient) GetPackageReturnsOnCall(i int, result1 re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetPackageBitsSize(arg1 string) (uint64, error) {
+	fake.getPackageBitsSizeMutex.Lock()
+	ret, specificReturn := fake.getPackageBitsSizeReturnsOnCall[len(fake.getPackageBitsSizeArgsForCall)]
+	fake.getPackageBitsSizeArgsForCall = append(fake.getPackageBitsSizeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetPackageBitsSize", []interface{}{arg1})
+	fake.getPackageBitsSizeMutex.Unlock()
+	if fake.GetPackageBitsSizeStub != nil {
+		return fake.GetPackageBitsSizeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getPackageBitsSizeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) GetPackageBitsSizeCallCount() int {
+	fake.getPackageBitsSizeMutex.RLock()
+	defer fake.getPackageBitsSizeMutex.RUnlock()
+	return len(fake.getPackageBitsSizeArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetPackageBitsSizeCalls(stub func(string) (uint64, error)) {
+	fake.getPackageBitsSizeMutex.Lock()
+	defer fake.getPackageBitsSizeMutex.Unlock()
+	fake.GetPackageBitsSizeStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetPackageBitsSizeArgsForCall(i int) string {
+	fake.getPackageBitsSizeMutex.RLock()
+	defer fake.getPackageBitsSizeMutex.RUnlock()
+	argsForCall := fake.getPackageBitsSizeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetPackageBitsSizeReturns(result1 uint64, result2 error) {
+	fake.getPackageBitsSizeMutex.Lock()
+	defer fake.getPackageBitsSizeMutex.Unlock()
+	fake.GetPackageBitsSizeStub = nil
+	fake.getPackageBitsSizeReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) GetPackageBitsSizeReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.getPackageBitsSizeMutex.Lock()
+	defer fake.getPackageBitsSizeMutex.Unlock()
+	fake.GetPackageBitsSizeStub = nil
+	if fake.getPackageBitsSizeReturnsOnCall == nil {
+		fake.getPackageBitsSizeReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.getPackageBitsSizeReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) GetPackageDroplets(arg1 string, arg2 ...ccv3.Query) ([]resources.Droplet, ccv3.Warnings, error) {
 	fake.getPackageDropletsMutex.Lock()
 	ret, specificReturn := fake.getPackageDropletsReturnsOnCall[len(fake.getPackageDropletsArgsForCall)]
@@ -8907,6 +9361,72 @@ func (fake *FakeCloudControllerClient) GetProcessesReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariables(arg1 string) (resources.EnvironmentVariables, ccv3.Warnings, error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	ret, specificReturn := fake.getRevisionEnvironmentVariablesReturnsOnCall[len(fake.getRevisionEnvironmentVariablesArgsForCall)]
+	fake.getRevisionEnvironmentVariablesArgsForCall = append(fake.getRevisionEnvironmentVariablesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetRevisionEnvironmentVariables", []interface{}{arg1})
+	fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	if fake.GetRevisionEnvironmentVariablesStub != nil {
+		return fake.GetRevisionEnvironmentVariablesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRevisionEnvironmentVariablesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesCallCount() int {
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	return len(fake.getRevisionEnvironmentVariablesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesCalls(stub func(string) (resources.EnvironmentVariables, ccv3.Warnings, error)) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	defer fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	fake.GetRevisionEnvironmentVariablesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesArgsForCall(i int) string {
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	argsForCall := fake.getRevisionEnvironmentVariablesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesReturns(result1 resources.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	defer fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	fake.GetRevisionEnvironmentVariablesStub = nil
+	fake.getRevisionEnvironmentVariablesReturns = struct {
+		result1 resources.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesReturnsOnCall(i int, result1 resources.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	defer fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	fake.GetRevisionEnvironmentVariablesStub = nil
+	if fake.getRevisionEnvironmentVariablesReturnsOnCall == nil {
+		fake.getRevisionEnvironmentVariablesReturnsOnCall = make(map[int]struct {
+			result1 resources.EnvironmentVariables
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRevisionEnvironmentVariablesReturnsOnCall[i] = struct {
+		result1 resources.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetRoles(arg1 ...ccv3.Query) ([]resources.Role, ccv3.IncludedResources, ccv3.Warnings, error) {
 	fake.getRolesMutex.Lock()
 	ret, specificReturn := fake.getRolesReturnsOnCall[len(fake.getRolesArgsForCall)]
@@ -9111,6 +9631,72 @@ func (fake *FakeCloudControllerClient) GetRouteDestinationsReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRouteSharedSpaces(arg1 string) ([]string, ccv3.Warnings, error) {
+	fake.getRouteSharedSpacesMutex.Lock()
+	ret, specificReturn := fake.getRouteSharedSpacesReturnsOnCall[len(fake.getRouteSharedSpacesArgsForCall)]
+	fake.getRouteSharedSpacesArgsForCall = append(fake.getRouteSharedSpacesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetRouteSharedSpaces", []interface{}{arg1})
+	fake.getRouteSharedSpacesMutex.Unlock()
+	if fake.GetRouteSharedSpacesStub != nil {
+		return fake.GetRouteSharedSpacesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteSharedSpacesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteSharedSpacesCallCount() int {
+	fake.getRouteSharedSpacesMutex.RLock()
+	defer fake.getRouteSharedSpacesMutex.RUnlock()
+	return len(fake.getRouteSharedSpacesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteSharedSpacesCalls(stub func(string) ([]string, ccv3.Warnings, error)) {
+	fake.getRouteSharedSpacesMutex.Lock()
+	defer fake.getRouteSharedSpacesMutex.Unlock()
+	fake.GetRouteSharedSpacesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetRouteSharedSpacesArgsForCall(i int) string {
+	fake.getRouteSharedSpacesMutex.RLock()
+	defer fake.getRouteSharedSpacesMutex.RUnlock()
+	argsForCall := fake.getRouteSharedSpacesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetRouteSharedSpacesReturns(result1 []string, result2 ccv3.Warnings, result3 error) {
+	fake.getRouteSharedSpacesMutex.Lock()
+	defer fake.getRouteSharedSpacesMutex.Unlock()
+	fake.GetRouteSharedSpacesStub = nil
+	fake.getRouteSharedSpacesReturns = struct {
+		result1 []string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteSharedSpacesReturnsOnCall(i int, result1 []string, result2 ccv3.Warnings, result3 error) {
+	fake.getRouteSharedSpacesMutex.Lock()
+	defer fake.getRouteSharedSpacesMutex.Unlock()
+	fake.GetRouteSharedSpacesStub = nil
+	if fake.getRouteSharedSpacesReturnsOnCall == nil {
+		fake.getRouteSharedSpacesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteSharedSpacesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetRoutes(arg1 ...ccv3.Query) ([]resources.Route, ccv3.Warnings, error) {
 	fake.getRoutesMutex.Lock()
 	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
@@ -10778,6 +11364,72 @@ func (fake *FakeCloudControllerClient) GetSpaceQuotasReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummary(arg1 string) (resources.UsageSummary, ccv3.Warnings, error) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	ret, specificReturn := fake.getSpaceUsageSummaryReturnsOnCall[len(fake.getSpaceUsageSummaryArgsForCall)]
+	fake.getSpaceUsageSummaryArgsForCall = append(fake.getSpaceUsageSummaryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetSpaceUsageSummary", []interface{}{arg1})
+	fake.getSpaceUsageSummaryMutex.Unlock()
+	if fake.GetSpaceUsageSummaryStub != nil {
+		return fake.GetSpaceUsageSummaryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceUsageSummaryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryCallCount() int {
+	fake.getSpaceUsageSummaryMutex.RLock()
+	defer fake.getSpaceUsageSummaryMutex.RUnlock()
+	return len(fake.getSpaceUsageSummaryArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryCalls(stub func(string) (resources.UsageSummary, ccv3.Warnings, error)) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	defer fake.getSpaceUsageSummaryMutex.Unlock()
+	fake.GetSpaceUsageSummaryStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryArgsForCall(i int) string {
+	fake.getSpaceUsageSummaryMutex.RLock()
+	defer fake.getSpaceUsageSummaryMutex.RUnlock()
+	argsForCall := fake.getSpaceUsageSummaryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryReturns(result1 resources.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	defer fake.getSpaceUsageSummaryMutex.Unlock()
+	fake.GetSpaceUsageSummaryStub = nil
+	fake.getSpaceUsageSummaryReturns = struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetSpaceUsageSummaryReturnsOnCall(i int, result1 resources.UsageSummary, result2 ccv3.Warnings, result3 error) {
+	fake.getSpaceUsageSummaryMutex.Lock()
+	defer fake.getSpaceUsageSummaryMutex.Unlock()
+	fake.GetSpaceUsageSummaryStub = nil
+	if fake.getSpaceUsageSummaryReturnsOnCall == nil {
+		fake.getSpaceUsageSummaryReturnsOnCall = make(map[int]struct {
+			result1 resources.UsageSummary
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceUsageSummaryReturnsOnCall[i] = struct {
+		result1 resources.UsageSummary
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaces(arg1 ...ccv3.Query) ([]resources.Space, ccv3.IncludedResources, ccv3.Warnings, error) {
 	fake.getSpacesMutex.Lock()
 	ret, specificReturn := fake.getSpacesReturnsOnCall[len(fake.getSpacesArgsForCall)]
@@ -11631,6 +12283,75 @@ func (fake *FakeCloudControllerClient) PurgeServiceOfferingReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinations(arg1 string, arg2 []resources.RouteDestination) (ccv3.Warnings, error) {
+	var arg2Copy []resources.RouteDestination
+	if arg2 != nil {
+		arg2Copy = make([]resources.RouteDestination, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.replaceRouteDestinationsMutex.Lock()
+	ret, specificReturn := fake.replaceRouteDestinationsReturnsOnCall[len(fake.replaceRouteDestinationsArgsForCall)]
+	fake.replaceRouteDestinationsArgsForCall = append(fake.replaceRouteDestinationsArgsForCall, struct {
+		arg1 string
+		arg2 []resources.RouteDestination
+	}{arg1, arg2Copy})
+	fake.recordInvocation("ReplaceRouteDestinations", []interface{}{arg1, arg2Copy})
+	fake.replaceRouteDestinationsMutex.Unlock()
+	if fake.ReplaceRouteDestinationsStub != nil {
+		return fake.ReplaceRouteDestinationsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.replaceRouteDestinationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinationsCallCount() int {
+	fake.replaceRouteDestinationsMutex.RLock()
+	defer fake.replaceRouteDestinationsMutex.RUnlock()
+	return len(fake.replaceRouteDestinationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinationsCalls(stub func(string, []resources.RouteDestination) (ccv3.Warnings, error)) {
+	fake.replaceRouteDestinationsMutex.Lock()
+	defer fake.replaceRouteDestinationsMutex.Unlock()
+	fake.ReplaceRouteDestinationsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinationsArgsForCall(i int) (string, []resources.RouteDestination) {
+	fake.replaceRouteDestinationsMutex.RLock()
+	defer fake.replaceRouteDestinationsMutex.RUnlock()
+	argsForCall := fake.replaceRouteDestinationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinationsReturns(result1 ccv3.Warnings, result2 error) {
+	fake.replaceRouteDestinationsMutex.Lock()
+	defer fake.replaceRouteDestinationsMutex.Unlock()
+	fake.ReplaceRouteDestinationsStub = nil
+	fake.replaceRouteDestinationsReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) ReplaceRouteDestinationsReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.replaceRouteDestinationsMutex.Lock()
+	defer fake.replaceRouteDestinationsMutex.Unlock()
+	fake.ReplaceRouteDestinationsStub = nil
+	if fake.replaceRouteDestinationsReturnsOnCall == nil {
+		fake.replaceRouteDestinationsReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.replaceRouteDestinationsReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) ResourceMatch(arg1 []ccv3.Resource) ([]ccv3.Resource, ccv3.Warnings, error) {
 	var arg1Copy []ccv3.Resource
 	if arg1 != nil {
@@ -14849,6 +15570,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteBuildpackMutex.RUnlock()
 	fake.deleteDomainMutex.RLock()
 	defer fake.deleteDomainMutex.RUnlock()
+	fake.deleteDropletMutex.RLock()
+	defer fake.deleteDropletMutex.RUnlock()
 	fake.deleteIsolationSegmentMutex.RLock()
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
 	fake.deleteIsolationSegmentOrganizationMutex.RLock()
@@ -14859,6 +15582,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.deleteOrganizationQuotaMutex.RUnlock()
 	fake.deleteOrphanedRoutesMutex.RLock()
 	defer fake.deleteOrphanedRoutesMutex.RUnlock()
+	fake.deletePackageMutex.RLock()
+	defer fake.deletePackageMutex.RUnlock()
 	fake.deleteRoleMutex.RLock()
 	defer fake.deleteRoleMutex.RUnlock()
 	fake.deleteRouteMutex.RLock()
@@ -14925,6 +15650,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getDomainsMutex.RUnlock()
 	fake.getDropletMutex.RLock()
 	defer fake.getDropletMutex.RUnlock()
+	fake.getDropletBitsSizeMutex.RLock()
+	defer fake.getDropletBitsSizeMutex.RUnlock()
 	fake.getDropletsMutex.RLock()
 	defer fake.getDropletsMutex.RUnlock()
 	fake.getEnvironmentVariableGroupMutex.RLock()
@@ -14955,10 +15682,14 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getOrganizationQuotaMutex.RUnlock()
 	fake.getOrganizationQuotasMutex.RLock()
 	defer fake.getOrganizationQuotasMutex.RUnlock()
+	fake.getOrganizationUsageSummaryMutex.RLock()
+	defer fake.getOrganizationUsageSummaryMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPackageMutex.RLock()
 	defer fake.getPackageMutex.RUnlock()
+	fake.getPackageBitsSizeMutex.RLock()
+	defer fake.getPackageBitsSizeMutex.RUnlock()
 	fake.getPackageDropletsMutex.RLock()
 	defer fake.getPackageDropletsMutex.RUnlock()
 	fake.getPackagesMutex.RLock()
@@ -14971,12 +15702,16 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getProcessSidecarsMutex.RUnlock()
 	fake.getProcessesMutex.RLock()
 	defer fake.getProcessesMutex.RUnlock()
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
 	fake.getRolesMutex.RLock()
 	defer fake.getRolesMutex.RUnlock()
 	fake.getRouteBindingsMutex.RLock()
 	defer fake.getRouteBindingsMutex.RUnlock()
 	fake.getRouteDestinationsMutex.RLock()
 	defer fake.getRouteDestinationsMutex.RUnlock()
+	fake.getRouteSharedSpacesMutex.RLock()
+	defer fake.getRouteSharedSpacesMutex.RUnlock()
 	fake.getRoutesMutex.RLock()
 	defer fake.getRoutesMutex.RUnlock()
 	fake.getRunningSecurityGroupsMutex.RLock()
@@ -15027,6 +15762,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getSpaceQuotaMutex.RUnlock()
 	fake.getSpaceQuotasMutex.RLock()
 	defer fake.getSpaceQuotasMutex.RUnlock()
+	fake.getSpaceUsageSummaryMutex.RLock()
+	defer fake.getSpaceUsageSummaryMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
 	fake.getStacksMutex.RLock()
@@ -15053,6 +15790,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.pollJobToEventStreamMutex.RUnlock()
 	fake.purgeServiceOfferingMutex.RLock()
 	defer fake.purgeServiceOfferingMutex.RUnlock()
+	fake.replaceRouteDestinationsMutex.RLock()
+	defer fake.replaceRouteDestinationsMutex.RUnlock()
 	fake.resourceMatchMutex.RLock()
 	defer fake.resourceMatchMutex.RUnlock()
 	fake.rootResponseMutex.RLock()
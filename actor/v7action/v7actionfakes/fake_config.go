@@ -125,6 +125,11 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	SetUAAClientKeyFileStub        func(string)
+	setUAAClientKeyFileMutex       sync.RWMutex
+	setUAAClientKeyFileArgsForCall []struct {
+		arg1 string
+	}
 	SetUAAGrantTypeStub        func(string)
 	setUAAGrantTypeMutex       sync.RWMutex
 	setUAAGrantTypeArgsForCall []struct {
@@ -796,6 +801,37 @@ func (fake *FakeConfig) SetUAAClientCredentialsArgsForCall(i int) (string, strin
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) SetUAAClientKeyFile(arg1 string) {
+	fake.setUAAClientKeyFileMutex.Lock()
+	fake.setUAAClientKeyFileArgsForCall = append(fake.setUAAClientKeyFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetUAAClientKeyFile", []interface{}{arg1})
+	fake.setUAAClientKeyFileMutex.Unlock()
+	if fake.SetUAAClientKeyFileStub != nil {
+		fake.SetUAAClientKeyFileStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetUAAClientKeyFileCallCount() int {
+	fake.setUAAClientKeyFileMutex.RLock()
+	defer fake.setUAAClientKeyFileMutex.RUnlock()
+	return len(fake.setUAAClientKeyFileArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAClientKeyFileCalls(stub func(string)) {
+	fake.setUAAClientKeyFileMutex.Lock()
+	defer fake.setUAAClientKeyFileMutex.Unlock()
+	fake.SetUAAClientKeyFileStub = stub
+}
+
+func (fake *FakeConfig) SetUAAClientKeyFileArgsForCall(i int) string {
+	fake.setUAAClientKeyFileMutex.RLock()
+	defer fake.setUAAClientKeyFileMutex.RUnlock()
+	argsForCall := fake.setUAAClientKeyFileArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetUAAGrantType(arg1 string) {
 	fake.setUAAGrantTypeMutex.Lock()
 	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
@@ -1141,6 +1177,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setTokenInformationMutex.RUnlock()
 	fake.setUAAClientCredentialsMutex.RLock()
 	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	fake.setUAAClientKeyFileMutex.RLock()
+	defer fake.setUAAClientKeyFileMutex.RUnlock()
 	fake.setUAAGrantTypeMutex.RLock()
 	defer fake.setUAAGrantTypeMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
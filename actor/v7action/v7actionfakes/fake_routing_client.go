@@ -34,6 +34,19 @@ type FakeRoutingClient struct {
 		result1 []router.RouterGroup
 		result2 error
 	}
+	UpdateRouterGroupStub        func(router.RouterGroup) (router.RouterGroup, error)
+	updateRouterGroupMutex       sync.RWMutex
+	updateRouterGroupArgsForCall []struct {
+		arg1 router.RouterGroup
+	}
+	updateRouterGroupReturns struct {
+		result1 router.RouterGroup
+		result2 error
+	}
+	updateRouterGroupReturnsOnCall map[int]struct {
+		result1 router.RouterGroup
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -156,6 +169,69 @@ func (fake *FakeRoutingClient) GetRouterGroupsReturnsOnCall(i int, result1 []rou
 	}{result1, result2}
 }
 
+func (fake *FakeRoutingClient) UpdateRouterGroup(arg1 router.RouterGroup) (router.RouterGroup, error) {
+	fake.updateRouterGroupMutex.Lock()
+	ret, specificReturn := fake.updateRouterGroupReturnsOnCall[len(fake.updateRouterGroupArgsForCall)]
+	fake.updateRouterGroupArgsForCall = append(fake.updateRouterGroupArgsForCall, struct {
+		arg1 router.RouterGroup
+	}{arg1})
+	fake.recordInvocation("UpdateRouterGroup", []interface{}{arg1})
+	fake.updateRouterGroupMutex.Unlock()
+	if fake.UpdateRouterGroupStub != nil {
+		return fake.UpdateRouterGroupStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateRouterGroupReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRoutingClient) UpdateRouterGroupCallCount() int {
+	fake.updateRouterGroupMutex.RLock()
+	defer fake.updateRouterGroupMutex.RUnlock()
+	return len(fake.updateRouterGroupArgsForCall)
+}
+
+func (fake *FakeRoutingClient) UpdateRouterGroupCalls(stub func(router.RouterGroup) (router.RouterGroup, error)) {
+	fake.updateRouterGroupMutex.Lock()
+	defer fake.updateRouterGroupMutex.Unlock()
+	fake.UpdateRouterGroupStub = stub
+}
+
+func (fake *FakeRoutingClient) UpdateRouterGroupArgsForCall(i int) router.RouterGroup {
+	fake.updateRouterGroupMutex.RLock()
+	defer fake.updateRouterGroupMutex.RUnlock()
+	argsForCall := fake.updateRouterGroupArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRoutingClient) UpdateRouterGroupReturns(result1 router.RouterGroup, result2 error) {
+	fake.updateRouterGroupMutex.Lock()
+	defer fake.updateRouterGroupMutex.Unlock()
+	fake.UpdateRouterGroupStub = nil
+	fake.updateRouterGroupReturns = struct {
+		result1 router.RouterGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRoutingClient) UpdateRouterGroupReturnsOnCall(i int, result1 router.RouterGroup, result2 error) {
+	fake.updateRouterGroupMutex.Lock()
+	defer fake.updateRouterGroupMutex.Unlock()
+	fake.UpdateRouterGroupStub = nil
+	if fake.updateRouterGroupReturnsOnCall == nil {
+		fake.updateRouterGroupReturnsOnCall = make(map[int]struct {
+			result1 router.RouterGroup
+			result2 error
+		})
+	}
+	fake.updateRouterGroupReturnsOnCall[i] = struct {
+		result1 router.RouterGroup
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeRoutingClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -163,6 +239,8 @@ func (fake *FakeRoutingClient) Invocations() map[string][][]interface{} {
 	defer fake.getRouterGroupByNameMutex.RUnlock()
 	fake.getRouterGroupsMutex.RLock()
 	defer fake.getRouterGroupsMutex.RUnlock()
+	fake.updateRouterGroupMutex.RLock()
+	defer fake.updateRouterGroupMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -27,6 +27,20 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	ClientAssertionStub        func(string, string) (string, error)
+	clientAssertionMutex       sync.RWMutex
+	clientAssertionArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	clientAssertionReturns struct {
+		result1 string
+		result2 error
+	}
+	clientAssertionReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	CreateUserStub        func(string, string, string) (uaa.User, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -93,6 +107,18 @@ type FakeUAAClient struct {
 		result1 string
 		result2 error
 	}
+	ListTokenKeysStub        func() ([]uaa.TokenKey, error)
+	listTokenKeysMutex       sync.RWMutex
+	listTokenKeysArgsForCall []struct {
+	}
+	listTokenKeysReturns struct {
+		result1 []uaa.TokenKey
+		result2 error
+	}
+	listTokenKeysReturnsOnCall map[int]struct {
+		result1 []uaa.TokenKey
+		result2 error
+	}
 	ListUsersStub        func(string, string) ([]uaa.User, error)
 	listUsersMutex       sync.RWMutex
 	listUsersArgsForCall []struct {
@@ -227,6 +253,70 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) ClientAssertion(arg1 string, arg2 string) (string, error) {
+	fake.clientAssertionMutex.Lock()
+	ret, specificReturn := fake.clientAssertionReturnsOnCall[len(fake.clientAssertionArgsForCall)]
+	fake.clientAssertionArgsForCall = append(fake.clientAssertionArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ClientAssertion", []interface{}{arg1, arg2})
+	fake.clientAssertionMutex.Unlock()
+	if fake.ClientAssertionStub != nil {
+		return fake.ClientAssertionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.clientAssertionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) ClientAssertionCallCount() int {
+	fake.clientAssertionMutex.RLock()
+	defer fake.clientAssertionMutex.RUnlock()
+	return len(fake.clientAssertionArgsForCall)
+}
+
+func (fake *FakeUAAClient) ClientAssertionCalls(stub func(string, string) (string, error)) {
+	fake.clientAssertionMutex.Lock()
+	defer fake.clientAssertionMutex.Unlock()
+	fake.ClientAssertionStub = stub
+}
+
+func (fake *FakeUAAClient) ClientAssertionArgsForCall(i int) (string, string) {
+	fake.clientAssertionMutex.RLock()
+	defer fake.clientAssertionMutex.RUnlock()
+	argsForCall := fake.clientAssertionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUAAClient) ClientAssertionReturns(result1 string, result2 error) {
+	fake.clientAssertionMutex.Lock()
+	defer fake.clientAssertionMutex.Unlock()
+	fake.ClientAssertionStub = nil
+	fake.clientAssertionReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) ClientAssertionReturnsOnCall(i int, result1 string, result2 error) {
+	fake.clientAssertionMutex.Lock()
+	defer fake.clientAssertionMutex.Unlock()
+	fake.ClientAssertionStub = nil
+	if fake.clientAssertionReturnsOnCall == nil {
+		fake.clientAssertionReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.clientAssertionReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) CreateUser(arg1 string, arg2 string, arg3 string) (uaa.User, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -529,6 +619,61 @@ func (fake *FakeUAAClient) GetSSHPasscodeReturnsOnCall(i int, result1 string, re
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) ListTokenKeys() ([]uaa.TokenKey, error) {
+	fake.listTokenKeysMutex.Lock()
+	ret, specificReturn := fake.listTokenKeysReturnsOnCall[len(fake.listTokenKeysArgsForCall)]
+	fake.listTokenKeysArgsForCall = append(fake.listTokenKeysArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListTokenKeys", []interface{}{})
+	fake.listTokenKeysMutex.Unlock()
+	if fake.ListTokenKeysStub != nil {
+		return fake.ListTokenKeysStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listTokenKeysReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) ListTokenKeysCallCount() int {
+	fake.listTokenKeysMutex.RLock()
+	defer fake.listTokenKeysMutex.RUnlock()
+	return len(fake.listTokenKeysArgsForCall)
+}
+
+func (fake *FakeUAAClient) ListTokenKeysCalls(stub func() ([]uaa.TokenKey, error)) {
+	fake.listTokenKeysMutex.Lock()
+	defer fake.listTokenKeysMutex.Unlock()
+	fake.ListTokenKeysStub = stub
+}
+
+func (fake *FakeUAAClient) ListTokenKeysReturns(result1 []uaa.TokenKey, result2 error) {
+	fake.listTokenKeysMutex.Lock()
+	defer fake.listTokenKeysMutex.Unlock()
+	fake.ListTokenKeysStub = nil
+	fake.listTokenKeysReturns = struct {
+		result1 []uaa.TokenKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) ListTokenKeysReturnsOnCall(i int, result1 []uaa.TokenKey, result2 error) {
+	fake.listTokenKeysMutex.Lock()
+	defer fake.listTokenKeysMutex.Unlock()
+	fake.ListTokenKeysStub = nil
+	if fake.listTokenKeysReturnsOnCall == nil {
+		fake.listTokenKeysReturnsOnCall = make(map[int]struct {
+			result1 []uaa.TokenKey
+			result2 error
+		})
+	}
+	fake.listTokenKeysReturnsOnCall[i] = struct {
+		result1 []uaa.TokenKey
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) ListUsers(arg1 string, arg2 string) ([]uaa.User, error) {
 	fake.listUsersMutex.Lock()
 	ret, specificReturn := fake.listUsersReturnsOnCall[len(fake.listUsersArgsForCall)]
@@ -843,6 +988,8 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.clientAssertionMutex.RLock()
+	defer fake.clientAssertionMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	fake.deleteUserMutex.RLock()
@@ -853,6 +1000,8 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.getLoginPromptsMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
+	fake.listTokenKeysMutex.RLock()
+	defer fake.listTokenKeysMutex.RUnlock()
 	fake.listUsersMutex.RLock()
 	defer fake.listUsersMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
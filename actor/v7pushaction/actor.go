@@ -14,6 +14,12 @@ type Actor struct {
 	SharedActor SharedActor
 	V7Actor     V7Actor
 
+	// BitsCache, when set, keeps prepared bits across failed uploads.
+	BitsCache BitsCache
+	// BitsCacheTarget is the URL of the Cloud Controller that cached bits
+	// were matched against.
+	BitsCacheTarget string
+
 	PreparePushPlanSequence   []UpdatePushPlanFunc
 	ChangeApplicationSequence func(plan PushPlan) []ChangeApplicationFunc
 	TransformManifestSequence []HandleFlagOverrideFunc
@@ -75,12 +81,16 @@ func NewActor(v3Actor V7Actor, sharedActor SharedActor) *Actor {
 		SetupNoStartForPushPlan,
 		SetupNoWaitForPushPlan,
 		SetupTaskAppForPushPlan,
+		SetupBlueGreenForPushPlan,
 	}
 
 	actor.ChangeApplicationSequence = func(plan PushPlan) []ChangeApplicationFunc {
 		var sequence []ChangeApplicationFunc
 		sequence = append(sequence, actor.GetPrepareApplicationSourceSequence(plan)...)
 		sequence = append(sequence, actor.GetRuntimeSequence(plan)...)
+		if plan.BlueGreen {
+			sequence = actor.GetBlueGreenSequence(sequence)
+		}
 		return sequence
 	}
 
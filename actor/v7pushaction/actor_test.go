@@ -26,6 +26,7 @@ var _ = Describe("Actor", func() {
 				SetupNoStartForPushPlan,
 				SetupNoWaitForPushPlan,
 				SetupTaskAppForPushPlan,
+				SetupBlueGreenForPushPlan,
 			))
 		})
 	})
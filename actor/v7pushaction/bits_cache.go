@@ -0,0 +1,184 @@
+package v7pushaction
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+)
+
+// DefaultBitsCacheMaxAge is how long a cached archive is reused for. Matched
+// resources are only guaranteed to be in the Cloud Controller's resource
+// cache for a limited time, so stale entries are discarded.
+const DefaultBitsCacheMaxAge = time.Hour
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . BitsCache
+
+// BitsCache keeps the archive and resource match results of an upload that
+// did not complete, so that retrying a push of the same files can skip
+// resource matching and zipping.
+type BitsCache interface {
+	Load(key string) (CachedBits, bool)
+	Store(key string, bits CachedBits) error
+	Remove(key string) error
+}
+
+// CachedBits are an application's files prepared for upload.
+type CachedBits struct {
+	MatchedResources []sharedaction.V3Resource `json:"matched_resources"`
+	ArchivePath      string                    `json:"-"`
+}
+
+// FileBitsCache is a BitsCache that keeps the most recently stored entry in
+// Dir.
+type FileBitsCache struct {
+	Dir    string
+	MaxAge time.Duration
+}
+
+func NewFileBitsCache(dir string) *FileBitsCache {
+	return &FileBitsCache{
+		Dir:    dir,
+		MaxAge: DefaultBitsCacheMaxAge,
+	}
+}
+
+// BitsCacheKey identifies a set of files by their paths, modes and SHA1s, and
+// the Cloud Controller, space and app they are pushed to. Resources are only
+// matched against the blobstore of that Cloud Controller, so the matches
+// cannot be reused for another target.
+func BitsCacheKey(apiURL string, spaceGUID string, appGUID string, allResources []sharedaction.V3Resource) string {
+	sorted := make([]sharedaction.V3Resource, len(allResources))
+	copy(sorted, allResources)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].FilePath < sorted[j].FilePath
+	})
+
+	hash := sha1.New()
+	fmt.Fprintf(hash, "%s\x00%s\x00%s\n", apiURL, spaceGUID, appGUID)
+	for _, resource := range sorted {
+		fmt.Fprintf(hash, "%s\x00%s\x00%d\x00%o\n", resource.FilePath, resource.Checksum.Value, resource.SizeInBytes, resource.Mode)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (cache *FileBitsCache) Load(key string) (CachedBits, bool) {
+	archivePath := cache.archivePath(key)
+	info, err := os.Stat(archivePath)
+	if err != nil {
+		return CachedBits{}, false
+	}
+
+	if time.Since(info.ModTime()) > cache.MaxAge {
+		_ = cache.Remove(key)
+		return CachedBits{}, false
+	}
+
+	raw, err := os.ReadFile(cache.metadataPath(key))
+	if err != nil {
+		return CachedBits{}, false
+	}
+
+	var bits CachedBits
+	if err := json.Unmarshal(raw, &bits); err != nil {
+		_ = cache.Remove(key)
+		return CachedBits{}, false
+	}
+
+	bits.ArchivePath = archivePath
+	return bits, true
+}
+
+// Store replaces any previously stored entry with a copy of bits.
+func (cache *FileBitsCache) Store(key string, bits CachedBits) error {
+	if err := os.MkdirAll(cache.Dir, 0700); err != nil {
+		return err
+	}
+	if err := cache.prune(); err != nil {
+		return err
+	}
+
+	if err := copyFile(bits.ArchivePath, cache.archivePath(key)); err != nil {
+		_ = cache.Remove(key)
+		return err
+	}
+
+	raw, err := json.Marshal(bits)
+	if err != nil {
+		_ = cache.Remove(key)
+		return err
+	}
+
+	if err := os.WriteFile(cache.metadataPath(key), raw, 0600); err != nil {
+		_ = cache.Remove(key)
+		return err
+	}
+
+	return nil
+}
+
+func (cache *FileBitsCache) Remove(key string) error {
+	for _, path := range []string{cache.metadataPath(key), cache.archivePath(key)} {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// prune removes every entry from Dir, leaving any unrelated files alone.
+func (cache *FileBitsCache) prune() error {
+	entries, err := os.ReadDir(cache.Dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if isBitsCacheKey(strings.TrimSuffix(name, filepath.Ext(name))) {
+			if err := os.Remove(filepath.Join(cache.Dir, name)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (cache *FileBitsCache) archivePath(key string) string {
+	return filepath.Join(cache.Dir, key+".zip")
+}
+
+func (cache *FileBitsCache) metadataPath(key string) string {
+	return filepath.Join(cache.Dir, key+".json")
+}
+
+func copyFile(source string, destination string) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(destination, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func isBitsCacheKey(name string) bool {
+	return len(name) == sha1.Size*2 && strings.Trim(name, "0123456789abcdef") == ""
+}
@@ -0,0 +1,123 @@
+package v7pushaction_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BitsCache", func() {
+	const apiURL = "https://api.example.com"
+
+	Describe("BitsCacheKey", func() {
+		var resources []sharedaction.V3Resource
+
+		BeforeEach(func() {
+			resources = []sharedaction.V3Resource{
+				buildV3Resource("file-a"),
+				buildV3Resource("file-b"),
+			}
+		})
+
+		It("does not depend on the order of the resources", func() {
+			reversed := []sharedaction.V3Resource{resources[1], resources[0]}
+			Expect(BitsCacheKey(apiURL, "space-guid", "app-guid", resources)).To(Equal(BitsCacheKey(apiURL, "space-guid", "app-guid", reversed)))
+		})
+
+		It("changes when a file's contents change", func() {
+			changed := []sharedaction.V3Resource{resources[0], resources[1]}
+			changed[1].Checksum.Value = "some-other-checksum"
+			Expect(BitsCacheKey(apiURL, "space-guid", "app-guid", resources)).ToNot(Equal(BitsCacheKey(apiURL, "space-guid", "app-guid", changed)))
+		})
+
+		It("changes when the same files are pushed to another target", func() {
+			key := BitsCacheKey(apiURL, "space-guid", "app-guid", resources)
+			Expect(key).ToNot(Equal(BitsCacheKey("https://api.other.example.com", "space-guid", "app-guid", resources)))
+			Expect(key).ToNot(Equal(BitsCacheKey(apiURL, "other-space-guid", "app-guid", resources)))
+			Expect(key).ToNot(Equal(BitsCacheKey(apiURL, "space-guid", "other-app-guid", resources)))
+		})
+	})
+
+	Describe("FileBitsCache", func() {
+		var (
+			cacheDir    string
+			archivePath string
+			cache       *FileBitsCache
+			key         string
+			bits        CachedBits
+		)
+
+		BeforeEach(func() {
+			cacheDir = filepath.Join(GinkgoT().TempDir(), "push-cache")
+			cache = NewFileBitsCache(cacheDir)
+
+			archivePath = filepath.Join(GinkgoT().TempDir(), "archive.zip")
+			Expect(os.WriteFile(archivePath, []byte("some-zip"), 0600)).To(Succeed())
+
+			key = BitsCacheKey(apiURL, "space-guid", "app-guid", []sharedaction.V3Resource{buildV3Resource("file-a")})
+			bits = CachedBits{
+				MatchedResources: []sharedaction.V3Resource{buildV3Resource("file-b")},
+				ArchivePath:      archivePath,
+			}
+		})
+
+		It("loads a copy of the stored bits", func() {
+			Expect(cache.Store(key, bits)).To(Succeed())
+			Expect(os.Remove(archivePath)).To(Succeed())
+
+			loaded, ok := cache.Load(key)
+			Expect(ok).To(BeTrue())
+			Expect(loaded.MatchedResources).To(Equal(bits.MatchedResources))
+			Expect(loaded.ArchivePath).To(Equal(filepath.Join(cacheDir, key+".zip")))
+
+			contents, err := os.ReadFile(loaded.ArchivePath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-zip"))
+		})
+
+		It("misses when nothing is stored", func() {
+			_, ok := cache.Load(key)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("misses and removes the entry once it is older than MaxAge", func() {
+			Expect(cache.Store(key, bits)).To(Succeed())
+			old := time.Now().Add(-2 * DefaultBitsCacheMaxAge)
+			Expect(os.Chtimes(filepath.Join(cacheDir, key+".zip"), old, old)).To(Succeed())
+
+			_, ok := cache.Load(key)
+			Expect(ok).To(BeFalse())
+			Expect(filepath.Join(cacheDir, key+".zip")).ToNot(BeAnExistingFile())
+			Expect(filepath.Join(cacheDir, key+".json")).ToNot(BeAnExistingFile())
+		})
+
+		It("keeps only the most recently stored entry", func() {
+			otherKey := BitsCacheKey(apiURL, "space-guid", "app-guid", []sharedaction.V3Resource{buildV3Resource("file-c")})
+			Expect(os.MkdirAll(cacheDir, 0700)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(cacheDir, "unrelated.txt"), nil, 0600)).To(Succeed())
+
+			Expect(cache.Store(otherKey, bits)).To(Succeed())
+			Expect(cache.Store(key, bits)).To(Succeed())
+
+			_, ok := cache.Load(otherKey)
+			Expect(ok).To(BeFalse())
+			_, ok = cache.Load(key)
+			Expect(ok).To(BeTrue())
+			Expect(filepath.Join(cacheDir, "unrelated.txt")).To(BeAnExistingFile())
+		})
+
+		It("removes stored entries", func() {
+			Expect(cache.Store(key, bits)).To(Succeed())
+			Expect(cache.Remove(key)).To(Succeed())
+
+			_, ok := cache.Load(key)
+			Expect(ok).To(BeFalse())
+			Expect(cache.Remove(key)).To(Succeed())
+		})
+	})
+})
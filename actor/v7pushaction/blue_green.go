@@ -0,0 +1,106 @@
+package v7pushaction
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+// BlueGreenAppName returns the name of the temporary app that a blue-green
+// push stages and starts next to the live app.
+func BlueGreenAppName(appName string) string {
+	return appName + "-new"
+}
+
+// OldAppName returns the name that a live app is given when it is kept after
+// a blue-green push.
+func OldAppName(appName string) string {
+	return appName + "-old"
+}
+
+// BlueGreenManifest returns a copy of the manifest that pushes each app as its
+// temporary blue-green app, without any routes. The routes are mapped once
+// the temporary app is healthy.
+func BlueGreenManifest(manifest manifestparser.Manifest) manifestparser.Manifest {
+	blueGreenManifest := manifest
+	blueGreenManifest.Applications = make([]manifestparser.Application, len(manifest.Applications))
+
+	for i, app := range manifest.Applications {
+		app.Name = BlueGreenAppName(app.Name)
+		app.NoRoute = true
+		app.RandomRoute = false
+		app.DefaultRoute = false
+
+		remainingFields := map[string]interface{}{}
+		for key, value := range app.RemainingManifestFields {
+			if key != "routes" {
+				remainingFields[key] = value
+			}
+		}
+		app.RemainingManifestFields = remainingFields
+
+		blueGreenManifest.Applications[i] = app
+	}
+
+	return blueGreenManifest
+}
+
+// GetBlueGreenSequence takes the sequence that stages and starts the temporary
+// app and adds the steps that move the routes over to it and retire the live
+// app. If a step fails before the live app is retired, the temporary app is
+// deleted so that the live app is left as it was.
+func (actor Actor) GetBlueGreenSequence(sequence []ChangeApplicationFunc) []ChangeApplicationFunc {
+	var blueGreenSequence []ChangeApplicationFunc
+	for _, changeAppFunc := range append(sequence, actor.MapRoutesForBlueGreen, actor.MonitorHealthForBlueGreen) {
+		blueGreenSequence = append(blueGreenSequence, actor.rollBackBlueGreenOnError(changeAppFunc))
+	}
+
+	return append(blueGreenSequence, actor.RetireLiveApplication, actor.RenameBlueGreenApplication)
+}
+
+func (actor Actor) rollBackBlueGreenOnError(changeAppFunc ChangeApplicationFunc) ChangeApplicationFunc {
+	return func(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+		pushPlan, warnings, err := changeAppFunc(pushPlan, eventStream, progressBar)
+		if err == nil {
+			return pushPlan, warnings, nil
+		}
+
+		log.WithField("app_name", pushPlan.Application.Name).Info("rolling back blue-green push")
+		eventStream <- &PushEvent{Plan: pushPlan, Event: RollingBack}
+
+		// the live app keeps its routes until it is retired, so deleting the
+		// temporary app is all it takes to roll back
+		deleteWarnings, deleteErr := actor.V7Actor.DeleteApplicationByNameAndSpace(pushPlan.Application.Name, pushPlan.SpaceGUID, false)
+		warnings = append(warnings, deleteWarnings...)
+		if deleteErr != nil {
+			log.Errorln("deleting temporary app:", deleteErr)
+			return pushPlan, warnings, err
+		}
+
+		eventStream <- &PushEvent{Plan: pushPlan, Event: RollingBackComplete}
+		return pushPlan, warnings, err
+	}
+}
+
+// manifestRouteURLs returns the routes listed in a manifest application.
+func manifestRouteURLs(app manifestparser.Application) []string {
+	routes, ok := app.RemainingManifestFields["routes"].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var urls []string
+	for _, route := range routes {
+		switch route := route.(type) {
+		case map[interface{}]interface{}:
+			if url, ok := route["route"].(string); ok {
+				urls = append(urls, url)
+			}
+		case map[string]interface{}:
+			if url, ok := route["route"].(string); ok {
+				urls = append(urls, url)
+			}
+		}
+	}
+	return urls
+}
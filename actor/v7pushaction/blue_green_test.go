@@ -0,0 +1,151 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("blue-green push", func() {
+	Describe("BlueGreenManifest", func() {
+		var (
+			manifest          manifestparser.Manifest
+			blueGreenManifest manifestparser.Manifest
+		)
+
+		BeforeEach(func() {
+			manifest = manifestparser.Manifest{
+				PathToManifest: "some-path",
+				Applications: []manifestparser.Application{
+					{
+						Name:         "some-app",
+						DefaultRoute: true,
+						RemainingManifestFields: map[string]interface{}{
+							"routes": []interface{}{map[interface{}]interface{}{"route": "www.example.com"}},
+							"env":    map[string]interface{}{"SOME": "value"},
+						},
+					},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			blueGreenManifest = BlueGreenManifest(manifest)
+		})
+
+		It("pushes the temporary app without routes", func() {
+			Expect(blueGreenManifest.PathToManifest).To(Equal("some-path"))
+			Expect(blueGreenManifest.Applications).To(HaveLen(1))
+
+			app := blueGreenManifest.Applications[0]
+			Expect(app.Name).To(Equal("some-app-new"))
+			Expect(app.NoRoute).To(BeTrue())
+			Expect(app.DefaultRoute).To(BeFalse())
+			Expect(app.RemainingManifestFields).To(Equal(map[string]interface{}{
+				"env": map[string]interface{}{"SOME": "value"},
+			}))
+		})
+
+		It("leaves the original manifest alone", func() {
+			Expect(manifest.Applications[0].Name).To(Equal("some-app"))
+			Expect(manifest.Applications[0].RemainingManifestFields).To(HaveKey("routes"))
+		})
+	})
+
+	Describe("GetBlueGreenSequence", func() {
+		var (
+			actor       *Actor
+			fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+			plan     PushPlan
+			stepErr  error
+			sequence []ChangeApplicationFunc
+		)
+
+		BeforeEach(func() {
+			actor, fakeV7Actor, _ = getTestPushActor()
+			plan = PushPlan{
+				SpaceGUID:       "some-space-guid",
+				Application:     resources.Application{Name: "some-app-new", GUID: "new-guid"},
+				LiveApplication: resources.Application{Name: "some-app", GUID: "live-guid"},
+			}
+			stepErr = nil
+		})
+
+		JustBeforeEach(func() {
+			step := func(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+				return pushPlan, Warnings{"step-warning"}, stepErr
+			}
+			sequence = actor.GetBlueGreenSequence([]ChangeApplicationFunc{step})
+		})
+
+		It("moves the routes, watches the temporary app, then retires the live app", func() {
+			Expect(sequence).To(HaveLen(5))
+			Expect(sequence[3:]).To(matchers.MatchFuncsByName(
+				actor.RetireLiveApplication,
+				actor.RenameBlueGreenApplication,
+			))
+		})
+
+		When("a step before the live app is retired fails", func() {
+			var (
+				warnings   Warnings
+				executeErr error
+				events     []Event
+			)
+
+			BeforeEach(func() {
+				stepErr = errors.New("step-error")
+				fakeV7Actor.DeleteApplicationByNameAndSpaceReturns(v7action.Warnings{"delete-warning"}, nil)
+			})
+
+			JustBeforeEach(func() {
+				events = EventFollower(func(eventStream chan<- *PushEvent) {
+					_, warnings, executeErr = sequence[0](plan, eventStream, nil)
+				})
+			})
+
+			It("deletes the temporary app and returns the error", func() {
+				Expect(executeErr).To(MatchError("step-error"))
+				Expect(warnings).To(ConsistOf("step-warning", "delete-warning"))
+				Expect(events).To(ConsistOf(RollingBack, RollingBackComplete))
+
+				Expect(fakeV7Actor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(1))
+				appName, spaceGUID, deleteRoutes := fakeV7Actor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app-new"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(deleteRoutes).To(BeFalse())
+			})
+
+			When("deleting the temporary app fails", func() {
+				BeforeEach(func() {
+					fakeV7Actor.DeleteApplicationByNameAndSpaceReturns(v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+				})
+
+				It("returns the original error", func() {
+					Expect(executeErr).To(MatchError("step-error"))
+					Expect(warnings).To(ConsistOf("step-warning", "delete-warning"))
+					Expect(events).To(ConsistOf(RollingBack))
+				})
+			})
+		})
+
+		When("the steps succeed", func() {
+			It("does not roll back", func() {
+				events := EventFollower(func(eventStream chan<- *PushEvent) {
+					_, _, err := sequence[0](plan, eventStream, nil)
+					Expect(err).NotTo(HaveOccurred())
+				})
+				Expect(events).To(BeEmpty())
+				Expect(fakeV7Actor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
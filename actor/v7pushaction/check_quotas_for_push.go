@@ -0,0 +1,192 @@
+package v7pushaction
+
+import (
+	"regexp"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+var unlimitedLogRate = regexp.MustCompile(`^-1[KMGT]?B?$`)
+
+// CheckQuotasForPush projects the memory, app instances, routes and log rate
+// the org and the space use once the apps in the manifest are pushed, and
+// compares them with the limits of the org and space quotas.
+func (actor Actor) CheckQuotasForPush(orgGUID string, spaceGUID string, manifest manifestparser.Manifest, overrides FlagOverrides) (v7action.QuotaCheck, Warnings, error) {
+	var (
+		allWarnings Warnings
+		change      v7action.QuotaChange
+	)
+
+	started := !overrides.NoStart && !overrides.Task
+
+	for _, manifestApp := range manifest.Applications {
+		app, warnings, err := actor.V7Actor.GetApplicationByNameAndSpace(manifestApp.Name, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			if _, ok := err.(actionerror.ApplicationNotFoundError); !ok {
+				return nil, allWarnings, err
+			}
+			app = resources.Application{Name: manifestApp.Name}
+		}
+
+		appChange, warnings, err := actor.V7Actor.GetApplicationQuotaChange(app, manifestProcessChanges(manifestApp), started)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		newRoutes, routeWarnings, err := actor.countNewRoutes(app, manifestApp, orgGUID, spaceGUID)
+		allWarnings = append(allWarnings, routeWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		appChange.Routes = newRoutes
+		change = change.Add(appChange)
+	}
+
+	check, warnings, err := actor.V7Actor.CheckQuotaChange(orgGUID, spaceGUID, change)
+	allWarnings = append(allWarnings, warnings...)
+	return check, allWarnings, err
+}
+
+// countNewRoutes counts the routes that pushing the app creates.
+func (actor Actor) countNewRoutes(app resources.Application, manifestApp manifestparser.Application, orgGUID string, spaceGUID string) (int, Warnings, error) {
+	if manifestApp.NoRoute {
+		return 0, nil, nil
+	}
+
+	var (
+		allWarnings   Warnings
+		currentRoutes []resources.Route
+	)
+
+	if app.GUID != "" {
+		routes, warnings, err := actor.V7Actor.GetApplicationRoutes(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return 0, allWarnings, err
+		}
+		currentRoutes = routes
+	}
+
+	urls := manifestRouteURLs(manifestApp)
+	if len(urls) == 0 {
+		// the Cloud Controller only adds a random or default route to an app
+		// without routes
+		if len(currentRoutes) > 0 {
+			return 0, allWarnings, nil
+		}
+
+		switch {
+		case manifestApp.RandomRoute:
+			return 1, allWarnings, nil
+		case manifestApp.DefaultRoute:
+			domain, warnings, err := actor.V7Actor.GetDefaultDomain(orgGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return 0, allWarnings, err
+			}
+
+			_, warnings, err = actor.V7Actor.GetRouteByAttributes(domain, manifestApp.Name, "", 0)
+			allWarnings = append(allWarnings, warnings...)
+			count, err := countIfRouteNotFound(err)
+			return count, allWarnings, err
+		default:
+			return 0, allWarnings, nil
+		}
+	}
+
+	mapped := map[string]bool{}
+	for _, route := range currentRoutes {
+		mapped[route.URL] = true
+	}
+
+	newRoutes := 0
+	for _, url := range urls {
+		if mapped[url] {
+			continue
+		}
+
+		_, warnings, err := actor.V7Actor.GetRoute(url, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		count, err := countIfRouteNotFound(err)
+		if err != nil {
+			return 0, allWarnings, err
+		}
+		newRoutes += count
+	}
+
+	return newRoutes, allWarnings, nil
+}
+
+// countIfRouteNotFound counts a route that does not exist yet as new. Routes
+// on domains that do not exist are left for the Cloud Controller to reject.
+func countIfRouteNotFound(err error) (int, error) {
+	switch err.(type) {
+	case nil, actionerror.DomainNotFoundError:
+		return 0, nil
+	case actionerror.RouteNotFoundError:
+		return 1, nil
+	default:
+		return 0, err
+	}
+}
+
+// manifestProcessChanges returns the instances, memory and log rate limits the
+// manifest sets for the processes of the app. The limits at the top of the
+// app apply to its web process. Values the Cloud Controller cannot parse are
+// left for it to reject.
+func manifestProcessChanges(app manifestparser.Application) []resources.Process {
+	web := manifestProcessChange(constant.ProcessTypeWeb, app.Instances, app.Memory, app.LogRateLimit)
+	processes := []resources.Process{web}
+
+	for _, process := range app.Processes {
+		change := manifestProcessChange(process.Type, process.Instances, process.Memory, process.LogRateLimit)
+		if process.Type == constant.ProcessTypeWeb {
+			if change.Instances.IsSet {
+				processes[0].Instances = change.Instances
+			}
+			if change.MemoryInMB.IsSet {
+				processes[0].MemoryInMB = change.MemoryInMB
+			}
+			if change.LogRateLimitInBPS.IsSet {
+				processes[0].LogRateLimitInBPS = change.LogRateLimitInBPS
+			}
+			continue
+		}
+		processes = append(processes, change)
+	}
+
+	return processes
+}
+
+func manifestProcessChange(processType string, instances *int, memory string, logRateLimit string) resources.Process {
+	process := resources.Process{Type: processType}
+
+	if instances != nil {
+		process.Instances = types.NullInt{IsSet: true, Value: *instances}
+	}
+
+	if memory != "" {
+		if megabytes, err := bytefmt.ToMegabytes(memory); err == nil {
+			process.MemoryInMB = types.NullUint64{IsSet: true, Value: megabytes}
+		}
+	}
+
+	if unlimitedLogRate.MatchString(logRateLimit) {
+		process.LogRateLimitInBPS = types.NullInt{IsSet: true, Value: -1}
+	} else if logRateLimit != "" {
+		if bytes, err := bytefmt.ToBytes(logRateLimit); err == nil {
+			process.LogRateLimitInBPS = types.NullInt{IsSet: true, Value: int(bytes)}
+		}
+	}
+
+	return process
+}
@@ -0,0 +1,180 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckQuotasForPush", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		manifest  manifestparser.Manifest
+		overrides FlagOverrides
+
+		check      v7action.QuotaCheck
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+
+		instances := 3
+		manifest = manifestparser.Manifest{
+			Applications: []manifestparser.Application{
+				{
+					Name:         "existing-app",
+					Instances:    &instances,
+					Memory:       "1G",
+					LogRateLimit: "-1",
+					Processes: []manifestparser.Process{
+						{Type: "worker", Memory: "256M"},
+					},
+					RemainingManifestFields: map[string]interface{}{
+						"routes": []interface{}{
+							map[interface{}]interface{}{"route": "existing.example.com"},
+							map[interface{}]interface{}{"route": "taken.example.com"},
+							map[interface{}]interface{}{"route": "new.example.com"},
+						},
+					},
+				},
+				{
+					Name:         "new-app",
+					DefaultRoute: true,
+				},
+			},
+		}
+		overrides = FlagOverrides{}
+
+		fakeV7Actor.GetApplicationByNameAndSpaceStub = func(appName string, spaceGUID string) (resources.Application, v7action.Warnings, error) {
+			if appName == "existing-app" {
+				return resources.Application{GUID: "existing-app-guid", Name: appName}, v7action.Warnings{"app-warning"}, nil
+			}
+			return resources.Application{}, v7action.Warnings{"app-warning"}, actionerror.ApplicationNotFoundError{Name: appName}
+		}
+		fakeV7Actor.GetApplicationQuotaChangeStub = func(app resources.Application, processes []resources.Process, started bool) (v7action.QuotaChange, v7action.Warnings, error) {
+			return v7action.QuotaChange{MemoryInMB: 1024, AppInstances: 1}, v7action.Warnings{"change-warning"}, nil
+		}
+		fakeV7Actor.GetApplicationRoutesReturns(
+			[]resources.Route{{URL: "existing.example.com"}},
+			v7action.Warnings{"app-routes-warning"},
+			nil,
+		)
+		fakeV7Actor.GetRouteStub = func(routePath string, spaceGUID string) (resources.Route, v7action.Warnings, error) {
+			if routePath == "taken.example.com" {
+				return resources.Route{GUID: "taken-route-guid"}, v7action.Warnings{"route-warning"}, nil
+			}
+			return resources.Route{}, v7action.Warnings{"route-warning"}, actionerror.RouteNotFoundError{}
+		}
+		fakeV7Actor.GetDefaultDomainReturns(resources.Domain{Name: "example.com"}, v7action.Warnings{"domain-warning"}, nil)
+		fakeV7Actor.GetRouteByAttributesReturns(resources.Route{}, v7action.Warnings{"default-route-warning"}, actionerror.RouteNotFoundError{})
+		fakeV7Actor.CheckQuotaChangeReturns(
+			v7action.QuotaCheck{{Scope: "org", Resource: v7action.QuotaResourceMemory, Current: 1024, Change: 2048, Limit: 2048}},
+			v7action.Warnings{"check-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		check, warnings, executeErr = actor.CheckQuotasForPush("org-guid", "space-guid", manifest, overrides)
+	})
+
+	It("adds up the changes of every app and checks them against the quotas", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(check.Exceeded()).To(BeTrue())
+		Expect(warnings).To(ConsistOf(
+			"app-warning", "change-warning", "app-routes-warning", "route-warning", "route-warning",
+			"app-warning", "change-warning", "domain-warning", "default-route-warning",
+			"check-warning",
+		))
+
+		Expect(fakeV7Actor.GetApplicationQuotaChangeCallCount()).To(Equal(2))
+		app, processes, started := fakeV7Actor.GetApplicationQuotaChangeArgsForCall(0)
+		Expect(app.GUID).To(Equal("existing-app-guid"))
+		Expect(started).To(BeTrue())
+		Expect(processes).To(Equal([]resources.Process{
+			{
+				Type:              constant.ProcessTypeWeb,
+				Instances:         types.NullInt{IsSet: true, Value: 3},
+				MemoryInMB:        types.NullUint64{IsSet: true, Value: 1024},
+				LogRateLimitInBPS: types.NullInt{IsSet: true, Value: -1},
+			},
+			{Type: "worker", MemoryInMB: types.NullUint64{IsSet: true, Value: 256}},
+		}))
+
+		app, _, _ = fakeV7Actor.GetApplicationQuotaChangeArgsForCall(1)
+		Expect(app).To(Equal(resources.Application{Name: "new-app"}))
+
+		domain, hostname, _, _ := fakeV7Actor.GetRouteByAttributesArgsForCall(0)
+		Expect(domain.Name).To(Equal("example.com"))
+		Expect(hostname).To(Equal("new-app"))
+
+		Expect(fakeV7Actor.CheckQuotaChangeCallCount()).To(Equal(1))
+		orgGUID, spaceGUID, change := fakeV7Actor.CheckQuotaChangeArgsForCall(0)
+		Expect(orgGUID).To(Equal("org-guid"))
+		Expect(spaceGUID).To(Equal("space-guid"))
+		Expect(change).To(Equal(v7action.QuotaChange{MemoryInMB: 2048, AppInstances: 2, Routes: 2}))
+	})
+
+	When("the apps are not started", func() {
+		BeforeEach(func() {
+			overrides.NoStart = true
+		})
+
+		It("tells the actor the apps will not run", func() {
+			_, _, started := fakeV7Actor.GetApplicationQuotaChangeArgsForCall(0)
+			Expect(started).To(BeFalse())
+		})
+	})
+
+	When("the apps have no routes", func() {
+		BeforeEach(func() {
+			manifest.Applications[0].NoRoute = true
+			manifest.Applications[1].DefaultRoute = false
+			manifest.Applications[1].RandomRoute = true
+		})
+
+		It("only counts the random route", func() {
+			_, _, change := fakeV7Actor.CheckQuotaChangeArgsForCall(0)
+			Expect(change.Routes).To(Equal(1))
+			Expect(fakeV7Actor.GetRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("looking up an app fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetApplicationByNameAndSpaceStub = nil
+			fakeV7Actor.GetApplicationByNameAndSpaceReturns(resources.Application{}, v7action.Warnings{"app-warning"}, errors.New("app-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("app-error"))
+			Expect(warnings).To(ConsistOf("app-warning"))
+			Expect(fakeV7Actor.CheckQuotaChangeCallCount()).To(Equal(0))
+		})
+	})
+
+	When("looking up a route fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetRouteStub = nil
+			fakeV7Actor.GetRouteReturns(resources.Route{}, v7action.Warnings{"route-warning"}, errors.New("route-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("route-error"))
+			Expect(warnings).To(ContainElement("route-warning"))
+		})
+	})
+})
@@ -33,17 +33,20 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 		allWarnings        Warnings
 		matchedResources   []sharedaction.V3Resource
 		unmatchedResources []sharedaction.V3Resource
+		cachedBits         CachedBits
+		usingCachedBits    bool
 	)
 
-	// check if all source files are empty
-	shouldResourceMatch := false
-	for _, resource := range pushPlan.AllResources {
-		if resource.SizeInBytes != 0 {
-			shouldResourceMatch = true
-		}
+	cacheKey := BitsCacheKey(actor.BitsCacheTarget, pushPlan.SpaceGUID, pushPlan.Application.GUID, pushPlan.AllResources)
+	if actor.BitsCache != nil {
+		cachedBits, usingCachedBits = actor.BitsCache.Load(cacheKey)
 	}
 
-	if shouldResourceMatch {
+	if usingCachedBits {
+		log.WithField("ArchivePath", cachedBits.ArchivePath).Info("using cached archive")
+		eventStream <- &PushEvent{Plan: pushPlan, Event: UsingCachedArchive}
+		matchedResources = cachedBits.MatchedResources
+	} else if shouldResourceMatch(pushPlan.AllResources) {
 		eventStream <- &PushEvent{Plan: pushPlan, Event: ResourceMatching}
 		var warnings Warnings
 		var err error
@@ -66,13 +69,17 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 		return resources.Package{}, allWarnings, err
 	}
 
-	if len(unmatchedResources) > 0 {
-		eventStream <- &PushEvent{Plan: pushPlan, Event: CreatingArchive}
-		archivePath, archiveErr := actor.CreateAndReturnArchivePath(pushPlan, unmatchedResources)
-		if archiveErr != nil {
-			return resources.Package{}, allWarnings, archiveErr
+	if usingCachedBits || len(unmatchedResources) > 0 {
+		archivePath := cachedBits.ArchivePath
+		if !usingCachedBits {
+			eventStream <- &PushEvent{Plan: pushPlan, Event: CreatingArchive}
+			var archiveErr error
+			archivePath, archiveErr = actor.CreateAndReturnArchivePath(pushPlan, unmatchedResources)
+			if archiveErr != nil {
+				return resources.Package{}, allWarnings, archiveErr
+			}
+			defer os.RemoveAll(archivePath)
 		}
-		defer os.RemoveAll(archivePath)
 
 		// Uploading package/app bits
 		for count := 0; count < PushRetries; count++ {
@@ -80,6 +87,9 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 			log.WithField("GUID", pushPlan.Application.GUID).Info("reading archive")
 			file, size, readErr := actor.SharedActor.ReadArchive(archivePath)
 			if readErr != nil {
+				if usingCachedBits {
+					actor.removeCachedBits(cacheKey)
+				}
 				return resources.Package{}, allWarnings, readErr
 			}
 			defer file.Close()
@@ -101,9 +111,21 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 			if e, ok := err.(ccerror.PipeSeekError); ok {
 				return resources.Package{}, allWarnings, actionerror.UploadFailedError{Err: e.Err}
 			}
+			if _, ok := err.(ccerror.RequestError); ok {
+				// the upload never reached the API, so keep the bits for a retry
+				if !usingCachedBits {
+					actor.storeCachedBits(cacheKey, CachedBits{MatchedResources: matchedResources, ArchivePath: archivePath})
+				}
+			} else if usingCachedBits {
+				// the API rejected the upload, so retrying with the same bits won't help
+				actor.removeCachedBits(cacheKey)
+			}
 			return resources.Package{}, allWarnings, err
 		}
 
+		if usingCachedBits {
+			actor.removeCachedBits(cacheKey)
+		}
 		eventStream <- &PushEvent{Plan: pushPlan, Event: UploadWithArchiveComplete}
 	} else {
 		eventStream <- &PushEvent{Plan: pushPlan, Event: UploadingApplication}
@@ -118,6 +140,24 @@ func (actor Actor) CreateAndUploadApplicationBits(pushPlan PushPlan, eventStream
 	return pkg, allWarnings, nil
 }
 
+func (actor Actor) storeCachedBits(key string, bits CachedBits) {
+	if actor.BitsCache == nil {
+		return
+	}
+	if err := actor.BitsCache.Store(key, bits); err != nil {
+		log.WithField("error", err).Warn("unable to cache archive")
+	}
+}
+
+func (actor Actor) removeCachedBits(key string) {
+	if actor.BitsCache == nil {
+		return
+	}
+	if err := actor.BitsCache.Remove(key); err != nil {
+		log.WithField("error", err).Warn("unable to remove cached archive")
+	}
+}
+
 func (actor Actor) CreateAndReturnArchivePath(pushPlan PushPlan, unmatchedResources []sharedaction.V3Resource) (string, error) {
 	// translate between v3 and v2 resources
 	var v2Resources []sharedaction.Resource
@@ -426,4 +426,132 @@ var _ = Describe("CreateBitsPackageForApplication", func() {
 			})
 		})
 	})
+
+	Describe("bits cache", func() {
+		var (
+			fakeBitsCache *v7pushactionfakes.FakeBitsCache
+			matches       []sharedaction.V3Resource
+			cacheKey      string
+		)
+
+		BeforeEach(func() {
+			fakeBitsCache = new(v7pushactionfakes.FakeBitsCache)
+			actor.BitsCache = fakeBitsCache
+			actor.BitsCacheTarget = "https://api.example.com"
+
+			matches = []sharedaction.V3Resource{buildV3Resource("some-matching-filename")}
+			paramPlan = PushPlan{
+				SpaceGUID: "some-space-guid",
+				Application: resources.Application{
+					Name: "some-app",
+					GUID: "some-app-guid",
+				},
+				BitsPath:     "/some-bits-path",
+				AllResources: append(matches, buildV3Resource("some-unmatching-filename")),
+			}
+			cacheKey = BitsCacheKey("https://api.example.com", "some-space-guid", "some-app-guid", paramPlan.AllResources)
+
+			fakeV7Actor.ResourceMatchReturns(matches, v7action.Warnings{"some-resource-match-warning"}, nil)
+			fakeV7Actor.CreateBitsPackageByApplicationReturns(resources.Package{GUID: "some-guid"}, nil, nil)
+			fakeSharedActor.ZipDirectoryResourcesReturns("/some/archive/path", nil)
+		})
+
+		It("looks up the bits by the target and the checksums of all resources", func() {
+			Expect(fakeBitsCache.LoadCallCount()).To(Equal(1))
+			Expect(fakeBitsCache.LoadArgsForCall(0)).To(Equal(cacheKey))
+		})
+
+		When("there are no cached bits", func() {
+			When("the upload succeeds", func() {
+				It("does not cache the bits", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeBitsCache.StoreCallCount()).To(Equal(0))
+					Expect(fakeBitsCache.RemoveCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the upload fails to reach the API", func() {
+				BeforeEach(func() {
+					fakeV7Actor.UploadBitsPackageReturns(resources.Package{}, nil, ccerror.RequestError{Err: errors.New("connection reset")})
+				})
+
+				It("stores the archive and matched resources for the next attempt", func() {
+					Expect(executeErr).To(MatchError(ccerror.RequestError{Err: errors.New("connection reset")}))
+					Expect(fakeBitsCache.StoreCallCount()).To(Equal(1))
+					key, bits := fakeBitsCache.StoreArgsForCall(0)
+					Expect(key).To(Equal(cacheKey))
+					Expect(bits).To(Equal(CachedBits{MatchedResources: matches, ArchivePath: "/some/archive/path"}))
+				})
+			})
+
+			When("the API rejects the upload", func() {
+				BeforeEach(func() {
+					fakeV7Actor.UploadBitsPackageReturns(resources.Package{}, nil, ccerror.UnprocessableEntityError{Message: "bad bits"})
+				})
+
+				It("does not cache the bits", func() {
+					Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "bad bits"}))
+					Expect(fakeBitsCache.StoreCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("there are cached bits", func() {
+			var cachedMatches []sharedaction.V3Resource
+
+			BeforeEach(func() {
+				cachedMatches = []sharedaction.V3Resource{buildV3Resource("some-cached-filename")}
+				fakeBitsCache.LoadReturns(CachedBits{MatchedResources: cachedMatches, ArchivePath: "/some/cached/archive.zip"}, true)
+			})
+
+			It("uploads the cached archive without matching resources or zipping", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(events).To(ConsistOf(UsingCachedArchive, CreatingPackage, ReadingArchive, UploadingApplicationWithArchive, UploadWithArchiveComplete))
+
+				Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(0))
+				Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(Equal(0))
+				Expect(fakeBitsCache.StoreCallCount()).To(Equal(0))
+
+				Expect(fakeSharedActor.ReadArchiveArgsForCall(0)).To(Equal("/some/cached/archive.zip"))
+				_, actualMatches, _, _ := fakeV7Actor.UploadBitsPackageArgsForCall(0)
+				Expect(actualMatches).To(Equal(cachedMatches))
+				Expect(fakeBitsCache.RemoveCallCount()).To(Equal(1))
+				Expect(fakeBitsCache.RemoveArgsForCall(0)).To(Equal(cacheKey))
+			})
+
+			When("the upload fails to reach the API again", func() {
+				BeforeEach(func() {
+					fakeV7Actor.UploadBitsPackageReturns(resources.Package{}, nil, ccerror.RequestError{Err: errors.New("connection reset")})
+				})
+
+				It("keeps the cached bits for the next attempt", func() {
+					Expect(executeErr).To(HaveOccurred())
+					Expect(fakeBitsCache.StoreCallCount()).To(Equal(0))
+					Expect(fakeBitsCache.RemoveCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the API rejects the upload", func() {
+				BeforeEach(func() {
+					fakeV7Actor.UploadBitsPackageReturns(resources.Package{}, nil, ccerror.UnprocessableEntityError{Message: "bad bits"})
+				})
+
+				It("removes the cached bits", func() {
+					Expect(executeErr).To(HaveOccurred())
+					Expect(fakeBitsCache.RemoveCallCount()).To(Equal(1))
+				})
+			})
+
+			When("the cached archive cannot be read", func() {
+				BeforeEach(func() {
+					fakeSharedActor.ReadArchiveReturns(nil, 0, errors.New("gone"))
+				})
+
+				It("removes the cached bits", func() {
+					Expect(executeErr).To(MatchError("gone"))
+					Expect(fakeBitsCache.RemoveCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
 })
@@ -17,7 +17,14 @@ func (actor Actor) CreatePushPlans(
 ) ([]PushPlan, v7action.Warnings, error) {
 	var pushPlans []PushPlan
 
-	apps, warnings, err := actor.V7Actor.GetApplicationsByNamesAndSpace(manifest.AppNames(), spaceGUID)
+	appNames := manifest.AppNames()
+	if overrides.BlueGreen {
+		for _, appName := range manifest.AppNames() {
+			appNames = append(appNames, BlueGreenAppName(appName))
+		}
+	}
+
+	apps, warnings, err := actor.V7Actor.GetApplicationsByNamesAndSpace(appNames, spaceGUID)
 	if err != nil {
 		return nil, warnings, err
 	}
@@ -39,6 +46,15 @@ func (actor Actor) CreatePushPlans(
 			}
 		}
 
+		if overrides.BlueGreen {
+			var routeWarnings v7action.Warnings
+			plan, routeWarnings, err = actor.setupBlueGreenApplications(plan, manifestApplication, nameToApp)
+			warnings = append(warnings, routeWarnings...)
+			if err != nil {
+				return nil, warnings, err
+			}
+		}
+
 		// List of PreparePushPlanSequence is defined in NewActor
 		for _, updatePlan := range actor.PreparePushPlanSequence {
 			var err error
@@ -54,6 +70,29 @@ func (actor Actor) CreatePushPlans(
 	return pushPlans, warnings, nil
 }
 
+// setupBlueGreenApplications points the plan at the temporary app of a
+// blue-green push, and decides which routes it takes over from the live app:
+// the ones in the manifest, or else the ones the live app has.
+func (actor Actor) setupBlueGreenApplications(plan PushPlan, manifestApplication manifestparser.Application, nameToApp map[string]resources.Application) (PushPlan, v7action.Warnings, error) {
+	plan.LiveApplication = nameToApp[manifestApplication.Name]
+	plan.Application = nameToApp[BlueGreenAppName(manifestApplication.Name)]
+
+	plan.BlueGreenRoutes = manifestRouteURLs(manifestApplication)
+	if len(plan.BlueGreenRoutes) > 0 || manifestApplication.NoRoute {
+		return plan, nil, nil
+	}
+
+	routes, warnings, err := actor.V7Actor.GetApplicationRoutes(plan.LiveApplication.GUID)
+	if err != nil {
+		return plan, warnings, err
+	}
+	for _, route := range routes {
+		plan.BlueGreenRoutes = append(plan.BlueGreenRoutes, route.URL)
+	}
+
+	return plan, warnings, nil
+}
+
 func (actor Actor) generateAppNameToApplicationMapping(applications []resources.Application) map[string]resources.Application {
 	nameToApp := make(map[string]resources.Application, len(applications))
 	for _, app := range applications {
@@ -131,4 +131,87 @@ var _ = Describe("CreatePushPlans", func() {
 		})
 
 	})
+
+	When("pushing blue-green", func() {
+		BeforeEach(func() {
+			flagOverrides.BlueGreen = true
+			manifest = manifestparser.Manifest{
+				Applications: []manifestparser.Application{
+					{Name: "name-1", Path: "path1"},
+				},
+			}
+
+			fakeV7Actor.GetApplicationsByNamesAndSpaceReturns(
+				[]resources.Application{
+					{Name: "name-1", GUID: "app-guid-1"},
+					{Name: "name-1-new", GUID: "app-guid-1-new"},
+				},
+				v7action.Warnings{"get-apps-warning"},
+				nil,
+			)
+			fakeV7Actor.GetApplicationRoutesReturns(
+				[]resources.Route{
+					{GUID: "route-guid-1", URL: "name-1.example.com"},
+					{GUID: "route-guid-2", URL: "name-1.example.com/path"},
+				},
+				v7action.Warnings{"get-routes-warning"},
+				nil,
+			)
+		})
+
+		It("plans to push the temporary app in place of the live app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			actualAppNames, _ := fakeV7Actor.GetApplicationsByNamesAndSpaceArgsForCall(0)
+			Expect(actualAppNames).To(ConsistOf("name-1", "name-1-new"))
+
+			Expect(pushPlans).To(HaveLen(1))
+			Expect(pushPlans[0].Application.GUID).To(Equal("app-guid-1-new"))
+			Expect(pushPlans[0].LiveApplication.GUID).To(Equal("app-guid-1"))
+		})
+
+		It("moves the routes of the live app", func() {
+			Expect(fakeV7Actor.GetApplicationRoutesArgsForCall(0)).To(Equal("app-guid-1"))
+			Expect(pushPlans[0].BlueGreenRoutes).To(Equal([]string{"name-1.example.com", "name-1.example.com/path"}))
+			Expect(warnings).To(ConsistOf("get-apps-warning", "get-routes-warning"))
+		})
+
+		When("the manifest lists routes", func() {
+			BeforeEach(func() {
+				manifest.Applications[0].RemainingManifestFields = map[string]interface{}{
+					"routes": []interface{}{
+						map[interface{}]interface{}{"route": "www.example.com"},
+						map[interface{}]interface{}{"route": "tcp.example.com:1024", "protocol": "tcp"},
+					},
+				}
+			})
+
+			It("moves the manifest routes instead", func() {
+				Expect(fakeV7Actor.GetApplicationRoutesCallCount()).To(Equal(0))
+				Expect(pushPlans[0].BlueGreenRoutes).To(Equal([]string{"www.example.com", "tcp.example.com:1024"}))
+			})
+		})
+
+		When("the manifest has no-route set", func() {
+			BeforeEach(func() {
+				manifest.Applications[0].NoRoute = true
+			})
+
+			It("does not move any routes", func() {
+				Expect(fakeV7Actor.GetApplicationRoutesCallCount()).To(Equal(0))
+				Expect(pushPlans[0].BlueGreenRoutes).To(BeEmpty())
+			})
+		})
+
+		When("getting the live app's routes fails", func() {
+			BeforeEach(func() {
+				fakeV7Actor.GetApplicationRoutesReturns(nil, v7action.Warnings{"get-routes-warning"}, errors.New("get-routes-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get-routes-error"))
+				Expect(warnings).To(ConsistOf("get-apps-warning", "get-routes-warning"))
+			})
+		})
+	})
 })
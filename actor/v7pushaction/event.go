@@ -15,13 +15,21 @@ const (
 	CreatingArchive                 Event = "creating archive"
 	CreatingDroplet                 Event = "creating droplet"
 	CreatingPackage                 Event = "creating package"
+	DeletingApplication             Event = "deleting application"
+	DeletingApplicationComplete     Event = "deleting application complete"
 	InstanceDetails                 Event = "instance details"
+	MappingRoutes                   Event = "mapping routes"
+	MonitoringHealth                Event = "monitoring health"
 	PollingBuild                    Event = "polling build"
 	ReadingArchive                  Event = "reading archive"
+	RenamingApplication             Event = "renaming application"
+	RenamingOldApplication          Event = "renaming old application"
 	ResourceMatching                Event = "resource matching"
 	RestartingApplication           Event = "restarting application"
 	RestartingApplicationComplete   Event = "restarting application complete"
 	RetryUpload                     Event = "retry upload"
+	RollingBack                     Event = "rolling back"
+	RollingBackComplete             Event = "rolling back complete"
 	SetDockerImage                  Event = "setting docker properties"
 	SetDockerImageComplete          Event = "completed setting docker properties"
 	SetDropletComplete              Event = "set droplet complete"
@@ -31,10 +39,12 @@ const (
 	StartingStaging                 Event = "starting staging"
 	StoppingApplication             Event = "stopping application"
 	StoppingApplicationComplete     Event = "stopping application complete"
+	UnmappingRoutes                 Event = "unmapping routes"
 	UploadDropletComplete           Event = "upload droplet complete"
 	UploadingApplication            Event = "uploading application"
 	UploadingApplicationWithArchive Event = "uploading application with archive"
 	UploadingDroplet                Event = "uploading droplet"
 	UploadWithArchiveComplete       Event = "upload complete"
+	UsingCachedArchive              Event = "using cached archive"
 	WaitingForDeployment            Event = "waiting for deployment"
 )
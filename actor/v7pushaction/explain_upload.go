@@ -0,0 +1,147 @@
+package v7pushaction
+
+import (
+	"archive/zip"
+	"os"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	log "github.com/sirupsen/logrus"
+)
+
+// MaxUploadContributors is the number of entries in
+// UploadReport.LargestContributors.
+const MaxUploadContributors = 10
+
+// UploadReport explains which of an app's files a push would upload.
+type UploadReport struct {
+	// Included are all the files gathered for the push.
+	Included []sharedaction.V3Resource
+	// Ignored are the files left out by .cfignore or the default ignore rules.
+	Ignored []sharedaction.IgnoredFile
+	// Matched are the included files already in the resource cache, which are
+	// not uploaded again.
+	Matched []sharedaction.V3Resource
+	// Unmatched are the included files that would be zipped and uploaded.
+	Unmatched []sharedaction.V3Resource
+
+	// ArchiveSize is the size of the zip that would be uploaded.
+	ArchiveSize int64
+	// LargestContributors are the top level files and directories that take
+	// up the most space in the zip, largest first.
+	LargestContributors []UploadContributor
+}
+
+// UploadContributor is a top level file or directory in an upload.
+type UploadContributor struct {
+	Path           string
+	Files          int
+	Size           int64
+	CompressedSize int64
+}
+
+// ExplainUpload reports what pushing the plan's bits would upload without
+// creating a package.
+func (actor Actor) ExplainUpload(pushPlan PushPlan) (UploadReport, Warnings, error) {
+	report := UploadReport{Included: pushPlan.AllResources}
+
+	var err error
+	if pushPlan.Archive {
+		report.Ignored, err = actor.SharedActor.GatherIgnoredArchiveFiles(pushPlan.BitsPath)
+	} else {
+		report.Ignored, err = actor.SharedActor.GatherIgnoredDirectoryFiles(pushPlan.BitsPath)
+	}
+	if err != nil {
+		return UploadReport{}, nil, err
+	}
+
+	var warnings Warnings
+	if shouldResourceMatch(pushPlan.AllResources) {
+		report.Matched, report.Unmatched, warnings, err = actor.MatchResources(pushPlan.AllResources)
+		if err != nil {
+			return UploadReport{}, warnings, err
+		}
+	} else {
+		report.Unmatched = pushPlan.AllResources
+	}
+
+	if len(report.Unmatched) == 0 {
+		return report, warnings, nil
+	}
+
+	archivePath, err := actor.CreateAndReturnArchivePath(pushPlan, report.Unmatched)
+	if err != nil {
+		return UploadReport{}, warnings, err
+	}
+	defer os.RemoveAll(archivePath)
+
+	report.ArchiveSize, report.LargestContributors, err = summarizeArchive(archivePath)
+	if err != nil {
+		return UploadReport{}, warnings, err
+	}
+
+	return report, warnings, nil
+}
+
+// shouldResourceMatch returns false when all the files are empty, in which
+// case there is nothing to match.
+func shouldResourceMatch(allResources []sharedaction.V3Resource) bool {
+	for _, resource := range allResources {
+		if resource.SizeInBytes != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func summarizeArchive(archivePath string) (int64, []UploadContributor, error) {
+	info, err := os.Stat(archivePath)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		log.WithField("archivePath", archivePath).Errorln("opening archive:", err)
+		return 0, nil, err
+	}
+	defer reader.Close()
+
+	contributors := map[string]*UploadContributor{}
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		path := file.Name
+		if i := strings.Index(path, "/"); i >= 0 {
+			path = path[:i+1]
+		}
+
+		contributor, ok := contributors[path]
+		if !ok {
+			contributor = &UploadContributor{Path: path}
+			contributors[path] = contributor
+		}
+		contributor.Files++
+		contributor.Size += int64(file.UncompressedSize64)
+		contributor.CompressedSize += int64(file.CompressedSize64)
+	}
+
+	var largest []UploadContributor
+	for _, contributor := range contributors {
+		largest = append(largest, *contributor)
+	}
+	sort.Slice(largest, func(i, j int) bool {
+		if largest[i].CompressedSize != largest[j].CompressedSize {
+			return largest[i].CompressedSize > largest[j].CompressedSize
+		}
+		return largest[i].Path < largest[j].Path
+	})
+	if len(largest) > MaxUploadContributors {
+		largest = largest[:MaxUploadContributors]
+	}
+
+	return info.Size(), largest, nil
+}
@@ -0,0 +1,150 @@
+package v7pushaction_test
+
+import (
+	"archive/zip"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ExplainUpload", func() {
+	var (
+		actor           *Actor
+		fakeV7Actor     *v7pushactionfakes.FakeV7Actor
+		fakeSharedActor *v7pushactionfakes.FakeSharedActor
+
+		pushPlan    PushPlan
+		matches     []sharedaction.V3Resource
+		unmatches   []sharedaction.V3Resource
+		archivePath string
+
+		report     UploadReport
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, fakeSharedActor = getTestPushActor()
+
+		matches = []sharedaction.V3Resource{buildV3Resource("vendor/cached.js")}
+		unmatches = []sharedaction.V3Resource{
+			buildV3Resource("app.js"),
+			buildV3Resource("node_modules/a/index.js"),
+			buildV3Resource("node_modules/b/index.js"),
+		}
+		pushPlan = PushPlan{
+			BitsPath:     "/some-bits-path",
+			AllResources: append(append([]sharedaction.V3Resource{}, matches...), unmatches...),
+		}
+
+		fakeSharedActor.GatherIgnoredDirectoryFilesReturns([]sharedaction.IgnoredFile{
+			{Filename: "debug.log", Rule: "*.log", RuleSource: sharedaction.CFIgnoreRuleSource},
+		}, nil)
+		fakeV7Actor.ResourceMatchReturns(matches, v7action.Warnings{"resource-match-warning"}, nil)
+
+		archivePath = filepath.Join(GinkgoT().TempDir(), "archive.zip")
+		archive, err := os.Create(archivePath)
+		Expect(err).ToNot(HaveOccurred())
+		writer := zip.NewWriter(archive)
+		for name, size := range map[string]int{"app.js": 10, "node_modules/": 0, "node_modules/a/index.js": 1000, "node_modules/b/index.js": 1000} {
+			fileWriter, err := writer.Create(name)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = fileWriter.Write([]byte(strings.Repeat("x", size)))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(writer.Close()).To(Succeed())
+		Expect(archive.Close()).To(Succeed())
+		fakeSharedActor.ZipDirectoryResourcesReturns(archivePath, nil)
+	})
+
+	JustBeforeEach(func() {
+		report, warnings, executeErr = actor.ExplainUpload(pushPlan)
+	})
+
+	It("reports included, ignored, matched and unmatched files", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(warnings).To(ConsistOf("resource-match-warning"))
+
+		Expect(report.Included).To(Equal(pushPlan.AllResources))
+		Expect(report.Ignored).To(Equal([]sharedaction.IgnoredFile{
+			{Filename: "debug.log", Rule: "*.log", RuleSource: sharedaction.CFIgnoreRuleSource},
+		}))
+		Expect(report.Matched).To(Equal(matches))
+		Expect(report.Unmatched).To(Equal(unmatches))
+
+		Expect(fakeSharedActor.GatherIgnoredDirectoryFilesArgsForCall(0)).To(Equal("/some-bits-path"))
+		Expect(fakeSharedActor.GatherIgnoredArchiveFilesCallCount()).To(Equal(0))
+	})
+
+	It("zips the unmatched files and reports the largest contributors", func() {
+		Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(Equal(1))
+		_, zipped := fakeSharedActor.ZipDirectoryResourcesArgsForCall(0)
+		Expect(zipped).To(HaveLen(3))
+
+		Expect(archivePath).ToNot(BeAnExistingFile())
+
+		Expect(report.ArchiveSize).To(BeNumerically(">", 0))
+		Expect(report.LargestContributors).To(HaveLen(2))
+		Expect(report.LargestContributors[0].Path).To(Equal("node_modules/"))
+		Expect(report.LargestContributors[0].Files).To(Equal(2))
+		Expect(report.LargestContributors[0].Size).To(BeNumerically("==", 2000))
+		Expect(report.LargestContributors[1].Path).To(Equal("app.js"))
+		Expect(report.LargestContributors[1].Files).To(Equal(1))
+	})
+
+	When("the bits path is an archive", func() {
+		BeforeEach(func() {
+			pushPlan.Archive = true
+			fakeSharedActor.ZipArchiveResourcesReturns(archivePath, nil)
+		})
+
+		It("gathers the ignored files from the archive", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeSharedActor.GatherIgnoredArchiveFilesArgsForCall(0)).To(Equal("/some-bits-path"))
+			Expect(fakeSharedActor.ZipArchiveResourcesCallCount()).To(Equal(1))
+		})
+	})
+
+	When("every file is in the resource cache", func() {
+		BeforeEach(func() {
+			fakeV7Actor.ResourceMatchReturns(pushPlan.AllResources, nil, nil)
+		})
+
+		It("does not create an archive", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(report.Unmatched).To(BeEmpty())
+			Expect(report.ArchiveSize).To(BeZero())
+			Expect(fakeSharedActor.ZipDirectoryResourcesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("gathering ignored files fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.GatherIgnoredDirectoryFilesReturns(nil, errors.New("bad cfignore"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("bad cfignore"))
+			Expect(fakeV7Actor.ResourceMatchCallCount()).To(Equal(0))
+		})
+	})
+
+	When("resource matching fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.ResourceMatchReturns(nil, v7action.Warnings{"resource-match-warning"}, errors.New("match error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("match error"))
+			Expect(warnings).To(ConsistOf("resource-match-warning"))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package v7pushaction
+
+import (
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+
+	"code.cloudfoundry.org/cli/util/manifestparser"
+)
+
+// MapRoutesForBlueGreen maps the routes of a blue-green push to the temporary
+// app, creating any that do not exist yet.
+func (actor Actor) MapRoutesForBlueGreen(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+	if len(pushPlan.BlueGreenRoutes) == 0 {
+		return pushPlan, nil, nil
+	}
+
+	log.WithField("routes", pushPlan.BlueGreenRoutes).Info("mapping routes to temporary app")
+	eventStream <- &PushEvent{Plan: pushPlan, Event: MappingRoutes}
+
+	var routes []map[string]string
+	for _, url := range pushPlan.BlueGreenRoutes {
+		routes = append(routes, map[string]string{"route": url})
+	}
+
+	rawManifest, err := yaml.Marshal(manifestparser.Manifest{
+		Applications: []manifestparser.Application{{
+			Name:                    pushPlan.Application.Name,
+			RemainingManifestFields: map[string]interface{}{"routes": routes},
+		}},
+	})
+	if err != nil {
+		return pushPlan, nil, err
+	}
+
+	warnings, err := actor.V7Actor.SetApplicationManifest(pushPlan.Application.GUID, rawManifest)
+	return pushPlan, Warnings(warnings), err
+}
@@ -0,0 +1,82 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MapRoutesForBlueGreen", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		paramPlan PushPlan
+
+		warnings   Warnings
+		executeErr error
+
+		events []Event
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+
+		paramPlan = PushPlan{
+			Application:     resources.Application{Name: "some-app-new", GUID: "new-guid"},
+			BlueGreenRoutes: []string{"www.example.com", "www.example.com/path"},
+		}
+		fakeV7Actor.SetApplicationManifestReturns(v7action.Warnings{"manifest-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		events = EventFollower(func(eventStream chan<- *PushEvent) {
+			_, warnings, executeErr = actor.MapRoutesForBlueGreen(paramPlan, eventStream, nil)
+		})
+	})
+
+	It("applies the routes to the temporary app", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf("manifest-warning"))
+		Expect(events).To(ConsistOf(MappingRoutes))
+
+		Expect(fakeV7Actor.SetApplicationManifestCallCount()).To(Equal(1))
+		appGUID, rawManifest := fakeV7Actor.SetApplicationManifestArgsForCall(0)
+		Expect(appGUID).To(Equal("new-guid"))
+		Expect(rawManifest).To(MatchYAML(`
+applications:
+- name: some-app-new
+  routes:
+  - route: www.example.com
+  - route: www.example.com/path
+`))
+	})
+
+	When("applying the routes fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.SetApplicationManifestReturns(v7action.Warnings{"manifest-warning"}, errors.New("manifest-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("manifest-error"))
+			Expect(warnings).To(ConsistOf("manifest-warning"))
+		})
+	})
+
+	When("there are no routes to move", func() {
+		BeforeEach(func() {
+			paramPlan.BlueGreenRoutes = nil
+		})
+
+		It("does nothing", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(events).To(BeEmpty())
+			Expect(fakeV7Actor.SetApplicationManifestCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,19 @@
+package v7pushaction
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// MonitorHealthForBlueGreen watches the temporary app of a blue-green push
+// while it serves traffic next to the live app.
+func (actor Actor) MonitorHealthForBlueGreen(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+	if pushPlan.BlueGreenTimeout == 0 {
+		return pushPlan, nil, nil
+	}
+
+	log.WithField("timeout", pushPlan.BlueGreenTimeout).Info("monitoring temporary app health")
+	eventStream <- &PushEvent{Plan: pushPlan, Event: MonitoringHealth}
+
+	warnings, err := actor.V7Actor.MonitorApplicationHealth(pushPlan.Application, pushPlan.BlueGreenTimeout)
+	return pushPlan, Warnings(warnings), err
+}
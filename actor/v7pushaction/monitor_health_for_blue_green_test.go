@@ -0,0 +1,76 @@
+package v7pushaction_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MonitorHealthForBlueGreen", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		paramPlan PushPlan
+
+		warnings   Warnings
+		executeErr error
+
+		events []Event
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+
+		paramPlan = PushPlan{
+			Application:      resources.Application{Name: "some-app-new", GUID: "new-guid"},
+			BlueGreenTimeout: time.Minute,
+		}
+		fakeV7Actor.MonitorApplicationHealthReturns(v7action.Warnings{"health-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		events = EventFollower(func(eventStream chan<- *PushEvent) {
+			_, warnings, executeErr = actor.MonitorHealthForBlueGreen(paramPlan, eventStream, nil)
+		})
+	})
+
+	It("monitors the temporary app for the timeout", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf("health-warning"))
+		Expect(events).To(ConsistOf(MonitoringHealth))
+
+		Expect(fakeV7Actor.MonitorApplicationHealthCallCount()).To(Equal(1))
+		app, duration := fakeV7Actor.MonitorApplicationHealthArgsForCall(0)
+		Expect(app.GUID).To(Equal("new-guid"))
+		Expect(duration).To(Equal(time.Minute))
+	})
+
+	When("the temporary app becomes unhealthy", func() {
+		BeforeEach(func() {
+			fakeV7Actor.MonitorApplicationHealthReturns(v7action.Warnings{"health-warning"}, actionerror.ApplicationUnhealthyError{Name: "some-app-new"})
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationUnhealthyError{Name: "some-app-new"}))
+			Expect(warnings).To(ConsistOf("health-warning"))
+		})
+	})
+
+	When("there is no timeout", func() {
+		BeforeEach(func() {
+			paramPlan.BlueGreenTimeout = 0
+		})
+
+		It("does not monitor the app", func() {
+			Expect(events).To(BeEmpty())
+			Expect(fakeV7Actor.MonitorApplicationHealthCallCount()).To(Equal(0))
+		})
+	})
+})
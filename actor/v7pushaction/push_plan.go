@@ -2,6 +2,7 @@ package v7pushaction
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -31,6 +32,14 @@ type PushPlan struct {
 
 	PackageGUID string
 	DropletGUID string
+
+	// BlueGreen is set when Application is a temporary app that takes over
+	// from LiveApplication once it is healthy.
+	BlueGreen        bool
+	BlueGreenTimeout time.Duration
+	BlueGreenRoutes  []string
+	KeepOldApp       bool
+	LiveApplication  resources.Application
 }
 
 type FlagOverrides struct {
@@ -60,6 +69,9 @@ type FlagOverrides struct {
 	NoManifest          bool
 	Task                bool
 	LogRateLimit        string
+	BlueGreen           bool
+	BlueGreenTimeout    time.Duration
+	KeepOldApp          bool
 }
 
 func (state PushPlan) String() string {
@@ -0,0 +1,20 @@
+package v7pushaction
+
+import (
+	log "github.com/sirupsen/logrus"
+)
+
+// RenameBlueGreenApplication gives the temporary app of a blue-green push the
+// name of the app it replaced.
+func (actor Actor) RenameBlueGreenApplication(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+	log.WithField("app_name", pushPlan.Application.Name).Info("renaming temporary app")
+	eventStream <- &PushEvent{Plan: pushPlan, Event: RenamingApplication}
+
+	app, warnings, err := actor.V7Actor.RenameApplicationByNameAndSpaceGUID(pushPlan.Application.Name, pushPlan.LiveApplication.Name, pushPlan.SpaceGUID)
+	if err != nil {
+		return pushPlan, Warnings(warnings), err
+	}
+	pushPlan.Application = app
+
+	return pushPlan, Warnings(warnings), nil
+}
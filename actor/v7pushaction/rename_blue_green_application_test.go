@@ -0,0 +1,73 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RenameBlueGreenApplication", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		paramPlan    PushPlan
+		returnedPlan PushPlan
+
+		warnings   Warnings
+		executeErr error
+
+		events []Event
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+
+		paramPlan = PushPlan{
+			SpaceGUID:       "some-space-guid",
+			Application:     resources.Application{Name: "some-app-new", GUID: "new-guid"},
+			LiveApplication: resources.Application{Name: "some-app", GUID: "live-guid"},
+		}
+		fakeV7Actor.RenameApplicationByNameAndSpaceGUIDReturns(
+			resources.Application{Name: "some-app", GUID: "new-guid"},
+			v7action.Warnings{"rename-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		events = EventFollower(func(eventStream chan<- *PushEvent) {
+			returnedPlan, warnings, executeErr = actor.RenameBlueGreenApplication(paramPlan, eventStream, nil)
+		})
+	})
+
+	It("gives the temporary app the name of the live app", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf("rename-warning"))
+		Expect(events).To(ConsistOf(RenamingApplication))
+
+		appName, newAppName, spaceGUID := fakeV7Actor.RenameApplicationByNameAndSpaceGUIDArgsForCall(0)
+		Expect(appName).To(Equal("some-app-new"))
+		Expect(newAppName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(returnedPlan.Application).To(Equal(resources.Application{Name: "some-app", GUID: "new-guid"}))
+	})
+
+	When("renaming fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.RenameApplicationByNameAndSpaceGUIDReturns(resources.Application{}, v7action.Warnings{"rename-warning"}, errors.New("rename-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("rename-error"))
+			Expect(warnings).To(ConsistOf("rename-warning"))
+			Expect(returnedPlan.Application.Name).To(Equal("some-app-new"))
+		})
+	})
+})
@@ -0,0 +1,98 @@
+package v7pushaction
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// RetireLiveApplication takes the live app of a blue-green push out of
+// service. Its routes are unmapped, then it is either deleted or stopped and
+// renamed so that it can be inspected later. If it fails before the live app
+// is deleted or stopped, the routes already unmapped are mapped back to it.
+func (actor Actor) RetireLiveApplication(pushPlan PushPlan, eventStream chan<- *PushEvent, progressBar ProgressBar) (PushPlan, Warnings, error) {
+	var allWarnings Warnings
+	liveApp := pushPlan.LiveApplication
+
+	log.WithField("app_name", liveApp.Name).Info("retiring live app")
+	eventStream <- &PushEvent{Plan: pushPlan, Event: UnmappingRoutes}
+
+	routes, warnings, err := actor.V7Actor.GetApplicationRoutes(liveApp.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return pushPlan, allWarnings, err
+	}
+
+	var unmapped []resources.Route
+	for _, route := range routes {
+		for _, destination := range route.Destinations {
+			if destination.App.GUID != liveApp.GUID {
+				continue
+			}
+
+			warnings, err = actor.V7Actor.UnmapRoute(route.GUID, destination.GUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				allWarnings = append(allWarnings, actor.remapRoutes(liveApp.GUID, unmapped)...)
+				return pushPlan, allWarnings, err
+			}
+			unmapped = append(unmapped, resources.Route{GUID: route.GUID, Destinations: []resources.RouteDestination{destination}})
+		}
+	}
+
+	if !pushPlan.KeepOldApp {
+		eventStream <- &PushEvent{Plan: pushPlan, Event: DeletingApplication}
+		warnings, err = actor.V7Actor.DeleteApplicationByNameAndSpace(liveApp.Name, pushPlan.SpaceGUID, false)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			allWarnings = append(allWarnings, actor.remapRoutes(liveApp.GUID, unmapped)...)
+			return pushPlan, allWarnings, err
+		}
+		eventStream <- &PushEvent{Plan: pushPlan, Event: DeletingApplicationComplete}
+
+		return pushPlan, allWarnings, nil
+	}
+
+	eventStream <- &PushEvent{Plan: pushPlan, Event: StoppingApplication}
+	warnings, err = actor.V7Actor.StopApplication(liveApp.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		allWarnings = append(allWarnings, actor.remapRoutes(liveApp.GUID, unmapped)...)
+		return pushPlan, allWarnings, err
+	}
+	eventStream <- &PushEvent{Plan: pushPlan, Event: StoppingApplicationComplete}
+
+	eventStream <- &PushEvent{Plan: pushPlan, Event: RenamingOldApplication}
+
+	// only the app kept by the previous blue-green push is replaced
+	warnings, err = actor.V7Actor.DeleteApplicationByNameAndSpace(OldAppName(liveApp.Name), pushPlan.SpaceGUID, false)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		if _, ok := err.(actionerror.ApplicationNotFoundError); !ok {
+			return pushPlan, allWarnings, err
+		}
+	}
+
+	_, warnings, err = actor.V7Actor.RenameApplicationByNameAndSpaceGUID(liveApp.Name, OldAppName(liveApp.Name), pushPlan.SpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+
+	return pushPlan, allWarnings, err
+}
+
+// remapRoutes maps the app back to the routes it was unmapped from, so that a
+// failed retirement leaves it serving traffic. Failures are logged rather than
+// returned, so that the error that stopped the retirement is reported.
+func (actor Actor) remapRoutes(appGUID string, routes []resources.Route) Warnings {
+	var allWarnings Warnings
+	for _, route := range routes {
+		for _, destination := range route.Destinations {
+			warnings, err := actor.V7Actor.MapRoute(route.GUID, appGUID, destination.Protocol)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				log.WithField("route_guid", route.GUID).Errorln("remapping route:", err)
+			}
+		}
+	}
+	return allWarnings
+}
@@ -0,0 +1,189 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RetireLiveApplication", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		paramPlan PushPlan
+
+		warnings   Warnings
+		executeErr error
+
+		events []Event
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+
+		paramPlan = PushPlan{
+			SpaceGUID:       "some-space-guid",
+			Application:     resources.Application{Name: "some-app-new", GUID: "new-guid"},
+			LiveApplication: resources.Application{Name: "some-app", GUID: "live-guid"},
+		}
+
+		fakeV7Actor.GetApplicationRoutesReturns(
+			[]resources.Route{
+				{
+					GUID: "route-guid",
+					Destinations: []resources.RouteDestination{
+						{GUID: "live-destination-guid", App: resources.RouteDestinationApp{GUID: "live-guid"}},
+						{GUID: "new-destination-guid", App: resources.RouteDestinationApp{GUID: "new-guid"}},
+					},
+				},
+			},
+			v7action.Warnings{"routes-warning"},
+			nil,
+		)
+		fakeV7Actor.UnmapRouteReturns(v7action.Warnings{"unmap-warning"}, nil)
+		fakeV7Actor.DeleteApplicationByNameAndSpaceReturns(v7action.Warnings{"delete-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		events = EventFollower(func(eventStream chan<- *PushEvent) {
+			_, warnings, executeErr = actor.RetireLiveApplication(paramPlan, eventStream, nil)
+		})
+	})
+
+	It("unmaps the routes of the live app and deletes it", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(warnings).To(ConsistOf("routes-warning", "unmap-warning", "delete-warning"))
+		Expect(events).To(Equal([]Event{UnmappingRoutes, DeletingApplication, DeletingApplicationComplete}))
+
+		Expect(fakeV7Actor.GetApplicationRoutesArgsForCall(0)).To(Equal("live-guid"))
+
+		Expect(fakeV7Actor.UnmapRouteCallCount()).To(Equal(1))
+		routeGUID, destinationGUID := fakeV7Actor.UnmapRouteArgsForCall(0)
+		Expect(routeGUID).To(Equal("route-guid"))
+		Expect(destinationGUID).To(Equal("live-destination-guid"))
+
+		Expect(fakeV7Actor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(1))
+		appName, spaceGUID, deleteRoutes := fakeV7Actor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(deleteRoutes).To(BeFalse())
+	})
+
+	When("unmapping a route fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.UnmapRouteReturns(v7action.Warnings{"unmap-warning"}, errors.New("unmap-error"))
+		})
+
+		It("returns the error without deleting the live app", func() {
+			Expect(executeErr).To(MatchError("unmap-error"))
+			Expect(warnings).To(ConsistOf("routes-warning", "unmap-warning"))
+			Expect(fakeV7Actor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("unmapping a route fails after another route was unmapped", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetApplicationRoutesReturns(
+				[]resources.Route{
+					{
+						GUID:         "route-guid",
+						Destinations: []resources.RouteDestination{{GUID: "live-destination-guid", App: resources.RouteDestinationApp{GUID: "live-guid"}, Protocol: "http2"}},
+					},
+					{
+						GUID:         "other-route-guid",
+						Destinations: []resources.RouteDestination{{GUID: "other-live-destination-guid", App: resources.RouteDestinationApp{GUID: "live-guid"}}},
+					},
+				},
+				v7action.Warnings{"routes-warning"},
+				nil,
+			)
+			fakeV7Actor.UnmapRouteReturnsOnCall(0, v7action.Warnings{"unmap-warning"}, nil)
+			fakeV7Actor.UnmapRouteReturnsOnCall(1, v7action.Warnings{"unmap-warning-2"}, errors.New("unmap-error"))
+			fakeV7Actor.MapRouteReturns(v7action.Warnings{"map-warning"}, nil)
+		})
+
+		It("maps the live app back to the unmapped route and returns the error", func() {
+			Expect(executeErr).To(MatchError("unmap-error"))
+			Expect(warnings).To(ConsistOf("routes-warning", "unmap-warning", "unmap-warning-2", "map-warning"))
+			Expect(fakeV7Actor.DeleteApplicationByNameAndSpaceCallCount()).To(Equal(0))
+
+			Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(1))
+			routeGUID, appGUID, protocol := fakeV7Actor.MapRouteArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(appGUID).To(Equal("live-guid"))
+			Expect(protocol).To(Equal("http2"))
+		})
+
+		When("mapping the route back fails", func() {
+			BeforeEach(func() {
+				fakeV7Actor.MapRouteReturns(v7action.Warnings{"map-warning"}, errors.New("map-error"))
+			})
+
+			It("returns the unmap error", func() {
+				Expect(executeErr).To(MatchError("unmap-error"))
+				Expect(warnings).To(ContainElement("map-warning"))
+			})
+		})
+	})
+
+	When("deleting the live app fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.DeleteApplicationByNameAndSpaceReturns(v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+			fakeV7Actor.MapRouteReturns(v7action.Warnings{"map-warning"}, nil)
+		})
+
+		It("maps the live app back to its routes and returns the error", func() {
+			Expect(executeErr).To(MatchError("delete-error"))
+			Expect(warnings).To(ConsistOf("routes-warning", "unmap-warning", "delete-warning", "map-warning"))
+
+			Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(1))
+			routeGUID, appGUID, _ := fakeV7Actor.MapRouteArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(appGUID).To(Equal("live-guid"))
+		})
+	})
+
+	When("the old app is kept", func() {
+		BeforeEach(func() {
+			paramPlan.KeepOldApp = true
+			fakeV7Actor.StopApplicationReturns(v7action.Warnings{"stop-warning"}, nil)
+			fakeV7Actor.DeleteApplicationByNameAndSpaceReturns(v7action.Warnings{"delete-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app-old"})
+			fakeV7Actor.RenameApplicationByNameAndSpaceGUIDReturns(resources.Application{}, v7action.Warnings{"rename-warning"}, nil)
+		})
+
+		It("stops it and renames it", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("routes-warning", "unmap-warning", "stop-warning", "delete-warning", "rename-warning"))
+			Expect(events).To(Equal([]Event{UnmappingRoutes, StoppingApplication, StoppingApplicationComplete, RenamingOldApplication}))
+
+			Expect(fakeV7Actor.StopApplicationArgsForCall(0)).To(Equal("live-guid"))
+
+			appName, _, _ := fakeV7Actor.DeleteApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app-old"))
+
+			appName, newAppName, spaceGUID := fakeV7Actor.RenameApplicationByNameAndSpaceGUIDArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(newAppName).To(Equal("some-app-old"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+
+		When("deleting the previously kept app fails", func() {
+			BeforeEach(func() {
+				fakeV7Actor.DeleteApplicationByNameAndSpaceReturns(v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("returns the error without renaming", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(fakeV7Actor.RenameApplicationByNameAndSpaceGUIDCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,9 @@
+package v7pushaction
+
+func SetupBlueGreenForPushPlan(pushPlan PushPlan, overrides FlagOverrides) (PushPlan, error) {
+	pushPlan.BlueGreen = overrides.BlueGreen
+	pushPlan.BlueGreenTimeout = overrides.BlueGreenTimeout
+	pushPlan.KeepOldApp = overrides.KeepOldApp
+
+	return pushPlan, nil
+}
@@ -0,0 +1,51 @@
+package v7pushaction_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SetupBlueGreenForPushPlan", func() {
+	var (
+		pushPlan  PushPlan
+		overrides FlagOverrides
+
+		expectedPushPlan PushPlan
+		executeErr       error
+	)
+
+	BeforeEach(func() {
+		pushPlan = PushPlan{}
+		overrides = FlagOverrides{}
+	})
+
+	JustBeforeEach(func() {
+		expectedPushPlan, executeErr = SetupBlueGreenForPushPlan(pushPlan, overrides)
+	})
+
+	When("flag overrides specify a blue-green push", func() {
+		BeforeEach(func() {
+			overrides.BlueGreen = true
+			overrides.BlueGreenTimeout = time.Minute
+			overrides.KeepOldApp = true
+		})
+
+		It("sets the blue-green settings on the push plan", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(expectedPushPlan.BlueGreen).To(BeTrue())
+			Expect(expectedPushPlan.BlueGreenTimeout).To(Equal(time.Minute))
+			Expect(expectedPushPlan.KeepOldApp).To(BeTrue())
+		})
+	})
+
+	When("flag overrides do not specify a blue-green push", func() {
+		It("leaves the push plan as an in-place push", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(expectedPushPlan.BlueGreen).To(BeFalse())
+		})
+	})
+})
@@ -12,6 +12,8 @@ import (
 type SharedActor interface {
 	GatherArchiveResources(archivePath string) ([]sharedaction.Resource, error)
 	GatherDirectoryResources(sourceDir string) ([]sharedaction.Resource, error)
+	GatherIgnoredArchiveFiles(archivePath string) ([]sharedaction.IgnoredFile, error)
+	GatherIgnoredDirectoryFiles(sourceDir string) ([]sharedaction.IgnoredFile, error)
 	ReadArchive(archivePath string) (io.ReadCloser, int64, error)
 	ZipArchiveResources(sourceArchivePath string, filesToInclude []sharedaction.Resource) (string, error)
 	ZipDirectoryResources(sourceDir string, filesToInclude []sharedaction.Resource) (string, error)
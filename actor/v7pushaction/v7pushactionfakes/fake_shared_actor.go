@@ -36,6 +36,32 @@ type FakeSharedActor struct {
 		result1 []sharedaction.Resource
 		result2 error
 	}
+	GatherIgnoredArchiveFilesStub        func(string) ([]sharedaction.IgnoredFile, error)
+	gatherIgnoredArchiveFilesMutex       sync.RWMutex
+	gatherIgnoredArchiveFilesArgsForCall []struct {
+		arg1 string
+	}
+	gatherIgnoredArchiveFilesReturns struct {
+		result1 []sharedaction.IgnoredFile
+		result2 error
+	}
+	gatherIgnoredArchiveFilesReturnsOnCall map[int]struct {
+		result1 []sharedaction.IgnoredFile
+		result2 error
+	}
+	GatherIgnoredDirectoryFilesStub        func(string) ([]sharedaction.IgnoredFile, error)
+	gatherIgnoredDirectoryFilesMutex       sync.RWMutex
+	gatherIgnoredDirectoryFilesArgsForCall []struct {
+		arg1 string
+	}
+	gatherIgnoredDirectoryFilesReturns struct {
+		result1 []sharedaction.IgnoredFile
+		result2 error
+	}
+	gatherIgnoredDirectoryFilesReturnsOnCall map[int]struct {
+		result1 []sharedaction.IgnoredFile
+		result2 error
+	}
 	ReadArchiveStub        func(string) (io.ReadCloser, int64, error)
 	readArchiveMutex       sync.RWMutex
 	readArchiveArgsForCall []struct {
@@ -209,6 +235,132 @@ func (fake *FakeSharedActor) GatherDirectoryResourcesReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeSharedActor) GatherIgnoredArchiveFiles(arg1 string) ([]sharedaction.IgnoredFile, error) {
+	fake.gatherIgnoredArchiveFilesMutex.Lock()
+	ret, specificReturn := fake.gatherIgnoredArchiveFilesReturnsOnCall[len(fake.gatherIgnoredArchiveFilesArgsForCall)]
+	fake.gatherIgnoredArchiveFilesArgsForCall = append(fake.gatherIgnoredArchiveFilesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GatherIgnoredArchiveFiles", []interface{}{arg1})
+	fake.gatherIgnoredArchiveFilesMutex.Unlock()
+	if fake.GatherIgnoredArchiveFilesStub != nil {
+		return fake.GatherIgnoredArchiveFilesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.gatherIgnoredArchiveFilesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSharedActor) GatherIgnoredArchiveFilesCallCount() int {
+	fake.gatherIgnoredArchiveFilesMutex.RLock()
+	defer fake.gatherIgnoredArchiveFilesMutex.RUnlock()
+	return len(fake.gatherIgnoredArchiveFilesArgsForCall)
+}
+
+func (fake *FakeSharedActor) GatherIgnoredArchiveFilesCalls(stub func(string) ([]sharedaction.IgnoredFile, error)) {
+	fake.gatherIgnoredArchiveFilesMutex.Lock()
+	defer fake.gatherIgnoredArchiveFilesMutex.Unlock()
+	fake.GatherIgnoredArchiveFilesStub = stub
+}
+
+func (fake *FakeSharedActor) GatherIgnoredArchiveFilesArgsForCall(i int) string {
+	fake.gatherIgnoredArchiveFilesMutex.RLock()
+	defer fake.gatherIgnoredArchiveFilesMutex.RUnlock()
+	argsForCall := fake.gatherIgnoredArchiveFilesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSharedActor) GatherIgnoredArchiveFilesReturns(result1 []sharedaction.IgnoredFile, result2 error) {
+	fake.gatherIgnoredArchiveFilesMutex.Lock()
+	defer fake.gatherIgnoredArchiveFilesMutex.Unlock()
+	fake.GatherIgnoredArchiveFilesStub = nil
+	fake.gatherIgnoredArchiveFilesReturns = struct {
+		result1 []sharedaction.IgnoredFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSharedActor) GatherIgnoredArchiveFilesReturnsOnCall(i int, result1 []sharedaction.IgnoredFile, result2 error) {
+	fake.gatherIgnoredArchiveFilesMutex.Lock()
+	defer fake.gatherIgnoredArchiveFilesMutex.Unlock()
+	fake.GatherIgnoredArchiveFilesStub = nil
+	if fake.gatherIgnoredArchiveFilesReturnsOnCall == nil {
+		fake.gatherIgnoredArchiveFilesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.IgnoredFile
+			result2 error
+		})
+	}
+	fake.gatherIgnoredArchiveFilesReturnsOnCall[i] = struct {
+		result1 []sharedaction.IgnoredFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSharedActor) GatherIgnoredDirectoryFiles(arg1 string) ([]sharedaction.IgnoredFile, error) {
+	fake.gatherIgnoredDirectoryFilesMutex.Lock()
+	ret, specificReturn := fake.gatherIgnoredDirectoryFilesReturnsOnCall[len(fake.gatherIgnoredDirectoryFilesArgsForCall)]
+	fake.gatherIgnoredDirectoryFilesArgsForCall = append(fake.gatherIgnoredDirectoryFilesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GatherIgnoredDirectoryFiles", []interface{}{arg1})
+	fake.gatherIgnoredDirectoryFilesMutex.Unlock()
+	if fake.GatherIgnoredDirectoryFilesStub != nil {
+		return fake.GatherIgnoredDirectoryFilesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.gatherIgnoredDirectoryFilesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSharedActor) GatherIgnoredDirectoryFilesCallCount() int {
+	fake.gatherIgnoredDirectoryFilesMutex.RLock()
+	defer fake.gatherIgnoredDirectoryFilesMutex.RUnlock()
+	return len(fake.gatherIgnoredDirectoryFilesArgsForCall)
+}
+
+func (fake *FakeSharedActor) GatherIgnoredDirectoryFilesCalls(stub func(string) ([]sharedaction.IgnoredFile, error)) {
+	fake.gatherIgnoredDirectoryFilesMutex.Lock()
+	defer fake.gatherIgnoredDirectoryFilesMutex.Unlock()
+	fake.GatherIgnoredDirectoryFilesStub = stub
+}
+
+func (fake *FakeSharedActor) GatherIgnoredDirectoryFilesArgsForCall(i int) string {
+	fake.gatherIgnoredDirectoryFilesMutex.RLock()
+	defer fake.gatherIgnoredDirectoryFilesMutex.RUnlock()
+	argsForCall := fake.gatherIgnoredDirectoryFilesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSharedActor) GatherIgnoredDirectoryFilesReturns(result1 []sharedaction.IgnoredFile, result2 error) {
+	fake.gatherIgnoredDirectoryFilesMutex.Lock()
+	defer fake.gatherIgnoredDirectoryFilesMutex.Unlock()
+	fake.GatherIgnoredDirectoryFilesStub = nil
+	fake.gatherIgnoredDirectoryFilesReturns = struct {
+		result1 []sharedaction.IgnoredFile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSharedActor) GatherIgnoredDirectoryFilesReturnsOnCall(i int, result1 []sharedaction.IgnoredFile, result2 error) {
+	fake.gatherIgnoredDirectoryFilesMutex.Lock()
+	defer fake.gatherIgnoredDirectoryFilesMutex.Unlock()
+	fake.GatherIgnoredDirectoryFilesStub = nil
+	if fake.gatherIgnoredDirectoryFilesReturnsOnCall == nil {
+		fake.gatherIgnoredDirectoryFilesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.IgnoredFile
+			result2 error
+		})
+	}
+	fake.gatherIgnoredDirectoryFilesReturnsOnCall[i] = struct {
+		result1 []sharedaction.IgnoredFile
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSharedActor) ReadArchive(arg1 string) (io.ReadCloser, int64, error) {
 	fake.readArchiveMutex.Lock()
 	ret, specificReturn := fake.readArchiveReturnsOnCall[len(fake.readArchiveArgsForCall)]
@@ -420,6 +572,10 @@ func (fake *FakeSharedActor) Invocations() map[string][][]interface{} {
 	defer fake.gatherArchiveResourcesMutex.RUnlock()
 	fake.gatherDirectoryResourcesMutex.RLock()
 	defer fake.gatherDirectoryResourcesMutex.RUnlock()
+	fake.gatherIgnoredArchiveFilesMutex.RLock()
+	defer fake.gatherIgnoredArchiveFilesMutex.RUnlock()
+	fake.gatherIgnoredDirectoryFilesMutex.RLock()
+	defer fake.gatherIgnoredDirectoryFilesMutex.RUnlock()
 	fake.readArchiveMutex.RLock()
 	defer fake.readArchiveMutex.RUnlock()
 	fake.zipArchiveResourcesMutex.RLock()
@@ -4,6 +4,7 @@ package v7pushactionfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
@@ -12,6 +13,23 @@ import (
 )
 
 type FakeV7Actor struct {
+	CheckQuotaChangeStub        func(string, string, v7action.QuotaChange) (v7action.QuotaCheck, v7action.Warnings, error)
+	checkQuotaChangeMutex       sync.RWMutex
+	checkQuotaChangeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.QuotaChange
+	}
+	checkQuotaChangeReturns struct {
+		result1 v7action.QuotaCheck
+		result2 v7action.Warnings
+		result3 error
+	}
+	checkQuotaChangeReturnsOnCall map[int]struct {
+		result1 v7action.QuotaCheck
+		result2 v7action.Warnings
+		result3 error
+	}
 	CreateApplicationDropletStub        func(string) (resources.Droplet, v7action.Warnings, error)
 	createApplicationDropletMutex       sync.RWMutex
 	createApplicationDropletArgsForCall []struct {
@@ -109,6 +127,21 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	DeleteApplicationByNameAndSpaceStub        func(string, string, bool) (v7action.Warnings, error)
+	deleteApplicationByNameAndSpaceMutex       sync.RWMutex
+	deleteApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	deleteApplicationByNameAndSpaceReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, v7action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -141,6 +174,23 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationQuotaChangeStub        func(resources.Application, []resources.Process, bool) (v7action.QuotaChange, v7action.Warnings, error)
+	getApplicationQuotaChangeMutex       sync.RWMutex
+	getApplicationQuotaChangeArgsForCall []struct {
+		arg1 resources.Application
+		arg2 []resources.Process
+		arg3 bool
+	}
+	getApplicationQuotaChangeReturns struct {
+		result1 v7action.QuotaChange
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationQuotaChangeReturnsOnCall map[int]struct {
+		result1 v7action.QuotaChange
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationRoutesStub        func(string) ([]resources.Route, v7action.Warnings, error)
 	getApplicationRoutesMutex       sync.RWMutex
 	getApplicationRoutesArgsForCall []struct {
@@ -202,6 +252,22 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRouteStub        func(string, string) (resources.Route, v7action.Warnings, error)
+	getRouteMutex       sync.RWMutex
+	getRouteArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getRouteReturns struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteReturnsOnCall map[int]struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteByAttributesStub        func(resources.Domain, string, string, int) (resources.Route, v7action.Warnings, error)
 	getRouteByAttributesMutex       sync.RWMutex
 	getRouteByAttributesArgsForCall []struct {
@@ -249,6 +315,20 @@ type FakeV7Actor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	MonitorApplicationHealthStub        func(resources.Application, time.Duration) (v7action.Warnings, error)
+	monitorApplicationHealthMutex       sync.RWMutex
+	monitorApplicationHealthArgsForCall []struct {
+		arg1 resources.Application
+		arg2 time.Duration
+	}
+	monitorApplicationHealthReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	monitorApplicationHealthReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	PollBuildStub        func(string, string) (resources.Droplet, v7action.Warnings, error)
 	pollBuildMutex       sync.RWMutex
 	pollBuildArgsForCall []struct {
@@ -311,6 +391,23 @@ type FakeV7Actor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	RenameApplicationByNameAndSpaceGUIDStub        func(string, string, string) (resources.Application, v7action.Warnings, error)
+	renameApplicationByNameAndSpaceGUIDMutex       sync.RWMutex
+	renameApplicationByNameAndSpaceGUIDArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	renameApplicationByNameAndSpaceGUIDReturns struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	renameApplicationByNameAndSpaceGUIDReturnsOnCall map[int]struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}
 	ResourceMatchStub        func([]sharedaction.V3Resource) ([]sharedaction.V3Resource, v7action.Warnings, error)
 	resourceMatchMutex       sync.RWMutex
 	resourceMatchArgsForCall []struct {
@@ -506,6 +603,74 @@ type FakeV7Actor struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeV7Actor) CheckQuotaChange(arg1 string, arg2 string, arg3 v7action.QuotaChange) (v7action.QuotaCheck, v7action.Warnings, error) {
+	fake.checkQuotaChangeMutex.Lock()
+	ret, specificReturn := fake.checkQuotaChangeReturnsOnCall[len(fake.checkQuotaChangeArgsForCall)]
+	fake.checkQuotaChangeArgsForCall = append(fake.checkQuotaChangeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.QuotaChange
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CheckQuotaChange", []interface{}{arg1, arg2, arg3})
+	fake.checkQuotaChangeMutex.Unlock()
+	if fake.CheckQuotaChangeStub != nil {
+		return fake.CheckQuotaChangeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.checkQuotaChangeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) CheckQuotaChangeCallCount() int {
+	fake.checkQuotaChangeMutex.RLock()
+	defer fake.checkQuotaChangeMutex.RUnlock()
+	return len(fake.checkQuotaChangeArgsForCall)
+}
+
+func (fake *FakeV7Actor) CheckQuotaChangeCalls(stub func(string, string, v7action.QuotaChange) (v7action.QuotaCheck, v7action.Warnings, error)) {
+	fake.checkQuotaChangeMutex.Lock()
+	defer fake.checkQuotaChangeMutex.Unlock()
+	fake.CheckQuotaChangeStub = stub
+}
+
+func (fake *FakeV7Actor) CheckQuotaChangeArgsForCall(i int) (string, string, v7action.QuotaChange) {
+	fake.checkQuotaChangeMutex.RLock()
+	defer fake.checkQuotaChangeMutex.RUnlock()
+	argsForCall := fake.checkQuotaChangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV7Actor) CheckQuotaChangeReturns(result1 v7action.QuotaCheck, result2 v7action.Warnings, result3 error) {
+	fake.checkQuotaChangeMutex.Lock()
+	defer fake.checkQuotaChangeMutex.Unlock()
+	fake.CheckQuotaChangeStub = nil
+	fake.checkQuotaChangeReturns = struct {
+		result1 v7action.QuotaCheck
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) CheckQuotaChangeReturnsOnCall(i int, result1 v7action.QuotaCheck, result2 v7action.Warnings, result3 error) {
+	fake.checkQuotaChangeMutex.Lock()
+	defer fake.checkQuotaChangeMutex.Unlock()
+	fake.CheckQuotaChangeStub = nil
+	if fake.checkQuotaChangeReturnsOnCall == nil {
+		fake.checkQuotaChangeReturnsOnCall = make(map[int]struct {
+			result1 v7action.QuotaCheck
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.checkQuotaChangeReturnsOnCall[i] = struct {
+		result1 v7action.QuotaCheck
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) CreateApplicationDroplet(arg1 string) (resources.Droplet, v7action.Warnings, error) {
 	fake.createApplicationDropletMutex.Lock()
 	ret, specificReturn := fake.createApplicationDropletReturnsOnCall[len(fake.createApplicationDropletArgsForCall)]
@@ -909,6 +1074,71 @@ func (fake *FakeV7Actor) CreateRouteReturnsOnCall(i int, result1 resources.Route
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) DeleteApplicationByNameAndSpace(arg1 string, arg2 string, arg3 bool) (v7action.Warnings, error) {
+	fake.deleteApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationByNameAndSpaceReturnsOnCall[len(fake.deleteApplicationByNameAndSpaceArgsForCall)]
+	fake.deleteApplicationByNameAndSpaceArgsForCall = append(fake.deleteApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
+	fake.deleteApplicationByNameAndSpaceMutex.Unlock()
+	if fake.DeleteApplicationByNameAndSpaceStub != nil {
+		return fake.DeleteApplicationByNameAndSpaceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV7Actor) DeleteApplicationByNameAndSpaceCallCount() int {
+	fake.deleteApplicationByNameAndSpaceMutex.RLock()
+	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.deleteApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV7Actor) DeleteApplicationByNameAndSpaceCalls(stub func(string, string, bool) (v7action.Warnings, error)) {
+	fake.deleteApplicationByNameAndSpaceMutex.Lock()
+	defer fake.deleteApplicationByNameAndSpaceMutex.Unlock()
+	fake.DeleteApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeV7Actor) DeleteApplicationByNameAndSpaceArgsForCall(i int) (string, string, bool) {
+	fake.deleteApplicationByNameAndSpaceMutex.RLock()
+	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.deleteApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV7Actor) DeleteApplicationByNameAndSpaceReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationByNameAndSpaceMutex.Lock()
+	defer fake.deleteApplicationByNameAndSpaceMutex.Unlock()
+	fake.DeleteApplicationByNameAndSpaceStub = nil
+	fake.deleteApplicationByNameAndSpaceReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7Actor) DeleteApplicationByNameAndSpaceReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteApplicationByNameAndSpaceMutex.Lock()
+	defer fake.deleteApplicationByNameAndSpaceMutex.Unlock()
+	fake.DeleteApplicationByNameAndSpaceStub = nil
+	if fake.deleteApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.deleteApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV7Actor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, v7action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -1043,6 +1273,79 @@ func (fake *FakeV7Actor) GetApplicationDropletsReturnsOnCall(i int, result1 []re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) GetApplicationQuotaChange(arg1 resources.Application, arg2 []resources.Process, arg3 bool) (v7action.QuotaChange, v7action.Warnings, error) {
+	var arg2Copy []resources.Process
+	if arg2 != nil {
+		arg2Copy = make([]resources.Process, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getApplicationQuotaChangeMutex.Lock()
+	ret, specificReturn := fake.getApplicationQuotaChangeReturnsOnCall[len(fake.getApplicationQuotaChangeArgsForCall)]
+	fake.getApplicationQuotaChangeArgsForCall = append(fake.getApplicationQuotaChangeArgsForCall, struct {
+		arg1 resources.Application
+		arg2 []resources.Process
+		arg3 bool
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("GetApplicationQuotaChange", []interface{}{arg1, arg2Copy, arg3})
+	fake.getApplicationQuotaChangeMutex.Unlock()
+	if fake.GetApplicationQuotaChangeStub != nil {
+		return fake.GetApplicationQuotaChangeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationQuotaChangeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetApplicationQuotaChangeCallCount() int {
+	fake.getApplicationQuotaChangeMutex.RLock()
+	defer fake.getApplicationQuotaChangeMutex.RUnlock()
+	return len(fake.getApplicationQuotaChangeArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetApplicationQuotaChangeCalls(stub func(resources.Application, []resources.Process, bool) (v7action.QuotaChange, v7action.Warnings, error)) {
+	fake.getApplicationQuotaChangeMutex.Lock()
+	defer fake.getApplicationQuotaChangeMutex.Unlock()
+	fake.GetApplicationQuotaChangeStub = stub
+}
+
+func (fake *FakeV7Actor) GetApplicationQuotaChangeArgsForCall(i int) (resources.Application, []resources.Process, bool) {
+	fake.getApplicationQuotaChangeMutex.RLock()
+	defer fake.getApplicationQuotaChangeMutex.RUnlock()
+	argsForCall := fake.getApplicationQuotaChangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV7Actor) GetApplicationQuotaChangeReturns(result1 v7action.QuotaChange, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationQuotaChangeMutex.Lock()
+	defer fake.getApplicationQuotaChangeMutex.Unlock()
+	fake.GetApplicationQuotaChangeStub = nil
+	fake.getApplicationQuotaChangeReturns = struct {
+		result1 v7action.QuotaChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetApplicationQuotaChangeReturnsOnCall(i int, result1 v7action.QuotaChange, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationQuotaChangeMutex.Lock()
+	defer fake.getApplicationQuotaChangeMutex.Unlock()
+	fake.GetApplicationQuotaChangeStub = nil
+	if fake.getApplicationQuotaChangeReturnsOnCall == nil {
+		fake.getApplicationQuotaChangeReturnsOnCall = make(map[int]struct {
+			result1 v7action.QuotaChange
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationQuotaChangeReturnsOnCall[i] = struct {
+		result1 v7action.QuotaChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) GetApplicationRoutes(arg1 string) ([]resources.Route, v7action.Warnings, error) {
 	fake.getApplicationRoutesMutex.Lock()
 	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
@@ -1313,6 +1616,73 @@ func (fake *FakeV7Actor) GetDomainReturnsOnCall(i int, result1 resources.Domain,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) GetRoute(arg1 string, arg2 string) (resources.Route, v7action.Warnings, error) {
+	fake.getRouteMutex.Lock()
+	ret, specificReturn := fake.getRouteReturnsOnCall[len(fake.getRouteArgsForCall)]
+	fake.getRouteArgsForCall = append(fake.getRouteArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetRoute", []interface{}{arg1, arg2})
+	fake.getRouteMutex.Unlock()
+	if fake.GetRouteStub != nil {
+		return fake.GetRouteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetRouteCallCount() int {
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	return len(fake.getRouteArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetRouteCalls(stub func(string, string) (resources.Route, v7action.Warnings, error)) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = stub
+}
+
+func (fake *FakeV7Actor) GetRouteArgsForCall(i int) (string, string) {
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	argsForCall := fake.getRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) GetRouteReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = nil
+	fake.getRouteReturns = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetRouteReturnsOnCall(i int, result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = nil
+	if fake.getRouteReturnsOnCall == nil {
+		fake.getRouteReturnsOnCall = make(map[int]struct {
+			result1 resources.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteReturnsOnCall[i] = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) GetRouteByAttributes(arg1 resources.Domain, arg2 string, arg3 string, arg4 int) (resources.Route, v7action.Warnings, error) {
 	fake.getRouteByAttributesMutex.Lock()
 	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
@@ -1511,6 +1881,70 @@ func (fake *FakeV7Actor) MapRouteReturnsOnCall(i int, result1 v7action.Warnings,
 	}{result1, result2}
 }
 
+func (fake *FakeV7Actor) MonitorApplicationHealth(arg1 resources.Application, arg2 time.Duration) (v7action.Warnings, error) {
+	fake.monitorApplicationHealthMutex.Lock()
+	ret, specificReturn := fake.monitorApplicationHealthReturnsOnCall[len(fake.monitorApplicationHealthArgsForCall)]
+	fake.monitorApplicationHealthArgsForCall = append(fake.monitorApplicationHealthArgsForCall, struct {
+		arg1 resources.Application
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("MonitorApplicationHealth", []interface{}{arg1, arg2})
+	fake.monitorApplicationHealthMutex.Unlock()
+	if fake.MonitorApplicationHealthStub != nil {
+		return fake.MonitorApplicationHealthStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.monitorApplicationHealthReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV7Actor) MonitorApplicationHealthCallCount() int {
+	fake.monitorApplicationHealthMutex.RLock()
+	defer fake.monitorApplicationHealthMutex.RUnlock()
+	return len(fake.monitorApplicationHealthArgsForCall)
+}
+
+func (fake *FakeV7Actor) MonitorApplicationHealthCalls(stub func(resources.Application, time.Duration) (v7action.Warnings, error)) {
+	fake.monitorApplicationHealthMutex.Lock()
+	defer fake.monitorApplicationHealthMutex.Unlock()
+	fake.MonitorApplicationHealthStub = stub
+}
+
+func (fake *FakeV7Actor) MonitorApplicationHealthArgsForCall(i int) (resources.Application, time.Duration) {
+	fake.monitorApplicationHealthMutex.RLock()
+	defer fake.monitorApplicationHealthMutex.RUnlock()
+	argsForCall := fake.monitorApplicationHealthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) MonitorApplicationHealthReturns(result1 v7action.Warnings, result2 error) {
+	fake.monitorApplicationHealthMutex.Lock()
+	defer fake.monitorApplicationHealthMutex.Unlock()
+	fake.MonitorApplicationHealthStub = nil
+	fake.monitorApplicationHealthReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7Actor) MonitorApplicationHealthReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.monitorApplicationHealthMutex.Lock()
+	defer fake.monitorApplicationHealthMutex.Unlock()
+	fake.MonitorApplicationHealthStub = nil
+	if fake.monitorApplicationHealthReturnsOnCall == nil {
+		fake.monitorApplicationHealthReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.monitorApplicationHealthReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV7Actor) PollBuild(arg1 string, arg2 string) (resources.Droplet, v7action.Warnings, error) {
 	fake.pollBuildMutex.Lock()
 	ret, specificReturn := fake.pollBuildReturnsOnCall[len(fake.pollBuildArgsForCall)]
@@ -1775,6 +2209,74 @@ func (fake *FakeV7Actor) PollStartForRollingReturnsOnCall(i int, result1 v7actio
 	}{result1, result2}
 }
 
+func (fake *FakeV7Actor) RenameApplicationByNameAndSpaceGUID(arg1 string, arg2 string, arg3 string) (resources.Application, v7action.Warnings, error) {
+	fake.renameApplicationByNameAndSpaceGUIDMutex.Lock()
+	ret, specificReturn := fake.renameApplicationByNameAndSpaceGUIDReturnsOnCall[len(fake.renameApplicationByNameAndSpaceGUIDArgsForCall)]
+	fake.renameApplicationByNameAndSpaceGUIDArgsForCall = append(fake.renameApplicationByNameAndSpaceGUIDArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("RenameApplicationByNameAndSpaceGUID", []interface{}{arg1, arg2, arg3})
+	fake.renameApplicationByNameAndSpaceGUIDMutex.Unlock()
+	if fake.RenameApplicationByNameAndSpaceGUIDStub != nil {
+		return fake.RenameApplicationByNameAndSpaceGUIDStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.renameApplicationByNameAndSpaceGUIDReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) RenameApplicationByNameAndSpaceGUIDCallCount() int {
+	fake.renameApplicationByNameAndSpaceGUIDMutex.RLock()
+	defer fake.renameApplicationByNameAndSpaceGUIDMutex.RUnlock()
+	return len(fake.renameApplicationByNameAndSpaceGUIDArgsForCall)
+}
+
+func (fake *FakeV7Actor) RenameApplicationByNameAndSpaceGUIDCalls(stub func(string, string, string) (resources.Application, v7action.Warnings, error)) {
+	fake.renameApplicationByNameAndSpaceGUIDMutex.Lock()
+	defer fake.renameApplicationByNameAndSpaceGUIDMutex.Unlock()
+	fake.RenameApplicationByNameAndSpaceGUIDStub = stub
+}
+
+func (fake *FakeV7Actor) RenameApplicationByNameAndSpaceGUIDArgsForCall(i int) (string, string, string) {
+	fake.renameApplicationByNameAndSpaceGUIDMutex.RLock()
+	defer fake.renameApplicationByNameAndSpaceGUIDMutex.RUnlock()
+	argsForCall := fake.renameApplicationByNameAndSpaceGUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeV7Actor) RenameApplicationByNameAndSpaceGUIDReturns(result1 resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.renameApplicationByNameAndSpaceGUIDMutex.Lock()
+	defer fake.renameApplicationByNameAndSpaceGUIDMutex.Unlock()
+	fake.RenameApplicationByNameAndSpaceGUIDStub = nil
+	fake.renameApplicationByNameAndSpaceGUIDReturns = struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) RenameApplicationByNameAndSpaceGUIDReturnsOnCall(i int, result1 resources.Application, result2 v7action.Warnings, result3 error) {
+	fake.renameApplicationByNameAndSpaceGUIDMutex.Lock()
+	defer fake.renameApplicationByNameAndSpaceGUIDMutex.Unlock()
+	fake.RenameApplicationByNameAndSpaceGUIDStub = nil
+	if fake.renameApplicationByNameAndSpaceGUIDReturnsOnCall == nil {
+		fake.renameApplicationByNameAndSpaceGUIDReturnsOnCall = make(map[int]struct {
+			result1 resources.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.renameApplicationByNameAndSpaceGUIDReturnsOnCall[i] = struct {
+		result1 resources.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) ResourceMatch(arg1 []sharedaction.V3Resource) ([]sharedaction.V3Resource, v7action.Warnings, error) {
 	var arg1Copy []sharedaction.V3Resource
 	if arg1 != nil {
@@ -2643,6 +3145,8 @@ func (fake *FakeV7Actor) UploadDropletReturnsOnCall(i int, result1 v7action.Warn
 func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.checkQuotaChangeMutex.RLock()
+	defer fake.checkQuotaChangeMutex.RUnlock()
 	fake.createApplicationDropletMutex.RLock()
 	defer fake.createApplicationDropletMutex.RUnlock()
 	fake.createApplicationInSpaceMutex.RLock()
@@ -2655,10 +3159,14 @@ func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	defer fake.createDockerPackageByApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.deleteApplicationByNameAndSpaceMutex.RLock()
+	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationDropletsMutex.RLock()
 	defer fake.getApplicationDropletsMutex.RUnlock()
+	fake.getApplicationQuotaChangeMutex.RLock()
+	defer fake.getApplicationQuotaChangeMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
@@ -2667,12 +3175,16 @@ func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	defer fake.getDefaultDomainMutex.RUnlock()
 	fake.getDomainMutex.RLock()
 	defer fake.getDomainMutex.RUnlock()
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
 	fake.getRouteByAttributesMutex.RLock()
 	defer fake.getRouteByAttributesMutex.RUnlock()
 	fake.getRouteDestinationByAppGUIDMutex.RLock()
 	defer fake.getRouteDestinationByAppGUIDMutex.RUnlock()
 	fake.mapRouteMutex.RLock()
 	defer fake.mapRouteMutex.RUnlock()
+	fake.monitorApplicationHealthMutex.RLock()
+	defer fake.monitorApplicationHealthMutex.RUnlock()
 	fake.pollBuildMutex.RLock()
 	defer fake.pollBuildMutex.RUnlock()
 	fake.pollPackageMutex.RLock()
@@ -2681,6 +3193,8 @@ func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	defer fake.pollStartMutex.RUnlock()
 	fake.pollStartForRollingMutex.RLock()
 	defer fake.pollStartForRollingMutex.RUnlock()
+	fake.renameApplicationByNameAndSpaceGUIDMutex.RLock()
+	defer fake.renameApplicationByNameAndSpaceGUIDMutex.RUnlock()
 	fake.resourceMatchMutex.RLock()
 	defer fake.resourceMatchMutex.RUnlock()
 	fake.restartApplicationMutex.RLock()
@@ -0,0 +1,39 @@
+package ccv3
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+)
+
+var contentRangeSizeRegexp = regexp.MustCompile(`^bytes \d+-\d+/(\d+)$`)
+
+// getBitsSize requests the first byte of the bits at the given download path.
+// The Cloud Controller redirects the request to the blobstore, which reports
+// the full size in the Content-Range header. A GET is used because the
+// presigned URLs of S3 and GCS blobstores are only signed for GET. Blobstores
+// that ignore the Range header send all of the bits instead, whose size is
+// then taken from the Content-Length header, or counted if it is missing.
+func (client *Client) getBitsSize(downloadPath string) (uint64, error) {
+	body, response, err := client.MakeRequestSendReceiveRaw(
+		http.MethodGet,
+		client.CloudControllerURL+downloadPath,
+		http.Header{"Range": {"bytes=0-0"}},
+		nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if response != nil && response.StatusCode == http.StatusPartialContent {
+		if matches := contentRangeSizeRegexp.FindStringSubmatch(response.Header.Get("Content-Range")); matches != nil {
+			return strconv.ParseUint(matches[1], 10, 64)
+		}
+	}
+
+	if response != nil && response.ContentLength >= 0 {
+		return uint64(response.ContentLength), nil
+	}
+
+	return uint64(len(body)), nil
+}
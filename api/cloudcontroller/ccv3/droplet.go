@@ -33,6 +33,16 @@ func (client *Client) CreateDroplet(appGUID string) (resources.Droplet, Warnings
 	return responseBody, warnings, err
 }
 
+// DeleteDroplet deletes the droplet with the given GUID and its bits.
+func (client *Client) DeleteDroplet(dropletGUID string) (JobURL, Warnings, error) {
+	jobURL, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.DeleteDropletRequest,
+		URIParams:   internal.Params{"droplet_guid": dropletGUID},
+	})
+
+	return jobURL, warnings, err
+}
+
 // GetApplicationDropletCurrent returns the current droplet for a given
 // application.
 func (client *Client) GetApplicationDropletCurrent(appGUID string) (resources.Droplet, Warnings, error) {
@@ -60,6 +70,12 @@ func (client *Client) GetDroplet(dropletGUID string) (resources.Droplet, Warning
 	return responseBody, warnings, err
 }
 
+// GetDropletBitsSize returns the size in bytes of the droplet's bits in the
+// blobstore, without downloading them.
+func (client *Client) GetDropletBitsSize(dropletGUID string) (uint64, error) {
+	return client.getBitsSize("/v3/droplets/" + dropletGUID + "/download")
+}
+
 // GetDroplets lists droplets with optional filters.
 func (client *Client) GetDroplets(query ...Query) ([]resources.Droplet, Warnings, error) {
 	var droplets []resources.Droplet
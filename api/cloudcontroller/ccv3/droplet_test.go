@@ -71,6 +71,35 @@ var _ = Describe("Droplet", func() {
 		})
 	})
 
+	Describe("DeleteDroplet", func() {
+		var (
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.DeleteDroplet("some-droplet-guid")
+		})
+
+		BeforeEach(func() {
+			requester.MakeRequestReturns("some-job-url", Warnings{"some-warning"}, errors.New("some-error"))
+		})
+
+		It("makes the correct request", func() {
+			Expect(requester.MakeRequestCallCount()).To(Equal(1))
+			actualParams := requester.MakeRequestArgsForCall(0)
+			Expect(actualParams.RequestName).To(Equal(internal.DeleteDropletRequest))
+			Expect(actualParams.URIParams).To(Equal(internal.Params{"droplet_guid": "some-droplet-guid"}))
+		})
+
+		It("returns the job URL and all warnings", func() {
+			Expect(jobURL).To(Equal(JobURL("some-job-url")))
+			Expect(warnings).To(ConsistOf("some-warning"))
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+
 	Describe("GetApplicationDropletCurrent", func() {
 		var (
 			droplet    resources.Droplet
@@ -453,4 +482,80 @@ var _ = Describe("Droplet", func() {
 			Expect(executeErr).To(MatchError("some-error"))
 		})
 	})
+
+	Describe("GetDropletBitsSize", func() {
+		var (
+			size       uint64
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			client.CloudControllerURL = "https://api.example.com"
+		})
+
+		JustBeforeEach(func() {
+			size, executeErr = client.GetDropletBitsSize("some-droplet-guid")
+		})
+
+		It("requests the first byte of the droplet bits", func() {
+			Expect(requester.MakeRequestSendReceiveRawCallCount()).To(Equal(1))
+			method, url, headers, body := requester.MakeRequestSendReceiveRawArgsForCall(0)
+			Expect(method).To(Equal(http.MethodGet))
+			Expect(url).To(Equal("https://api.example.com/v3/droplets/some-droplet-guid/download"))
+			Expect(headers).To(Equal(http.Header{"Range": {"bytes=0-0"}}))
+			Expect(body).To(BeEmpty())
+		})
+
+		When("the blobstore returns a partial response", func() {
+			BeforeEach(func() {
+				requester.MakeRequestSendReceiveRawReturns([]byte{'d'}, &http.Response{
+					StatusCode: http.StatusPartialContent,
+					Header:     http.Header{"Content-Range": {"bytes 0-0/123456"}},
+				}, nil)
+			})
+
+			It("returns the size from the Content-Range header", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(size).To(BeEquivalentTo(123456))
+			})
+		})
+
+		When("the blobstore ignores the Range header", func() {
+			BeforeEach(func() {
+				requester.MakeRequestSendReceiveRawReturns([]byte("droplet"), &http.Response{
+					StatusCode:    http.StatusOK,
+					ContentLength: 7,
+				}, nil)
+			})
+
+			It("returns the size from the Content-Length header", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(size).To(BeEquivalentTo(7))
+			})
+
+			When("it does not report the Content-Length", func() {
+				BeforeEach(func() {
+					requester.MakeRequestSendReceiveRawReturns([]byte("droplet"), &http.Response{
+						StatusCode:    http.StatusOK,
+						ContentLength: -1,
+					}, nil)
+				})
+
+				It("returns the length of the bits", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(size).To(BeEquivalentTo(7))
+				})
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				requester.MakeRequestSendReceiveRawReturns(nil, nil, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+			})
+		})
+	})
 })
@@ -13,11 +13,13 @@ const (
 	DeleteApplicationRequest                                    = "DeleteApplication"
 	DeleteBuildpackRequest                                      = "DeleteBuildpack"
 	DeleteDomainRequest                                         = "DeleteDomainRequest"
+	DeleteDropletRequest                                        = "DeleteDroplet"
 	DeleteIsolationSegmentRelationshipOrganizationRequest       = "DeleteIsolationSegmentRelationshipOrganization"
 	DeleteIsolationSegmentRequest                               = "DeleteIsolationSegment"
 	DeleteOrganizationRequest                                   = "DeleteOrganization"
 	DeleteOrganizationQuotaRequest                              = "DeleteOrganizationQuota"
 	DeleteOrphanedRoutesRequest                                 = "DeleteOrphanedRoutes"
+	DeletePackageRequest                                        = "DeletePackage"
 	DeleteRoleRequest                                           = "DeleteRoleRequest"
 	DeleteRouteRequest                                          = "DeleteRouteRequest"
 	DeleteRouteBindingRequest                                   = "DeleteRouteBinding"
@@ -70,6 +72,7 @@ const (
 	GetOrganizationRelationshipDefaultIsolationSegmentRequest   = "GetOrganizationRelationshipDefaultIsolationSegment"
 	GetOrganizationRequest                                      = "GetOrganization"
 	GetOrganizationsRequest                                     = "GetOrganizations"
+	GetOrganizationUsageSummaryRequest                          = "GetOrganizationUsageSummary"
 	GetPackageRequest                                           = "GetPackage"
 	GetPackagesRequest                                          = "GetPackages"
 	GetPackageDropletsRequest                                   = "GetPackageDroplets"
@@ -77,9 +80,11 @@ const (
 	GetProcessesRequest                                         = "GetProcesses"
 	GetProcessStatsRequest                                      = "GetProcessStats"
 	GetProcessSidecarsRequest                                   = "GetProcessSidecars"
+	GetRevisionEnvironmentVariablesRequest                      = "GetRevisionEnvironmentVariables"
 	GetRolesRequest                                             = "GetRoles"
 	GetRouteBindingsRequest                                     = "GetRouteBindings"
 	GetRouteDestinationsRequest                                 = "GetRouteDestinations"
+	GetRouteSharedSpacesRequest                                 = "GetRouteSharedSpaces"
 	GetRoutesRequest                                            = "GetRoutes"
 	GetSecurityGroupsRequest                                    = "GetSecurityGroups"
 	GetServiceBrokersRequest                                    = "GetServiceBrokers"
@@ -98,6 +103,7 @@ const (
 	GetSpaceRelationshipIsolationSegmentRequest                 = "GetSpaceRelationshipIsolationSegment"
 	GetSpaceRunningSecurityGroupsRequest                        = "GetSpaceRunningSecurityGroups"
 	GetSpacesRequest                                            = "GetSpaces"
+	GetSpaceUsageSummaryRequest                                 = "GetSpaceUsageSummary"
 	GetSpaceQuotaRequest                                        = "GetSpaceQuota"
 	GetSpaceQuotasRequest                                       = "GetSpaceQuotas"
 	GetSpaceStagingSecurityGroupsRequest                        = "GetSpaceStagingSecurityGroups"
@@ -120,6 +126,7 @@ const (
 	PatchOrganizationRequest                                    = "PatchOrganization"
 	PatchOrganizationQuotaRequest                               = "PatchOrganizationQuota"
 	PatchProcessRequest                                         = "PatchProcess"
+	PatchRouteDestinationsRequest                               = "PatchRouteDestinations"
 	PatchRouteRequest                                           = "PatchRoute"
 	PatchSecurityGroupRequest                                   = "PatchSecurityGroup"
 	PatchServiceBrokerRequest                                   = "PatchServiceBrokerRequest"
@@ -229,6 +236,7 @@ var APIRoutes = map[string]Route{
 	GetDropletsRequest:                                          {Path: "/v3/droplets", Method: http.MethodGet},
 	PostDropletRequest:                                          {Path: "/v3/droplets", Method: http.MethodPost},
 	GetDropletRequest:                                           {Path: "/v3/droplets/:droplet_guid", Method: http.MethodGet},
+	DeleteDropletRequest:                                        {Path: "/v3/droplets/:droplet_guid", Method: http.MethodDelete},
 	PostDropletBitsRequest:                                      {Path: "/v3/droplets/:droplet_guid/upload", Method: http.MethodPost},
 	GetDropletBitsRequest:                                       {Path: "/v3/droplets/:droplet_guid/download", Method: http.MethodGet},
 	GetEnvironmentVariableGroupRequest:                          {Path: "/v3/environment_variable_groups/:group_name", Method: http.MethodGet},
@@ -254,6 +262,7 @@ var APIRoutes = map[string]Route{
 	GetOrganizationRelationshipDefaultIsolationSegmentRequest:   {Path: "/v3/organizations/:organization_guid/relationships/default_isolation_segment", Method: http.MethodGet},
 	PatchOrganizationRelationshipDefaultIsolationSegmentRequest: {Path: "/v3/organizations/:organization_guid/relationships/default_isolation_segment", Method: http.MethodPatch},
 	PatchOrganizationQuotaRequest:                               {Path: "/v3/organization_quotas/:quota_guid", Method: http.MethodPatch},
+	GetOrganizationUsageSummaryRequest:                          {Path: "/v3/organizations/:organization_guid/usage_summary", Method: http.MethodGet},
 	PostOrganizationQuotaRequest:                                {Path: "/v3/organization_quotas", Method: http.MethodPost},
 	PostOrganizationQuotaApplyRequest:                           {Path: "/v3/organization_quotas/:quota_guid/relationships/organizations", Method: http.MethodPost},
 	GetOrganizationQuotaRequest:                                 {Path: "/v3/organization_quotas/:quota_guid", Method: http.MethodGet},
@@ -262,6 +271,7 @@ var APIRoutes = map[string]Route{
 	GetPackagesRequest:                                          {Path: "/v3/packages", Method: http.MethodGet},
 	PostPackageRequest:                                          {Path: "/v3/packages", Method: http.MethodPost},
 	GetPackageRequest:                                           {Path: "/v3/packages/:package_guid", Method: http.MethodGet},
+	DeletePackageRequest:                                        {Path: "/v3/packages/:package_guid", Method: http.MethodDelete},
 	PostPackageBitsRequest:                                      {Path: "/v3/packages/:package_guid/upload", Method: http.MethodPost},
 	GetPackageDropletsRequest:                                   {Path: "/v3/packages/:package_guid/droplets", Method: http.MethodGet},
 	GetProcessRequest:                                           {Path: "/v3/processes/:process_guid", Method: http.MethodGet},
@@ -270,6 +280,7 @@ var APIRoutes = map[string]Route{
 	GetProcessStatsRequest:                                      {Path: "/v3/processes/:process_guid/stats", Method: http.MethodGet},
 	GetProcessSidecarsRequest:                                   {Path: "/v3/processes/:process_guid/sidecars", Method: http.MethodGet},
 	PostResourceMatchesRequest:                                  {Path: "/v3/resource_matches", Method: http.MethodPost},
+	GetRevisionEnvironmentVariablesRequest:                      {Path: "/v3/revisions/:revision_guid/environment_variables", Method: http.MethodGet},
 	GetRolesRequest:                                             {Path: "/v3/roles", Method: http.MethodGet},
 	PostRoleRequest:                                             {Path: "/v3/roles", Method: http.MethodPost},
 	DeleteRoleRequest:                                           {Path: "/v3/roles/:role_guid", Method: http.MethodDelete},
@@ -279,8 +290,10 @@ var APIRoutes = map[string]Route{
 	PatchRouteRequest:                                           {Path: "/v3/routes/:route_guid", Method: http.MethodPatch},
 	GetRouteDestinationsRequest:                                 {Path: "/v3/routes/:route_guid/destinations", Method: http.MethodGet},
 	MapRouteRequest:                                             {Path: "/v3/routes/:route_guid/destinations", Method: http.MethodPost},
+	PatchRouteDestinationsRequest:                               {Path: "/v3/routes/:route_guid/destinations", Method: http.MethodPatch},
 	UnmapRouteRequest:                                           {Path: "/v3/routes/:route_guid/destinations/:destination_guid", Method: http.MethodDelete},
 	PatchDestinationRequest:                                     {Path: "/v3/routes/:route_guid/destinations/:destination_guid", Method: http.MethodPatch},
+	GetRouteSharedSpacesRequest:                                 {Path: "/v3/routes/:route_guid/relationships/shared_spaces", Method: http.MethodGet},
 	ShareRouteRequest:                                           {Path: "/v3/routes/:route_guid/relationships/shared_spaces", Method: http.MethodPost},
 	UnshareRouteRequest:                                         {Path: "/v3/routes/:route_guid/relationships/shared_spaces/:space_guid", Method: http.MethodDelete},
 	PatchMoveRouteRequest:                                       {Path: "/v3/routes/:route_guid/relationships/space", Method: http.MethodPatch},
@@ -335,6 +348,7 @@ var APIRoutes = map[string]Route{
 	GetSpaceStagingSecurityGroupsRequest:                        {Path: "/v3/spaces/:space_guid/staging_security_groups", Method: http.MethodGet},
 	PatchSpaceFeaturesRequest:                                   {Path: "/v3/spaces/:space_guid/features/:feature", Method: http.MethodPatch},
 	GetSpaceFeatureRequest:                                      {Path: "/v3/spaces/:space_guid/features/:feature", Method: http.MethodGet},
+	GetSpaceUsageSummaryRequest:                                 {Path: "/v3/spaces/:space_guid/usage_summary", Method: http.MethodGet},
 	PostSpaceQuotaRequest:                                       {Path: "/v3/space_quotas", Method: http.MethodPost},
 	GetSpaceQuotaRequest:                                        {Path: "/v3/space_quotas/:quota_guid", Method: http.MethodGet},
 	DeleteSpaceQuotaRequest:                                     {Path: "/v3/space_quotas/:quota_guid", Method: http.MethodDelete},
@@ -72,6 +72,22 @@ func (client *Client) GetOrganization(orgGUID string) (resources.Organization, W
 	return responseBody, warnings, err
 }
 
+// GetOrganizationUsageSummary gets the usage of the quota-limited resources
+// of the organization.
+func (client *Client) GetOrganizationUsageSummary(orgGUID string) (resources.UsageSummary, Warnings, error) {
+	var responseBody struct {
+		UsageSummary resources.UsageSummary `json:"usage_summary"`
+	}
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.GetOrganizationUsageSummaryRequest,
+		URIParams:    internal.Params{"organization_guid": orgGUID},
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody.UsageSummary, warnings, err
+}
+
 // GetOrganizations lists organizations with optional filters.
 func (client *Client) GetOrganizations(query ...Query) ([]resources.Organization, Warnings, error) {
 	var organizations []resources.Organization
@@ -31,6 +31,16 @@ func (client *Client) CreatePackage(pkg resources.Package) (resources.Package, W
 	return responseBody, warnings, err
 }
 
+// DeletePackage deletes the package with the given GUID and its bits.
+func (client *Client) DeletePackage(packageGUID string) (JobURL, Warnings, error) {
+	jobURL, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.DeletePackageRequest,
+		URIParams:   internal.Params{"package_guid": packageGUID},
+	})
+
+	return jobURL, warnings, err
+}
+
 // GetPackage returns the package with the given GUID.
 func (client *Client) GetPackage(packageGUID string) (resources.Package, Warnings, error) {
 	var responseBody resources.Package
@@ -44,6 +54,12 @@ func (client *Client) GetPackage(packageGUID string) (resources.Package, Warning
 	return responseBody, warnings, err
 }
 
+// GetPackageBitsSize returns the size in bytes of the package's bits in the
+// blobstore, without downloading them.
+func (client *Client) GetPackageBitsSize(packageGUID string) (uint64, error) {
+	return client.getBitsSize("/v3/packages/" + packageGUID + "/download")
+}
+
 // GetPackages returns the list of packages.
 func (client *Client) GetPackages(query ...Query) ([]resources.Package, Warnings, error) {
 	var packages []resources.Package
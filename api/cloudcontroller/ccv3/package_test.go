@@ -220,6 +220,63 @@ var _ = Describe("Package", func() {
 		})
 	})
 
+	Describe("DeletePackage", func() {
+		var (
+			jobURL     JobURL
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			jobURL, warnings, executeErr = client.DeletePackage("some-pkg-guid")
+		})
+
+		When("the package exists", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-pkg-guid"),
+						RespondWith(http.StatusAccepted, nil, http.Header{
+							"X-Cf-Warnings": {"this is a warning"},
+							"Location":      {"some-job-url"},
+						}),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(jobURL).To(Equal(JobURL("some-job-url")))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		When("the package does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Package not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/packages/some-pkg-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Package not found"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
 	Describe("GetPackage", func() {
 		var (
 			pkg        resources.Package
@@ -311,6 +368,87 @@ var _ = Describe("Package", func() {
 		})
 	})
 
+	Describe("GetPackageBitsSize", func() {
+		var (
+			size       uint64
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			size, executeErr = client.GetPackageBitsSize("some-pkg-guid")
+		})
+
+		When("the blobstore honors the Range header", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid/download"),
+						VerifyHeaderKV("Range", "bytes=0-0"),
+						RespondWith(http.StatusPartialContent, "p", http.Header{"Content-Range": {"bytes 0-0/4096"}}),
+					),
+				)
+			})
+
+			It("returns the size from the Content-Range header", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(size).To(BeEquivalentTo(4096))
+			})
+		})
+
+		When("the Cloud Controller redirects to a blobstore URL that is only signed for GET", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid/download"),
+						RespondWith(http.StatusFound, nil, http.Header{"Location": {server.URL() + "/blobstore/some-pkg-guid?signature=some-signature"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/blobstore/some-pkg-guid", "signature=some-signature"),
+						VerifyHeaderKV("Range", "bytes=0-0"),
+						RespondWith(http.StatusPartialContent, "p", http.Header{"Content-Range": {"bytes 0-0/4096"}}),
+					),
+				)
+			})
+
+			It("follows the redirect and returns the size from the Content-Range header", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(size).To(BeEquivalentTo(4096))
+				Expect(server.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		When("the blobstore ignores the Range header", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid/download"),
+						RespondWith(http.StatusOK, "package bits"),
+					),
+				)
+			})
+
+			It("returns the length of the bits", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(size).To(BeEquivalentTo(12))
+			})
+		})
+
+		When("the package has no bits", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/packages/some-pkg-guid/download"),
+						RespondWith(http.StatusUnprocessableEntity, `{"errors":[{"code":10008,"detail":"Package has no bits to download.","title":"CF-UnprocessableEntity"}]}`),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("GetPackages", func() {
 		var (
 			pkgs       []resources.Package
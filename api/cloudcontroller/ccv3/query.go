@@ -75,6 +75,8 @@ const (
 	ServiceOfferingNamesFilter QueryKey = "service_offering_names"
 	// ServiceOfferingGUIDsFilter is a query parameter when getting resources according to service offering GUIDs
 	ServiceOfferingGUIDsFilter QueryKey = "service_offering_guids"
+	// ServicePlanGUIDsFilter is a query parameter when getting service instances according to the service plans they relate to
+	ServicePlanGUIDsFilter QueryKey = "service_plan_guids"
 	// FieldsServiceOfferingServiceBroker is a query parameter to include specific fields from a service broker in a plan response
 	FieldsServiceOfferingServiceBroker QueryKey = "fields[service_offering.service_broker]"
 	// FieldsServiceBroker is a query parameter to include specific fields from a service broker in an offering response
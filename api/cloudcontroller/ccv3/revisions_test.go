@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/ccv3fakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -133,4 +134,38 @@ var _ = Describe("Revisions", func() {
 			})
 		})
 	})
+
+	Describe("GetRevisionEnvironmentVariables", func() {
+		var (
+			envVars    resources.EnvironmentVariables
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			envVars, warnings, executeErr = client.GetRevisionEnvironmentVariables("some-revision-guid")
+		})
+
+		BeforeEach(func() {
+			requester.MakeRequestCalls(func(requestParams RequestParams) (JobURL, Warnings, error) {
+				*requestParams.ResponseBody.(*resources.EnvironmentVariables) = resources.EnvironmentVariables{
+					"SOME_VAR": {Value: "some-value", IsSet: true},
+				}
+				return "", Warnings{"this is a warning"}, nil
+			})
+		})
+
+		It("makes the correct request", func() {
+			Expect(requester.MakeRequestCallCount()).To(Equal(1))
+			actualParams := requester.MakeRequestArgsForCall(0)
+			Expect(actualParams.RequestName).To(Equal(internal.GetRevisionEnvironmentVariablesRequest))
+			Expect(actualParams.URIParams).To(Equal(internal.Params{"revision_guid": "some-revision-guid"}))
+		})
+
+		It("returns the environment variables and all warnings", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf("this is a warning"))
+			Expect(envVars).To(HaveKeyWithValue("SOME_VAR", types.FilteredString{Value: "some-value", IsSet: true}))
+		})
+	})
 })
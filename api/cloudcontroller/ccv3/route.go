@@ -66,6 +66,20 @@ func (client Client) GetRouteDestinations(routeGUID string) ([]resources.RouteDe
 	return responseBody.Destinations, warnings, err
 }
 
+// GetRouteSharedSpaces returns the GUIDs of the spaces that the route is
+// shared with.
+func (client Client) GetRouteSharedSpaces(routeGUID string) ([]string, Warnings, error) {
+	var responseBody resources.RelationshipList
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.GetRouteSharedSpacesRequest,
+		URIParams:    internal.Params{"route_guid": routeGUID},
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody.GUIDs, warnings, err
+}
+
 func (client Client) GetRoutes(query ...Query) ([]resources.Route, Warnings, error) {
 	var routes []resources.Route
 
@@ -120,6 +134,55 @@ func (client Client) MapRoute(routeGUID string, appGUID string, destinationProto
 	return warnings, err
 }
 
+// ReplaceRouteDestinations replaces all of a route's destinations in a single
+// request. It is the only way to set destination weights, which have to add
+// up to 100.
+func (client Client) ReplaceRouteDestinations(routeGUID string, destinations []resources.RouteDestination) (Warnings, error) {
+	type destinationProcess struct {
+		Type string `json:"type"`
+	}
+
+	type destinationApp struct {
+		GUID    string              `json:"guid"`
+		Process *destinationProcess `json:"process,omitempty"`
+	}
+	type destination struct {
+		App      destinationApp `json:"app"`
+		Port     int            `json:"port,omitempty"`
+		Protocol string         `json:"protocol,omitempty"`
+		Weight   *int           `json:"weight,omitempty"`
+	}
+
+	type body struct {
+		Destinations []destination `json:"destinations"`
+	}
+
+	requestBody := body{Destinations: []destination{}}
+	for _, dst := range destinations {
+		requestDestination := destination{
+			App:      destinationApp{GUID: dst.App.GUID},
+			Port:     dst.Port,
+			Protocol: dst.Protocol,
+		}
+		if dst.App.Process.Type != "" {
+			requestDestination.App.Process = &destinationProcess{Type: dst.App.Process.Type}
+		}
+		if dst.Weight.IsSet {
+			weight := dst.Weight.Value
+			requestDestination.Weight = &weight
+		}
+		requestBody.Destinations = append(requestBody.Destinations, requestDestination)
+	}
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName: internal.PatchRouteDestinationsRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+		RequestBody: &requestBody,
+	})
+
+	return warnings, err
+}
+
 func (client Client) UnmapRoute(routeGUID string, destinationGUID string) (Warnings, error) {
 	var responseBody resources.Build
 
@@ -310,6 +310,68 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("GetRouteSharedSpaces", func() {
+		var (
+			spaceGUIDs []string
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			spaceGUIDs, warnings, executeErr = client.GetRouteSharedSpaces("route-guid")
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"data": [
+						{"guid": "space-guid-1"},
+						{"guid": "space-guid-2"}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes/route-guid/relationships/shared_spaces"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the shared space GUIDs and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(spaceGUIDs).To(Equal([]string{"space-guid-1", "space-guid-2"}))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Route not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes/route-guid/relationships/shared_spaces"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Route not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("GetRoutes", func() {
 		var (
 			query      Query
@@ -674,7 +736,8 @@ var _ = Describe("Route", func() {
 								"process": {
 									"type": "web"
 								}
-							}
+							},
+							"weight": 80
 						},
 						{
 							"guid": "destination-2-guid",
@@ -683,7 +746,8 @@ var _ = Describe("Route", func() {
 								"process": {
 									"type": "worker"
 								}
-							}
+							},
+							"weight": null
 						}
 					]
 				}`
@@ -705,8 +769,9 @@ var _ = Describe("Route", func() {
 
 					Expect(destinations).To(Equal([]resources.RouteDestination{
 						{
-							GUID: "destination-1-guid",
-							App:  resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
+							GUID:   "destination-1-guid",
+							App:    resources.RouteDestinationApp{GUID: "app-1-guid", Process: struct{ Type string }{Type: "web"}},
+							Weight: types.NullInt{IsSet: true, Value: 80},
 						},
 						{
 							GUID: "destination-2-guid",
@@ -718,6 +783,95 @@ var _ = Describe("Route", func() {
 		})
 	})
 
+	Describe("ReplaceRouteDestinations", func() {
+		var (
+			destinations []resources.RouteDestination
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			webDestination := resources.RouteDestination{
+				App:    resources.RouteDestinationApp{GUID: "app-1-guid"},
+				Weight: types.NullInt{IsSet: true, Value: 80},
+			}
+			webDestination.App.Process.Type = "web"
+			destinations = []resources.RouteDestination{
+				webDestination,
+				{
+					App:      resources.RouteDestinationApp{GUID: "app-2-guid"},
+					Protocol: "http2",
+					Weight:   types.NullInt{IsSet: true, Value: 20},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.ReplaceRouteDestinations("route-guid", destinations)
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid/destinations"),
+						VerifyJSON(`{
+							"destinations": [
+								{
+									"app": {
+										"guid": "app-1-guid",
+										"process": {
+											"type": "web"
+										}
+									},
+									"weight": 80
+								},
+								{
+									"app": {
+										"guid": "app-2-guid"
+									},
+									"protocol": "http2",
+									"weight": 20
+								}
+							]
+						}`),
+						RespondWith(http.StatusOK, `{}`, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("replaces the destinations and returns the warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Destinations weights must sum to 100.",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/routes/route-guid/destinations"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "Destinations weights must sum to 100."}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
 	Describe("UnmapRoute", func() {
 		var (
 			routeGUID       string
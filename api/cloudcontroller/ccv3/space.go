@@ -43,6 +43,22 @@ func (client *Client) GetSpaces(query ...Query) ([]resources.Space, IncludedReso
 	return returnedResources, includedResources, warnings, err
 }
 
+// GetSpaceUsageSummary gets the usage of the quota-limited resources of the
+// space.
+func (client *Client) GetSpaceUsageSummary(spaceGUID string) (resources.UsageSummary, Warnings, error) {
+	var responseBody struct {
+		UsageSummary resources.UsageSummary `json:"usage_summary"`
+	}
+
+	_, warnings, err := client.MakeRequest(RequestParams{
+		RequestName:  internal.GetSpaceUsageSummaryRequest,
+		URIParams:    internal.Params{"space_guid": spaceGUID},
+		ResponseBody: &responseBody,
+	})
+
+	return responseBody.UsageSummary, warnings, err
+}
+
 func (client *Client) UpdateSpace(space resources.Space) (resources.Space, Warnings, error) {
 	spaceGUID := space.GUID
 	space.GUID = ""
@@ -2,6 +2,7 @@ package wrapper
 
 import (
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/SermoDigital/jose/jws"
@@ -35,6 +36,10 @@ type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// refreshLock keeps requests made in parallel from refreshing the token
+	// more than once.
+	refreshLock sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -52,12 +57,15 @@ func NewUAAAuthentication(client UAAClient, cache TokenCache) *UAAAuthentication
 func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	if request.Header.Get("Authorization") == "" && (t.cache.AccessToken() != "" || t.cache.RefreshToken() != "") {
 		// assert a valid access token for authenticated requests
+		t.refreshLock.Lock()
 		err := t.refreshTokenIfNecessary(t.cache.AccessToken())
+		accessToken := t.cache.AccessToken()
+		t.refreshLock.Unlock()
 		if nil != err {
 			return err
 		}
 
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 	}
 
 	err := t.connection.Make(request, passedResponse)
@@ -15,11 +15,13 @@ import (
 //
 // The const name should always be the const value + Request.
 const (
-	GetRouterGroups = "GetRouterGroups"
+	GetRouterGroups   = "GetRouterGroups"
+	UpdateRouterGroup = "UpdateRouterGroup"
 )
 
 // APIRoutes is a list of routes used by the rata library to construct request
 // URLs.
 var APIRoutes = rata.Routes{
 	{Path: "/v1/router_groups", Method: http.MethodGet, Name: GetRouterGroups},
+	{Path: "/v1/router_groups/:router_group_guid", Method: http.MethodPut, Name: UpdateRouterGroup},
 }
@@ -1,6 +1,8 @@
 package router
 
 import (
+	"bytes"
+	"encoding/json"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/router/internal"
@@ -66,3 +68,37 @@ func (client *Client) GetRouterGroupByName(name string) (RouterGroup, error) {
 
 	return RouterGroup{}, routererror.ResourceNotFoundError{}
 }
+
+// UpdateRouterGroup updates the reservable ports of a TCP router group. They
+// are the only part of a router group that can be changed.
+func (client *Client) UpdateRouterGroup(routerGroup RouterGroup) (RouterGroup, error) {
+	body, err := json.Marshal(struct {
+		ReservablePorts string `json:"reservable_ports"`
+	}{
+		ReservablePorts: routerGroup.ReservablePorts,
+	})
+	if err != nil {
+		return RouterGroup{}, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.UpdateRouterGroup,
+		URIParams:   Params{"router_group_guid": routerGroup.GUID},
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return RouterGroup{}, err
+	}
+
+	var updatedRouterGroup RouterGroup
+	var response = Response{
+		Result: &updatedRouterGroup,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return RouterGroup{}, err
+	}
+
+	return updatedRouterGroup, nil
+}
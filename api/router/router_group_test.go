@@ -80,4 +80,69 @@ var _ = Describe("Router Groups", func() {
 			})
 		})
 	})
+
+	Describe("UpdateRouterGroup", func() {
+		var (
+			client      *Client
+			routerGroup RouterGroup
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			fakeConfig := NewTestConfig()
+			fakeConfig.Wrappers = append([]ConnectionWrapper{wrapper.NewErrorWrapper()}, fakeConfig.Wrappers...)
+			client = NewTestRouterClient(fakeConfig)
+			routerGroup, executeErr = client.UpdateRouterGroup(RouterGroup{
+				GUID:            "some-router-group-guid",
+				Name:            "some-router-group",
+				ReservablePorts: "1024-1199,1300",
+			})
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid":"some-router-group-guid",
+					"name":"some-router-group",
+					"type":"tcp",
+					"reservable_ports":"1024-1199,1300"
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/routing/v1/router_groups/some-router-group-guid"),
+						VerifyJSON(`{"reservable_ports":"1024-1199,1300"}`),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the updated router group", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(routerGroup).To(Equal(RouterGroup{
+					GUID:            "some-router-group-guid",
+					Name:            "some-router-group",
+					Type:            "tcp",
+					ReservablePorts: "1024-1199,1300",
+				}))
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				response := `{"name":"ResourceNotFoundError","message":"Router Group 'some-router-group-guid' not found"}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/routing/v1/router_groups/some-router-group-guid"),
+						RespondWith(http.StatusNotFound, response),
+					))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(routererror.ResourceNotFoundError{
+					Message: "Router Group 'some-router-group-guid' not found",
+				}))
+				Expect(routerGroup).To(Equal(RouterGroup{}))
+			})
+		})
+	})
 })
@@ -0,0 +1,108 @@
+package servicebroker
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/servicebroker/servicebrokererror"
+)
+
+// APIVersion is the version of the Open Service Broker API that the client
+// asks brokers for.
+const APIVersion = "2.17"
+
+// Catalog is the catalog of service offerings and plans that a broker
+// returns from GET /v2/catalog.
+type Catalog struct {
+	Services []CatalogService `json:"services"`
+}
+
+// CatalogService is a service offering in a broker catalog. Fields that the
+// Open Service Broker API requires but that can be false are pointers, so
+// that a missing field can be told apart from false.
+type CatalogService struct {
+	ID              string                  `json:"id"`
+	Name            string                  `json:"name"`
+	Description     string                  `json:"description"`
+	Bindable        *bool                   `json:"bindable"`
+	PlanUpdateable  bool                    `json:"plan_updateable"`
+	Tags            []string                `json:"tags"`
+	Requires        []string                `json:"requires"`
+	DashboardClient *CatalogDashboardClient `json:"dashboard_client"`
+	Plans           []CatalogPlan           `json:"plans"`
+}
+
+// CatalogDashboardClient is the OAuth client that a broker asks the Cloud
+// Controller to create for the dashboard of a service offering.
+type CatalogDashboardClient struct {
+	ID          string `json:"id"`
+	Secret      string `json:"secret"`
+	RedirectURI string `json:"redirect_uri"`
+}
+
+// CatalogPlan is a service plan in a broker catalog.
+type CatalogPlan struct {
+	ID              string                  `json:"id"`
+	Name            string                  `json:"name"`
+	Description     string                  `json:"description"`
+	Free            *bool                   `json:"free"`
+	Bindable        *bool                   `json:"bindable"`
+	MaintenanceInfo *CatalogMaintenanceInfo `json:"maintenance_info"`
+}
+
+// CatalogMaintenanceInfo is the maintenance info of a service plan.
+type CatalogMaintenanceInfo struct {
+	Version     string `json:"version"`
+	Description string `json:"description"`
+}
+
+// GetCatalog fetches the catalog of the broker at brokerURL, using basic
+// auth with the given credentials.
+func (client *Client) GetCatalog(brokerURL string, username string, password string) (Catalog, error) {
+	request, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(brokerURL, "/")+"/v2/catalog", nil)
+	if err != nil {
+		return Catalog{}, err
+	}
+	request.SetBasicAuth(username, password)
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("User-Agent", client.userAgent)
+	request.Header.Set("X-Broker-API-Version", APIVersion)
+
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return Catalog{}, processRequestErrors(request, err)
+	}
+	defer response.Body.Close()
+
+	rawBytes, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return Catalog{}, err
+	}
+
+	if response.StatusCode >= 400 {
+		return Catalog{}, servicebrokererror.RawHTTPStatusError{
+			Status:      response.Status,
+			RawResponse: rawBytes,
+		}
+	}
+
+	var catalog Catalog
+	err = json.Unmarshal(rawBytes, &catalog)
+	if err != nil {
+		return Catalog{}, servicebrokererror.InvalidCatalogError{Message: err.Error()}
+	}
+
+	return catalog, nil
+}
+
+func processRequestErrors(request *http.Request, err error) error {
+	if _, ok := err.(*url.Error); ok && errors.As(err, &x509.UnknownAuthorityError{}) {
+		return servicebrokererror.UnverifiedServerError{URL: request.URL.String()}
+	}
+	return err
+}
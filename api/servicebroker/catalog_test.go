@@ -0,0 +1,150 @@
+package servicebroker_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/servicebroker"
+	"code.cloudfoundry.org/cli/api/servicebroker/servicebrokererror"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Catalog", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetCatalog", func() {
+		var (
+			catalog    Catalog
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			catalog, executeErr = client.GetCatalog(server.URL()+"/", "some-user", "some-password")
+		})
+
+		When("the broker does not respond in time", func() {
+			BeforeEach(func() {
+				client = NewClient(Config{SkipSSLValidation: true, RequestTimeout: 50 * time.Millisecond})
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/catalog"),
+						func(http.ResponseWriter, *http.Request) {
+							time.Sleep(500 * time.Millisecond)
+						},
+						RespondWith(http.StatusOK, `{"services": []}`),
+					),
+				)
+			})
+
+			It("gives up on the request", func() {
+				Expect(executeErr).To(MatchError(ContainSubstring("Client.Timeout exceeded")))
+			})
+		})
+
+		When("the broker returns a catalog", func() {
+			BeforeEach(func() {
+				response := `{
+					"services": [{
+						"id": "service-id",
+						"name": "service-name",
+						"description": "service description",
+						"bindable": true,
+						"tags": ["tag"],
+						"dashboard_client": {"id": "client-id", "secret": "client-secret", "redirect_uri": "https://dashboard.example.com"},
+						"plans": [{
+							"id": "plan-id",
+							"name": "plan-name",
+							"description": "plan description",
+							"free": false,
+							"maintenance_info": {"version": "1.2.3"}
+						}]
+					}]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/catalog"),
+						VerifyBasicAuth("some-user", "some-password"),
+						VerifyHeaderKV("X-Broker-API-Version", "2.17"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the catalog", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				bindable, free := true, false
+				Expect(catalog).To(Equal(Catalog{
+					Services: []CatalogService{{
+						ID:          "service-id",
+						Name:        "service-name",
+						Description: "service description",
+						Bindable:    &bindable,
+						Tags:        []string{"tag"},
+						DashboardClient: &CatalogDashboardClient{
+							ID:          "client-id",
+							Secret:      "client-secret",
+							RedirectURI: "https://dashboard.example.com",
+						},
+						Plans: []CatalogPlan{{
+							ID:              "plan-id",
+							Name:            "plan-name",
+							Description:     "plan description",
+							Free:            &free,
+							MaintenanceInfo: &CatalogMaintenanceInfo{Version: "1.2.3"},
+						}},
+					}},
+				}))
+			})
+		})
+
+		When("the broker returns an error status", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/catalog"),
+						RespondWith(http.StatusUnauthorized, "not authorized"),
+					),
+				)
+			})
+
+			It("returns a RawHTTPStatusError", func() {
+				Expect(executeErr).To(MatchError(servicebrokererror.RawHTTPStatusError{
+					Status:      "401 Unauthorized",
+					RawResponse: []byte("not authorized"),
+				}))
+			})
+		})
+
+		When("the broker returns something that is not a catalog", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/catalog"),
+						RespondWith(http.StatusOK, `{"services": "none"}`),
+					),
+				)
+			})
+
+			It("returns an InvalidCatalogError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(servicebrokererror.InvalidCatalogError{}))
+			})
+		})
+
+		When("the broker certificate cannot be verified", func() {
+			BeforeEach(func() {
+				client = NewClient(Config{AppName: "CF CLI API Service Broker Test", AppVersion: "Unknown"})
+			})
+
+			It("returns an UnverifiedServerError", func() {
+				Expect(executeErr).To(MatchError(servicebrokererror.UnverifiedServerError{URL: server.URL() + "/v2/catalog"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package servicebroker
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"runtime"
+	"time"
+
+	"code.cloudfoundry.org/cli/util"
+)
+
+// DefaultRequestTimeout is how long a request to a service broker may take,
+// including reading the response, when Config.RequestTimeout is not set.
+const DefaultRequestTimeout = 60 * time.Second
+
+// Client is a client that can be used to make HTTP requests to service
+// brokers directly, without going through the Cloud Controller.
+type Client struct {
+	httpClient *http.Client
+	userAgent  string
+}
+
+// Config allows the Client to be configured
+type Config struct {
+	// AppName is the name of the application/process using the client.
+	AppName string
+
+	// AppVersion is the version of the application/process using the client.
+	AppVersion string
+
+	// DialTimeout is the DNS lookup timeout for the client. If not set, it is
+	// infinite.
+	DialTimeout time.Duration
+
+	// RequestTimeout is how long a request may take, including reading the
+	// response. If not set, DefaultRequestTimeout is used.
+	RequestTimeout time.Duration
+
+	// SkipSSLValidation controls whether a client verifies the server's
+	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
+	// any certificate presented by the server and any host name in that
+	// certificate for *all* client requests going forward.
+	//
+	// In this mode, TLS is susceptible to man-in-the-middle attacks. This should
+	// be used only for testing.
+	SkipSSLValidation bool
+}
+
+// NewClient returns a new service broker Client.
+func NewClient(config Config) *Client {
+	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)",
+		config.AppName,
+		config.AppVersion,
+		runtime.Version(),
+		runtime.GOARCH,
+		runtime.GOOS,
+	)
+
+	tr := &http.Transport{
+		TLSClientConfig: util.NewTLSConfig(nil, config.SkipSSLValidation),
+		Proxy:           http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			KeepAlive: 30 * time.Second,
+			Timeout:   config.DialTimeout,
+		}).DialContext,
+	}
+
+	requestTimeout := config.RequestTimeout
+	if requestTimeout == 0 {
+		requestTimeout = DefaultRequestTimeout
+	}
+
+	return &Client{
+		httpClient: &http.Client{Transport: tr, Timeout: requestTimeout},
+		userAgent:  userAgent,
+	}
+}
@@ -0,0 +1,41 @@
+package servicebroker_test
+
+import (
+	"bytes"
+	"log"
+
+	. "code.cloudfoundry.org/cli/api/servicebroker"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+
+	"testing"
+)
+
+func TestServiceBroker(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Service Broker Suite")
+}
+
+var server *Server
+
+var _ = SynchronizedBeforeSuite(func() []byte {
+	return []byte{}
+}, func(data []byte) {
+	server = NewTLSServer()
+
+	// Suppresses ginkgo server logs
+	server.HTTPTestServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+})
+
+var _ = SynchronizedAfterSuite(func() {
+	server.Close()
+}, func() {})
+
+var _ = BeforeEach(func() {
+	server.Reset()
+})
+
+func NewTestClient() *Client {
+	return NewClient(Config{SkipSSLValidation: true, AppName: "CF CLI API Service Broker Test", AppVersion: "Unknown"})
+}
@@ -0,0 +1,11 @@
+package servicebrokererror
+
+// InvalidCatalogError is returned when the broker returns a catalog that is
+// not valid JSON or does not have the shape of a catalog.
+type InvalidCatalogError struct {
+	Message string
+}
+
+func (e InvalidCatalogError) Error() string {
+	return "invalid catalog: " + e.Message
+}
@@ -0,0 +1,11 @@
+package servicebrokererror
+
+// UnverifiedServerError replaces x509.UnknownAuthorityError when the broker
+// has SSL but the client is unable to verify its certificate
+type UnverifiedServerError struct {
+	URL string
+}
+
+func (UnverifiedServerError) Error() string {
+	return "x509: certificate signed by unknown authority"
+}
@@ -20,7 +20,9 @@ type AuthResponse struct {
 }
 
 // Authenticate sends a username and password to UAA then returns an access
-// token and a refresh token.
+// token and a refresh token. For grants other than password, creds is also
+// expected to identify the client, either with a client_secret or with a
+// client_assertion.
 func (client Client) Authenticate(creds map[string]string, origin string, grantType constant.GrantType) (string, string, error) {
 	requestBody := url.Values{
 		"grant_type": {string(grantType)},
@@ -157,6 +157,70 @@ var _ = Describe("Auth", func() {
 				It("authenticates with the credentials provided", func() {
 					Expect(executeErr).NotTo(HaveOccurred())
 
+					Expect(accessToken).To(Equal("some-access-token"))
+					Expect(refreshToken).To(BeEmpty())
+				})
+			})
+			When("the grant type is jwt-bearer", func() {
+				BeforeEach(func() {
+					credentials = map[string]string{
+						"assertion":             "some-assertion",
+						"client_id":             "some-client-id",
+						"client_assertion":      "some-client-assertion",
+						"client_assertion_type": constant.ClientAssertionTypeJWTBearer,
+					}
+					origin = ""
+					grantType = constant.GrantTypeJWTBearer
+					server.AppendHandlers(
+						CombineHandlers(
+							verifyRequestHost(TestAuthorizationResource),
+							VerifyRequest(http.MethodPost, "/oauth/token"),
+							VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+							VerifyHeaderKV("Authorization"),
+							VerifyFormKV("assertion", "some-assertion"),
+							VerifyFormKV("client_id", "some-client-id"),
+							VerifyFormKV("client_assertion", "some-client-assertion"),
+							VerifyFormKV("client_assertion_type", constant.ClientAssertionTypeJWTBearer),
+							VerifyFormKV("grant_type", string(constant.GrantTypeJWTBearer)),
+							RespondWith(http.StatusOK, `{"access_token":"some-access-token","refresh_token":"some-refresh-token"}`),
+						))
+				})
+
+				It("authenticates the client with the credentials provided instead of basic auth", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(accessToken).To(Equal("some-access-token"))
+					Expect(refreshToken).To(Equal("some-refresh-token"))
+				})
+			})
+
+			When("the grant type is token exchange", func() {
+				BeforeEach(func() {
+					credentials = map[string]string{
+						"client_id":          "some-client-id",
+						"client_secret":      "some-client-secret",
+						"subject_token":      "some-id-token",
+						"subject_token_type": string(constant.TokenTypeIDToken),
+					}
+					origin = ""
+					grantType = constant.GrantTypeTokenExchange
+					server.AppendHandlers(
+						CombineHandlers(
+							verifyRequestHost(TestAuthorizationResource),
+							VerifyRequest(http.MethodPost, "/oauth/token"),
+							VerifyHeaderKV("Authorization"),
+							VerifyFormKV("client_id", "some-client-id"),
+							VerifyFormKV("client_secret", "some-client-secret"),
+							VerifyFormKV("subject_token", "some-id-token"),
+							VerifyFormKV("subject_token_type", string(constant.TokenTypeIDToken)),
+							VerifyFormKV("grant_type", string(constant.GrantTypeTokenExchange)),
+							RespondWith(http.StatusOK, `{"access_token":"some-access-token"}`),
+						))
+				})
+
+				It("exchanges the subject token", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
 					Expect(accessToken).To(Equal("some-access-token"))
 					Expect(refreshToken).To(BeEmpty())
 				})
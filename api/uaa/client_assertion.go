@@ -0,0 +1,87 @@
+package uaa
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+	"github.com/SermoDigital/jose/crypto"
+	"github.com/SermoDigital/jose/jws"
+)
+
+// clientAssertionLifetime is how long a generated client assertion is valid
+// for. Assertions are generated per request, so this only needs to cover
+// clock skew and the round trip to UAA.
+const clientAssertionLifetime = 5 * time.Minute
+
+// ClientAssertion returns a JWT identifying clientID, signed with the RSA
+// private key in keyFile, suitable for private_key_jwt client
+// authentication against the UAA token endpoint.
+func (client Client) ClientAssertion(clientID string, keyFile string) (string, error) {
+	key, err := readRSAPrivateKey(keyFile)
+	if err != nil {
+		return "", err
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostOAuthTokenRequest,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	jti := make([]byte, 16)
+	if _, err = rand.Read(jti); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	claims := jws.Claims{}
+	claims.SetIssuer(clientID)
+	claims.SetSubject(clientID)
+	claims.SetAudience(request.URL.String())
+	claims.SetJWTID(hex.EncodeToString(jti))
+	claims.SetIssuedAt(now)
+	claims.SetExpiration(now.Add(clientAssertionLifetime))
+
+	assertion, err := jws.NewJWT(claims, crypto.SigningMethodRS256).Serialize(key)
+	if err != nil {
+		return "", err
+	}
+
+	return string(assertion), nil
+}
+
+func readRSAPrivateKey(keyFile string) (*rsa.PrivateKey, error) {
+	contents, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(contents)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded private key found in %s", keyFile)
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsedKey, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse private key in %s: %s", keyFile, err)
+	}
+
+	key, ok := parsedKey.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key in %s is not an RSA key", keyFile)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,138 @@
+package uaa_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	"github.com/SermoDigital/jose/crypto"
+	"github.com/SermoDigital/jose/jws"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ClientAssertion", func() {
+	var (
+		client *Client
+
+		keyDir     string
+		keyFile    string
+		privateKey *rsa.PrivateKey
+
+		assertion  string
+		executeErr error
+	)
+
+	writeKey := func(block *pem.Block) {
+		Expect(os.WriteFile(keyFile, pem.EncodeToMemory(block), 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore(NewTestConfig())
+
+		keyDir = GinkgoT().TempDir()
+		keyFile = filepath.Join(keyDir, "client-key.pem")
+
+		var err error
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	JustBeforeEach(func() {
+		assertion, executeErr = client.ClientAssertion("some-client-id", keyFile)
+	})
+
+	When("the key file contains a PKCS1 RSA key", func() {
+		BeforeEach(func() {
+			writeKey(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+		})
+
+		It("returns a JWT signed by the key, identifying the client to the token endpoint", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			token, err := jws.ParseJWT([]byte(assertion))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.Validate(&privateKey.PublicKey, crypto.SigningMethodRS256)).To(Succeed())
+
+			claims := token.Claims()
+			issuer, _ := claims.Issuer()
+			Expect(issuer).To(Equal("some-client-id"))
+			subject, _ := claims.Subject()
+			Expect(subject).To(Equal("some-client-id"))
+			audience, _ := claims.Audience()
+			Expect(audience).To(ConsistOf(server.URL() + "/oauth/token"))
+
+			jti, ok := claims.JWTID()
+			Expect(ok).To(BeTrue())
+			Expect(jti).ToNot(BeEmpty())
+
+			expiration, ok := claims.Expiration()
+			Expect(ok).To(BeTrue())
+			Expect(expiration).To(BeTemporally("~", time.Now().Add(5*time.Minute), time.Minute))
+		})
+
+		It("uses a new JWT ID for every assertion", func() {
+			secondAssertion, err := client.ClientAssertion("some-client-id", keyFile)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secondAssertion).ToNot(Equal(assertion))
+		})
+	})
+
+	When("the key file contains a PKCS8 RSA key", func() {
+		BeforeEach(func() {
+			der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+			Expect(err).ToNot(HaveOccurred())
+			writeKey(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+		})
+
+		It("returns a JWT signed by the key", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			token, err := jws.ParseJWT([]byte(assertion))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token.Validate(&privateKey.PublicKey, crypto.SigningMethodRS256)).To(Succeed())
+		})
+	})
+
+	When("the key file contains a non-RSA key", func() {
+		BeforeEach(func() {
+			ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			der, err := x509.MarshalPKCS8PrivateKey(ecKey)
+			Expect(err).ToNot(HaveOccurred())
+			writeKey(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(ContainSubstring("is not an RSA key")))
+		})
+	})
+
+	When("the key file does not contain PEM data", func() {
+		BeforeEach(func() {
+			Expect(os.WriteFile(keyFile, []byte("not a key"), 0600)).To(Succeed())
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(ContainSubstring("no PEM encoded private key found")))
+		})
+	})
+
+	When("the key file does not exist", func() {
+		BeforeEach(func() {
+			keyFile = filepath.Join(keyDir, "missing.pem")
+		})
+
+		It("returns an error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+})
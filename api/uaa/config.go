@@ -43,4 +43,8 @@ type Config interface {
 
 	// UAAOAuthClientSecret is the UAA client secret the client will use.
 	UAAOAuthClientSecret() string
+
+	// UAAOAuthClientKeyFile is the path to the private key the client will use
+	// to sign client assertions in place of a client secret.
+	UAAOAuthClientKeyFile() string
 }
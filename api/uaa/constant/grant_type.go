@@ -10,4 +10,14 @@ const (
 	// GrantTypePassword is used for user's username/password authentication.
 	GrantTypePassword     GrantType = "password"
 	GrantTypeRefreshToken GrantType = "refresh_token"
+	// GrantTypeJWTBearer is used to exchange a signed JWT assertion, such as a
+	// workload identity token, for an access token (RFC 7523).
+	GrantTypeJWTBearer GrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+	// GrantTypeTokenExchange is used to exchange a token issued by a trusted
+	// identity provider for an access token (RFC 8693).
+	GrantTypeTokenExchange GrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
 )
+
+// ClientAssertionTypeJWTBearer is the client_assertion_type used when a client
+// authenticates with a JWT signed by its private key (private_key_jwt).
+const ClientAssertionTypeJWTBearer = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
@@ -0,0 +1,14 @@
+package constant
+
+// TokenType identifies the type of a token passed to the token exchange
+// grant.
+type TokenType string
+
+const (
+	// TokenTypeIDToken is an OpenID Connect ID token.
+	TokenTypeIDToken TokenType = "urn:ietf:params:oauth:token-type:id_token"
+	// TokenTypeAccessToken is an OAuth 2.0 access token.
+	TokenTypeAccessToken TokenType = "urn:ietf:params:oauth:token-type:access_token"
+	// TokenTypeJWT is a generic JSON Web Token.
+	TokenTypeJWT TokenType = "urn:ietf:params:oauth:token-type:jwt"
+)
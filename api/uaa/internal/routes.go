@@ -13,6 +13,7 @@ const (
 	DeleteUserRequest     = "DeleteUser"
 	UpdatePasswordRequest = "UpdatePassword"
 	DeleteTokenRequest    = "DeleteToken"
+	ListTokenKeysRequest  = "ListTokenKeys"
 )
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
@@ -25,4 +26,5 @@ var APIRoutes = []Route{
 	{Path: "/oauth/clients/:client_id", Method: http.MethodGet, Name: GetClientUser, Resource: UAAResource},
 	{Path: "/oauth/token", Method: http.MethodPost, Name: PostOAuthTokenRequest, Resource: AuthorizationResource},
 	{Path: "/oauth/token/revoke/:token_id", Method: http.MethodDelete, Name: DeleteTokenRequest, Resource: AuthorizationResource},
+	{Path: "/token_keys", Method: http.MethodGet, Name: ListTokenKeysRequest, Resource: UAAResource},
 }
@@ -24,13 +24,20 @@ func (refreshTokenResponse RefreshedTokens) AuthorizationToken() string {
 
 // RefreshAccessToken refreshes the current access token.
 func (client *Client) RefreshAccessToken(refreshToken string) (RefreshedTokens, error) {
-	var values url.Values
+	var (
+		values url.Values
+		err    error
+	)
 
-	switch client.config.UAAGrantType() {
-	case string(constant.GrantTypeClientCredentials):
-		values = client.clientCredentialRefreshBody()
-	case "", string(constant.GrantTypePassword): // CLI used to write empty string for grant type in the case of password; preserve compatibility with old config.json files
-		values = client.refreshTokenBody(refreshToken)
+	grantType := constant.GrantType(client.config.UAAGrantType())
+	switch grantType {
+	case constant.GrantTypeClientCredentials:
+		values, err = client.clientCredentialRefreshBody()
+	case "", constant.GrantTypePassword, constant.GrantTypeJWTBearer, constant.GrantTypeTokenExchange: // CLI used to write empty string for grant type in the case of password; preserve compatibility with old config.json files
+		values, err = client.refreshTokenBody(refreshToken)
+	}
+	if err != nil {
+		return RefreshedTokens{}, err
 	}
 
 	body := strings.NewReader(values.Encode())
@@ -44,7 +51,7 @@ func (client *Client) RefreshAccessToken(refreshToken string) (RefreshedTokens,
 		return RefreshedTokens{}, err
 	}
 
-	if client.config.UAAGrantType() != string(constant.GrantTypeClientCredentials) {
+	if grantType != constant.GrantTypeClientCredentials && client.config.UAAOAuthClientKeyFile() == "" {
 		request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
 	}
 
@@ -55,23 +62,65 @@ func (client *Client) RefreshAccessToken(refreshToken string) (RefreshedTokens,
 
 	err = client.connection.Make(request, &response)
 	if err != nil {
+		// The client secret of an assertion grant is not stored, so a client
+		// that UAA will not accept without it has to log in again.
+		if _, ok := err.(UnauthorizedError); ok && isAssertionGrant(grantType) && client.config.UAAOAuthClientKeyFile() == "" {
+			return RefreshedTokens{}, InvalidAuthTokenError{Message: err.Error()}
+		}
 		return RefreshedTokens{}, err
 	}
 
 	return refreshResponse, nil
 }
 
-func (client *Client) clientCredentialRefreshBody() url.Values {
-	return url.Values{
-		"client_id":     {client.config.UAAOAuthClient()},
-		"client_secret": {client.config.UAAOAuthClientSecret()},
-		"grant_type":    {string(constant.GrantTypeClientCredentials)},
+func isAssertionGrant(grantType constant.GrantType) bool {
+	return grantType == constant.GrantTypeJWTBearer || grantType == constant.GrantTypeTokenExchange
+}
+
+func (client *Client) clientCredentialRefreshBody() (url.Values, error) {
+	values, err := client.clientAuthenticationBody()
+	if err != nil {
+		return nil, err
 	}
+	values.Set("grant_type", string(constant.GrantTypeClientCredentials))
+	return values, nil
 }
 
-func (client *Client) refreshTokenBody(refreshToken string) url.Values {
-	return url.Values{
-		"refresh_token": {refreshToken},
-		"grant_type":    {string(constant.GrantTypeRefreshToken)},
+func (client *Client) refreshTokenBody(refreshToken string) (url.Values, error) {
+	values := url.Values{}
+	if client.config.UAAOAuthClientKeyFile() != "" {
+		var err error
+		values, err = client.clientAuthenticationBody()
+		if err != nil {
+			return nil, err
+		}
 	}
+	values.Set("refresh_token", refreshToken)
+	values.Set("grant_type", string(constant.GrantTypeRefreshToken))
+	return values, nil
+}
+
+// clientAuthenticationBody returns the form values that authenticate the
+// configured client. When a private key is configured a freshly signed client
+// assertion is sent instead of the client secret.
+func (client *Client) clientAuthenticationBody() (url.Values, error) {
+	clientID := client.config.UAAOAuthClient()
+	keyFile := client.config.UAAOAuthClientKeyFile()
+	if keyFile == "" {
+		return url.Values{
+			"client_id":     {clientID},
+			"client_secret": {client.config.UAAOAuthClientSecret()},
+		}, nil
+	}
+
+	assertion, err := client.ClientAssertion(clientID, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return url.Values{
+		"client_id":             {clientID},
+		"client_assertion":      {assertion},
+		"client_assertion_type": {constant.ClientAssertionTypeJWTBearer},
+	}, nil
 }
@@ -0,0 +1,41 @@
+package uaa
+
+import (
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// TokenKey represents a key UAA uses to sign tokens.
+type TokenKey struct {
+	// KeyID is the identifier referenced by the 'kid' header of signed tokens.
+	KeyID string `json:"kid"`
+	// Algorithm is the signing algorithm the key is used with, e.g. RS256.
+	Algorithm string `json:"alg"`
+	// KeyType is the family of the key, e.g. RSA.
+	KeyType string `json:"kty"`
+	// Value is the PEM encoded public key.
+	Value string `json:"value"`
+}
+
+// ListTokenKeys returns the public keys UAA uses to sign tokens.
+func (client Client) ListTokenKeys() ([]TokenKey, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.ListTokenKeysRequest,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var keysResponse struct {
+		Keys []TokenKey `json:"keys"`
+	}
+	response := Response{
+		Result: &keysResponse,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return keysResponse.Keys, nil
+}
@@ -0,0 +1,95 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("TokenKeys", func() {
+	var (
+		client *Client
+
+		fakeConfig *uaafakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = NewTestConfig()
+
+		client = NewTestUAAClientAndStore(fakeConfig)
+	})
+
+	Describe("ListTokenKeys", func() {
+		var (
+			keys []TokenKey
+			err  error
+		)
+
+		JustBeforeEach(func() {
+			keys, err = client.ListTokenKeys()
+		})
+
+		When("no errors occur", func() {
+			BeforeEach(func() {
+				response := `{
+					"keys": [
+						{
+							"kty": "RSA",
+							"e": "AQAB",
+							"use": "sig",
+							"kid": "key-1",
+							"alg": "RS256",
+							"value": "-----BEGIN PUBLIC KEY-----\nsome-key\n-----END PUBLIC KEY-----",
+							"n": "some-modulus"
+						}
+					]
+				}`
+
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/token_keys"),
+						RespondWith(http.StatusOK, response),
+					))
+			})
+
+			It("returns the token keys", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keys).To(Equal([]TokenKey{
+					{
+						KeyID:     "key-1",
+						Algorithm: "RS256",
+						KeyType:   "RSA",
+						Value:     "-----BEGIN PUBLIC KEY-----\nsome-key\n-----END PUBLIC KEY-----",
+					},
+				}))
+			})
+		})
+
+		When("an error occurs", func() {
+			var response string
+
+			BeforeEach(func() {
+				response = `{"error": "server_error"}`
+
+				uaaServer.AppendHandlers(
+					CombineHandlers(
+						verifyRequestHost(TestUAAResource),
+						VerifyRequest(http.MethodGet, "/token_keys"),
+						RespondWith(http.StatusInternalServerError, response),
+					))
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError(RawHTTPStatusError{
+					StatusCode:  http.StatusInternalServerError,
+					RawResponse: []byte(response),
+				}))
+			})
+		})
+	})
+})
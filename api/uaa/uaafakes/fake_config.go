@@ -84,6 +84,16 @@ type FakeConfig struct {
 	uAAOAuthClientReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UAAOAuthClientKeyFileStub        func() string
+	uAAOAuthClientKeyFileMutex       sync.RWMutex
+	uAAOAuthClientKeyFileArgsForCall []struct {
+	}
+	uAAOAuthClientKeyFileReturns struct {
+		result1 string
+	}
+	uAAOAuthClientKeyFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAAOAuthClientSecretStub        func() string
 	uAAOAuthClientSecretMutex       sync.RWMutex
 	uAAOAuthClientSecretArgsForCall []struct {
@@ -493,6 +503,58 @@ func (fake *FakeConfig) UAAOAuthClientReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) UAAOAuthClientKeyFile() string {
+	fake.uAAOAuthClientKeyFileMutex.Lock()
+	ret, specificReturn := fake.uAAOAuthClientKeyFileReturnsOnCall[len(fake.uAAOAuthClientKeyFileArgsForCall)]
+	fake.uAAOAuthClientKeyFileArgsForCall = append(fake.uAAOAuthClientKeyFileArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UAAOAuthClientKeyFile", []interface{}{})
+	fake.uAAOAuthClientKeyFileMutex.Unlock()
+	if fake.UAAOAuthClientKeyFileStub != nil {
+		return fake.UAAOAuthClientKeyFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uAAOAuthClientKeyFileReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UAAOAuthClientKeyFileCallCount() int {
+	fake.uAAOAuthClientKeyFileMutex.RLock()
+	defer fake.uAAOAuthClientKeyFileMutex.RUnlock()
+	return len(fake.uAAOAuthClientKeyFileArgsForCall)
+}
+
+func (fake *FakeConfig) UAAOAuthClientKeyFileCalls(stub func() string) {
+	fake.uAAOAuthClientKeyFileMutex.Lock()
+	defer fake.uAAOAuthClientKeyFileMutex.Unlock()
+	fake.UAAOAuthClientKeyFileStub = stub
+}
+
+func (fake *FakeConfig) UAAOAuthClientKeyFileReturns(result1 string) {
+	fake.uAAOAuthClientKeyFileMutex.Lock()
+	defer fake.uAAOAuthClientKeyFileMutex.Unlock()
+	fake.UAAOAuthClientKeyFileStub = nil
+	fake.uAAOAuthClientKeyFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAOAuthClientKeyFileReturnsOnCall(i int, result1 string) {
+	fake.uAAOAuthClientKeyFileMutex.Lock()
+	defer fake.uAAOAuthClientKeyFileMutex.Unlock()
+	fake.UAAOAuthClientKeyFileStub = nil
+	if fake.uAAOAuthClientKeyFileReturnsOnCall == nil {
+		fake.uAAOAuthClientKeyFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uAAOAuthClientKeyFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAOAuthClientSecret() string {
 	fake.uAAOAuthClientSecretMutex.Lock()
 	ret, specificReturn := fake.uAAOAuthClientSecretReturnsOnCall[len(fake.uAAOAuthClientSecretArgsForCall)]
@@ -564,6 +626,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.uAAOAuthClientMutex.RLock()
 	defer fake.uAAOAuthClientMutex.RUnlock()
+	fake.uAAOAuthClientKeyFileMutex.RLock()
+	defer fake.uAAOAuthClientKeyFileMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
 	defer fake.uAAOAuthClientSecretMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -28,6 +28,7 @@ type Data struct {
 	AuthorizationEndpoint    string
 	ColorEnabled             string
 	ConfigVersion            int
+	CredentialStore          string `json:",omitempty"`
 	DopplerEndPoint          string
 	Locale                   string
 	LogCacheEndPoint         string
@@ -46,6 +47,9 @@ type Data struct {
 	UAAGrantType             string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+
+	credentialKey         string
+	credentialsUnreadable bool
 }
 
 func NewData() *Data {
@@ -59,7 +63,43 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = configv3.CurrentConfigVersion
-	return json.MarshalIndent(d, "", "  ")
+
+	store := d.credentialStore()
+	if store == nil {
+		return json.MarshalIndent(d, "", "  ")
+	}
+
+	if d.credentialKey != "" && d.credentialKey != d.Target {
+		err := store.Erase(d.credentialKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	credentials := configv3.Credentials{
+		AccessToken:          d.AccessToken,
+		RefreshToken:         d.RefreshToken,
+		UAAOAuthClientSecret: d.UAAOAuthClientSecret,
+	}
+
+	var err error
+	if credentials.IsEmpty() {
+		if !d.credentialsUnreadable {
+			err = store.Erase(d.Target)
+		}
+	} else {
+		err = store.Store(d.Target, credentials)
+	}
+	if err != nil {
+		return nil, err
+	}
+	d.credentialKey = d.Target
+
+	withoutSecrets := *d
+	withoutSecrets.AccessToken = ""
+	withoutSecrets.RefreshToken = ""
+	withoutSecrets.UAAOAuthClientSecret = ""
+	return json.MarshalIndent(withoutSecrets, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
@@ -73,5 +113,31 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 		return nil
 	}
 
+	store := d.credentialStore()
+	if store == nil {
+		return nil
+	}
+
+	// The store is read again after configv3 has loaded the config, which
+	// already warns when it cannot be read, so the secrets are left empty here.
+	credentials, err := store.Get(d.Target)
+	if err == configv3.ErrCredentialsNotFound {
+		return nil
+	}
+	if err != nil {
+		d.credentialsUnreadable = true
+		return nil
+	}
+
+	d.AccessToken = credentials.AccessToken
+	d.RefreshToken = credentials.RefreshToken
+	d.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	d.credentialKey = d.Target
 	return nil
 }
+
+// credentialStore returns the credential store configured for the CLI, so
+// that secrets stay out of config.json when it is written by these commands.
+func (d *Data) credentialStore() configv3.CredentialStore {
+	return configv3.SelectCredentialStore(d.CredentialStore, configv3.ReadEnvOverride())
+}
@@ -221,4 +221,42 @@ var _ = Describe("V3 Config files", func() {
 			Expect(*actualData).To(Equal(coreconfig.Data{}))
 		})
 	})
+
+	Describe("with a credential store", func() {
+		BeforeEach(func() {
+			GinkgoT().Setenv("CF_HOME", GinkgoT().TempDir())
+		})
+
+		It("keeps the secrets out of the JSON and reads them back from the store", func() {
+			data := coreconfig.NewData()
+			data.CredentialStore = "file"
+			data.Target = "https://api.example.com"
+			data.AccessToken = "bearer some-access-token"
+			data.RefreshToken = "some-refresh-token"
+
+			jsonData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(jsonData)).NotTo(ContainSubstring("some-access-token"))
+			Expect(string(jsonData)).NotTo(ContainSubstring("some-refresh-token"))
+			Expect(string(jsonData)).To(ContainSubstring(`"CredentialStore": "file"`))
+			Expect(data.AccessToken).To(Equal("bearer some-access-token"))
+
+			actualData := coreconfig.NewData()
+			err = actualData.JSONUnmarshalV3(jsonData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualData.AccessToken).To(Equal("bearer some-access-token"))
+			Expect(actualData.RefreshToken).To(Equal("some-refresh-token"))
+		})
+
+		It("reads the JSON without the secrets when the store cannot be read", func() {
+			GinkgoT().Setenv("CF_CREDENTIAL_STORE", "missing")
+
+			actualData := coreconfig.NewData()
+			err := actualData.JSONUnmarshalV3([]byte(`{"ConfigVersion": 4, "Target": "https://api.example.com"}`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualData.Target).To(Equal("https://api.example.com"))
+			Expect(actualData.AccessToken).To(BeEmpty())
+			Expect(actualData.RefreshToken).To(BeEmpty())
+		})
+	})
 })
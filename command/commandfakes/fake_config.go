@@ -101,6 +101,16 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	CredentialStoreStub        func() string
+	credentialStoreMutex       sync.RWMutex
+	credentialStoreArgsForCall []struct {
+	}
+	credentialStoreReturns struct {
+		result1 string
+	}
+	credentialStoreReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct {
@@ -381,6 +391,11 @@ type FakeConfig struct {
 	setColorEnabledArgsForCall []struct {
 		arg1 string
 	}
+	SetCredentialStoreStub        func(string)
+	setCredentialStoreMutex       sync.RWMutex
+	setCredentialStoreArgsForCall []struct {
+		arg1 string
+	}
 	SetKubernetesAuthInfoStub        func(string)
 	setKubernetesAuthInfoMutex       sync.RWMutex
 	setKubernetesAuthInfoArgsForCall []struct {
@@ -437,6 +452,11 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	SetUAAClientKeyFileStub        func(string)
+	setUAAClientKeyFileMutex       sync.RWMutex
+	setUAAClientKeyFileArgsForCall []struct {
+		arg1 string
+	}
 	SetUAAEndpointStub        func(string)
 	setUAAEndpointMutex       sync.RWMutex
 	setUAAEndpointArgsForCall []struct {
@@ -567,6 +587,16 @@ type FakeConfig struct {
 	uAAOAuthClientReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UAAOAuthClientKeyFileStub        func() string
+	uAAOAuthClientKeyFileMutex       sync.RWMutex
+	uAAOAuthClientKeyFileArgsForCall []struct {
+	}
+	uAAOAuthClientKeyFileReturns struct {
+		result1 string
+	}
+	uAAOAuthClientKeyFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAAOAuthClientSecretStub        func() string
 	uAAOAuthClientSecretMutex       sync.RWMutex
 	uAAOAuthClientSecretArgsForCall []struct {
@@ -1110,6 +1140,58 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) CredentialStore() string {
+	fake.credentialStoreMutex.Lock()
+	ret, specificReturn := fake.credentialStoreReturnsOnCall[len(fake.credentialStoreArgsForCall)]
+	fake.credentialStoreArgsForCall = append(fake.credentialStoreArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CredentialStore", []interface{}{})
+	fake.credentialStoreMutex.Unlock()
+	if fake.CredentialStoreStub != nil {
+		return fake.CredentialStoreStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.credentialStoreReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CredentialStoreCallCount() int {
+	fake.credentialStoreMutex.RLock()
+	defer fake.credentialStoreMutex.RUnlock()
+	return len(fake.credentialStoreArgsForCall)
+}
+
+func (fake *FakeConfig) CredentialStoreCalls(stub func() string) {
+	fake.credentialStoreMutex.Lock()
+	defer fake.credentialStoreMutex.Unlock()
+	fake.CredentialStoreStub = stub
+}
+
+func (fake *FakeConfig) CredentialStoreReturns(result1 string) {
+	fake.credentialStoreMutex.Lock()
+	defer fake.credentialStoreMutex.Unlock()
+	fake.CredentialStoreStub = nil
+	fake.credentialStoreReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CredentialStoreReturnsOnCall(i int, result1 string) {
+	fake.credentialStoreMutex.Lock()
+	defer fake.credentialStoreMutex.Unlock()
+	fake.CredentialStoreStub = nil
+	if fake.credentialStoreReturnsOnCall == nil {
+		fake.credentialStoreReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.credentialStoreReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -2562,6 +2644,37 @@ func (fake *FakeConfig) SetColorEnabledArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetCredentialStore(arg1 string) {
+	fake.setCredentialStoreMutex.Lock()
+	fake.setCredentialStoreArgsForCall = append(fake.setCredentialStoreArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetCredentialStore", []interface{}{arg1})
+	fake.setCredentialStoreMutex.Unlock()
+	if fake.SetCredentialStoreStub != nil {
+		fake.SetCredentialStoreStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetCredentialStoreCallCount() int {
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
+	return len(fake.setCredentialStoreArgsForCall)
+}
+
+func (fake *FakeConfig) SetCredentialStoreCalls(stub func(string)) {
+	fake.setCredentialStoreMutex.Lock()
+	defer fake.setCredentialStoreMutex.Unlock()
+	fake.SetCredentialStoreStub = stub
+}
+
+func (fake *FakeConfig) SetCredentialStoreArgsForCall(i int) string {
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
+	argsForCall := fake.setCredentialStoreArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetKubernetesAuthInfo(arg1 string) {
 	fake.setKubernetesAuthInfoMutex.Lock()
 	fake.setKubernetesAuthInfoArgsForCall = append(fake.setKubernetesAuthInfoArgsForCall, struct {
@@ -2878,6 +2991,37 @@ func (fake *FakeConfig) SetUAAClientCredentialsArgsForCall(i int) (string, strin
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) SetUAAClientKeyFile(arg1 string) {
+	fake.setUAAClientKeyFileMutex.Lock()
+	fake.setUAAClientKeyFileArgsForCall = append(fake.setUAAClientKeyFileArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetUAAClientKeyFile", []interface{}{arg1})
+	fake.setUAAClientKeyFileMutex.Unlock()
+	if fake.SetUAAClientKeyFileStub != nil {
+		fake.SetUAAClientKeyFileStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetUAAClientKeyFileCallCount() int {
+	fake.setUAAClientKeyFileMutex.RLock()
+	defer fake.setUAAClientKeyFileMutex.RUnlock()
+	return len(fake.setUAAClientKeyFileArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAClientKeyFileCalls(stub func(string)) {
+	fake.setUAAClientKeyFileMutex.Lock()
+	defer fake.setUAAClientKeyFileMutex.Unlock()
+	fake.SetUAAClientKeyFileStub = stub
+}
+
+func (fake *FakeConfig) SetUAAClientKeyFileArgsForCall(i int) string {
+	fake.setUAAClientKeyFileMutex.RLock()
+	defer fake.setUAAClientKeyFileMutex.RUnlock()
+	argsForCall := fake.setUAAClientKeyFileArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetUAAEndpoint(arg1 string) {
 	fake.setUAAEndpointMutex.Lock()
 	fake.setUAAEndpointArgsForCall = append(fake.setUAAEndpointArgsForCall, struct {
@@ -3564,6 +3708,58 @@ func (fake *FakeConfig) UAAOAuthClientReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) UAAOAuthClientKeyFile() string {
+	fake.uAAOAuthClientKeyFileMutex.Lock()
+	ret, specificReturn := fake.uAAOAuthClientKeyFileReturnsOnCall[len(fake.uAAOAuthClientKeyFileArgsForCall)]
+	fake.uAAOAuthClientKeyFileArgsForCall = append(fake.uAAOAuthClientKeyFileArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UAAOAuthClientKeyFile", []interface{}{})
+	fake.uAAOAuthClientKeyFileMutex.Unlock()
+	if fake.UAAOAuthClientKeyFileStub != nil {
+		return fake.UAAOAuthClientKeyFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uAAOAuthClientKeyFileReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UAAOAuthClientKeyFileCallCount() int {
+	fake.uAAOAuthClientKeyFileMutex.RLock()
+	defer fake.uAAOAuthClientKeyFileMutex.RUnlock()
+	return len(fake.uAAOAuthClientKeyFileArgsForCall)
+}
+
+func (fake *FakeConfig) UAAOAuthClientKeyFileCalls(stub func() string) {
+	fake.uAAOAuthClientKeyFileMutex.Lock()
+	defer fake.uAAOAuthClientKeyFileMutex.Unlock()
+	fake.UAAOAuthClientKeyFileStub = stub
+}
+
+func (fake *FakeConfig) UAAOAuthClientKeyFileReturns(result1 string) {
+	fake.uAAOAuthClientKeyFileMutex.Lock()
+	defer fake.uAAOAuthClientKeyFileMutex.Unlock()
+	fake.UAAOAuthClientKeyFileStub = nil
+	fake.uAAOAuthClientKeyFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAOAuthClientKeyFileReturnsOnCall(i int, result1 string) {
+	fake.uAAOAuthClientKeyFileMutex.Lock()
+	defer fake.uAAOAuthClientKeyFileMutex.Unlock()
+	fake.UAAOAuthClientKeyFileStub = nil
+	if fake.uAAOAuthClientKeyFileReturnsOnCall == nil {
+		fake.uAAOAuthClientKeyFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uAAOAuthClientKeyFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAOAuthClientSecret() string {
 	fake.uAAOAuthClientSecretMutex.Lock()
 	ret, specificReturn := fake.uAAOAuthClientSecretReturnsOnCall[len(fake.uAAOAuthClientSecretArgsForCall)]
@@ -3899,6 +4095,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.cFUsernameMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.credentialStoreMutex.RLock()
+	defer fake.credentialStoreMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
@@ -3957,6 +4155,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setAsyncTimeoutMutex.RUnlock()
 	fake.setColorEnabledMutex.RLock()
 	defer fake.setColorEnabledMutex.RUnlock()
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
 	fake.setKubernetesAuthInfoMutex.RLock()
 	defer fake.setKubernetesAuthInfoMutex.RUnlock()
 	fake.setLocaleMutex.RLock()
@@ -3977,6 +4177,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setTraceMutex.RUnlock()
 	fake.setUAAClientCredentialsMutex.RLock()
 	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	fake.setUAAClientKeyFileMutex.RLock()
+	defer fake.setUAAClientKeyFileMutex.RUnlock()
 	fake.setUAAEndpointMutex.RLock()
 	defer fake.setUAAEndpointMutex.RUnlock()
 	fake.setUAAGrantTypeMutex.RLock()
@@ -4005,6 +4207,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.uAAOAuthClientMutex.RLock()
 	defer fake.uAAOAuthClientMutex.RUnlock()
+	fake.uAAOAuthClientKeyFileMutex.RLock()
+	defer fake.uAAOAuthClientKeyFileMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
 	defer fake.uAAOAuthClientSecretMutex.RUnlock()
 	fake.unsetOrganizationAndSpaceInformationMutex.RLock()
@@ -16,10 +16,10 @@ var HelpCategoryList = []HelpCategory{
 			{"cancel-deployment"},
 			{"start", "stop", "restart", "stage-package", "restage", "restart-app-instance"},
 			{"run-task", "tasks", "terminate-task"},
-			{"packages", "create-package"},
-			{"droplets", "set-droplet", "download-droplet"},
+			{"packages", "create-package", "prune-packages"},
+			{"droplets", "set-droplet", "download-droplet", "inspect-droplet", "prune-droplets"},
 			{"events", "logs"},
-			{"env", "set-env", "unset-env"},
+			{"env", "set-env", "unset-env", "import-env", "export-env", "diff-env"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
@@ -58,13 +58,13 @@ var HelpCategoryList = []HelpCategory{
 			{"domains"},
 			{"create-private-domain", "delete-private-domain"},
 			{"create-shared-domain", "delete-shared-domain"},
-			{"router-groups"},
+			{"router-groups", "router-group-ports", "update-router-group"},
 		},
 	},
 	{
 		CategoryName: "ROUTES:",
 		CommandList: [][]string{
-			{"routes", "route"},
+			{"routes", "route", "route-health", "route-inventory"},
 			{"create-route", "check-route", "map-route", "unmap-route", "delete-route"},
 			{"delete-orphaned-routes"},
 			{"update-destination"},
@@ -97,6 +97,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"org-quotas", "org-quota", "set-org-quota"},
 			{"create-org-quota", "delete-org-quota", "update-org-quota"},
+			{"quota-usage"},
 			{"share-private-domain", "unshare-private-domain"},
 		},
 	},
@@ -112,8 +113,10 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "SERVICE ADMIN:",
 		CommandList: [][]string{
 			{"service-brokers", "create-service-broker", "update-service-broker", "delete-service-broker", "rename-service-broker"},
+			{"validate-service-broker"},
 			{"purge-service-offering", "purge-service-instance"},
 			{"service-access", "enable-service-access", "disable-service-access"},
+			{"service-access-matrix", "apply-service-access"},
 		},
 	},
 	{
@@ -146,12 +149,13 @@ var HelpCategoryList = []HelpCategory{
 		CategoryName: "METADATA:",
 		CommandList: [][]string{
 			{"labels", "set-label", "unset-label"},
+			{"annotations", "set-annotation", "unset-annotation"},
 		},
 	},
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
-			{"curl", "config", "oauth-token", "ssh-code"},
+			{"curl", "config", "oauth-token", "token-info", "ssh-code"},
 		},
 	},
 	{
@@ -172,7 +176,7 @@ var ExperimentalHelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "EXPERIMENTAL COMMANDS:",
 		CommandList: [][]string{
-			{"revisions", "revision", "rollback"},
+			{"revisions", "revision", "diff-revisions", "rollback"},
 		},
 	},
 }
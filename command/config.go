@@ -20,6 +20,7 @@ type Config interface {
 	CFPassword() string
 	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
+	CredentialStore() string
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
 	DialTimeout() time.Duration
@@ -47,6 +48,7 @@ type Config interface {
 	SetAsyncTimeout(timeout int)
 	SetAccessToken(token string)
 	SetColorEnabled(enabled string)
+	SetCredentialStore(name string)
 	SetLocale(locale string)
 	SetMinCLIVersion(version string)
 	SetOrganizationInformation(guid string, name string)
@@ -57,6 +59,7 @@ type Config interface {
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
 	SetTrace(trace string)
 	SetUAAClientCredentials(client string, clientSecret string)
+	SetUAAClientKeyFile(keyFile string)
 	SetUAAEndpoint(uaaEndpoint string)
 	SetUAAGrantType(uaaGrantType string)
 	SkipSSLValidation() bool
@@ -73,6 +76,7 @@ type Config interface {
 	UAAEndpoint() string
 	UAAGrantType() string
 	UAAOAuthClient() string
+	UAAOAuthClientKeyFile() string
 	UAAOAuthClientSecret() string
 	UnsetOrganizationAndSpaceInformation()
 	UnsetSpaceInformation()
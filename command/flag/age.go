@@ -0,0 +1,40 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Age is a positive duration given in days (e.g. 30d) or in any unit
+// understood by time.ParseDuration (e.g. 12h).
+type Age struct {
+	Value time.Duration
+}
+
+func (a *Age) UnmarshalFlag(val string) error {
+	var (
+		duration time.Duration
+		err      error
+	)
+
+	if days := strings.TrimSuffix(val, "d"); days != val {
+		var count int64
+		count, err = strconv.ParseInt(days, 10, 64)
+		duration = time.Duration(count) * 24 * time.Hour
+	} else {
+		duration, err = time.ParseDuration(val)
+	}
+
+	if err != nil || duration <= 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "Age must be a positive duration such as 30d or 12h",
+		}
+	}
+
+	a.Value = duration
+	return nil
+}
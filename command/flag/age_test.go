@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Age", func() {
+	var age Age
+
+	BeforeEach(func() {
+		age = Age{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("accepts positive durations",
+			func(input string, expected time.Duration) {
+				err := age.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(age.Value).To(Equal(expected))
+			},
+			Entry("days", "30d", 30*24*time.Hour),
+			Entry("hours", "12h", 12*time.Hour),
+			Entry("mixed units", "1h30m", 90*time.Minute),
+		)
+
+		DescribeTable("rejects anything else",
+			func(input string) {
+				err := age.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Age must be a positive duration such as 30d or 12h",
+				}))
+				Expect(age.Value).To(BeZero())
+			},
+			Entry("no unit", "30"),
+			Entry("fractional days", "1.5d"),
+			Entry("zero", "0d"),
+			Entry("negative", "-2h"),
+			Entry("garbage", "a week"),
+		)
+	})
+})
@@ -37,6 +37,10 @@ type ServiceOffering struct {
 	ServiceOffering string `positional-arg-name:"SERVICE_OFFERING" required:"true" description:"The service offering name"`
 }
 
+type ServiceAccessFileArgs struct {
+	PathToFile PathWithExistenceCheck `positional-arg-name:"FILE_PATH" required:"true" description:"Path to a YAML file of the desired access to service plans"`
+}
+
 type ServiceInstance struct {
 	ServiceInstance TrimmedString `positional-arg-name:"SERVICE_INSTANCE" required:"true" description:"The service instance name"`
 }
@@ -65,6 +69,10 @@ type Quota struct {
 	Quota string `positional-arg-name:"QUOTA" required:"true" description:"The organization quota"`
 }
 
+type RouterGroup struct {
+	RouterGroup string `positional-arg-name:"ROUTER_GROUP" required:"true" description:"The router group"`
+}
+
 type SecurityGroup struct {
 	SecurityGroup string `positional-arg-name:"SECURITY_GROUP" required:"true" description:"The security group"`
 }
@@ -187,6 +195,21 @@ type UnsetEnvironmentArgs struct {
 	EnvironmentVariableName string `positional-arg-name:"ENV_VAR_NAME" required:"true" description:"The environment variable name"`
 }
 
+type ImportEnvironmentArgs struct {
+	AppName    string                 `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	PathToFile PathWithExistenceCheck `positional-arg-name:"FILE_PATH" required:"true" description:"Path to a .env or YAML file of env variables"`
+}
+
+type ExportEnvironmentArgs struct {
+	AppName    string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	PathToFile string `positional-arg-name:"FILE_PATH" required:"true" description:"Path to write the env variables to"`
+}
+
+type DiffEnvironmentArgs struct {
+	AppName      string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	OtherAppName string `positional-arg-name:"OTHER_APP_NAME" required:"true" description:"The name of the application to compare with"`
+}
+
 type CopySourceArgs struct {
 	SourceAppName string `positional-arg-name:"SOURCE-APP" required:"true" description:"The old application name"`
 	TargetAppName string `positional-arg-name:"TARGET-NAME" required:"true" description:"The new application name"`
@@ -278,16 +301,34 @@ type LabelsArgs struct {
 }
 
 type SetLabelArgs struct {
-	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource to label"`
-	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
-	Labels       []string `positional-arg-name:"KEY=VALUE" required:"true" description:"A space-separated list of labels to set on the resource"`
+	ResourceType string            `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource to label"`
+	ResourceName LabelResourceName `positional-arg-name:"RESOURCE_NAME" description:"The name of the resource"`
+	Labels       []string          `positional-arg-name:"KEY=VALUE" description:"A space-separated list of labels to set on the resource"`
 }
 
 type UnsetLabelArgs struct {
-	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource"`
+	ResourceType string            `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource"`
+	ResourceName LabelResourceName `positional-arg-name:"RESOURCE_NAME" description:"The name of the resource"`
+	LabelKeys    []string          `positional-arg-name:"KEY" description:"A label to unset on the resource"`
+}
+
+type AnnotationsArgs struct {
+	ResourceType string `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource"`
+	ResourceName string `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+}
+
+type SetAnnotationArgs struct {
+	ResourceType string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource to annotate"`
 	ResourceName string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
-	LabelKeys    []string `positional-arg-name:"KEY" required:"true" description:"A label to unset on the resource"`
+	Annotations  []string `positional-arg-name:"KEY=VALUE" required:"true" description:"A space-separated list of annotations to set on the resource"`
 }
+
+type UnsetAnnotationArgs struct {
+	ResourceType   string   `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource"`
+	ResourceName   string   `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	AnnotationKeys []string `positional-arg-name:"KEY" required:"true" description:"An annotation to unset on the resource"`
+}
+
 type OrgRoleArgs struct {
 	Username     string  `positional-arg-name:"USERNAME" required:"true" description:"The user"`
 	Organization string  `positional-arg-name:"ORG" required:"true" description:"The organization"`
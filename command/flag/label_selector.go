@@ -0,0 +1,159 @@
+package flag
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+const (
+	maxLabelNameLength   = 63
+	maxLabelPrefixLength = 253
+)
+
+var (
+	labelNameRegexp    = regexp.MustCompile(`^[a-zA-Z0-9]([-_.a-zA-Z0-9]*[a-zA-Z0-9])?$`)
+	labelPrefixRegexp  = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	setOperationRegexp = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+)
+
+// LabelSelector is a label selector as accepted by the Cloud Controller's
+// label_selector query parameter, e.g. "env=prod,tier in (web,worker),!legacy".
+// The syntax is checked when the flag is parsed so that typos are reported
+// before any request is made.
+type LabelSelector string
+
+func (s *LabelSelector) UnmarshalFlag(val string) error {
+	if err := validateLabelSelector(val); err != nil {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Invalid label selector '%s': %s", val, err),
+		}
+	}
+
+	*s = LabelSelector(val)
+	return nil
+}
+
+func validateLabelSelector(selector string) error {
+	requirements, err := splitLabelSelector(selector)
+	if err != nil {
+		return err
+	}
+
+	for _, requirement := range requirements {
+		if err := validateLabelRequirement(strings.TrimSpace(requirement)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitLabelSelector splits a selector on the commas that separate
+// requirements, leaving the commas inside "in (...)" value sets alone.
+func splitLabelSelector(selector string) ([]string, error) {
+	var (
+		requirements []string
+		depth        int
+		start        int
+	)
+
+	for i, char := range selector {
+		switch char {
+		case '(':
+			depth++
+			if depth > 1 {
+				return nil, fmt.Errorf("nested parentheses are not allowed")
+			}
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unexpected ')'")
+			}
+		case ',':
+			if depth == 0 {
+				requirements = append(requirements, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("missing ')'")
+	}
+
+	return append(requirements, selector[start:]), nil
+}
+
+func validateLabelRequirement(requirement string) error {
+	if requirement == "" {
+		return fmt.Errorf("empty requirement")
+	}
+
+	if matches := setOperationRegexp.FindStringSubmatch(requirement); matches != nil {
+		if err := validateLabelKey(matches[1]); err != nil {
+			return err
+		}
+		if strings.TrimSpace(matches[3]) == "" {
+			return fmt.Errorf("no values given for '%s %s'", matches[1], matches[2])
+		}
+		for _, value := range strings.Split(matches[3], ",") {
+			if err := validateLabelValue(strings.TrimSpace(value)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if strings.ContainsAny(requirement, "()") {
+		return fmt.Errorf("'%s' is not a valid requirement; use 'KEY in (VALUE,...)' or 'KEY notin (VALUE,...)'", requirement)
+	}
+
+	if strings.HasPrefix(requirement, "!") {
+		return validateLabelKey(strings.TrimSpace(requirement[1:]))
+	}
+
+	for _, operator := range []string{"!=", "==", "="} {
+		if parts := strings.SplitN(requirement, operator, 2); len(parts) == 2 {
+			if err := validateLabelKey(strings.TrimSpace(parts[0])); err != nil {
+				return err
+			}
+			return validateLabelValue(strings.TrimSpace(parts[1]))
+		}
+	}
+
+	return validateLabelKey(requirement)
+}
+
+func validateLabelKey(key string) error {
+	name := key
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		prefix := key[:i]
+		name = key[i+1:]
+
+		if len(prefix) == 0 || len(prefix) > maxLabelPrefixLength || !labelPrefixRegexp.MatchString(prefix) {
+			return fmt.Errorf("'%s' is not a valid key prefix; it must be a DNS subdomain of at most %d characters", prefix, maxLabelPrefixLength)
+		}
+	}
+
+	if len(name) == 0 || len(name) > maxLabelNameLength || !labelNameRegexp.MatchString(name) {
+		return fmt.Errorf("'%s' is not a valid key; keys must be at most %d alphanumeric characters, '-', '_' or '.', beginning and ending with an alphanumeric character", key, maxLabelNameLength)
+	}
+
+	return nil
+}
+
+func validateLabelValue(value string) error {
+	if value == "" {
+		return nil
+	}
+
+	if len(value) > maxLabelNameLength || !labelNameRegexp.MatchString(value) {
+		return fmt.Errorf("'%s' is not a valid value; values must be at most %d alphanumeric characters, '-', '_' or '.', beginning and ending with an alphanumeric character", value, maxLabelNameLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LabelSelectorScope string
+
+const (
+	SpaceLabelSelectorScope LabelSelectorScope = "space"
+	OrgLabelSelectorScope   LabelSelectorScope = "org"
+	AllLabelSelectorScope   LabelSelectorScope = "all"
+)
+
+func (LabelSelectorScope) Complete(prefix string) []flags.Completion {
+	return completions([]string{string(SpaceLabelSelectorScope), string(OrgLabelSelectorScope), string(AllLabelSelectorScope)}, prefix, false)
+}
+
+func (s *LabelSelectorScope) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch LabelSelectorScope(valLower) {
+	case SpaceLabelSelectorScope, OrgLabelSelectorScope, AllLabelSelectorScope:
+		*s = LabelSelectorScope(valLower)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SCOPE must be "space", "org" or "all"`,
+		}
+	}
+	return nil
+}
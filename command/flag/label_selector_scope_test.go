@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelSelectorScope", func() {
+	var scope LabelSelectorScope
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := scope.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+
+			Entry("completes to 'space' when passed 's'", "s",
+				[]flags.Completion{{Item: "space"}}),
+			Entry("completes to 'org' when passed 'O'", "O",
+				[]flags.Completion{{Item: "org"}}),
+			Entry("returns all scopes when passed nothing", "",
+				[]flags.Completion{{Item: "space"}, {Item: "org"}, {Item: "all"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			scope = ""
+		})
+
+		DescribeTable("downcases and sets the scope",
+			func(input string, expected LabelSelectorScope) {
+				err := scope.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(scope).To(Equal(expected))
+			},
+			Entry("sets 'space' when passed 'space'", "space", SpaceLabelSelectorScope),
+			Entry("sets 'org' when passed 'Org'", "Org", OrgLabelSelectorScope),
+			Entry("sets 'all' when passed 'ALL'", "ALL", AllLabelSelectorScope),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := scope.UnmarshalFlag("foundation")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `SCOPE must be "space", "org" or "all"`,
+				}))
+				Expect(scope).To(BeEmpty())
+			})
+		})
+	})
+})
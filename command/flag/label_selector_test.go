@@ -0,0 +1,59 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelSelector", func() {
+	var selector LabelSelector
+
+	BeforeEach(func() {
+		selector = ""
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("accepts valid selectors",
+			func(input string) {
+				err := selector.UnmarshalFlag(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(selector).To(Equal(LabelSelector(input)))
+			},
+			Entry("existence", "env"),
+			Entry("non-existence", "!env"),
+			Entry("equality", "env=prod"),
+			Entry("double equality", "env==prod"),
+			Entry("inequality", "env!=prod"),
+			Entry("empty value", "env="),
+			Entry("set inclusion", "env in (prod,staging)"),
+			Entry("set exclusion", "env notin (prod, staging)"),
+			Entry("prefixed keys", "example.com/team=payments"),
+			Entry("multiple requirements", "environment in (production,staging),tier in (backend),!chargeback-code,version=1.2_3"),
+		)
+
+		DescribeTable("rejects invalid selectors",
+			func(input string, message string) {
+				err := selector.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "Invalid label selector '" + input + "': " + message,
+				}))
+				Expect(selector).To(BeEmpty())
+			},
+			Entry("empty selector", "", "empty requirement"),
+			Entry("trailing comma", "env=prod,", "empty requirement"),
+			Entry("missing closing parenthesis", "env in (prod,staging", "missing ')'"),
+			Entry("unexpected closing parenthesis", "env=prod)", "unexpected ')'"),
+			Entry("nested parentheses", "env in ((prod))", "nested parentheses are not allowed"),
+			Entry("empty value set", "env in ()", "no values given for 'env in'"),
+			Entry("unknown set operator", "env within (prod)", "'env within (prod)' is not a valid requirement; use 'KEY in (VALUE,...)' or 'KEY notin (VALUE,...)'"),
+			Entry("missing key", "=prod", "'' is not a valid key; keys must be at most 63 alphanumeric characters, '-', '_' or '.', beginning and ending with an alphanumeric character"),
+			Entry("invalid key characters", "env*=prod", "'env*' is not a valid key; keys must be at most 63 alphanumeric characters, '-', '_' or '.', beginning and ending with an alphanumeric character"),
+			Entry("invalid key prefix", "Example.com/team=payments", "'Example.com' is not a valid key prefix; it must be a DNS subdomain of at most 253 characters"),
+			Entry("invalid value", "env=-prod", "'-prod' is not a valid value; values must be at most 63 alphanumeric characters, '-', '_' or '.', beginning and ending with an alphanumeric character"),
+			Entry("invalid set value", "env in (prod,sta ging)", "'sta ging' is not a valid value; values must be at most 63 alphanumeric characters, '-', '_' or '.', beginning and ending with an alphanumeric character"),
+		)
+	})
+})
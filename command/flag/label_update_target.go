@@ -0,0 +1,43 @@
+package flag
+
+import "sync/atomic"
+
+// labelArgumentOrder numbers the RESOURCE_NAME and --selector values of
+// set-label and unset-label in the order they are parsed, since the parser
+// does not keep the position of options relative to positional arguments.
+var labelArgumentOrder int64
+
+// LabelResourceName is the RESOURCE_NAME argument of set-label and
+// unset-label. With --selector, the positional arguments that follow the
+// selector are labels, so a name given before --selector names a resource.
+type LabelResourceName struct {
+	Name  string
+	order int64
+}
+
+func (n *LabelResourceName) UnmarshalFlag(val string) error {
+	n.Name = val
+	n.order = atomic.AddInt64(&labelArgumentOrder, 1)
+	return nil
+}
+
+// GivenBefore returns true if the name was parsed before the selector.
+func (n LabelResourceName) GivenBefore(selector LabelUpdateSelector) bool {
+	return n.order != 0 && selector.order != 0 && n.order < selector.order
+}
+
+// LabelUpdateSelector is the --selector option of set-label and unset-label.
+type LabelUpdateSelector struct {
+	Selector LabelSelector
+	order    int64
+}
+
+func (s *LabelUpdateSelector) UnmarshalFlag(val string) error {
+	err := s.Selector.UnmarshalFlag(val)
+	if err != nil {
+		return err
+	}
+
+	s.order = atomic.AddInt64(&labelArgumentOrder, 1)
+	return nil
+}
@@ -0,0 +1,73 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelResourceName", func() {
+	var command struct {
+		Args struct {
+			ResourceType string            `positional-arg-name:"RESOURCE"`
+			ResourceName LabelResourceName `positional-arg-name:"RESOURCE_NAME"`
+			LabelKeys    []string          `positional-arg-name:"KEY"`
+		} `positional-args:"yes"`
+		Selector LabelUpdateSelector `long:"selector"`
+	}
+
+	BeforeEach(func() {
+		command.Args.ResourceType = ""
+		command.Args.ResourceName = LabelResourceName{}
+		command.Args.LabelKeys = nil
+		command.Selector = LabelUpdateSelector{}
+	})
+
+	Describe("GivenBefore", func() {
+		DescribeTable("reports whether the name was given before the selector",
+			func(args []string, expected bool) {
+				_, err := flags.ParseArgs(&command, args)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(command.Args.ResourceName.GivenBefore(command.Selector)).To(Equal(expected))
+			},
+			Entry("name before the selector", []string{"app", "my-app", "--selector", "env=dev", "k"}, true),
+			Entry("selector before the name", []string{"app", "--selector", "env=dev", "k1", "k2"}, false),
+			Entry("selector first", []string{"--selector", "env=dev", "app", "k1"}, false),
+			Entry("no selector", []string{"app", "my-app", "k"}, false),
+			Entry("only a selector", []string{"app", "--selector", "env=dev"}, false),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("sets the name", func() {
+			var name LabelResourceName
+			Expect(name.UnmarshalFlag("my-app")).To(Succeed())
+			Expect(name.Name).To(Equal("my-app"))
+		})
+	})
+})
+
+var _ = Describe("LabelUpdateSelector", func() {
+	var selector LabelUpdateSelector
+
+	BeforeEach(func() {
+		selector = LabelUpdateSelector{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("sets a valid selector", func() {
+			Expect(selector.UnmarshalFlag("env=dev")).To(Succeed())
+			Expect(selector.Selector).To(Equal(LabelSelector("env=dev")))
+		})
+
+		It("rejects an invalid selector", func() {
+			err := selector.UnmarshalFlag("env=prod,")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: "Invalid label selector 'env=prod,': empty requirement",
+			}))
+			Expect(selector.Selector).To(BeEmpty())
+		})
+	})
+})
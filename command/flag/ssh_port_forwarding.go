@@ -16,30 +16,88 @@ type SSHPortForwarding struct {
 }
 
 func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
+	bindAddress, targetAddress, err := parseForwardingSpecification(val, "local")
+	if err != nil {
+		return err
+	}
+
+	s.LocalAddress = bindAddress
+	s.RemoteAddress = targetAddress
+	return nil
+}
+
+// SSHRemotePortForwarding is a remote port forward specification of the form
+// [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT. Connections made to the
+// remote address inside the container are forwarded to the local address.
+type SSHRemotePortForwarding struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+func (s *SSHRemotePortForwarding) UnmarshalFlag(val string) error {
+	bindAddress, targetAddress, err := parseForwardingSpecification(val, "remote")
+	if err != nil {
+		return err
+	}
+
+	s.RemoteAddress = bindAddress
+	s.LocalAddress = targetAddress
+	return nil
+}
+
+// SSHDynamicPortForwarding is a dynamic port forward specification of the
+// form [BIND_ADDRESS:]PORT. A SOCKS proxy is started on the local address.
+type SSHDynamicPortForwarding struct {
+	LocalAddress string
+}
+
+func (s *SSHDynamicPortForwarding) UnmarshalFlag(val string) error {
 	splitHosts := strings.Split(val, ":")
 	for _, piece := range splitHosts {
 		if len(piece) == 0 {
-			return &flags.Error{
-				Type:    flags.ErrRequired,
-				Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
-			}
+			return badForwardingSpecification(val, "dynamic")
 		}
 	}
 
 	re := regexp.MustCompile(`^\d+$`)
 	switch {
-	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
+	case len(splitHosts) == 1 && re.MatchString(splitHosts[0]):
 		s.LocalAddress = fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2])
-	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
+	case len(splitHosts) == 2 && re.MatchString(splitHosts[1]):
 		s.LocalAddress = fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3])
 	default:
-		return &flags.Error{
-			Type:    flags.ErrRequired,
-			Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
-		}
+		return badForwardingSpecification(val, "dynamic")
 	}
 
 	return nil
 }
+
+func parseForwardingSpecification(val string, kind string) (string, string, error) {
+	splitHosts := strings.Split(val, ":")
+	for _, piece := range splitHosts {
+		if len(piece) == 0 {
+			return "", "", badForwardingSpecification(val, kind)
+		}
+	}
+
+	re := regexp.MustCompile(`^\d+$`)
+	switch {
+	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
+		return fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0]),
+			fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2]),
+			nil
+	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
+		return fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1]),
+			fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3]),
+			nil
+	default:
+		return "", "", badForwardingSpecification(val, kind)
+	}
+}
+
+func badForwardingSpecification(val string, kind string) error {
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: fmt.Sprintf("Bad %s forwarding specification '%s'", kind, val),
+	}
+}
@@ -59,4 +59,90 @@ var _ = Describe("SpaceRole", func() {
 			Entry("[explicit localhost] incorrect port numbers for fourth value", "localhost:8080:AM:bar"),
 		)
 	})
+
+	Describe("SSHRemotePortForwarding", func() {
+		var remoteForward SSHRemotePortForwarding
+
+		BeforeEach(func() {
+			remoteForward = SSHRemotePortForwarding{}
+		})
+
+		When("passed remote_port:local:local_port", func() {
+			It("binds the remote port on localhost", func() {
+				err := remoteForward.UnmarshalFlag("9999:local:5432")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(remoteForward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "localhost:9999",
+					LocalAddress:  "local:5432",
+				}))
+			})
+		})
+
+		When("passed remote:remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := remoteForward.UnmarshalFlag("0.0.0.0:9999:local:5432")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(remoteForward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "0.0.0.0:9999",
+					LocalAddress:  "local:5432",
+				}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := remoteForward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad remote forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("no colons", "IAMABANANA909009009"),
+			Entry("too many colons", "I:AM:A:BANANA:909009009"),
+			Entry("incorrect port numbers", "remote:foo:local:8888"),
+		)
+	})
+
+	Describe("SSHDynamicPortForwarding", func() {
+		var dynamicForward SSHDynamicPortForwarding
+
+		BeforeEach(func() {
+			dynamicForward = SSHDynamicPortForwarding{}
+		})
+
+		When("passed port", func() {
+			It("binds the port on localhost", func() {
+				err := dynamicForward.UnmarshalFlag("1080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dynamicForward).To(Equal(SSHDynamicPortForwarding{
+					LocalAddress: "localhost:1080",
+				}))
+			})
+		})
+
+		When("passed bind_address:port", func() {
+			It("extracts the local address", func() {
+				err := dynamicForward.UnmarshalFlag("127.0.0.1:1080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dynamicForward).To(Equal(SSHDynamicPortForwarding{
+					LocalAddress: "127.0.0.1:1080",
+				}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := dynamicForward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("not a port", "potato"),
+			Entry("too many colons", "a:b:1080"),
+			Entry("empty values", "localhost:"),
+		)
+	})
 })
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	"code.cloudfoundry.org/cli/api/servicebroker/servicebrokererror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"code.cloudfoundry.org/cli/util/download"
@@ -210,6 +211,14 @@ func ConvertToTranslatableError(err error) error {
 	case pluginerror.UnverifiedServerError:
 		return DownloadPluginHTTPError{Message: e.Error()}
 
+	// Service Broker Catalog Errors
+	case servicebrokererror.RawHTTPStatusError:
+		return GetServiceBrokerCatalogError{Message: e.Status}
+	case servicebrokererror.InvalidCatalogError:
+		return GetServiceBrokerCatalogError{Message: e.Error()}
+	case servicebrokererror.UnverifiedServerError:
+		return InvalidSSLCertError{URL: e.URL, SuggestedCommand: "api"}
+
 	// SSH Errors
 	case ssherror.UnableToAuthenticateError:
 		return SSHUnableToAuthenticateError{}
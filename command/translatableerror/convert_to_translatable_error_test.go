@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	"code.cloudfoundry.org/cli/api/servicebroker/servicebrokererror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	. "code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
@@ -416,6 +417,20 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			DownloadPluginHTTPError{Message: "x509: certificate signed by unknown authority"},
 		),
 
+		// Service Broker Catalog Errors
+		Entry("servicebrokererror.RawHTTPStatusError -> GetServiceBrokerCatalogError",
+			servicebrokererror.RawHTTPStatusError{Status: "some status"},
+			GetServiceBrokerCatalogError{Message: "some status"},
+		),
+		Entry("servicebrokererror.InvalidCatalogError -> GetServiceBrokerCatalogError",
+			servicebrokererror.InvalidCatalogError{Message: "some message"},
+			GetServiceBrokerCatalogError{Message: "invalid catalog: some message"},
+		),
+		Entry("servicebrokererror.UnverifiedServerError -> InvalidSSLCertError",
+			servicebrokererror.UnverifiedServerError{URL: "some URL"},
+			InvalidSSLCertError{URL: "some URL", SuggestedCommand: "api"},
+		),
+
 		// SSH Error
 		Entry("ssherror.UnableToAuthenticateError -> UnableToAuthenticateError",
 			ssherror.UnableToAuthenticateError{},
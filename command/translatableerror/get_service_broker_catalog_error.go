@@ -0,0 +1,15 @@
+package translatableerror
+
+type GetServiceBrokerCatalogError struct {
+	Message string
+}
+
+func (GetServiceBrokerCatalogError) Error() string {
+	return "Getting the service broker catalog failed: {{.ErrorMessage}}"
+}
+
+func (e GetServiceBrokerCatalogError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ErrorMessage": e.Message,
+	})
+}
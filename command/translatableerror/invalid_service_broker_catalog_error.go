@@ -0,0 +1,15 @@
+package translatableerror
+
+type InvalidServiceBrokerCatalogError struct {
+	Errors int
+}
+
+func (InvalidServiceBrokerCatalogError) Error() string {
+	return "The service broker catalog has {{.Errors}} error(s) that Cloud Foundry would reject."
+}
+
+func (e InvalidServiceBrokerCatalogError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Errors": e.Errors,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+// QuotaCheckFailedError is returned when a push or scale would take the org or
+// space over a quota limit. Flag is the flag that skips the check.
+type QuotaCheckFailedError struct {
+	Command string
+	Flag    string
+}
+
+func (QuotaCheckFailedError) Error() string {
+	return "The {{.Command}} would exceed the quota limits above. Use '{{.Flag}}' to {{.Command}} anyway."
+}
+
+func (e QuotaCheckFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": e.Command,
+		"Flag":    e.Flag,
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/servicebroker"
 	uaa "code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/resources"
@@ -27,6 +28,7 @@ type Actor interface {
 	Authenticate(credentials map[string]string, origin string, grantType uaa.GrantType) error
 	BindSecurityGroupToSpaces(securityGroupGUID string, spaces []resources.Space, lifecycle constant.SecurityGroupLifecycle) (v7action.Warnings, error)
 	CancelDeployment(deploymentGUID string) (v7action.Warnings, error)
+	CheckQuotaChange(orgGUID string, spaceGUID string, change v7action.QuotaChange) (v7action.QuotaCheck, v7action.Warnings, error)
 	CheckRoute(domainName string, hostname string, path string, port int) (bool, v7action.Warnings, error)
 	ClearTarget()
 	CopyPackage(sourceApp resources.Application, targetApp resources.Application) (resources.Package, v7action.Warnings, error)
@@ -57,6 +59,7 @@ type Actor interface {
 	CreateSpaceRole(roleType constant.RoleType, orgGUID string, spaceGUID string, userNameOrGUID string, userOrigin string, isClient bool) (v7action.Warnings, error)
 	CreateUser(username string, password string, origin string) (resources.User, v7action.Warnings, error)
 	CreateUserProvidedServiceInstance(instance resources.ServiceInstance) (v7action.Warnings, error)
+	DecodeToken(token string) (v7action.TokenInfo, error)
 	DeleteApplicationByNameAndSpace(name, spaceGUID string, deleteRoutes bool) (v7action.Warnings, error)
 	DeleteBuildpackByNameAndStack(buildpackName string, buildpackStack string) (v7action.Warnings, error)
 	DeleteDomain(domain resources.Domain) (v7action.Warnings, error)
@@ -66,6 +69,7 @@ type Actor interface {
 	DeleteOrganizationQuota(quotaName string) (v7action.Warnings, error)
 	DeleteOrphanedRoutes(spaceGUID string) (v7action.Warnings, error)
 	DeleteRoute(domainName, hostname, path string, port int) (v7action.Warnings, error)
+	DeleteRoutes(routeGUIDs []string) (v7action.Warnings, error)
 	DeleteRouteBinding(params v7action.DeleteRouteBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	DeleteSecurityGroup(securityGroupName string) (v7action.Warnings, error)
 	DeleteServiceAppBinding(params v7action.DeleteServiceAppBindingParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
@@ -75,9 +79,13 @@ type Actor interface {
 	DeleteSpaceByNameAndOrganizationName(spaceName string, orgName string) (v7action.Warnings, error)
 	DeleteSpaceQuotaByName(quotaName string, orgGUID string) (v7action.Warnings, error)
 	DeleteSpaceRole(roleType constant.RoleType, spaceGUID string, userNameOrGUID string, userOrigin string, isClient bool) (v7action.Warnings, error)
+	DeleteStaleArtifact(artifact v7action.StaleArtifact) (v7action.Warnings, error)
 	DeleteUser(userGuid string) (v7action.Warnings, error)
 	DeleteIsolationSegmentByName(name string) (v7action.Warnings, error)
 	DeleteIsolationSegmentOrganizationByName(isolationSegmentName string, orgName string) (v7action.Warnings, error)
+	DiffDropletArchives(original v7action.DropletArchive, other v7action.DropletArchive) []v7action.DropletFileDiff
+	DiffEnvironmentVariableGroups(original v7action.EnvironmentVariableGroups, other v7action.EnvironmentVariableGroups) v7action.EnvironmentVariableGroupsDiff
+	DiffServiceBrokerCatalog(serviceBrokerName string, catalog servicebroker.Catalog) (v7action.ServiceBrokerCatalogDiff, v7action.Warnings, error)
 	DiffSpaceManifest(spaceGUID string, rawManifest []byte) (resources.ManifestDiff, v7action.Warnings, error)
 	DisableFeatureFlag(flagName string) (v7action.Warnings, error)
 	DisableServiceAccess(offeringName, brokerName, orgName, planName string) (v7action.SkippedPlans, v7action.Warnings, error)
@@ -86,24 +94,31 @@ type Actor interface {
 	EnableFeatureFlag(flagName string) (v7action.Warnings, error)
 	EnableServiceAccess(offeringName, brokerName, orgName, planName string) (v7action.SkippedPlans, v7action.Warnings, error)
 	EntitleIsolationSegmentToOrganizationByName(isolationSegmentName string, orgName string) (v7action.Warnings, error)
+	ExtractDropletFiles(rawDroplet []byte, patterns []string, destination string) ([]string, error)
 	GetAppFeature(appGUID string, featureName string) (resources.ApplicationFeature, v7action.Warnings, error)
+	GetApplicationAnnotations(appName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetAppSummariesForSpace(spaceGUID string, labels string, omitStats bool) ([]v7action.ApplicationSummary, v7action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	GetApplicationMapForRoute(route resources.Route) (map[string]resources.Application, v7action.Warnings, error)
 	GetApplicationDroplets(appName string, spaceGUID string) ([]resources.Droplet, v7action.Warnings, error)
+	GetApplicationDropletsWithLabelSelector(appName string, spaceGUID string, labelSelector string) ([]resources.Droplet, v7action.Warnings, error)
 	GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetApplicationPackages(appName string, spaceGUID string) ([]resources.Package, v7action.Warnings, error)
 	GetApplicationProcessHealthChecksByNameAndSpace(appName string, spaceGUID string) ([]v7action.ProcessHealthCheck, v7action.Warnings, error)
+	GetApplicationQuotaChange(app resources.Application, processes []resources.Process, started bool) (v7action.QuotaChange, v7action.Warnings, error)
 	GetApplicationRevisionsDeployed(appGUID string) ([]resources.Revision, v7action.Warnings, error)
 	GetApplicationRoutes(appGUID string) ([]resources.Route, v7action.Warnings, error)
 	GetApplicationTasks(appName string, sortOrder v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
 	GetApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, v7action.Warnings, error)
+	GetBuildpackAnnotations(buildpackName string, buildpackStack string) (map[string]types.NullString, v7action.Warnings, error)
 	GetBuildpackLabels(buildpackName string, buildpackStack string) (map[string]types.NullString, v7action.Warnings, error)
 	GetBuildpacks(labelSelector string) ([]resources.Buildpack, v7action.Warnings, error)
+	GetCurrentRevision(appGUID string) (resources.Revision, v7action.Warnings, error)
 	GetCurrentUser() (configv3.User, error)
 	GetDefaultDomain(orgGUID string) (resources.Domain, v7action.Warnings, error)
 	GetDetailedAppSummary(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.DetailedApplicationSummary, v7action.Warnings, error)
 	GetDomain(domainGUID string) (resources.Domain, v7action.Warnings, error)
+	GetDomainAnnotations(domainName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetDomainByName(domainName string) (resources.Domain, v7action.Warnings, error)
 	GetDomainLabels(domainName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (resources.IsolationSegment, v7action.Warnings, error)
@@ -119,48 +134,66 @@ type Actor interface {
 	GetLatestActiveDeploymentForApp(appGUID string) (resources.Deployment, v7action.Warnings, error)
 	GetLoginPrompts() (map[string]coreconfig.AuthPrompt, error)
 	GetNewestReadyPackageForApplication(app resources.Application) (resources.Package, v7action.Warnings, error)
+	GetOrganizationAnnotations(orgName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetOrgUsersByRoleType(orgGUID string) (map[constant.RoleType][]resources.User, v7action.Warnings, error)
 	GetOrganizationByName(orgName string) (resources.Organization, v7action.Warnings, error)
 	GetOrganizationDomains(string, string) ([]resources.Domain, v7action.Warnings, error)
 	GetOrganizationLabels(orgName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetOrganizationQuotaByName(orgQuotaName string) (resources.OrganizationQuota, v7action.Warnings, error)
 	GetOrganizationQuotas() ([]resources.OrganizationQuota, v7action.Warnings, error)
+	GetOrganizationQuotaUsage(orgGUID string) (v7action.OrganizationQuotaUsage, v7action.Warnings, error)
 	GetOrganizationSpaces(orgGUID string) ([]resources.Space, v7action.Warnings, error)
 	GetOrganizationSpacesWithLabelSelector(orgGUID string, labelSelector string) ([]resources.Space, v7action.Warnings, error)
 	GetOrganizationSummaryByName(orgName string) (v7action.OrganizationSummary, v7action.Warnings, error)
 	GetOrganizations(labelSelector string) ([]resources.Organization, v7action.Warnings, error)
+	GetOrganizationsQuotaUsage() ([]v7action.QuotaUsageReport, v7action.Warnings, error)
 	GetProcessByTypeAndApplication(processType string, appGUID string) (resources.Process, v7action.Warnings, error)
 	GetRawApplicationManifestByNameAndSpace(appName string, spaceGUID string) ([]byte, v7action.Warnings, error)
 	GetRecentEventsByApplicationNameAndSpace(appName string, spaceGUID string) ([]v7action.Event, v7action.Warnings, error)
 	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) ([]sharedaction.LogMessage, v7action.Warnings, error)
 	GetRootResponse() (v7action.Info, v7action.Warnings, error)
+	GetResourcesByLabelSelector(resourceType string, labelSelector string, scope v7action.LabelSelectorScope) ([]v7action.MetadataResource, v7action.Warnings, error)
 	GetRevisionByApplicationAndVersion(appGUID string, revisionVersion int) (resources.Revision, v7action.Warnings, error)
+	GetRevisionDetails(revision resources.Revision) (v7action.RevisionDetails, v7action.Warnings, error)
 	GetRevisionsByApplicationNameAndSpace(appName string, spaceGUID string) ([]resources.Revision, v7action.Warnings, error)
+	GetRoute(routePath string, spaceGUID string) (resources.Route, v7action.Warnings, error)
+	GetRouteAnnotations(routeName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetRouteByAttributes(domain resources.Domain, hostname string, path string, port int) (resources.Route, v7action.Warnings, error)
 	GetRouteDestinationByAppGUID(route resources.Route, appGUID string) (resources.RouteDestination, error)
+	GetRouteDestinationsHealth(route resources.Route) ([]v7action.RouteDestinationHealth, v7action.Warnings, error)
+	GetRouteInventory(orgGUID string) (v7action.RouteInventory, v7action.Warnings, error)
 	GetRouteLabels(routeName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetRouterGroupPortUsage(routerGroupName string) (v7action.RouterGroupPortUsage, v7action.Warnings, error)
 	GetRouterGroups() ([]v7action.RouterGroup, error)
 	GetRouteSummaries([]resources.Route) ([]v7action.RouteSummary, v7action.Warnings, error)
 	GetRoutesByOrg(orgGUID string, labels string) ([]resources.Route, v7action.Warnings, error)
 	GetRoutesBySpace(spaceGUID string, labels string) ([]resources.Route, v7action.Warnings, error)
+	GetServiceBrokerAnnotations(serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetServiceInstanceAnnotations(serviceInstanceName, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetServiceOfferingAnnotations(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetServicePlanAnnotations(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetSpaceAnnotations(spaceName string, orgGUID string) (map[string]types.NullString, v7action.Warnings, error)
 	GetSSHEnabled(appGUID string) (ccv3.SSHEnabled, v7action.Warnings, error)
 	GetSSHEnabledByAppName(appName string, spaceGUID string) (ccv3.SSHEnabled, v7action.Warnings, error)
 	GetSSHPasscode() (string, error)
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error)
+	GetSecureShellConfigurationForRunningInstance(appName string, spaceGUID string, processType string, preferredIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error)
 	GetSecurityGroup(securityGroupName string) (resources.SecurityGroup, v7action.Warnings, error)
 	GetSecurityGroupSummary(securityGroupName string) (v7action.SecurityGroupSummary, v7action.Warnings, error)
 	GetSecurityGroups() ([]v7action.SecurityGroupSummary, v7action.Warnings, error)
 	GetServiceAccess(offeringName, brokerName, orgName string) ([]v7action.ServicePlanAccess, v7action.Warnings, error)
+	GetServiceAccessChanges(desired []v7action.DesiredServiceAccess) ([]v7action.ServiceAccessChange, v7action.Warnings, error)
+	GetServiceAccessMatrix(offeringName, brokerName string) (v7action.ServiceAccessMatrix, v7action.Warnings, error)
 	GetServiceBrokerByName(serviceBrokerName string) (resources.ServiceBroker, v7action.Warnings, error)
 	GetServiceBrokerLabels(serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
-	GetServiceBrokers() ([]resources.ServiceBroker, v7action.Warnings, error)
+	GetServiceBrokers(labelSelector string) ([]resources.ServiceBroker, v7action.Warnings, error)
 	GetServiceKeyByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID string) (resources.ServiceCredentialBinding, v7action.Warnings, error)
 	GetServiceKeyDetailsByServiceInstanceAndName(serviceInstanceName, serviceKeyName, spaceGUID string) (resources.ServiceCredentialBindingDetails, v7action.Warnings, error)
 	GetServiceInstanceByNameAndSpace(serviceInstanceName, spaceGUID string) (resources.ServiceInstance, v7action.Warnings, error)
 	GetServiceInstanceDetails(serviceInstanceName, spaceGUID string, omitApps bool) (v7action.ServiceInstanceDetails, v7action.Warnings, error)
 	GetServiceInstanceParameters(serviceInstanceName, spaceGUID string) (v7action.ServiceInstanceParameters, v7action.Warnings, error)
 	GetServiceInstanceLabels(serviceInstanceName, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
-	GetServiceInstancesForSpace(spaceGUID string, omitApps bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
+	GetServiceInstancesForSpace(spaceGUID string, labelSelector string, omitApps bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
 	GetServiceKeysByServiceInstance(serviceInstanceName, spaceGUID string) ([]resources.ServiceCredentialBinding, v7action.Warnings, error)
 	GetServiceOfferingLabels(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServicePlanLabels(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
@@ -172,9 +205,12 @@ type Actor interface {
 	GetSpaceQuotasByOrgGUID(orgGUID string) ([]resources.SpaceQuota, v7action.Warnings, error)
 	GetSpaceSummaryByNameAndOrganization(spaceName string, orgGUID string) (v7action.SpaceSummary, v7action.Warnings, error)
 	GetSpaceUsersByRoleType(spaceGuid string) (map[constant.RoleType][]resources.User, v7action.Warnings, error)
+	GetStackAnnotations(stackName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetStackByName(stackName string) (resources.Stack, v7action.Warnings, error)
 	GetStackLabels(stackName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetStacks(string) ([]resources.Stack, v7action.Warnings, error)
+	GetStaleArtifacts(artifactType v7action.ArtifactType, appName string, spaceGUID string, policy v7action.ArtifactRetentionPolicy) ([]v7action.StaleArtifact, v7action.Warnings, error)
+	GetStaleArtifactSizes(artifacts []v7action.StaleArtifact) []v7action.StaleArtifact
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	GetTaskBySequenceIDAndApplication(sequenceID int, appGUID string) (resources.Task, v7action.Warnings, error)
 	GetUAAAPIVersion() (string, error)
@@ -182,9 +218,14 @@ type Actor interface {
 	GetUser(username, origin string) (resources.User, error)
 	MakeCurlRequest(httpMethod string, path string, customHeaders []string, httpData string, failOnHTTPError bool) ([]byte, *http.Response, error)
 	MapRoute(routeGUID string, appGUID string, destinationProtocol string) (v7action.Warnings, error)
+	MapRouteWithWeight(route resources.Route, appGUID string, destinationProtocol string, weight int) (v7action.Warnings, error)
+	MarshalEnvironmentVariableFile(format v7action.EnvironmentVariableFileFormat, envVars resources.EnvironmentVariables, revealSecrets bool) ([]byte, []string, error)
+	MonitorApplicationHealth(app resources.Application, duration time.Duration) (v7action.Warnings, error)
 	Marketplace(filter v7action.MarketplaceFilter) ([]v7action.ServiceOfferingWithPlans, v7action.Warnings, error)
 	MoveRoute(routeGUID string, spaceGUID string) (v7action.Warnings, error)
 	ParseAccessToken(accessToken string) (jwt.JWT, error)
+	ParseEnvironmentVariableFile(format v7action.EnvironmentVariableFileFormat, rawFile []byte) (resources.EnvironmentVariables, error)
+	ParseServiceAccessFile(rawFile []byte) ([]v7action.DesiredServiceAccess, error)
 	PollBuild(buildGUID string, appName string) (resources.Droplet, v7action.Warnings, error)
 	PollPackage(pkg resources.Package) (resources.Package, v7action.Warnings, error)
 	PollStart(app resources.Application, noWait bool, handleProcessStats func(string)) (v7action.Warnings, error)
@@ -192,8 +233,10 @@ type Actor interface {
 	PollTask(task resources.Task) (resources.Task, v7action.Warnings, error)
 	PollUploadBuildpackJob(jobURL ccv3.JobURL) (v7action.Warnings, error)
 	PrepareBuildpackBits(inputPath string, tmpDirPath string, downloader v7action.Downloader) (string, error)
+	ProbeRoute(url string, timeout time.Duration, skipSSLValidation bool) (v7action.RouteProbe, error)
 	PurgeServiceInstance(serviceInstanceName, spaceGUID string) (v7action.Warnings, error)
 	PurgeServiceOfferingByNameAndBroker(serviceOfferingName, serviceBrokerName string) (v7action.Warnings, error)
+	ReadDropletArchive(rawDroplet []byte) (v7action.DropletArchive, error)
 	RefreshAccessToken() (string, error)
 	RenameApplicationByNameAndSpaceGUID(oldAppName, newAppName, spaceGUID string) (resources.Application, v7action.Warnings, error)
 	RenameOrganization(oldOrgName, newOrgName string) (resources.Organization, v7action.Warnings, error)
@@ -213,6 +256,7 @@ type Actor interface {
 	SetApplicationProcessHealthCheckTypeByNameAndSpace(appName string, spaceGUID string, healthCheckType constant.HealthCheckType, httpEndpoint string, processType string, invocationTimeout int64) (resources.Application, v7action.Warnings, error)
 	SetEnvironmentVariableByApplicationNameAndSpace(appName string, spaceGUID string, envPair v7action.EnvironmentVariablePair) (v7action.Warnings, error)
 	SetEnvironmentVariableGroup(group constant.EnvironmentVariableGroupName, envVars resources.EnvironmentVariables) (v7action.Warnings, error)
+	SetEnvironmentVariablesByApplicationNameAndSpace(appName string, spaceGUID string, envVars resources.EnvironmentVariables, prune bool) ([]string, v7action.Warnings, error)
 	SetOrganizationDefaultIsolationSegment(orgGUID string, isoSegGUID string) (v7action.Warnings, error)
 	SetSpaceManifest(spaceGUID string, rawManifest []byte) (v7action.Warnings, error)
 	SetTarget(settings v7action.TargetSettings) (v7action.Warnings, error)
@@ -233,17 +277,31 @@ type Actor interface {
 	UnshareServiceInstanceFromSpaceAndOrg(serviceInstanceName, targetedSpaceGUID, targetedOrgGUID string, unshareFromDetails v7action.ServiceInstanceSharingParams) (v7action.Warnings, error)
 	UpdateAppFeature(app resources.Application, enabled bool, featureName string) (v7action.Warnings, error)
 	UpdateApplication(app resources.Application) (resources.Application, v7action.Warnings, error)
+	UpdateApplicationAnnotationsByApplicationName(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateApplicationLabelsByApplicationName(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateBuildpackAnnotationsByBuildpackNameAndStack(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateBuildpackByNameAndStack(buildpackName string, buildpackStack string, buildpack resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
 	UpdateBuildpackLabelsByBuildpackNameAndStack(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateDestination(string, string, string) (v7action.Warnings, error)
+	UpdateDestinationWeight(route resources.Route, destinationGUID string, weight int) (v7action.Warnings, error)
+	UpdateDomainAnnotationsByDomainName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateDomainLabelsByDomainName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateManagedServiceInstance(params v7action.UpdateManagedServiceInstanceParams) (chan v7action.PollJobEvent, v7action.Warnings, error)
+	UpdateOrganizationAnnotationsByOrganizationName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateRouteAnnotations(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceBrokerAnnotationsByServiceBrokerName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceInstanceAnnotations(serviceInstanceName, spaceGUID string, annotations map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceOfferingAnnotations(serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServicePlanAnnotations(servicePlanName string, serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (v7action.Warnings, error)
+	UpdateSpaceAnnotationsBySpaceName(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateStackAnnotationsByStackName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpgradeManagedServiceInstance(serviceInstanceName, spaceGUID string) (chan v7action.PollJobEvent, v7action.Warnings, error)
 	UpdateOrganizationLabelsByOrganizationName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateOrganizationQuota(quotaName string, newName string, limits v7action.QuotaLimits) (v7action.Warnings, error)
 	UpdateProcessByTypeAndApplication(processType string, appGUID string, updatedProcess resources.Process) (v7action.Warnings, error)
+	UpdateResourcesMetadata(resourceType string, resourceGUIDs []string, metadata resources.Metadata, batchUpdated func(updated int)) (v7action.Warnings, error)
 	UpdateRouteLabels(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateRouterGroupReservablePorts(routerGroupName string, reservablePorts string) (v7action.RouterGroup, v7action.Warnings, error)
 	UpdateSecurityGroup(name, filePath string) (v7action.Warnings, error)
 	UpdateSecurityGroupGloballyEnabled(securityGroupName string, lifecycle constant.SecurityGroupLifecycle, enabled bool) (v7action.Warnings, error)
 	UpdateServiceBroker(serviceBrokerGUID string, model resources.ServiceBroker) (v7action.Warnings, error)
@@ -260,4 +318,6 @@ type Actor interface {
 	UploadBitsPackage(pkg resources.Package, matchedResources []sharedaction.V3Resource, newResources io.Reader, newResourcesLength int64) (resources.Package, v7action.Warnings, error)
 	UploadBuildpack(guid string, pathToBuildpackBits string, progressBar v7action.SimpleProgressBar) (ccv3.JobURL, v7action.Warnings, error)
 	UploadDroplet(dropletGUID string, dropletPath string, progressReader io.Reader, fileSize int64) (v7action.Warnings, error)
+	ValidateServiceBrokerCatalog(catalog servicebroker.Catalog) v7action.CatalogProblems
+	ValidateTokenSignature(token string) error
 }
@@ -0,0 +1,142 @@
+package v7_test
+
+import (
+	"errors"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("annotations command", func() {
+	var (
+		cmd             AnnotationsCommand
+		fakeActor       *v7fakes.FakeActor
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		testUI          *ui.UI
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(v7fakes.FakeActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		cmd = AnnotationsCommand{
+			BaseCommand: BaseCommand{
+				Actor:       fakeActor,
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+			},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "fake-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "fake-space", GUID: "some-space-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("listing app annotations", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "app", ResourceName: "dora"}
+			fakeActor.GetApplicationAnnotationsReturns(
+				map[string]types.NullString{
+					"contact":     types.NewNullString("team@example.com"),
+					"description": types.NewNullString("some description"),
+				},
+				v7action.Warnings{"some-warning"},
+				nil,
+			)
+		})
+
+		It("checks that an org and space are targeted", func() {
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkOrg).To(BeTrue())
+			Expect(checkSpace).To(BeTrue())
+		})
+
+		It("displays the annotations sorted by key", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Getting annotations for app dora in org fake-org / space fake-space as some-user...")))
+			Expect(testUI.Out).To(Say(`key\s+value`))
+			Expect(testUI.Out).To(Say(`contact\s+team@example.com`))
+			Expect(testUI.Out).To(Say(`description\s+some description`))
+			Expect(testUI.Err).To(Say("some-warning"))
+
+			Expect(fakeActor.GetApplicationLabelsCallCount()).To(Equal(0))
+			appName, spaceGUID := fakeActor.GetApplicationAnnotationsArgsForCall(0)
+			Expect(appName).To(Equal("dora"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	When("the resource has no annotations", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "org", ResourceName: "business"}
+		})
+
+		It("says that no annotations were found", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Getting annotations for org business as some-user...")))
+			Expect(testUI.Out).To(Say("No annotations found."))
+		})
+	})
+
+	When("listing service offering annotations with a broker", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "service-offering", ResourceName: "some-offering"}
+			cmd.ServiceBroker = "some-broker"
+		})
+
+		It("disambiguates by broker", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(regexp.QuoteMeta("Getting annotations for service-offering some-offering from service broker some-broker as some-user...")))
+			offeringName, brokerName := fakeActor.GetServiceOfferingAnnotationsArgsForCall(0)
+			Expect(offeringName).To(Equal("some-offering"))
+			Expect(brokerName).To(Equal("some-broker"))
+		})
+	})
+
+	When("--offering is used with a resource other than a service plan", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "stack", ResourceName: "some-stack"}
+			cmd.ServiceOffering = "some-offering"
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"stack", "--offering, -o"},
+			}))
+		})
+	})
+
+	When("getting the annotations fails", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.AnnotationsArgs{ResourceType: "space", ResourceName: "some-space"}
+			fakeActor.GetSpaceAnnotationsReturns(nil, v7action.Warnings{"some-warning"}, errors.New("boom"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("boom"))
+			Expect(testUI.Err).To(Say("some-warning"))
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package v7
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type ApplyServiceAccessCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ServiceAccessFileArgs `positional-args:"yes"`
+	DryRun          bool                       `long:"dry-run" description:"Show the access changes without making them"`
+	usage           interface{}                `usage:"CF_NAME apply-service-access FILE_PATH [--dry-run]\n\n   The file lists the plans to manage and who can see them. Plans that are not listed are left alone.\n   Access is 'public' for every org, 'orgs' for the listed orgs only, or 'none'.\n\n   ---\n   service_access:\n   - broker: my-broker\n     offering: my-db\n     plan: small\n     access: public\n   - broker: my-broker\n     offering: my-db\n     plan: large\n     access: orgs\n     orgs: [org-a, org-b]\n\nEXAMPLES:\n   CF_NAME apply-service-access service-access.yml --dry-run"`
+	relatedCommands interface{}                `related_commands:"disable-service-access, enable-service-access, service-access, service-access-matrix"`
+}
+
+func (cmd ApplyServiceAccessCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(false, false); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	path := string(cmd.RequiredArgs.PathToFile)
+	rawFile, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	desired, err := cmd.Actor.ParseServiceAccessFile(rawFile)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying service access from {{.Path}} as {{.Username}}...", map[string]interface{}{
+		"Path":     path,
+		"Username": user.Name,
+	})
+
+	changes, warnings, err := cmd.Actor.GetServiceAccessChanges(desired)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(changes) == 0 {
+		cmd.UI.DisplayText("Service access already matches {{.Path}}.", map[string]interface{}{
+			"Path": path,
+		})
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	for _, change := range changes {
+		operation := "Disabling"
+		switch {
+		case cmd.DryRun && change.Enable:
+			operation = "Would enable"
+		case cmd.DryRun:
+			operation = "Would disable"
+		case change.Enable:
+			operation = "Enabling"
+		}
+
+		setServiceAccessMessage{
+			Operation:       operation,
+			ServiceOffering: change.ServiceOfferingName,
+			ServicePlan:     change.ServicePlanName,
+			Organization:    change.OrganizationName,
+			ServiceBroker:   change.BrokerName,
+			User:            user.Name,
+		}.displayMessage(cmd.UI)
+
+		if cmd.DryRun {
+			continue
+		}
+
+		if change.Enable {
+			_, warnings, err = cmd.Actor.EnableServiceAccess(change.ServiceOfferingName, change.BrokerName, change.OrganizationName, change.ServicePlanName)
+		} else {
+			_, warnings, err = cmd.Actor.DisableServiceAccess(change.ServiceOfferingName, change.BrokerName, change.OrganizationName, change.ServicePlanName)
+		}
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	if cmd.DryRun {
+		cmd.UI.DisplayText("Dry run: no changes were made.")
+		return nil
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
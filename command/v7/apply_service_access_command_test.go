@@ -0,0 +1,160 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply-service-access Command", func() {
+	var (
+		cmd             v7.ApplyServiceAccessCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+
+		tmpDir     string
+		accessFile string
+		desired    []v7action.DesiredServiceAccess
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "apply-service-access")
+		Expect(err).ToNot(HaveOccurred())
+		accessFile = filepath.Join(tmpDir, "service-access.yml")
+		Expect(os.WriteFile(accessFile, []byte("service_access: []\n"), 0600)).To(Succeed())
+
+		cmd = v7.ApplyServiceAccessCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.ServiceAccessFileArgs{PathToFile: flag.PathWithExistenceCheck(accessFile)},
+		}
+
+		desired = []v7action.DesiredServiceAccess{{Broker: "broker", Offering: "db", Plan: "large", Access: "orgs", Orgs: []string{"org-b"}}}
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.ParseServiceAccessFileReturns(desired, nil)
+		fakeActor.GetServiceAccessChangesReturns(
+			[]v7action.ServiceAccessChange{
+				{Enable: true, BrokerName: "broker", ServiceOfferingName: "db", ServicePlanName: "large", OrganizationName: "org-b"},
+				{Enable: false, BrokerName: "broker", ServiceOfferingName: "db", ServicePlanName: "large", OrganizationName: "org-a"},
+			},
+			v7action.Warnings{"changes-warning"},
+			nil,
+		)
+		fakeActor.EnableServiceAccessReturns(nil, v7action.Warnings{"enable-warning"}, nil)
+		fakeActor.DisableServiceAccessReturns(nil, v7action.Warnings{"disable-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("enables and disables service access to match the file", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.ParseServiceAccessFileArgsForCall(0)).To(Equal([]byte("service_access: []\n")))
+		Expect(fakeActor.GetServiceAccessChangesArgsForCall(0)).To(Equal(desired))
+
+		Expect(fakeActor.EnableServiceAccessCallCount()).To(Equal(1))
+		offering, broker, org, plan := fakeActor.EnableServiceAccessArgsForCall(0)
+		Expect([]string{offering, broker, org, plan}).To(Equal([]string{"db", "broker", "org-b", "large"}))
+
+		Expect(fakeActor.DisableServiceAccessCallCount()).To(Equal(1))
+		offering, broker, org, plan = fakeActor.DisableServiceAccessArgsForCall(0)
+		Expect([]string{offering, broker, org, plan}).To(Equal([]string{"db", "broker", "org-a", "large"}))
+
+		Expect(testUI.Out).To(Say(`Applying service access from .*service-access\.yml as admin\.\.\.`))
+		Expect(testUI.Out).To(Say(`Enabling access to plan large of service offering db from broker broker for org org-b as admin\.\.\.`))
+		Expect(testUI.Out).To(Say(`Disabling access to plan large of service offering db from broker broker for org org-a as admin\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(testUI.Err).To(Say("changes-warning"))
+		Expect(testUI.Err).To(Say("enable-warning"))
+		Expect(testUI.Err).To(Say("disable-warning"))
+	})
+
+	When("--dry-run is passed", func() {
+		BeforeEach(func() {
+			cmd.DryRun = true
+		})
+
+		It("displays the changes without making them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.EnableServiceAccessCallCount()).To(Equal(0))
+			Expect(fakeActor.DisableServiceAccessCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say(`Would enable access to plan large of service offering db from broker broker for org org-b as admin\.\.\.`))
+			Expect(testUI.Out).To(Say(`Would disable access to plan large of service offering db from broker broker for org org-a as admin\.\.\.`))
+			Expect(testUI.Out).To(Say(`Dry run: no changes were made\.`))
+		})
+	})
+
+	When("the access already matches the file", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceAccessChangesReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Service access already matches .*service-access\.yml\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("the file is invalid", func() {
+		BeforeEach(func() {
+			fakeActor.ParseServiceAccessFileReturns(nil, errors.New("parse-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("parse-error"))
+			Expect(fakeActor.GetServiceAccessChangesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a change fails", func() {
+		BeforeEach(func() {
+			fakeActor.EnableServiceAccessReturns(nil, v7action.Warnings{"enable-warning"}, errors.New("enable-error"))
+		})
+
+		It("stops and returns the error", func() {
+			Expect(executeErr).To(MatchError("enable-error"))
+			Expect(testUI.Err).To(Say("enable-warning"))
+			Expect(fakeActor.DisableServiceAccessCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package v7
 import (
 	"strings"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -13,8 +14,8 @@ type AppsCommand struct {
 	usage           interface{} `usage:"CF_NAME apps [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME apps\n   CF_NAME apps --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME apps --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
 
-	Labels    string `long:"labels" description:"Selector to filter apps by labels"`
-	OmitStats bool   `long:"no-stats" description:"Do not retrieve process stats"`
+	Labels    flag.LabelSelector `long:"labels" description:"Selector to filter apps by labels"`
+	OmitStats bool               `long:"no-stats" description:"Do not retrieve process stats"`
 }
 
 func (cmd AppsCommand) Execute(args []string) error {
@@ -35,7 +36,7 @@ func (cmd AppsCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	summaries, warnings, err := cmd.Actor.GetAppSummariesForSpace(cmd.Config.TargetedSpace().GUID, cmd.Labels, cmd.OmitStats)
+	summaries, warnings, err := cmd.Actor.GetAppSummariesForSpace(cmd.Config.TargetedSpace().GUID, string(cmd.Labels), cmd.OmitStats)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
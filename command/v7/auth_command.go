@@ -3,7 +3,10 @@ package v7
 import (
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/uaaversion"
 	"code.cloudfoundry.org/cli/command"
@@ -15,11 +18,14 @@ import (
 type AuthCommand struct {
 	BaseCommand
 
-	RequiredArgs      flag.Authentication `positional-args:"yes"`
-	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
-	Origin            string              `long:"origin" description:"Indicates the identity provider to be used for authentication"`
-	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overridden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
-	relatedCommands   interface{}         `related_commands:"api, login, target"`
+	RequiredArgs      flag.Authentication         `positional-args:"yes"`
+	ClientCredentials bool                        `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
+	Origin            string                      `long:"origin" description:"Indicates the identity provider to be used for authentication"`
+	Assertion         flag.PathWithExistenceCheck `long:"assertion" description:"Path to a file containing a signed JWT, such as a workload identity token, to exchange for an access token"`
+	TokenExchange     bool                        `long:"token-exchange" description:"Exchange the --assertion token using the token exchange grant instead of the JWT bearer grant"`
+	PrivateKeyJWT     flag.PathWithExistenceCheck `long:"private-key-jwt" description:"Path to a private key used to authenticate the client with a signed JWT instead of a client secret"`
+	usage             interface{}                 `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n   CF_NAME auth CLIENT_ID --client-credentials --private-key-jwt KEY_FILE\n   CF_NAME auth CLIENT_ID [CLIENT_SECRET] --assertion TOKEN_FILE [--token-exchange] [--private-key-jwt KEY_FILE]\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overridden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME auth ci-client --assertion /var/run/secrets/tokens/cf-token --private-key-jwt ci-client-key.pem"`
+	relatedCommands   interface{}                 `related_commands:"api, login, target"`
 }
 
 func (cmd AuthCommand) Execute(args []string) error {
@@ -41,6 +47,11 @@ func (cmd AuthCommand) Execute(args []string) error {
 		}
 	}
 
+	err := cmd.validateAssertionArgs()
+	if err != nil {
+		return err
+	}
+
 	username, password, err := cmd.getUsernamePassword()
 	if err != nil {
 		return err
@@ -60,8 +71,8 @@ func (cmd AuthCommand) Execute(args []string) error {
 		cmd.UI.DisplayWarning(versionWarning)
 	}
 
-	if !cmd.ClientCredentials {
-		if cmd.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+	if !cmd.ClientCredentials && cmd.Assertion == "" {
+		if isServiceAccountGrantType(cmd.Config.UAAGrantType()) {
 			return translatableerror.PasswordGrantTypeLogoutRequiredError{}
 		} else if cmd.Config.UAAOAuthClient() != "cf" || cmd.Config.UAAOAuthClientSecret() != "" {
 			return translatableerror.ManualClientCredentialsError{}
@@ -74,10 +85,19 @@ func (cmd AuthCommand) Execute(args []string) error {
 
 	credentials := make(map[string]string)
 	grantType := constant.GrantTypePassword
-	if cmd.ClientCredentials {
+	if cmd.Assertion != "" {
+		grantType, credentials, err = cmd.assertionCredentials(username, password)
+		if err != nil {
+			return err
+		}
+	} else if cmd.ClientCredentials {
 		grantType = constant.GrantTypeClientCredentials
 		credentials["client_id"] = username
-		credentials["client_secret"] = password
+		if cmd.PrivateKeyJWT != "" {
+			credentials[v7action.ClientKeyFileCredential] = string(cmd.PrivateKeyJWT)
+		} else {
+			credentials["client_secret"] = password
+		}
 	} else if cmd.Config.IsCFOnK8s() {
 		prompts, err := cmd.Actor.GetLoginPrompts()
 		if err != nil {
@@ -142,7 +162,7 @@ func (cmd AuthCommand) getUsernamePassword() (string, string, error) {
 	if password == "" {
 		if envPassword := cmd.Config.CFPassword(); envPassword != "" {
 			password = envPassword
-		} else if !cmd.Config.IsCFOnK8s() {
+		} else if !cmd.Config.IsCFOnK8s() && cmd.Assertion == "" && cmd.PrivateKeyJWT == "" {
 			passwordMissing = true
 		}
 	}
@@ -156,3 +176,71 @@ func (cmd AuthCommand) getUsernamePassword() (string, string, error) {
 
 	return username, password, nil
 }
+
+func (cmd AuthCommand) validateAssertionArgs() error {
+	switch {
+	case cmd.Assertion != "" && cmd.ClientCredentials:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--assertion", "--client-credentials"},
+		}
+	case cmd.Assertion != "" && cmd.Origin != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--assertion", "--origin"},
+		}
+	case cmd.TokenExchange && cmd.Assertion == "":
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--token-exchange",
+			Arg2: "--assertion",
+		}
+	case cmd.PrivateKeyJWT != "" && !cmd.ClientCredentials && cmd.Assertion == "":
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--private-key-jwt",
+			Arg2: "--client-credentials or --assertion",
+		}
+	}
+
+	return nil
+}
+
+// assertionCredentials builds the credentials for exchanging the token in the
+// --assertion file, authenticating clientID with its private key or secret
+// when one was provided.
+func (cmd AuthCommand) assertionCredentials(clientID string, clientSecret string) (constant.GrantType, map[string]string, error) {
+	contents, err := os.ReadFile(string(cmd.Assertion))
+	if err != nil {
+		return "", nil, err
+	}
+
+	assertion := strings.TrimSpace(string(contents))
+	if assertion == "" {
+		return "", nil, fmt.Errorf("assertion file %s is empty", cmd.Assertion)
+	}
+
+	grantType := constant.GrantTypeJWTBearer
+	credentials := map[string]string{
+		"client_id": clientID,
+	}
+	if cmd.TokenExchange {
+		grantType = constant.GrantTypeTokenExchange
+		credentials["subject_token"] = assertion
+		credentials["subject_token_type"] = string(constant.TokenTypeIDToken)
+	} else {
+		credentials["assertion"] = assertion
+	}
+
+	if cmd.PrivateKeyJWT != "" {
+		credentials[v7action.ClientKeyFileCredential] = string(cmd.PrivateKeyJWT)
+	} else if clientSecret != "" {
+		credentials["client_secret"] = clientSecret
+	}
+
+	return grantType, credentials, nil
+}
+
+func isServiceAccountGrantType(grantType string) bool {
+	switch constant.GrantType(grantType) {
+	case constant.GrantTypeClientCredentials, constant.GrantTypeJWTBearer, constant.GrantTypeTokenExchange:
+		return true
+	}
+	return false
+}
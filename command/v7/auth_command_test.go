@@ -2,6 +2,11 @@ package v7_test
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 
@@ -89,6 +94,46 @@ var _ = Describe("auth Command", func() {
 		})
 	})
 
+	When("--assertion is set with --client-credentials", func() {
+		BeforeEach(func() {
+			cmd.Assertion = "/some/token"
+			cmd.ClientCredentials = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--assertion", "--client-credentials"},
+			}))
+		})
+	})
+
+	When("--token-exchange is set without --assertion", func() {
+		BeforeEach(func() {
+			cmd.TokenExchange = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(err).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--token-exchange",
+				Arg2: "--assertion",
+			}))
+		})
+	})
+
+	When("--private-key-jwt is set without --client-credentials or --assertion", func() {
+		BeforeEach(func() {
+			cmd.PrivateKeyJWT = "/some/key.pem"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(err).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--private-key-jwt",
+				Arg2: "--client-credentials or --assertion",
+			}))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+		})
+	})
+
 	When("credentials are missing", func() {
 		When("username and password are both missing", func() {
 			It("raises an error", func() {
@@ -220,6 +265,118 @@ var _ = Describe("auth Command", func() {
 			})
 		})
 
+		When("--client-credentials and --private-key-jwt are set", func() {
+			BeforeEach(func() {
+				cmd.ClientCredentials = true
+				cmd.PrivateKeyJWT = "/some/key.pem"
+				cmd.RequiredArgs.Username = testID
+			})
+
+			It("authenticates the client with the key instead of a secret", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{
+					"client_id":                      testID,
+					v7action.ClientKeyFileCredential: "/some/key.pem",
+				}))
+				Expect(origin).To(BeEmpty())
+				Expect(grantType).To(Equal(constant.GrantTypeClientCredentials))
+			})
+		})
+
+		When("--assertion is set", func() {
+			var assertionFile string
+
+			BeforeEach(func() {
+				assertionFile = filepath.Join(GinkgoT().TempDir(), "token")
+				Expect(os.WriteFile(assertionFile, []byte("some-workload-token\n"), 0600)).To(Succeed())
+
+				cmd.Assertion = flag.PathWithExistenceCheck(assertionFile)
+				cmd.RequiredArgs.Username = testID
+			})
+
+			It("exchanges the assertion using the jwt-bearer grant", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Authenticating\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+				credentials, origin, grantType := fakeActor.AuthenticateArgsForCall(0)
+				Expect(credentials).To(Equal(map[string]string{
+					"client_id": testID,
+					"assertion": "some-workload-token",
+				}))
+				Expect(origin).To(BeEmpty())
+				Expect(grantType).To(Equal(constant.GrantTypeJWTBearer))
+			})
+
+			When("a client secret is provided", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Password = testSecret
+				})
+
+				It("authenticates the client with the secret", func() {
+					credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+					Expect(credentials).To(HaveKeyWithValue("client_secret", testSecret))
+				})
+			})
+
+			When("--private-key-jwt is set", func() {
+				BeforeEach(func() {
+					cmd.PrivateKeyJWT = "/some/key.pem"
+				})
+
+				It("authenticates the client with the key", func() {
+					credentials, _, _ := fakeActor.AuthenticateArgsForCall(0)
+					Expect(credentials).To(HaveKeyWithValue(v7action.ClientKeyFileCredential, "/some/key.pem"))
+					Expect(credentials).ToNot(HaveKey("client_secret"))
+				})
+			})
+
+			When("--token-exchange is set", func() {
+				BeforeEach(func() {
+					cmd.TokenExchange = true
+				})
+
+				It("exchanges the assertion using the token exchange grant", func() {
+					Expect(err).ToNot(HaveOccurred())
+
+					credentials, _, grantType := fakeActor.AuthenticateArgsForCall(0)
+					Expect(credentials).To(Equal(map[string]string{
+						"client_id":          testID,
+						"subject_token":      "some-workload-token",
+						"subject_token_type": string(constant.TokenTypeIDToken),
+					}))
+					Expect(grantType).To(Equal(constant.GrantTypeTokenExchange))
+				})
+			})
+
+			When("the assertion file is empty", func() {
+				BeforeEach(func() {
+					Expect(os.WriteFile(assertionFile, []byte("\n"), 0600)).To(Succeed())
+				})
+
+				It("returns an error", func() {
+					Expect(err).To(MatchError(ContainSubstring("is empty")))
+					Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+				})
+			})
+
+			When("a service account is already logged in", func() {
+				BeforeEach(func() {
+					fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypeJWTBearer))
+					fakeConfig.UAAOAuthClientReturns("some-other-client")
+				})
+
+				It("does not error", func() {
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+		})
+
 		When("--client-credentials is not set", func() {
 			When("username and password are only provided as arguments", func() {
 				BeforeEach(func() {
@@ -395,6 +552,18 @@ var _ = Describe("auth Command", func() {
 			})
 		})
 
+		When("authenticating as a user after an assertion login", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns(string(constant.GrantTypeTokenExchange))
+				cmd.RequiredArgs.Username = "some-username"
+				cmd.RequiredArgs.Password = "some-password"
+			})
+
+			It("returns an already logged in error", func() {
+				Expect(err).To(MatchError(translatableerror.PasswordGrantTypeLogoutRequiredError{}))
+			})
+		})
+
 		When("authenticating as a client", func() {
 			BeforeEach(func() {
 				cmd.ClientCredentials = true
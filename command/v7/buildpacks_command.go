@@ -3,6 +3,7 @@ package v7
 import (
 	"strconv"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -10,9 +11,9 @@ import (
 type BuildpacksCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME buildpacks [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME buildpacks\n   CF_NAME buildpacks --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME buildpacks --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
-	relatedCommands interface{} `related_commands:"create-buildpack, delete-buildpack, rename-buildpack, update-buildpack"`
-	Labels          string      `long:"labels" description:"Selector to filter buildpacks by labels"`
+	usage           interface{}        `usage:"CF_NAME buildpacks [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME buildpacks\n   CF_NAME buildpacks --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME buildpacks --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
+	relatedCommands interface{}        `related_commands:"create-buildpack, delete-buildpack, rename-buildpack, update-buildpack"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter buildpacks by labels"`
 }
 
 func (cmd BuildpacksCommand) Execute(args []string) error {
@@ -31,7 +32,7 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	buildpacks, warnings, err := cmd.Actor.GetBuildpacks(cmd.Labels)
+	buildpacks, warnings, err := cmd.Actor.GetBuildpacks(string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -7,13 +7,14 @@ import (
 )
 
 type ConfigCommand struct {
-	UI           command.UI
-	Config       command.Config
-	AsyncTimeout flag.Timeout      `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
-	Color        flag.Color        `long:"color" description:"Enable or disable color in CLI output"`
-	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
-	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	UI              command.UI
+	Config          command.Config
+	AsyncTimeout    flag.Timeout      `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
+	Color           flag.Color        `long:"color" description:"Enable or disable color in CLI output"`
+	CredentialStore string            `long:"credential-store" description:"Store tokens and secrets outside of config.json. Use 'file' for an encrypted file, the name of a cf-credential-NAME helper on the PATH, or 'none' to store them in config.json"`
+	Locale          flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
+	Trace           flag.PathWithBool `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
+	usage           interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--credential-store (file | HELPER | none)]"`
 }
 
 func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
@@ -24,7 +25,7 @@ func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd ConfigCommand) Execute(args []string) error {
-	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet {
+	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet && cmd.CredentialStore == "" {
 		return translatableerror.IncorrectUsageError{Message: "at least one flag must be provided"}
 	}
 
@@ -46,6 +47,10 @@ func (cmd ConfigCommand) Execute(args []string) error {
 		cmd.Config.SetTrace(string(cmd.Trace))
 	}
 
+	if cmd.CredentialStore != "" {
+		cmd.Config.SetCredentialStore(cmd.CredentialStore)
+	}
+
 	cmd.UI.DisplayOK()
 	return nil
 }
@@ -94,4 +94,17 @@ var _ = Describe("ConfigCommand", func() {
 			Expect(value).To(Equal("my-trace-file"))
 		})
 	})
+
+	When("using the credential store flag", func() {
+		BeforeEach(func() {
+			cmd.CredentialStore = "file"
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetCredentialStoreCallCount()).To(Equal(1))
+			value := fakeConfig.SetCredentialStoreArgsForCall(0)
+			Expect(value).To(Equal("file"))
+		})
+	})
 })
@@ -0,0 +1,164 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type DiffEnvCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.DiffEnvironmentArgs `positional-args:"yes"`
+	Organization    string                   `short:"o" long:"organization" description:"Org that contains the other application"`
+	Space           string                   `short:"s" long:"space" description:"Space that contains the other application"`
+	ShowValues      bool                     `long:"show-values" description:"Show the values of environment variables instead of redacting them"`
+	usage           interface{}              `usage:"CF_NAME diff-env APP_NAME OTHER_APP_NAME [-s OTHER_SPACE [-o OTHER_ORG]] [--show-values]\n\n   Compares the user-provided and system-provided env variables of two apps. System-provided values,\n   such as service credentials in VCAP_SERVICES, are always redacted. The running and staging\n   environment variable groups are foundation-wide, so they are the same for both apps.\n\nEXAMPLES:\n   CF_NAME diff-env my-app my-app-v2\n   CF_NAME diff-env my-app my-app -s staging --show-values"`
+	relatedCommands interface{}              `related_commands:"env, export-env, running-environment-variable-group, staging-environment-variable-group"`
+}
+
+func (cmd DiffEnvCommand) Execute(args []string) error {
+	if cmd.Organization != "" && cmd.Space == "" {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--organization, -o",
+			Arg2: "--space, -s",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	otherOrg, otherSpace, err := cmd.getOtherOrgAndSpace()
+	if err != nil {
+		return err
+	}
+
+	appName := cmd.RequiredArgs.AppName
+	otherAppName := cmd.RequiredArgs.OtherAppName
+	cmd.UI.DisplayTextWithFlavor("Comparing env variables of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} with app {{.OtherAppName}} in org {{.OtherOrgName}} / space {{.OtherSpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":        appName,
+		"OrgName":        cmd.Config.TargetedOrganization().Name,
+		"SpaceName":      cmd.Config.TargetedSpace().Name,
+		"OtherAppName":   otherAppName,
+		"OtherOrgName":   otherOrg.Name,
+		"OtherSpaceName": otherSpace.Name,
+		"Username":       user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	envGroups, warnings, err := cmd.Actor.GetEnvironmentVariablesByApplicationNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	otherEnvGroups, warnings, err := cmd.Actor.GetEnvironmentVariablesByApplicationNameAndSpace(otherAppName, otherSpace.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	diff := cmd.Actor.DiffEnvironmentVariableGroups(envGroups, otherEnvGroups)
+
+	label, otherLabel := appName, otherAppName
+	if appName == otherAppName {
+		label = cmd.Config.TargetedSpace().Name + "/" + appName
+		otherLabel = otherSpace.Name + "/" + otherAppName
+	}
+
+	sections := []struct {
+		header       string
+		diffs        []v7action.EnvironmentVariableDiff
+		alwaysRedact bool
+	}{
+		{"User-Provided:", diff.EnvironmentVariables, false},
+		{"System-Provided:", diff.System, true},
+		{"Running Environment Variable Groups (foundation-wide):", diff.Running, false},
+		{"Staging Environment Variable Groups (foundation-wide):", diff.Staging, false},
+	}
+
+	var added, removed, changed int
+	for _, section := range sections {
+		cmd.UI.DisplayHeader(section.header)
+		if len(section.diffs) == 0 {
+			cmd.UI.DisplayText("No differences.")
+			cmd.UI.DisplayNewline()
+			continue
+		}
+
+		showValues := cmd.ShowValues && !section.alwaysRedact
+		header := []string{cmd.UI.TranslateText("name"), cmd.UI.TranslateText("change")}
+		if showValues {
+			header = append(header, label, otherLabel)
+		}
+		table := [][]string{header}
+		for _, envVarDiff := range section.diffs {
+			row := []string{envVarDiff.Name, cmd.UI.TranslateText(string(envVarDiff.Change))}
+			if showValues {
+				row = append(row, envVarDiff.OriginalValue, envVarDiff.OtherValue)
+			}
+			table = append(table, row)
+
+			switch envVarDiff.Change {
+			case v7action.EnvironmentVariableAdded:
+				added++
+			case v7action.EnvironmentVariableRemoved:
+				removed++
+			default:
+				changed++
+			}
+		}
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+		if section.alwaysRedact && cmd.ShowValues {
+			cmd.UI.DisplayText("System-provided values are not shown because they can contain service credentials.")
+		}
+		cmd.UI.DisplayNewline()
+	}
+
+	cmd.UI.DisplayText("{{.Added}} added, {{.Removed}} removed, {{.Changed}} changed in app {{.OtherLabel}} compared to app {{.Label}}.", map[string]interface{}{
+		"Added":      added,
+		"Removed":    removed,
+		"Changed":    changed,
+		"Label":      label,
+		"OtherLabel": otherLabel,
+	})
+
+	return nil
+}
+
+func (cmd DiffEnvCommand) getOtherOrgAndSpace() (configv3.Organization, configv3.Space, error) {
+	otherOrg := cmd.Config.TargetedOrganization()
+	otherSpace := cmd.Config.TargetedSpace()
+	if cmd.Organization != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return configv3.Organization{}, configv3.Space{}, err
+		}
+		otherOrg = configv3.Organization{
+			GUID: org.GUID,
+			Name: org.Name,
+		}
+	}
+	if cmd.Space != "" {
+		space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, otherOrg.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return configv3.Organization{}, configv3.Space{}, err
+		}
+		otherSpace = configv3.Space{
+			GUID: space.GUID,
+			Name: space.Name,
+		}
+	}
+	return otherOrg, otherSpace, nil
+}
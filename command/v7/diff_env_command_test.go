@@ -0,0 +1,213 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff-env Command", func() {
+	var (
+		cmd             v7.DiffEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.DiffEnvCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.OtherAppName = "other-app"
+
+		fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall(0,
+			v7action.EnvironmentVariableGroups{EnvironmentVariables: map[string]interface{}{"LOG_LEVEL": "info"}},
+			v7action.Warnings{"env-warning-1"},
+			nil,
+		)
+		fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall(1,
+			v7action.EnvironmentVariableGroups{EnvironmentVariables: map[string]interface{}{"LOG_LEVEL": "debug"}},
+			v7action.Warnings{"env-warning-2"},
+			nil,
+		)
+		fakeActor.DiffEnvironmentVariableGroupsReturns(v7action.EnvironmentVariableGroupsDiff{
+			EnvironmentVariables: []v7action.EnvironmentVariableDiff{
+				{Name: "LOG_LEVEL", Change: v7action.EnvironmentVariableChanged, OriginalValue: "info", OtherValue: "debug"},
+				{Name: "NEW_VAR", Change: v7action.EnvironmentVariableAdded, OtherValue: "new"},
+			},
+			System: []v7action.EnvironmentVariableDiff{
+				{Name: "VCAP_SERVICES p-mysql/db", Change: v7action.EnvironmentVariableAdded},
+			},
+			Staging: []v7action.EnvironmentVariableDiff{
+				{Name: "OLD_VAR", Change: v7action.EnvironmentVariableRemoved, OriginalValue: "old"},
+			},
+		})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("an org is given without a space", func() {
+		BeforeEach(func() {
+			cmd.Organization = "other-org"
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--organization, -o",
+				Arg2: "--space, -s",
+			}))
+		})
+	})
+
+	It("compares the env of both apps in the targeted space with values redacted", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Comparing env variables of app some-app in org some-org / space some-space with app other-app in org some-org / space some-space as banana\.\.\.`))
+		Expect(testUI.Err).To(Say("env-warning-1"))
+		Expect(testUI.Err).To(Say("env-warning-2"))
+
+		appName, spaceGUID := fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		appName, spaceGUID = fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(1)
+		Expect(appName).To(Equal("other-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		original, other := fakeActor.DiffEnvironmentVariableGroupsArgsForCall(0)
+		Expect(original.EnvironmentVariables).To(HaveKeyWithValue("LOG_LEVEL", "info"))
+		Expect(other.EnvironmentVariables).To(HaveKeyWithValue("LOG_LEVEL", "debug"))
+
+		Expect(testUI.Out).To(Say(`User-Provided:`))
+		Expect(testUI.Out).To(Say(`name\s+change\n`))
+		Expect(testUI.Out).To(Say(`LOG_LEVEL\s+changed\n`))
+		Expect(testUI.Out).To(Say(`NEW_VAR\s+added\n`))
+		Expect(testUI.Out).To(Say(`System-Provided:`))
+		Expect(testUI.Out).To(Say(`name\s+change\n`))
+		Expect(testUI.Out).To(Say(`VCAP_SERVICES p-mysql/db\s+added\n`))
+		Expect(testUI.Out).To(Say(`Running Environment Variable Groups \(foundation-wide\):`))
+		Expect(testUI.Out).To(Say(`No differences\.`))
+		Expect(testUI.Out).To(Say(`Staging Environment Variable Groups \(foundation-wide\):`))
+		Expect(testUI.Out).To(Say(`OLD_VAR\s+removed\n`))
+		Expect(testUI.Out).To(Say(`2 added, 1 removed, 1 changed in app other-app compared to app some-app\.`))
+
+		Expect(string(testUI.Out.(*Buffer).Contents())).ToNot(ContainSubstring("info"))
+	})
+
+	When("--show-values is given", func() {
+		BeforeEach(func() {
+			cmd.ShowValues = true
+		})
+
+		It("shows the values of both apps", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`name\s+change\s+some-app\s+other-app`))
+			Expect(testUI.Out).To(Say(`LOG_LEVEL\s+changed\s+info\s+debug`))
+			Expect(testUI.Out).To(Say(`NEW_VAR\s+added\s+new`))
+		})
+
+		It("still redacts the system-provided values", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`System-Provided:`))
+			Expect(testUI.Out).To(Say(`name\s+change\n`))
+			Expect(testUI.Out).To(Say(`VCAP_SERVICES p-mysql/db\s+added\n`))
+			Expect(testUI.Out).To(Say(`System-provided values are not shown because they can contain service credentials\.`))
+		})
+	})
+
+	When("the other app is in another org and space", func() {
+		BeforeEach(func() {
+			cmd.Organization = "other-org"
+			cmd.Space = "other-space"
+			cmd.RequiredArgs.OtherAppName = "some-app"
+			cmd.ShowValues = true
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{Name: "other-org", GUID: "other-org-guid"}, v7action.Warnings{"org-warning"}, nil)
+			fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{Name: "other-space", GUID: "other-space-guid"}, v7action.Warnings{"space-warning"}, nil)
+		})
+
+		It("looks the app up in that space and labels the apps by space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("org-warning"))
+			Expect(testUI.Err).To(Say("space-warning"))
+
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("other-space"))
+			Expect(orgGUID).To(Equal("other-org-guid"))
+
+			_, spaceGUID := fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(1)
+			Expect(spaceGUID).To(Equal("other-space-guid"))
+
+			Expect(testUI.Out).To(Say(`with app some-app in org other-org / space other-space`))
+			Expect(testUI.Out).To(Say(`name\s+change\s+some-space/some-app\s+other-space/some-app`))
+		})
+
+		When("the space cannot be found", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{}, nil, actionerror.SpaceNotFoundError{Name: "other-space"})
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SpaceNotFoundError{Name: "other-space"}))
+			})
+		})
+	})
+
+	When("getting the env of the other app fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall(1, v7action.EnvironmentVariableGroups{}, v7action.Warnings{"env-warning-2"}, errors.New("env-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("env-error"))
+			Expect(testUI.Err).To(Say("env-warning-2"))
+			Expect(fakeActor.DiffEnvironmentVariableGroupsCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+type DiffRevisionsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.AppName  `positional-args:"yes"`
+	From            flag.Revision `long:"from" required:"true" description:"The revision to compare from"`
+	To              flag.Revision `long:"to" description:"The revision to compare to (default: the deployed revision)"`
+	ShowEnv         bool          `long:"show-env" description:"Show the values of environment variables instead of redacting them"`
+	usage           interface{}   `usage:"CF_NAME diff-revisions APP_NAME --from VERSION [--to VERSION] [--show-env]\n\nEXAMPLES:\n   CF_NAME diff-revisions my-app --from 3\n   CF_NAME diff-revisions my-app --from 3 --to 5 --show-env"`
+	relatedCommands interface{}   `related_commands:"revision, revisions, rollback"`
+}
+
+func (cmd DiffRevisionsCommand) Execute(_ []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+	cmd.UI.DisplayNewline()
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	from, warnings, err := cmd.Actor.GetRevisionByApplicationAndVersion(app.GUID, cmd.From.Value)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var to resources.Revision
+	if cmd.To.IsSet {
+		to, warnings, err = cmd.Actor.GetRevisionByApplicationAndVersion(app.GUID, cmd.To.Value)
+	} else {
+		to, warnings, err = cmd.Actor.GetCurrentRevision(app.GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing revision {{.From}} with revision {{.To}} of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"From":      from.Version,
+		"To":        to.Version,
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	fromDetails, toDetails, err := cmd.getRevisionDetails(from, to)
+	if err != nil {
+		return err
+	}
+
+	return shared.RevisionDiffDisplayer{UI: cmd.UI, ShowEnv: cmd.ShowEnv}.DisplayDiff(fromDetails, toDetails)
+}
+
+func (cmd DiffRevisionsCommand) getRevisionDetails(from resources.Revision, to resources.Revision) (v7action.RevisionDetails, v7action.RevisionDetails, error) {
+	fromDetails, warnings, err := cmd.Actor.GetRevisionDetails(from)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v7action.RevisionDetails{}, v7action.RevisionDetails{}, err
+	}
+
+	toDetails, warnings, err := cmd.Actor.GetRevisionDetails(to)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v7action.RevisionDetails{}, v7action.RevisionDetails{}, err
+	}
+
+	return fromDetails, toDetails, nil
+}
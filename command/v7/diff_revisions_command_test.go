@@ -0,0 +1,181 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff-revisions Command", func() {
+	var (
+		cmd             v7.DiffRevisionsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+
+		fromRevision resources.Revision
+		toRevision   resources.Revision
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.DiffRevisionsCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.From = flag.Revision{NullInt: types.NullInt{Value: 3, IsSet: true}}
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "some-app-guid"}, v7action.Warnings{"app-warning"}, nil)
+
+		fromRevision = resources.Revision{GUID: "revision-3", Version: 3}
+		toRevision = resources.Revision{GUID: "revision-5", Version: 5}
+		fakeActor.GetRevisionByApplicationAndVersionReturns(fromRevision, v7action.Warnings{"revision-warning"}, nil)
+		fakeActor.GetCurrentRevisionReturns(toRevision, v7action.Warnings{"current-revision-warning"}, nil)
+
+		fakeActor.GetRevisionDetailsStub = func(revision resources.Revision) (v7action.RevisionDetails, v7action.Warnings, error) {
+			return v7action.RevisionDetails{
+				Revision: revision,
+				Droplet:  resources.Droplet{GUID: "droplet-for-" + revision.GUID},
+				EnvironmentVariables: resources.EnvironmentVariables{
+					"LEVEL": {Value: "level-for-" + revision.GUID, IsSet: true},
+				},
+			}, v7action.Warnings{"details-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("displays the experimental warning", func() {
+		Expect(testUI.Err).To(Say("This command is in EXPERIMENTAL stage and may change without notice"))
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("compares the revision with the deployed revision", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Comparing revision 3 with revision 5 of app some-app in org some-org / space some-space as banana\.\.\.`))
+		Expect(testUI.Out).To(Say(`droplet:`))
+		Expect(testUI.Out).To(Say(`- guid:\s+droplet-for-revision-3`))
+		Expect(testUI.Out).To(Say(`\+ guid:\s+droplet-for-revision-5`))
+		Expect(testUI.Out).To(Say(`environment variables:`))
+		Expect(testUI.Out).To(Say(`- LEVEL:\s+\[PRIVATE DATA HIDDEN\]`))
+
+		Expect(testUI.Err).To(Say("app-warning"))
+		Expect(testUI.Err).To(Say("revision-warning"))
+		Expect(testUI.Err).To(Say("current-revision-warning"))
+		Expect(testUI.Err).To(Say("details-warning"))
+
+		appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		Expect(fakeActor.GetRevisionByApplicationAndVersionCallCount()).To(Equal(1))
+		appGUID, version := fakeActor.GetRevisionByApplicationAndVersionArgsForCall(0)
+		Expect(appGUID).To(Equal("some-app-guid"))
+		Expect(version).To(Equal(3))
+
+		Expect(fakeActor.GetCurrentRevisionArgsForCall(0)).To(Equal("some-app-guid"))
+
+		Expect(fakeActor.GetRevisionDetailsCallCount()).To(Equal(2))
+		Expect(fakeActor.GetRevisionDetailsArgsForCall(0)).To(Equal(fromRevision))
+		Expect(fakeActor.GetRevisionDetailsArgsForCall(1)).To(Equal(toRevision))
+	})
+
+	When("--to is provided", func() {
+		BeforeEach(func() {
+			cmd.To = flag.Revision{NullInt: types.NullInt{Value: 5, IsSet: true}}
+			fakeActor.GetRevisionByApplicationAndVersionReturnsOnCall(1, toRevision, nil, nil)
+		})
+
+		It("compares with that revision", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(fakeActor.GetCurrentRevisionCallCount()).To(Equal(0))
+
+			Expect(fakeActor.GetRevisionByApplicationAndVersionCallCount()).To(Equal(2))
+			_, version := fakeActor.GetRevisionByApplicationAndVersionArgsForCall(1)
+			Expect(version).To(Equal(5))
+
+			Expect(testUI.Out).To(Say(`Comparing revision 3 with revision 5`))
+		})
+	})
+
+	When("--show-env is provided", func() {
+		BeforeEach(func() {
+			cmd.ShowEnv = true
+		})
+
+		It("displays environment variable values", func() {
+			Expect(testUI.Out).To(Say(`- LEVEL:\s+level-for-revision-3`))
+			Expect(testUI.Out).To(Say(`\+ LEVEL:\s+level-for-revision-5`))
+		})
+	})
+
+	When("no revision is deployed", func() {
+		BeforeEach(func() {
+			fakeActor.GetCurrentRevisionReturns(resources.Revision{}, v7action.Warnings{"current-revision-warning"}, actionerror.NoDeployedRevisionError{})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoDeployedRevisionError{}))
+			Expect(testUI.Err).To(Say("current-revision-warning"))
+			Expect(fakeActor.GetRevisionDetailsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the revision details fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRevisionDetailsStub = nil
+			fakeActor.GetRevisionDetailsReturns(v7action.RevisionDetails{}, v7action.Warnings{"details-warning"}, errors.New("details-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("details-error"))
+			Expect(testUI.Err).To(Say("details-warning"))
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"sort"
 	"strings"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/sorting"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -12,9 +13,9 @@ import (
 type DomainsCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME domains\n\nEXAMPLES:\n   CF_NAME domains\n   CF_NAME domains --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME domains --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
-	relatedCommands interface{} `related_commands:"create-private-domain, create-route, create-shared-domain, routes, set-label"`
-	Labels          string      `long:"labels" description:"Selector to filter domains by labels"`
+	usage           interface{}        `usage:"CF_NAME domains\n\nEXAMPLES:\n   CF_NAME domains\n   CF_NAME domains --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME domains --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
+	relatedCommands interface{}        `related_commands:"create-private-domain, create-route, create-shared-domain, routes, set-label"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter domains by labels"`
 }
 
 func (cmd DomainsCommand) Execute(args []string) error {
@@ -34,7 +35,7 @@ func (cmd DomainsCommand) Execute(args []string) error {
 		"CurrentUser": currentUser.Name,
 	})
 
-	domains, warnings, err := cmd.Actor.GetOrganizationDomains(targetedOrg.GUID, cmd.Labels)
+	domains, warnings, err := cmd.Actor.GetOrganizationDomains(targetedOrg.GUID, string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
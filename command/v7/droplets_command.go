@@ -12,9 +12,10 @@ import (
 type DropletsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME droplets APP_NAME"`
-	relatedCommands interface{}  `related_commands:"set-droplet, create-package, packages, app, push"`
+	RequiredArgs    flag.AppName       `positional-args:"yes"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter droplets by labels"`
+	usage           interface{}        `usage:"CF_NAME droplets APP_NAME [--labels SELECTOR]"`
+	relatedCommands interface{}        `related_commands:"set-droplet, create-package, packages, app, push"`
 }
 
 func (cmd DropletsCommand) Execute(args []string) error {
@@ -37,7 +38,7 @@ func (cmd DropletsCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	droplets, warnings, err := cmd.Actor.GetApplicationDroplets(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	droplets, warnings, err := cmd.Actor.GetApplicationDropletsWithLabelSelector(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -100,7 +100,7 @@ var _ = Describe("droplets Command", func() {
 
 		BeforeEach(func() {
 			expectedErr = ccerror.RequestError{}
-			fakeActor.GetApplicationDropletsReturns([]resources.Droplet{}, v7action.Warnings{"warning-1", "warning-2"}, expectedErr)
+			fakeActor.GetApplicationDropletsWithLabelSelectorReturns([]resources.Droplet{}, v7action.Warnings{"warning-1", "warning-2"}, expectedErr)
 		})
 
 		It("returns the error and prints warnings", func() {
@@ -132,7 +132,7 @@ var _ = Describe("droplets Command", func() {
 					IsCurrent: false,
 				},
 			}
-			fakeActor.GetApplicationDropletsReturns(droplets, v7action.Warnings{"warning-1", "warning-2"}, nil)
+			fakeActor.GetApplicationDropletsWithLabelSelectorReturns(droplets, v7action.Warnings{"warning-1", "warning-2"}, nil)
 		})
 
 		It("prints the application droplets and outputs warnings", func() {
@@ -153,16 +153,29 @@ var _ = Describe("droplets Command", func() {
 			Expect(testUI.Err).To(Say("warning-1"))
 			Expect(testUI.Err).To(Say("warning-2"))
 
-			Expect(fakeActor.GetApplicationDropletsCallCount()).To(Equal(1))
-			appName, spaceGUID := fakeActor.GetApplicationDropletsArgsForCall(0)
+			Expect(fakeActor.GetApplicationDropletsWithLabelSelectorCallCount()).To(Equal(1))
+			appName, spaceGUID, labelSelector := fakeActor.GetApplicationDropletsWithLabelSelectorArgsForCall(0)
 			Expect(appName).To(Equal("some-app"))
 			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labelSelector).To(BeEmpty())
+		})
+
+		When("the --labels flag is given", func() {
+			BeforeEach(func() {
+				cmd.Labels = "env=prod"
+			})
+
+			It("passes the label selector to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, labelSelector := fakeActor.GetApplicationDropletsWithLabelSelectorArgsForCall(0)
+				Expect(labelSelector).To(Equal("env=prod"))
+			})
 		})
 	})
 
 	When("getting the application droplets returns no droplets", func() {
 		BeforeEach(func() {
-			fakeActor.GetApplicationDropletsReturns([]resources.Droplet{}, v7action.Warnings{"warning-1", "warning-2"}, nil)
+			fakeActor.GetApplicationDropletsWithLabelSelectorReturns([]resources.Droplet{}, v7action.Warnings{"warning-1", "warning-2"}, nil)
 		})
 
 		It("displays there are no droplets", func() {
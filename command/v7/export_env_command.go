@@ -0,0 +1,93 @@
+package v7
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type ExportEnvCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ExportEnvironmentArgs `positional-args:"yes"`
+	RevealSecrets   bool                       `long:"reveal-secrets" description:"Write the values of secret-looking env variables instead of masking them"`
+	usage           interface{}                `usage:"CF_NAME export-env APP_NAME FILE_PATH [--reveal-secrets]\n\n   Writes the app's user-provided env variables. Files ending in .yml or .yaml are written as a YAML map,\n   other files as .env files. Values of variables whose names look like credentials (e.g. *_PASSWORD,\n   *_TOKEN) or that are URLs with a password are masked, and must be filled in before the file is imported.\n\nEXAMPLES:\n   CF_NAME export-env my-app .env\n   CF_NAME export-env my-app vars.yml --reveal-secrets"`
+	relatedCommands interface{}                `related_commands:"env, import-env, set-env"`
+}
+
+func (cmd ExportEnvCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	appName := cmd.RequiredArgs.AppName
+	path := cmd.RequiredArgs.PathToFile
+	cmd.UI.DisplayTextWithFlavor("Exporting env variables of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} to {{.Path}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   appName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Path":      path,
+		"Username":  user.Name,
+	})
+
+	envGroups, warnings, err := cmd.Actor.GetEnvironmentVariablesByApplicationNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	envVars := resources.EnvironmentVariables{}
+	for name, value := range envGroups.EnvironmentVariables {
+		valueAsString, ok := value.(string)
+		if !ok {
+			rawValue, err := json.Marshal(value)
+			if err != nil {
+				return err
+			}
+			valueAsString = string(rawValue)
+		}
+		envVars[name] = types.FilteredString{IsSet: true, Value: valueAsString}
+	}
+
+	rawFile, masked, err := cmd.Actor.MarshalEnvironmentVariableFile(v7action.EnvironmentVariableFileFormatForPath(path), envVars, cmd.RevealSecrets)
+	if err != nil {
+		return err
+	}
+
+	// the file can hold credentials, so only the user can read it
+	err = os.WriteFile(path, rawFile, 0600)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(envVars) == 0 {
+		cmd.UI.DisplayText("App {{.AppName}} has no user-provided env variables.", map[string]interface{}{
+			"AppName": appName,
+		})
+	} else {
+		cmd.UI.DisplayText("Exported {{.Count}} env variables.", map[string]interface{}{
+			"Count": len(envVars),
+		})
+	}
+	if len(masked) > 0 {
+		cmd.UI.DisplayText("Masked: {{.Names}}", map[string]interface{}{
+			"Names": strings.Join(masked, ", "),
+		})
+		cmd.UI.DisplayText("Use '--reveal-secrets' to export their values.")
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,166 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-env Command", func() {
+	var (
+		cmd             v7.ExportEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+
+		tmpDir  string
+		envFile string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.ExportEnvCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "export-env")
+		Expect(err).ToNot(HaveOccurred())
+		envFile = filepath.Join(tmpDir, ".env")
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.PathToFile = envFile
+
+		fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(
+			v7action.EnvironmentVariableGroups{
+				EnvironmentVariables: map[string]interface{}{
+					"LOG_LEVEL":   "debug",
+					"DB_PASSWORD": "hunter2",
+					"LIMITS":      map[string]interface{}{"max": float64(3)},
+				},
+			},
+			v7action.Warnings{"get-env-warning"},
+			nil,
+		)
+		fakeActor.MarshalEnvironmentVariableFileReturns([]byte("some-file"), []string{"DB_PASSWORD"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+		})
+	})
+
+	It("writes the user-provided variables to the file", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		appName, spaceGUID := fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+
+		format, envVars, revealSecrets := fakeActor.MarshalEnvironmentVariableFileArgsForCall(0)
+		Expect(format).To(Equal(v7action.DotEnvFormat))
+		Expect(envVars).To(Equal(resources.EnvironmentVariables{
+			"LOG_LEVEL":   types.FilteredString{IsSet: true, Value: "debug"},
+			"DB_PASSWORD": types.FilteredString{IsSet: true, Value: "hunter2"},
+			"LIMITS":      types.FilteredString{IsSet: true, Value: `{"max":3}`},
+		}))
+		Expect(revealSecrets).To(BeFalse())
+
+		rawFile, err := os.ReadFile(envFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(rawFile)).To(Equal("some-file"))
+
+		info, err := os.Stat(envFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		Expect(testUI.Out).To(Say(`Exporting env variables of app some-app in org some-org / space some-space to %s as banana\.\.\.`, envFile))
+		Expect(testUI.Err).To(Say("get-env-warning"))
+		Expect(testUI.Out).To(Say("Exported 3 env variables."))
+		Expect(testUI.Out).To(Say("Masked: DB_PASSWORD"))
+		Expect(testUI.Out).To(Say("Use '--reveal-secrets' to export their values."))
+		Expect(testUI.Out).To(Say("OK"))
+	})
+
+	When("--reveal-secrets is given", func() {
+		BeforeEach(func() {
+			cmd.RevealSecrets = true
+			fakeActor.MarshalEnvironmentVariableFileReturns([]byte("some-file"), nil, nil)
+		})
+
+		It("does not mask values", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			_, _, revealSecrets := fakeActor.MarshalEnvironmentVariableFileArgsForCall(0)
+			Expect(revealSecrets).To(BeTrue())
+			Expect(testUI.Out).ToNot(Say("Masked"))
+		})
+	})
+
+	When("the app has no user-provided variables", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(v7action.EnvironmentVariableGroups{}, nil, nil)
+			fakeActor.MarshalEnvironmentVariableFileReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("App some-app has no user-provided env variables."))
+		})
+	})
+
+	When("getting the variables fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(v7action.EnvironmentVariableGroups{}, v7action.Warnings{"get-env-warning"}, errors.New("get-env-error"))
+		})
+
+		It("returns the error without writing the file", func() {
+			Expect(executeErr).To(MatchError("get-env-error"))
+			Expect(testUI.Err).To(Say("get-env-warning"))
+			Expect(envFile).ToNot(BeAnExistingFile())
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package v7
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type ImportEnvCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ImportEnvironmentArgs `positional-args:"yes"`
+	Prune           bool                       `long:"prune" description:"Remove the app's env variables that are not in the file"`
+	usage           interface{}                `usage:"CF_NAME import-env APP_NAME FILE_PATH [--prune]\n\n   Files ending in .yml or .yaml are read as a YAML map of names to values. Other files are read as\n   .env files with one NAME=VALUE pair per line.\n\nEXAMPLES:\n   CF_NAME import-env my-app .env\n   CF_NAME import-env my-app staging-vars.yml --prune"`
+	relatedCommands interface{}                `related_commands:"env, export-env, restage, set-env, unset-env"`
+}
+
+func (cmd ImportEnvCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	path := string(cmd.RequiredArgs.PathToFile)
+	rawFile, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	envVars, err := cmd.Actor.ParseEnvironmentVariableFile(v7action.EnvironmentVariableFileFormatForPath(path), rawFile)
+	if err != nil {
+		return err
+	}
+
+	appName := cmd.RequiredArgs.AppName
+	cmd.UI.DisplayTextWithFlavor("Importing env variables from {{.Path}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Path":      path,
+		"AppName":   appName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	removed, warnings, err := cmd.Actor.SetEnvironmentVariablesByApplicationNameAndSpace(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		envVars,
+		cmd.Prune,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for name := range envVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	cmd.UI.DisplayNewline()
+	if len(names) > 0 {
+		cmd.UI.DisplayText("Set: {{.Names}}", map[string]interface{}{
+			"Names": strings.Join(names, ", "),
+		})
+	}
+	if len(removed) > 0 {
+		cmd.UI.DisplayText("Removed: {{.Names}}", map[string]interface{}{
+			"Names": strings.Join(removed, ", "),
+		})
+	}
+	if len(names) == 0 && len(removed) == 0 {
+		cmd.UI.DisplayText("No env variables to import.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Use 'cf restage {{.AppName}}' to ensure your env variable changes take effect.", map[string]interface{}{
+		"AppName": appName,
+	})
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("import-env Command", func() {
+	var (
+		cmd             v7.ImportEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+
+		tmpDir  string
+		envFile string
+		envVars resources.EnvironmentVariables
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.ImportEnvCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+		var err error
+		tmpDir, err = os.MkdirTemp("", "import-env")
+		Expect(err).ToNot(HaveOccurred())
+		envFile = filepath.Join(tmpDir, "vars.yml")
+		Expect(os.WriteFile(envFile, []byte("LOG_LEVEL: debug\n"), 0600)).To(Succeed())
+
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.PathToFile = flag.PathWithExistenceCheck(envFile)
+
+		envVars = resources.EnvironmentVariables{
+			"LOG_LEVEL": {IsSet: true, Value: "debug"},
+			"WORKERS":   {IsSet: true, Value: "4"},
+		}
+		fakeActor.ParseEnvironmentVariableFileReturns(envVars, nil)
+		fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceReturns(nil, v7action.Warnings{"set-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("sets all of the variables in the file on the app", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		format, rawFile := fakeActor.ParseEnvironmentVariableFileArgsForCall(0)
+		Expect(format).To(Equal(v7action.YAMLEnvFormat))
+		Expect(string(rawFile)).To(Equal("LOG_LEVEL: debug\n"))
+
+		Expect(fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceCallCount()).To(Equal(1))
+		appName, spaceGUID, actualEnvVars, prune := fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(actualEnvVars).To(Equal(envVars))
+		Expect(prune).To(BeFalse())
+
+		Expect(testUI.Out).To(Say(`Importing env variables from %s to app some-app in org some-org / space some-space as banana\.\.\.`, envFile))
+		Expect(testUI.Err).To(Say("set-warning"))
+		Expect(testUI.Out).To(Say("Set: LOG_LEVEL, WORKERS"))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say(`TIP: Use 'cf restage some-app' to ensure your env variable changes take effect\.`))
+	})
+
+	When("--prune is given", func() {
+		BeforeEach(func() {
+			cmd.Prune = true
+			fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceReturns([]string{"OLD_VAR"}, nil, nil)
+		})
+
+		It("removes the variables that are not in the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, _, _, prune := fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(0)
+			Expect(prune).To(BeTrue())
+			Expect(testUI.Out).To(Say("Set: LOG_LEVEL, WORKERS"))
+			Expect(testUI.Out).To(Say("Removed: OLD_VAR"))
+		})
+	})
+
+	When("the file cannot be parsed", func() {
+		BeforeEach(func() {
+			fakeActor.ParseEnvironmentVariableFileReturns(nil, actionerror.EnvironmentVariableFileError{Line: 2, Reason: "expected NAME=VALUE"})
+		})
+
+		It("returns the error without changing the app", func() {
+			Expect(executeErr).To(MatchError(actionerror.EnvironmentVariableFileError{Line: 2, Reason: "expected NAME=VALUE"}))
+			Expect(fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the file is empty", func() {
+		BeforeEach(func() {
+			fakeActor.ParseEnvironmentVariableFileReturns(resources.EnvironmentVariables{}, nil)
+		})
+
+		It("says there was nothing to import", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No env variables to import."))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+		})
+	})
+
+	When("setting the variables fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetEnvironmentVariablesByApplicationNameAndSpaceReturns(nil, v7action.Warnings{"set-warning"}, errors.New("set-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("set-error"))
+			Expect(testUI.Err).To(Say("set-warning"))
+		})
+	})
+})
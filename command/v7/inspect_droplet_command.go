@@ -0,0 +1,284 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type InspectDropletCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.OptionalAppName        `positional-args:"yes"`
+	Droplet         string                      `long:"droplet" description:"The guid of the droplet to inspect (default: app's current droplet)"`
+	File            flag.PathWithExistenceCheck `long:"file" description:"Path to a downloaded droplet to inspect instead of an app's droplet"`
+	Files           bool                        `long:"files" description:"List every file in the droplet"`
+	Diff            flag.PathWithExistenceCheck `long:"diff" description:"Path to a downloaded droplet to compare with, file by file"`
+	Extract         []string                    `long:"extract" description:"Extract the files matching a path or glob (e.g. app/config/*.yml); can specify multiple times"`
+	Path            string                      `long:"path" short:"p" description:"Directory to extract files to (default: current working directory)"`
+	usage           interface{}                 `usage:"CF_NAME inspect-droplet (APP_NAME [--droplet DROPLET_GUID] | --file DROPLET_PATH) [--files]\n   [--diff OTHER_DROPLET_PATH] [--extract PATTERN]... [-p DIRECTORY]\n\nEXAMPLES:\n   CF_NAME inspect-droplet my-app --files\n   CF_NAME download-droplet my-app --path prod.tgz\n   CF_NAME inspect-droplet my-app --droplet 8d4c3f2e-7a41-4c8a-9f6b-1f0e6c6b2a55 --diff prod.tgz\n   CF_NAME inspect-droplet --file prod.tgz --extract staging_info.yml --extract 'app/config/*' -p /tmp/prod"`
+	relatedCommands interface{}                 `related_commands:"download-droplet, droplets, set-droplet"`
+}
+
+func (cmd InspectDropletCommand) Execute(args []string) error {
+	err := cmd.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	rawDroplet, err := cmd.getDroplet()
+	if err != nil {
+		return err
+	}
+
+	archive, err := cmd.Actor.ReadDropletArchive(rawDroplet)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.displaySummary(archive)
+
+	if cmd.Files {
+		cmd.UI.DisplayNewline()
+		cmd.displayFiles(archive)
+	}
+
+	if cmd.Diff != "" {
+		cmd.UI.DisplayNewline()
+		err = cmd.displayDiff(archive)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(cmd.Extract) > 0 {
+		cmd.UI.DisplayNewline()
+		err = cmd.extractFiles(rawDroplet)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (cmd InspectDropletCommand) validateFlags() error {
+	switch {
+	case cmd.File == "" && cmd.RequiredArgs.AppName == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case cmd.File != "" && cmd.RequiredArgs.AppName != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--file"}}
+	case cmd.File != "" && cmd.Droplet != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"--droplet", "--file"}}
+	case cmd.Path != "" && len(cmd.Extract) == 0:
+		return translatableerror.RequiredFlagsError{Arg1: "--path, -p", Arg2: "--extract"}
+	}
+	return nil
+}
+
+func (cmd InspectDropletCommand) getDroplet() ([]byte, error) {
+	if cmd.File != "" {
+		cmd.UI.DisplayText("Inspecting droplet {{.Path}}...", map[string]interface{}{
+			"Path": string(cmd.File),
+		})
+		return os.ReadFile(string(cmd.File))
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rawDroplet []byte
+		warnings   v7action.Warnings
+	)
+
+	if cmd.Droplet != "" {
+		cmd.UI.DisplayTextWithFlavor("Inspecting droplet {{.DropletGUID}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"DropletGUID": cmd.Droplet,
+			"AppName":     cmd.RequiredArgs.AppName,
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"SpaceName":   cmd.Config.TargetedSpace().Name,
+			"Username":    user.Name,
+		})
+
+		rawDroplet, warnings, err = cmd.Actor.DownloadDropletByGUIDAndAppName(cmd.Droplet, cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Inspecting current droplet for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+
+		rawDroplet, _, warnings, err = cmd.Actor.DownloadCurrentDropletByAppName(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	}
+
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.DropletNotFoundError); ok {
+			return nil, translatableerror.NoDropletForAppError{AppName: cmd.RequiredArgs.AppName, DropletGUID: cmd.Droplet}
+		}
+		return nil, err
+	}
+
+	return rawDroplet, nil
+}
+
+func (cmd InspectDropletCommand) displaySummary(archive v7action.DropletArchive) {
+	var files int
+	var size int64
+	for _, file := range archive.Files {
+		if file.Mode.IsRegular() {
+			files++
+			size += file.Size
+		}
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("detected buildpack:"), archive.StagingInfo.DetectedBuildpack},
+		{cmd.UI.TranslateText("buildpacks:"), cmd.buildpacksText(archive.Buildpacks)},
+		{cmd.UI.TranslateText("start command:"), archive.StagingInfo.StartCommand},
+		{cmd.UI.TranslateText("files:"), fmt.Sprint(files)},
+		{cmd.UI.TranslateText("size:"), bytefmt.ByteSize(uint64(size))},
+	}, 3)
+}
+
+func (cmd InspectDropletCommand) buildpacksText(buildpacks []v7action.DropletBuildpack) string {
+	var names []string
+	for _, buildpack := range buildpacks {
+		name := buildpack.Name
+		if buildpack.Version != "" {
+			name = fmt.Sprintf("%s %s", buildpack.Name, buildpack.Version)
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func (cmd InspectDropletCommand) displayFiles(archive v7action.DropletArchive) {
+	table := [][]string{{cmd.UI.TranslateText("mode"), cmd.UI.TranslateText("size"), cmd.UI.TranslateText("path")}}
+	for _, file := range archive.Files {
+		table = append(table, []string{file.Mode.String(), cmd.fileSize(file), cmd.filePath(file)})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd InspectDropletCommand) displayDiff(archive v7action.DropletArchive) error {
+	otherDroplet, err := os.ReadFile(string(cmd.Diff))
+	if err != nil {
+		return err
+	}
+
+	other, err := cmd.Actor.ReadDropletArchive(otherDroplet)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Comparing with droplet {{.Path}}...", map[string]interface{}{
+		"Path": string(cmd.Diff),
+	})
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayTableWithHeader("", [][]string{
+		{"", cmd.UI.TranslateText("this droplet"), cmd.UI.TranslateText("other droplet")},
+		{cmd.UI.TranslateText("detected buildpack:"), archive.StagingInfo.DetectedBuildpack, other.StagingInfo.DetectedBuildpack},
+		{cmd.UI.TranslateText("buildpacks:"), cmd.buildpacksText(archive.Buildpacks), cmd.buildpacksText(other.Buildpacks)},
+		{cmd.UI.TranslateText("start command:"), archive.StagingInfo.StartCommand, other.StagingInfo.StartCommand},
+	}, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	diffs := cmd.Actor.DiffDropletArchives(archive, other)
+	if len(diffs) == 0 {
+		cmd.UI.DisplayText("The droplets contain the same files.")
+		return nil
+	}
+
+	table := [][]string{{cmd.UI.TranslateText("change"), cmd.UI.TranslateText("path"), cmd.UI.TranslateText("this droplet"), cmd.UI.TranslateText("other droplet")}}
+	for _, diff := range diffs {
+		row := []string{cmd.UI.TranslateText(string(diff.Change)), diff.Path, "", ""}
+		if diff.Change != v7action.DropletFileAdded {
+			row[2] = cmd.fileDescription(diff.Original)
+		}
+		if diff.Change != v7action.DropletFileRemoved {
+			row[3] = cmd.fileDescription(diff.Other)
+		}
+		table = append(table, row)
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("{{.Count}} files differ.", map[string]interface{}{
+		"Count": len(diffs),
+	})
+	return nil
+}
+
+func (cmd InspectDropletCommand) extractFiles(rawDroplet []byte) error {
+	destination := cmd.Path
+	if destination == "" {
+		var err error
+		destination, err = os.Getwd()
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayText("Extracting files matching {{.Patterns}} to {{.Path}}...", map[string]interface{}{
+		"Patterns": strings.Join(cmd.Extract, ", "),
+		"Path":     destination,
+	})
+
+	extracted, err := cmd.Actor.ExtractDropletFiles(rawDroplet, cmd.Extract, destination)
+	for _, path := range extracted {
+		cmd.UI.DisplayText("  {{.Path}}", map[string]interface{}{"Path": path})
+	}
+	if err != nil {
+		return translatableerror.DropletFileError{Err: err}
+	}
+
+	if len(extracted) == 0 {
+		cmd.UI.DisplayWarning("No files in the droplet match the given patterns.")
+	}
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (InspectDropletCommand) fileSize(file v7action.DropletFile) string {
+	if !file.Mode.IsRegular() {
+		return ""
+	}
+	return bytefmt.ByteSize(uint64(file.Size))
+}
+
+func (InspectDropletCommand) filePath(file v7action.DropletFile) string {
+	if file.LinkTarget != "" {
+		return fmt.Sprintf("%s -> %s", file.Path, file.LinkTarget)
+	}
+	return file.Path
+}
+
+func (cmd InspectDropletCommand) fileDescription(file v7action.DropletFile) string {
+	description := file.Mode.String()
+	if size := cmd.fileSize(file); size != "" {
+		description = fmt.Sprintf("%s %s %s", description, size, file.SHA1[:7])
+	}
+	if file.LinkTarget != "" {
+		description = fmt.Sprintf("%s -> %s", description, file.LinkTarget)
+	}
+	return description
+}
@@ -0,0 +1,280 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("inspect-droplet Command", func() {
+	var (
+		cmd             InspectDropletCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+
+		archive v7action.DropletArchive
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = InspectDropletCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.DownloadCurrentDropletByAppNameReturns([]byte("some-droplet"), "some-droplet-guid", v7action.Warnings{"download-warning"}, nil)
+
+		archive = v7action.DropletArchive{
+			StagingInfo: v7action.DropletStagingInfo{DetectedBuildpack: "ruby", StartCommand: "bundle exec rackup"},
+			Buildpacks:  []v7action.DropletBuildpack{{Name: "nodejs", Version: "1.8.2"}, {Name: "ruby", Version: "1.10.0"}},
+			Files: []v7action.DropletFile{
+				{Path: "app", Mode: os.ModeDir | 0755},
+				{Path: "app/config.ru", Mode: 0644, Size: 2048, SHA1: "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"},
+				{Path: "app/current", Mode: os.ModeSymlink | 0777, LinkTarget: "releases/1"},
+			},
+		}
+		fakeActor.ReadDropletArchiveReturns(archive, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("summarizes the app's current droplet", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		appName, spaceGUID := fakeActor.DownloadCurrentDropletByAppNameArgsForCall(0)
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(fakeActor.ReadDropletArchiveArgsForCall(0)).To(Equal([]byte("some-droplet")))
+
+		Expect(testUI.Out).To(Say(`Inspecting current droplet for app some-app in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Err).To(Say("download-warning"))
+		Expect(testUI.Out).To(Say(`detected buildpack:\s+ruby`))
+		Expect(testUI.Out).To(Say(`buildpacks:\s+nodejs 1\.8\.2, ruby 1\.10\.0`))
+		Expect(testUI.Out).To(Say(`start command:\s+bundle exec rackup`))
+		Expect(testUI.Out).To(Say(`files:\s+1`))
+		Expect(testUI.Out).To(Say(`size:\s+2K`))
+		Expect(testUI.Out).ToNot(Say(`app/config\.ru`))
+	})
+
+	When("a droplet guid is provided", func() {
+		BeforeEach(func() {
+			cmd.Droplet = "some-droplet-guid"
+			fakeActor.DownloadDropletByGUIDAndAppNameReturns([]byte("other-droplet"), nil, nil)
+		})
+
+		It("inspects that droplet", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			dropletGUID, appName, spaceGUID := fakeActor.DownloadDropletByGUIDAndAppNameArgsForCall(0)
+			Expect(dropletGUID).To(Equal("some-droplet-guid"))
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(fakeActor.ReadDropletArchiveArgsForCall(0)).To(Equal([]byte("other-droplet")))
+			Expect(testUI.Out).To(Say(`Inspecting droplet some-droplet-guid for app some-app in org some-org / space some-space as some-user\.\.\.`))
+		})
+	})
+
+	When("the app has no droplet", func() {
+		BeforeEach(func() {
+			fakeActor.DownloadCurrentDropletByAppNameReturns(nil, "", nil, actionerror.DropletNotFoundError{})
+		})
+
+		It("returns a NoDropletForAppError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoDropletForAppError{AppName: "some-app"}))
+		})
+	})
+
+	When("a droplet file is provided", func() {
+		var dropletPath string
+
+		BeforeEach(func() {
+			dropletPath = filepath.Join(GinkgoT().TempDir(), "droplet.tgz")
+			Expect(os.WriteFile(dropletPath, []byte("local-droplet"), 0600)).To(Succeed())
+			cmd.RequiredArgs.AppName = ""
+			cmd.File = flag.PathWithExistenceCheck(dropletPath)
+		})
+
+		It("inspects the file without needing a target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.DownloadCurrentDropletByAppNameCallCount()).To(Equal(0))
+			Expect(fakeActor.ReadDropletArchiveArgsForCall(0)).To(Equal([]byte("local-droplet")))
+			Expect(testUI.Out).To(Say(`Inspecting droplet %s\.\.\.`, dropletPath))
+		})
+	})
+
+	When("--files is provided", func() {
+		BeforeEach(func() {
+			cmd.Files = true
+		})
+
+		It("lists every file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`mode\s+size\s+path`))
+			Expect(testUI.Out).To(Say(`drwxr-xr-x\s+app`))
+			Expect(testUI.Out).To(Say(`-rw-r--r--\s+2K\s+app/config\.ru`))
+			Expect(testUI.Out).To(Say(`Lrwxrwxrwx\s+app/current -> releases/1`))
+		})
+	})
+
+	When("--diff is provided", func() {
+		var otherPath string
+
+		BeforeEach(func() {
+			otherPath = filepath.Join(GinkgoT().TempDir(), "other.tgz")
+			Expect(os.WriteFile(otherPath, []byte("other-droplet"), 0600)).To(Succeed())
+			cmd.Diff = flag.PathWithExistenceCheck(otherPath)
+
+			other := v7action.DropletArchive{
+				StagingInfo: v7action.DropletStagingInfo{DetectedBuildpack: "ruby", StartCommand: "bin/rails server"},
+				Buildpacks:  []v7action.DropletBuildpack{{Name: "ruby", Version: "1.10.1"}},
+			}
+			fakeActor.ReadDropletArchiveReturnsOnCall(1, other, nil)
+			fakeActor.DiffDropletArchivesReturns([]v7action.DropletFileDiff{
+				{
+					Path:     "app/config.ru",
+					Change:   v7action.DropletFileModified,
+					Original: archive.Files[1],
+					Other:    v7action.DropletFile{Path: "app/config.ru", Mode: 0644, Size: 1024, SHA1: "bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"},
+				},
+				{
+					Path:   "app/new.rb",
+					Change: v7action.DropletFileAdded,
+					Other:  v7action.DropletFile{Path: "app/new.rb", Mode: 0644, Size: 10, SHA1: "cccccccccccccccccccccccccccccccccccccccc"},
+				},
+			})
+		})
+
+		It("compares the staging info and the files", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.ReadDropletArchiveArgsForCall(1)).To(Equal([]byte("other-droplet")))
+			original, other := fakeActor.DiffDropletArchivesArgsForCall(0)
+			Expect(original).To(Equal(archive))
+			Expect(other.StagingInfo.StartCommand).To(Equal("bin/rails server"))
+
+			Expect(testUI.Out).To(Say(`Comparing with droplet %s\.\.\.`, otherPath))
+			Expect(testUI.Out).To(Say(`this droplet\s+other droplet`))
+			Expect(testUI.Out).To(Say(`buildpacks:\s+nodejs 1\.8\.2, ruby 1\.10\.0\s+ruby 1\.10\.1`))
+			Expect(testUI.Out).To(Say(`start command:\s+bundle exec rackup\s+bin/rails server`))
+			Expect(testUI.Out).To(Say(`change\s+path\s+this droplet\s+other droplet`))
+			Expect(testUI.Out).To(Say(`modified\s+app/config\.ru\s+-rw-r--r-- 2K aaaaaaa\s+-rw-r--r-- 1K bbbbbbb`))
+			Expect(testUI.Out).To(Say(`added\s+app/new\.rb\s+-rw-r--r-- 10B ccccccc`))
+			Expect(testUI.Out).To(Say(`2 files differ\.`))
+		})
+
+		When("the droplets have the same files", func() {
+			BeforeEach(func() {
+				fakeActor.DiffDropletArchivesReturns(nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`The droplets contain the same files\.`))
+			})
+		})
+
+		When("the other droplet cannot be read", func() {
+			BeforeEach(func() {
+				fakeActor.ReadDropletArchiveReturnsOnCall(1, v7action.DropletArchive{}, errors.New("not a droplet"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("not a droplet"))
+			})
+		})
+	})
+
+	When("--extract is provided", func() {
+		BeforeEach(func() {
+			cmd.Extract = []string{"app/config.ru", "app/lib/*"}
+			cmd.Path = "/some/dir"
+			fakeActor.ExtractDropletFilesReturns([]string{"app/config.ru"}, nil)
+		})
+
+		It("extracts the matching files to the path", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			rawDroplet, patterns, destination := fakeActor.ExtractDropletFilesArgsForCall(0)
+			Expect(rawDroplet).To(Equal([]byte("some-droplet")))
+			Expect(patterns).To(Equal([]string{"app/config.ru", "app/lib/*"}))
+			Expect(destination).To(Equal("/some/dir"))
+
+			Expect(testUI.Out).To(Say(`Extracting files matching app/config\.ru, app/lib/\* to /some/dir\.\.\.`))
+			Expect(testUI.Out).To(Say(`app/config\.ru`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("no files match", func() {
+			BeforeEach(func() {
+				fakeActor.ExtractDropletFilesReturns(nil, nil)
+			})
+
+			It("warns", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("No files in the droplet match the given patterns."))
+			})
+		})
+
+		When("extracting fails", func() {
+			BeforeEach(func() {
+				fakeActor.ExtractDropletFilesReturns(nil, errors.New("disk full"))
+			})
+
+			It("returns a DropletFileError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.DropletFileError{Err: errors.New("disk full")}))
+			})
+		})
+	})
+
+	DescribeTable("invalid flag combinations",
+		func(setup func(), expectedErr error) {
+			setup()
+			Expect(cmd.Execute(nil)).To(MatchError(expectedErr))
+		},
+		Entry("neither an app nor a file",
+			func() { cmd.RequiredArgs.AppName = "" },
+			translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}),
+		Entry("an app and a file",
+			func() { cmd.File = "droplet.tgz" },
+			translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--file"}}),
+		Entry("a droplet guid and a file",
+			func() {
+				cmd.RequiredArgs.AppName = ""
+				cmd.File = "droplet.tgz"
+				cmd.Droplet = "some-guid"
+			},
+			translatableerror.ArgumentCombinationError{Args: []string{"--droplet", "--file"}}),
+		Entry("a path without --extract",
+			func() { cmd.Path = "/some/dir" },
+			translatableerror.RequiredFlagsError{Arg1: "--path, -p", Arg2: "--extract"}),
+	)
+})
@@ -7,9 +7,12 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SetLabelActor
@@ -27,6 +30,21 @@ type SetLabelActor interface {
 	UpdateServiceBrokerLabelsByServiceBrokerName(string, map[string]types.NullString) (v7action.Warnings, error)
 	UpdateServiceOfferingLabels(serviceOfferingName string, serviceBrokerName string, labels map[string]types.NullString) (v7action.Warnings, error)
 	UpdateServicePlanLabels(servicePlanName string, serviceOfferingName string, serviceBrokerName string, labels map[string]types.NullString) (v7action.Warnings, error)
+
+	UpdateApplicationAnnotationsByApplicationName(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateBuildpackAnnotationsByBuildpackNameAndStack(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateDomainAnnotationsByDomainName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateOrganizationAnnotationsByOrganizationName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateRouteAnnotations(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateSpaceAnnotationsBySpaceName(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateStackAnnotationsByStackName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceInstanceAnnotations(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceBrokerAnnotationsByServiceBrokerName(string, map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServiceOfferingAnnotations(serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (v7action.Warnings, error)
+	UpdateServicePlanAnnotations(servicePlanName string, serviceOfferingName string, serviceBrokerName string, annotations map[string]types.NullString) (v7action.Warnings, error)
+
+	GetResourcesByLabelSelector(resourceType string, labelSelector string, scope v7action.LabelSelectorScope) ([]v7action.MetadataResource, v7action.Warnings, error)
+	UpdateResourcesMetadata(resourceType string, resourceGUIDs []string, metadata resources.Metadata, batchUpdated func(updated int)) (v7action.Warnings, error)
 }
 
 type ActionType string
@@ -36,30 +54,80 @@ const (
 	Set   ActionType = "Setting"
 )
 
+// MetadataType is the kind of metadata a command reads or updates. The zero
+// value means labels.
+type MetadataType string
+
+const (
+	LabelMetadata      MetadataType = "label"
+	AnnotationMetadata MetadataType = "annotation"
+)
+
+func (metadataType MetadataType) String() string {
+	if metadataType == "" {
+		return string(LabelMetadata)
+	}
+	return string(metadataType)
+}
+
+// TargetResource identifies the resources to update: either a single named
+// resource, or every resource of ResourceType matching LabelSelector.
 type TargetResource struct {
 	ResourceType    string
 	ResourceName    string
 	BuildpackStack  string
 	ServiceBroker   string
 	ServiceOffering string
+
+	LabelSelector string
+	Scope         flag.LabelSelectorScope
+	Force         bool
 }
 
 type LabelUpdater struct {
 	targetResource TargetResource
-	labels         map[string]types.NullString
+	metadata       map[string]types.NullString
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       SetLabelActor
 
-	Username string
-	Action   ActionType
+	Username     string
+	Action       ActionType
+	MetadataType MetadataType
+}
+
+// labelUpdateArgs returns the resource name and the labels given to set-label
+// or unset-label. With --selector, the positional arguments after the resource
+// type are all labels, and a resource name given before --selector is an
+// error.
+func labelUpdateArgs(resourceName flag.LabelResourceName, labels []string, selector flag.LabelUpdateSelector, labelArgName string) (string, []string, error) {
+	if selector.Selector == "" {
+		if resourceName.Name == "" {
+			return "", nil, translatableerror.RequiredArgumentError{ArgumentName: "RESOURCE_NAME"}
+		}
+		if len(labels) == 0 {
+			return "", nil, translatableerror.RequiredArgumentError{ArgumentName: labelArgName}
+		}
+		return resourceName.Name, labels, nil
+	}
+
+	if resourceName.GivenBefore(selector) {
+		return "", nil, translatableerror.ArgumentCombinationError{Args: []string{"RESOURCE_NAME", "--selector"}}
+	}
+	if resourceName.Name != "" {
+		labels = append([]string{resourceName.Name}, labels...)
+	}
+	if len(labels) == 0 {
+		return "", nil, translatableerror.RequiredArgumentError{ArgumentName: labelArgName}
+	}
+	return "", labels, nil
 }
 
 func (cmd *LabelUpdater) Execute(targetResource TargetResource, labels map[string]types.NullString) error {
 	cmd.targetResource = targetResource
-	cmd.labels = labels
+	cmd.metadata = labels
 	cmd.targetResource.ResourceType = strings.ToLower(cmd.targetResource.ResourceType)
 
 	user, err := cmd.Actor.GetCurrentUser()
@@ -73,54 +141,252 @@ func (cmd *LabelUpdater) Execute(targetResource TargetResource, labels map[strin
 		return err
 	}
 
+	if cmd.targetResource.LabelSelector != "" {
+		return cmd.executeWithLabelSelector()
+	}
+
 	if err := cmd.checkTarget(); err != nil {
 		return err
 	}
 
+	cmd.displayMessage()
+
 	var warnings v7action.Warnings
+	if cmd.MetadataType == AnnotationMetadata {
+		warnings, err = cmd.updateAnnotations()
+	} else {
+		warnings, err = cmd.updateLabels()
+	}
+
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd *LabelUpdater) executeWithLabelSelector() error {
+	scope, err := cmd.labelSelectorScope()
+	if err != nil {
+		return err
+	}
+
+	var actorScope v7action.LabelSelectorScope
+	switch scope {
+	case flag.SpaceLabelSelectorScope:
+		err = cmd.SharedActor.CheckTarget(true, true)
+		actorScope = v7action.LabelSelectorScope{
+			OrganizationGUID: cmd.Config.TargetedOrganization().GUID,
+			SpaceGUID:        cmd.Config.TargetedSpace().GUID,
+		}
+	case flag.OrgLabelSelectorScope:
+		err = cmd.SharedActor.CheckTarget(true, false)
+		actorScope = v7action.LabelSelectorScope{OrganizationGUID: cmd.Config.TargetedOrganization().GUID}
+	default:
+		err = cmd.SharedActor.CheckTarget(false, false)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.displayLabelSelectorMessage(scope)
+
+	matches, warnings, err := cmd.Actor.GetResourcesByLabelSelector(cmd.targetResource.ResourceType, cmd.targetResource.LabelSelector, actorScope)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	if len(matches) == 0 {
+		cmd.UI.DisplayText("No {{.ResourceType}}s match the selector.", map[string]interface{}{
+			"ResourceType": cmd.targetResource.ResourceType,
+		})
+		return nil
+	}
+
+	table := [][]string{{cmd.UI.TranslateText("name")}}
+	guids := make([]string, 0, len(matches))
+	for _, match := range matches {
+		table = append(table, []string{match.Name})
+		guids = append(guids, match.GUID)
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	if !cmd.targetResource.Force {
+		confirmed, err := cmd.UI.DisplayBoolPrompt(false, "Really update {{.MetadataType}}(s) for {{.Count}} {{.ResourceType}}(s)?", map[string]interface{}{
+			"MetadataType": cmd.MetadataType,
+			"Count":        len(matches),
+			"ResourceType": cmd.targetResource.ResourceType,
+		})
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			cmd.UI.DisplayText("No {{.MetadataType}}s were updated.", map[string]interface{}{
+				"MetadataType": cmd.MetadataType,
+			})
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor(string(cmd.Action)+" {{.MetadataType}}(s) for {{.Count}} {{.ResourceType}}(s) as {{.User}}...", map[string]interface{}{
+		"MetadataType": cmd.MetadataType,
+		"Count":        len(matches),
+		"ResourceType": cmd.targetResource.ResourceType,
+		"User":         cmd.Username,
+	})
+
+	metadata := resources.Metadata{Labels: cmd.metadata}
+	if cmd.MetadataType == AnnotationMetadata {
+		metadata = resources.Metadata{Annotations: cmd.metadata}
+	}
+
+	updated := 0
+	warnings, err = cmd.Actor.UpdateResourcesMetadata(cmd.targetResource.ResourceType, guids, metadata, func(batchUpdated int) {
+		updated = batchUpdated
+		cmd.UI.DisplayText("Updated {{.Updated}} of {{.Count}} {{.ResourceType}}(s).", map[string]interface{}{
+			"Updated":      updated,
+			"Count":        len(guids),
+			"ResourceType": cmd.targetResource.ResourceType,
+		})
+	})
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		cmd.UI.DisplayWarning("Updated {{.Updated}} of {{.Count}} {{.ResourceType}}(s) before a batch failed.", map[string]interface{}{
+			"Updated":      updated,
+			"Count":        len(guids),
+			"ResourceType": cmd.targetResource.ResourceType,
+		})
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// labelSelectorScope returns the scope of a --selector update, defaulting to
+// the narrowest scope the resource type can be filtered by.
+func (cmd *LabelUpdater) labelSelectorScope() (flag.LabelSelectorScope, error) {
+	scope := cmd.targetResource.Scope
+
 	switch ResourceType(cmd.targetResource.ResourceType) {
-	case App:
+	case App, Route, ServiceInstance:
+		if scope == "" {
+			return flag.SpaceLabelSelectorScope, nil
+		}
+		return scope, nil
+	case Space:
+		if scope == "" {
+			return flag.OrgLabelSelectorScope, nil
+		}
+		if scope == flag.SpaceLabelSelectorScope {
+			return "", translatableerror.ArgumentCombinationError{
+				Args: []string{cmd.targetResource.ResourceType, "--scope " + string(scope)},
+			}
+		}
+		return scope, nil
+	default:
+		if scope != "" && scope != flag.AllLabelSelectorScope {
+			return "", translatableerror.ArgumentCombinationError{
+				Args: []string{cmd.targetResource.ResourceType, "--scope " + string(scope)},
+			}
+		}
+		return flag.AllLabelSelectorScope, nil
+	}
+}
+
+func (cmd *LabelUpdater) displayLabelSelectorMessage(scope flag.LabelSelectorScope) {
+	template := "Getting {{.ResourceType}}s matching selector {{.Selector}}"
+	switch scope {
+	case flag.SpaceLabelSelectorScope:
+		template += " in org {{.OrgName}} / space {{.SpaceName}}"
+	case flag.OrgLabelSelectorScope:
+		template += " in org {{.OrgName}}"
+	}
+	template += " as {{.User}}..."
+
+	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
+		"ResourceType": cmd.targetResource.ResourceType,
+		"Selector":     cmd.targetResource.LabelSelector,
+		"OrgName":      cmd.Config.TargetedOrganization().Name,
+		"SpaceName":    cmd.Config.TargetedSpace().Name,
+		"User":         cmd.Username,
+	})
+}
+
+func (cmd *LabelUpdater) displayMessage() {
+	switch ResourceType(cmd.targetResource.ResourceType) {
+	case App, Route, ServiceInstance:
 		cmd.displayMessageWithOrgAndSpace()
-		warnings, err = cmd.Actor.UpdateApplicationLabelsByApplicationName(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.labels)
 	case Buildpack:
 		cmd.displayMessageWithStack()
-		warnings, err = cmd.Actor.UpdateBuildpackLabelsByBuildpackNameAndStack(cmd.targetResource.ResourceName, cmd.targetResource.BuildpackStack, cmd.labels)
-	case Domain:
+	case Domain, Org, ServiceBroker, Stack:
 		cmd.displayMessageDefault()
-		warnings, err = cmd.Actor.UpdateDomainLabelsByDomainName(cmd.targetResource.ResourceName, cmd.labels)
+	case ServiceOffering, ServicePlan:
+		cmd.displayMessageForServiceCommands()
+	case Space:
+		cmd.displayMessageWithOrg()
+	}
+}
+
+func (cmd *LabelUpdater) updateLabels() (v7action.Warnings, error) {
+	switch ResourceType(cmd.targetResource.ResourceType) {
+	case App:
+		return cmd.Actor.UpdateApplicationLabelsByApplicationName(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.metadata)
+	case Buildpack:
+		return cmd.Actor.UpdateBuildpackLabelsByBuildpackNameAndStack(cmd.targetResource.ResourceName, cmd.targetResource.BuildpackStack, cmd.metadata)
+	case Domain:
+		return cmd.Actor.UpdateDomainLabelsByDomainName(cmd.targetResource.ResourceName, cmd.metadata)
 	case Org:
-		cmd.displayMessageDefault()
-		warnings, err = cmd.Actor.UpdateOrganizationLabelsByOrganizationName(cmd.targetResource.ResourceName, cmd.labels)
+		return cmd.Actor.UpdateOrganizationLabelsByOrganizationName(cmd.targetResource.ResourceName, cmd.metadata)
 	case Route:
-		cmd.displayMessageWithOrgAndSpace()
-		warnings, err = cmd.Actor.UpdateRouteLabels(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.labels)
+		return cmd.Actor.UpdateRouteLabels(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.metadata)
 	case ServiceBroker:
-		cmd.displayMessageDefault()
-		warnings, err = cmd.Actor.UpdateServiceBrokerLabelsByServiceBrokerName(cmd.targetResource.ResourceName, cmd.labels)
+		return cmd.Actor.UpdateServiceBrokerLabelsByServiceBrokerName(cmd.targetResource.ResourceName, cmd.metadata)
 	case ServiceInstance:
-		cmd.displayMessageWithOrgAndSpace()
-		warnings, err = cmd.Actor.UpdateServiceInstanceLabels(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.labels)
+		return cmd.Actor.UpdateServiceInstanceLabels(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.metadata)
 	case ServiceOffering:
-		cmd.displayMessageForServiceCommands()
-		warnings, err = cmd.Actor.UpdateServiceOfferingLabels(cmd.targetResource.ResourceName, cmd.targetResource.ServiceBroker, cmd.labels)
+		return cmd.Actor.UpdateServiceOfferingLabels(cmd.targetResource.ResourceName, cmd.targetResource.ServiceBroker, cmd.metadata)
 	case ServicePlan:
-		cmd.displayMessageForServiceCommands()
-		warnings, err = cmd.Actor.UpdateServicePlanLabels(cmd.targetResource.ResourceName, cmd.targetResource.ServiceOffering, cmd.targetResource.ServiceBroker, cmd.labels)
+		return cmd.Actor.UpdateServicePlanLabels(cmd.targetResource.ResourceName, cmd.targetResource.ServiceOffering, cmd.targetResource.ServiceBroker, cmd.metadata)
 	case Space:
-		cmd.displayMessageWithOrg()
-		warnings, err = cmd.Actor.UpdateSpaceLabelsBySpaceName(cmd.targetResource.ResourceName, cmd.Config.TargetedOrganization().GUID, cmd.labels)
+		return cmd.Actor.UpdateSpaceLabelsBySpaceName(cmd.targetResource.ResourceName, cmd.Config.TargetedOrganization().GUID, cmd.metadata)
 	case Stack:
-		cmd.displayMessageDefault()
-		warnings, err = cmd.Actor.UpdateStackLabelsByStackName(cmd.targetResource.ResourceName, cmd.labels)
+		return cmd.Actor.UpdateStackLabelsByStackName(cmd.targetResource.ResourceName, cmd.metadata)
 	}
+	return nil, nil
+}
 
-	cmd.UI.DisplayWarnings(warnings)
-	if err != nil {
-		return err
+func (cmd *LabelUpdater) updateAnnotations() (v7action.Warnings, error) {
+	switch ResourceType(cmd.targetResource.ResourceType) {
+	case App:
+		return cmd.Actor.UpdateApplicationAnnotationsByApplicationName(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.metadata)
+	case Buildpack:
+		return cmd.Actor.UpdateBuildpackAnnotationsByBuildpackNameAndStack(cmd.targetResource.ResourceName, cmd.targetResource.BuildpackStack, cmd.metadata)
+	case Domain:
+		return cmd.Actor.UpdateDomainAnnotationsByDomainName(cmd.targetResource.ResourceName, cmd.metadata)
+	case Org:
+		return cmd.Actor.UpdateOrganizationAnnotationsByOrganizationName(cmd.targetResource.ResourceName, cmd.metadata)
+	case Route:
+		return cmd.Actor.UpdateRouteAnnotations(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.metadata)
+	case ServiceBroker:
+		return cmd.Actor.UpdateServiceBrokerAnnotationsByServiceBrokerName(cmd.targetResource.ResourceName, cmd.metadata)
+	case ServiceInstance:
+		return cmd.Actor.UpdateServiceInstanceAnnotations(cmd.targetResource.ResourceName, cmd.Config.TargetedSpace().GUID, cmd.metadata)
+	case ServiceOffering:
+		return cmd.Actor.UpdateServiceOfferingAnnotations(cmd.targetResource.ResourceName, cmd.targetResource.ServiceBroker, cmd.metadata)
+	case ServicePlan:
+		return cmd.Actor.UpdateServicePlanAnnotations(cmd.targetResource.ResourceName, cmd.targetResource.ServiceOffering, cmd.targetResource.ServiceBroker, cmd.metadata)
+	case Space:
+		return cmd.Actor.UpdateSpaceAnnotationsBySpaceName(cmd.targetResource.ResourceName, cmd.Config.TargetedOrganization().GUID, cmd.metadata)
+	case Stack:
+		return cmd.Actor.UpdateStackAnnotationsByStackName(cmd.targetResource.ResourceName, cmd.metadata)
 	}
-
-	cmd.UI.DisplayOK()
-	return nil
+	return nil, nil
 }
 
 func (cmd *LabelUpdater) checkTarget() error {
@@ -142,6 +408,18 @@ func (cmd *LabelUpdater) validateFlags() error {
 		return errors.New(cmd.UI.TranslateText("Unsupported resource type of '{{.ResourceType}}'", map[string]interface{}{"ResourceType": cmd.targetResource.ResourceType}))
 	}
 
+	if cmd.targetResource.LabelSelector != "" {
+		return cmd.validateLabelSelectorFlags()
+	}
+
+	if cmd.targetResource.Scope != "" {
+		return translatableerror.RequiredFlagsError{Arg1: "--scope", Arg2: "--selector"}
+	}
+
+	if cmd.targetResource.Force {
+		return translatableerror.RequiredFlagsError{Arg1: "-f", Arg2: "--selector"}
+	}
+
 	if cmd.targetResource.BuildpackStack != "" && resourceType != Buildpack {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{
@@ -169,19 +447,40 @@ func (cmd *LabelUpdater) validateFlags() error {
 	return nil
 }
 
-func actionForResourceString(action string, resourceType string) string {
-	return fmt.Sprintf("%s label(s) for %s", action, resourceType)
+func (cmd *LabelUpdater) validateLabelSelectorFlags() error {
+	disambiguationFlags := []struct {
+		value string
+		flag  string
+	}{
+		{cmd.targetResource.BuildpackStack, "--stack, -s"},
+		{cmd.targetResource.ServiceBroker, "--broker, -b"},
+		{cmd.targetResource.ServiceOffering, "--offering, -e"},
+	}
+
+	for _, disambiguationFlag := range disambiguationFlags {
+		if disambiguationFlag.value != "" {
+			return translatableerror.ArgumentCombinationError{
+				Args: []string{"--selector", disambiguationFlag.flag},
+			}
+		}
+	}
+
+	return nil
+}
+
+func actionForResourceString(action string, metadataType MetadataType, resourceType string) string {
+	return fmt.Sprintf("%s %s(s) for %s", action, metadataType, resourceType)
 }
 
 func (cmd *LabelUpdater) displayMessageDefault() {
-	cmd.UI.DisplayTextWithFlavor(actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType)+" {{.ResourceName}} as {{.User}}...", map[string]interface{}{
+	cmd.UI.DisplayTextWithFlavor(actionForResourceString(string(cmd.Action), cmd.MetadataType, cmd.targetResource.ResourceType)+" {{.ResourceName}} as {{.User}}...", map[string]interface{}{
 		"ResourceName": cmd.targetResource.ResourceName,
 		"User":         cmd.Username,
 	})
 }
 
 func (cmd *LabelUpdater) displayMessageWithOrgAndSpace() {
-	cmd.UI.DisplayTextWithFlavor(actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType)+" {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
+	cmd.UI.DisplayTextWithFlavor(actionForResourceString(string(cmd.Action), cmd.MetadataType, cmd.targetResource.ResourceType)+" {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
 		"ResourceName": cmd.targetResource.ResourceName,
 		"OrgName":      cmd.Config.TargetedOrganization().Name,
 		"SpaceName":    cmd.Config.TargetedSpace().Name,
@@ -192,9 +491,9 @@ func (cmd *LabelUpdater) displayMessageWithOrgAndSpace() {
 func (cmd *LabelUpdater) displayMessageWithStack() {
 	var template string
 	if cmd.targetResource.BuildpackStack == "" {
-		template = actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType) + " {{.ResourceName}} as {{.User}}..."
+		template = actionForResourceString(string(cmd.Action), cmd.MetadataType, cmd.targetResource.ResourceType) + " {{.ResourceName}} as {{.User}}..."
 	} else {
-		template = actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType) + " {{.ResourceName}} with stack {{.StackName}} as {{.User}}..."
+		template = actionForResourceString(string(cmd.Action), cmd.MetadataType, cmd.targetResource.ResourceType) + " {{.ResourceName}} with stack {{.StackName}} as {{.User}}..."
 	}
 
 	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
@@ -205,7 +504,7 @@ func (cmd *LabelUpdater) displayMessageWithStack() {
 }
 
 func (cmd *LabelUpdater) displayMessageForServiceCommands() {
-	template := actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType) + " {{.ResourceName}}"
+	template := actionForResourceString(string(cmd.Action), cmd.MetadataType, cmd.targetResource.ResourceType) + " {{.ResourceName}}"
 
 	if cmd.targetResource.ServiceOffering != "" || cmd.targetResource.ServiceBroker != "" {
 		template += " from"
@@ -232,7 +531,7 @@ func (cmd *LabelUpdater) displayMessageForServiceCommands() {
 }
 
 func (cmd *LabelUpdater) displayMessageWithOrg() {
-	cmd.UI.DisplayTextWithFlavor(actionForResourceString(string(cmd.Action), cmd.targetResource.ResourceType)+" {{.ResourceName}} in org {{.OrgName}} as {{.User}}...", map[string]interface{}{
+	cmd.UI.DisplayTextWithFlavor(actionForResourceString(string(cmd.Action), cmd.MetadataType, cmd.targetResource.ResourceType)+" {{.ResourceName}} in org {{.OrgName}} as {{.User}}...", map[string]interface{}{
 		"ResourceName": cmd.targetResource.ResourceName,
 		"OrgName":      cmd.Config.TargetedOrganization().Name,
 		"User":         cmd.Username,
@@ -7,9 +7,11 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -1540,4 +1542,313 @@ var _ = Describe("LabelUpdater", func() {
 			})
 		})
 	})
+
+	When("updating annotations", func() {
+		var executeErr error
+
+		BeforeEach(func() {
+			cmd.MetadataType = AnnotationMetadata
+			cmd.Action = Set
+
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "fake-org", GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "fake-space", GUID: "some-space-guid"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			labels = map[string]types.NullString{
+				"contact":     types.NewNullString("team@example.com"),
+				"description": types.NewNullString(strings.Repeat("a long description ", 20)),
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = cmd.Execute(targetResource, labels)
+		})
+
+		When("updating the app annotations succeeds", func() {
+			BeforeEach(func() {
+				targetResource = TargetResource{ResourceType: "app", ResourceName: "some-app"}
+				fakeActor.UpdateApplicationAnnotationsByApplicationNameReturns(v7action.Warnings{"some-warning"}, nil)
+			})
+
+			It("updates the annotations, not the labels", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(0))
+				Expect(fakeActor.UpdateApplicationAnnotationsByApplicationNameCallCount()).To(Equal(1))
+				appName, spaceGUID, annotations := fakeActor.UpdateApplicationAnnotationsByApplicationNameArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(annotations).To(Equal(labels))
+			})
+
+			It("displays the annotation message, warnings and OK", func() {
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta(`Setting annotation(s) for app some-app in org fake-org / space fake-space as some-user...`)))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("some-warning"))
+			})
+		})
+
+		When("removing buildpack annotations with a stack", func() {
+			BeforeEach(func() {
+				cmd.Action = Unset
+				targetResource = TargetResource{ResourceType: "buildpack", ResourceName: "some-buildpack", BuildpackStack: "some-stack"}
+			})
+
+			It("disambiguates the buildpack by stack", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta(`Removing annotation(s) for buildpack some-buildpack with stack some-stack as some-user...`)))
+				Expect(fakeActor.UpdateBuildpackAnnotationsByBuildpackNameAndStackCallCount()).To(Equal(1))
+				buildpackName, stack, _ := fakeActor.UpdateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall(0)
+				Expect(buildpackName).To(Equal("some-buildpack"))
+				Expect(stack).To(Equal("some-stack"))
+			})
+		})
+
+		When("updating service plan annotations", func() {
+			BeforeEach(func() {
+				targetResource = TargetResource{
+					ResourceType:    "service-plan",
+					ResourceName:    "some-plan",
+					ServiceOffering: "some-offering",
+					ServiceBroker:   "some-broker",
+				}
+			})
+
+			It("disambiguates the plan by offering and broker", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta(`Setting annotation(s) for service-plan some-plan from service offering some-offering / service broker some-broker as some-user...`)))
+				Expect(fakeActor.UpdateServicePlanAnnotationsCallCount()).To(Equal(1))
+				planName, offeringName, brokerName, _ := fakeActor.UpdateServicePlanAnnotationsArgsForCall(0)
+				Expect(planName).To(Equal("some-plan"))
+				Expect(offeringName).To(Equal("some-offering"))
+				Expect(brokerName).To(Equal("some-broker"))
+			})
+		})
+
+		When("the stack flag is used with a resource other than a buildpack", func() {
+			BeforeEach(func() {
+				targetResource = TargetResource{ResourceType: "space", ResourceName: "some-space", BuildpackStack: "some-stack"}
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"space", "--stack, -s"},
+				}))
+				Expect(fakeActor.UpdateSpaceAnnotationsBySpaceNameCallCount()).To(Equal(0))
+			})
+		})
+
+		When("updating the space annotations fails", func() {
+			BeforeEach(func() {
+				targetResource = TargetResource{ResourceType: "space", ResourceName: "some-space"}
+				fakeActor.UpdateSpaceAnnotationsBySpaceNameReturns(v7action.Warnings{"some-warning"}, errors.New("api call failed"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("api call failed"))
+				Expect(testUI.Err).To(Say("some-warning"))
+
+				spaceName, orgGUID, _ := fakeActor.UpdateSpaceAnnotationsBySpaceNameArgsForCall(0)
+				Expect(spaceName).To(Equal("some-space"))
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
+	})
+
+	When("updating labels by label selector", func() {
+		var (
+			executeErr error
+			input      *Buffer
+		)
+
+		BeforeEach(func() {
+			input = NewBuffer()
+			testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+			cmd.UI = testUI
+			cmd.Action = Set
+
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "fake-org", GUID: "some-org-guid"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "fake-space", GUID: "some-space-guid"})
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeActor.GetResourcesByLabelSelectorReturns(
+				[]v7action.MetadataResource{{GUID: "app-1-guid", Name: "app-1"}, {GUID: "app-2-guid", Name: "app-2"}},
+				v7action.Warnings{"get-warning"},
+				nil,
+			)
+			fakeActor.UpdateResourcesMetadataStub = func(_ string, guids []string, _ resources.Metadata, batchUpdated func(int)) (v7action.Warnings, error) {
+				batchUpdated(len(guids))
+				return v7action.Warnings{"update-warning"}, nil
+			}
+
+			labels = map[string]types.NullString{"env": types.NewNullString("dev")}
+			targetResource = TargetResource{ResourceType: "app", LabelSelector: "team=payments"}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = cmd.Execute(targetResource, labels)
+		})
+
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("previews the matching resources in the targeted space and updates them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkOrg).To(BeTrue())
+				Expect(checkSpace).To(BeTrue())
+
+				Expect(fakeActor.GetResourcesByLabelSelectorCallCount()).To(Equal(1))
+				resourceType, selector, scope := fakeActor.GetResourcesByLabelSelectorArgsForCall(0)
+				Expect(resourceType).To(Equal("app"))
+				Expect(selector).To(Equal("team=payments"))
+				Expect(scope).To(Equal(v7action.LabelSelectorScope{OrganizationGUID: "some-org-guid", SpaceGUID: "some-space-guid"}))
+
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta(`Getting apps matching selector team=payments in org fake-org / space fake-space as some-user...`)))
+				Expect(testUI.Out).To(Say(`name`))
+				Expect(testUI.Out).To(Say(`app-1`))
+				Expect(testUI.Out).To(Say(`app-2`))
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta(`Really update label(s) for 2 app(s)?`)))
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta(`Setting label(s) for 2 app(s) as some-user...`)))
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta(`Updated 2 of 2 app(s).`)))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("get-warning"))
+				Expect(testUI.Err).To(Say("update-warning"))
+
+				Expect(fakeActor.UpdateResourcesMetadataCallCount()).To(Equal(1))
+				resourceType, guids, metadata, _ := fakeActor.UpdateResourcesMetadataArgsForCall(0)
+				Expect(resourceType).To(Equal("app"))
+				Expect(guids).To(Equal([]string{"app-1-guid", "app-2-guid"}))
+				Expect(metadata.Labels).To(Equal(labels))
+				Expect(metadata.Annotations).To(BeNil())
+			})
+		})
+
+		When("the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not update anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No labels were updated."))
+				Expect(fakeActor.UpdateResourcesMetadataCallCount()).To(Equal(0))
+			})
+		})
+
+		When("force is set", func() {
+			BeforeEach(func() {
+				targetResource.Force = true
+			})
+
+			It("does not prompt", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).NotTo(Say("Really update"))
+				Expect(fakeActor.UpdateResourcesMetadataCallCount()).To(Equal(1))
+			})
+		})
+
+		When("no resources match", func() {
+			BeforeEach(func() {
+				fakeActor.GetResourcesByLabelSelectorReturns(nil, nil, nil)
+			})
+
+			It("says so and does not update anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No apps match the selector."))
+				Expect(fakeActor.UpdateResourcesMetadataCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the update fails", func() {
+			BeforeEach(func() {
+				targetResource.Force = true
+				fakeActor.UpdateResourcesMetadataStub = func(_ string, _ []string, _ resources.Metadata, batchUpdated func(int)) (v7action.Warnings, error) {
+					batchUpdated(1)
+					return v7action.Warnings{"update-warning"}, errors.New("update-error")
+				}
+			})
+
+			It("returns the error and displays warnings and how far the update got", func() {
+				Expect(executeErr).To(MatchError("update-error"))
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta(`Updated 1 of 2 app(s).`)))
+				Expect(testUI.Err).To(Say("update-warning"))
+				Expect(testUI.Err).To(Say(regexp.QuoteMeta(`Updated 1 of 2 app(s) before a batch failed.`)))
+			})
+		})
+
+		When("scoped to the org", func() {
+			BeforeEach(func() {
+				targetResource = TargetResource{ResourceType: "space", LabelSelector: "env=dev", Force: true}
+			})
+
+			It("defaults spaces to the targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkOrg).To(BeTrue())
+				Expect(checkSpace).To(BeFalse())
+
+				_, _, scope := fakeActor.GetResourcesByLabelSelectorArgsForCall(0)
+				Expect(scope).To(Equal(v7action.LabelSelectorScope{OrganizationGUID: "some-org-guid"}))
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta(`Getting spaces matching selector env=dev in org fake-org as some-user...`)))
+			})
+		})
+
+		When("scoped to the whole foundation", func() {
+			BeforeEach(func() {
+				targetResource = TargetResource{ResourceType: "route", LabelSelector: "env=dev", Scope: flag.AllLabelSelectorScope, Force: true}
+			})
+
+			It("does not require a target", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkOrg).To(BeFalse())
+				Expect(checkSpace).To(BeFalse())
+
+				_, _, scope := fakeActor.GetResourcesByLabelSelectorArgsForCall(0)
+				Expect(scope).To(Equal(v7action.LabelSelectorScope{}))
+				Expect(testUI.Out).To(Say(regexp.QuoteMeta(`Getting routes matching selector env=dev as some-user...`)))
+			})
+		})
+
+		When("the scope is narrower than the resource type allows", func() {
+			BeforeEach(func() {
+				targetResource = TargetResource{ResourceType: "stack", LabelSelector: "env=dev", Scope: flag.OrgLabelSelectorScope}
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"stack", "--scope org"},
+				}))
+				Expect(fakeActor.GetResourcesByLabelSelectorCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a disambiguation flag is used with the selector", func() {
+			BeforeEach(func() {
+				targetResource = TargetResource{ResourceType: "buildpack", LabelSelector: "env=dev", BuildpackStack: "cflinuxfs4"}
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--selector", "--stack, -s"},
+				}))
+			})
+		})
+
+		When("the scope is given without a selector", func() {
+			BeforeEach(func() {
+				targetResource = TargetResource{ResourceType: "app", ResourceName: "some-app", Scope: flag.OrgLabelSelectorScope}
+			})
+
+			It("returns a required flags error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--scope", Arg2: "--selector"}))
+				Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
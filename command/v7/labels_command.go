@@ -37,7 +37,8 @@ type LabelsCommand struct {
 	ServiceBroker   string          `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
 	ServiceOffering string          `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
 
-	username string
+	username     string
+	metadataType MetadataType
 }
 
 func (cmd LabelsCommand) Execute(args []string) error {
@@ -62,42 +63,14 @@ func (cmd LabelsCommand) Execute(args []string) error {
 		return err
 	}
 
-	switch cmd.canonicalResourceTypeForName() {
-	case App:
-		cmd.displayMessageWithOrgAndSpace()
-		labels, warnings, err = cmd.Actor.GetApplicationLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
-	case Buildpack:
-		cmd.displayMessageWithStack()
-		labels, warnings, err = cmd.Actor.GetBuildpackLabels(cmd.RequiredArgs.ResourceName, cmd.BuildpackStack)
-	case Domain:
-		cmd.displayMessageDefault()
-		labels, warnings, err = cmd.Actor.GetDomainLabels(cmd.RequiredArgs.ResourceName)
-	case Org:
-		cmd.displayMessageDefault()
-		labels, warnings, err = cmd.Actor.GetOrganizationLabels(cmd.RequiredArgs.ResourceName)
-	case Route:
-		cmd.displayMessageWithOrgAndSpace()
-		labels, warnings, err = cmd.Actor.GetRouteLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
-	case ServiceBroker:
-		cmd.displayMessageDefault()
-		labels, warnings, err = cmd.Actor.GetServiceBrokerLabels(cmd.RequiredArgs.ResourceName)
-	case ServiceInstance:
-		cmd.displayMessageWithOrgAndSpace()
-		labels, warnings, err = cmd.Actor.GetServiceInstanceLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
-	case ServiceOffering:
-		cmd.displayMessageForServiceCommands()
-		labels, warnings, err = cmd.Actor.GetServiceOfferingLabels(cmd.RequiredArgs.ResourceName, cmd.ServiceBroker)
-	case ServicePlan:
-		cmd.displayMessageForServiceCommands()
-		labels, warnings, err = cmd.Actor.GetServicePlanLabels(cmd.RequiredArgs.ResourceName, cmd.ServiceOffering, cmd.ServiceBroker)
-	case Space:
-		cmd.displayMessageWithOrg()
-		labels, warnings, err = cmd.Actor.GetSpaceLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID)
-	case Stack:
-		cmd.displayMessageDefault()
-		labels, warnings, err = cmd.Actor.GetStackLabels(cmd.RequiredArgs.ResourceName)
-	default:
-		err = fmt.Errorf("Unsupported resource type of '%s'", cmd.RequiredArgs.ResourceType)
+	if err := cmd.displayMessage(); err != nil {
+		return err
+	}
+
+	if cmd.metadataType == AnnotationMetadata {
+		labels, warnings, err = cmd.getAnnotations()
+	} else {
+		labels, warnings, err = cmd.getLabels()
 	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -134,6 +107,80 @@ space
 stack`
 }
 
+func (cmd LabelsCommand) displayMessage() error {
+	switch cmd.canonicalResourceTypeForName() {
+	case App, Route, ServiceInstance:
+		cmd.displayMessageWithOrgAndSpace()
+	case Buildpack:
+		cmd.displayMessageWithStack()
+	case Domain, Org, ServiceBroker, Stack:
+		cmd.displayMessageDefault()
+	case ServiceOffering, ServicePlan:
+		cmd.displayMessageForServiceCommands()
+	case Space:
+		cmd.displayMessageWithOrg()
+	default:
+		return fmt.Errorf("Unsupported resource type of '%s'", cmd.RequiredArgs.ResourceType)
+	}
+	return nil
+}
+
+func (cmd LabelsCommand) getLabels() (map[string]types.NullString, v7action.Warnings, error) {
+	switch cmd.canonicalResourceTypeForName() {
+	case App:
+		return cmd.Actor.GetApplicationLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+	case Buildpack:
+		return cmd.Actor.GetBuildpackLabels(cmd.RequiredArgs.ResourceName, cmd.BuildpackStack)
+	case Domain:
+		return cmd.Actor.GetDomainLabels(cmd.RequiredArgs.ResourceName)
+	case Org:
+		return cmd.Actor.GetOrganizationLabels(cmd.RequiredArgs.ResourceName)
+	case Route:
+		return cmd.Actor.GetRouteLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+	case ServiceBroker:
+		return cmd.Actor.GetServiceBrokerLabels(cmd.RequiredArgs.ResourceName)
+	case ServiceInstance:
+		return cmd.Actor.GetServiceInstanceLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+	case ServiceOffering:
+		return cmd.Actor.GetServiceOfferingLabels(cmd.RequiredArgs.ResourceName, cmd.ServiceBroker)
+	case ServicePlan:
+		return cmd.Actor.GetServicePlanLabels(cmd.RequiredArgs.ResourceName, cmd.ServiceOffering, cmd.ServiceBroker)
+	case Space:
+		return cmd.Actor.GetSpaceLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID)
+	case Stack:
+		return cmd.Actor.GetStackLabels(cmd.RequiredArgs.ResourceName)
+	}
+	return nil, nil, nil
+}
+
+func (cmd LabelsCommand) getAnnotations() (map[string]types.NullString, v7action.Warnings, error) {
+	switch cmd.canonicalResourceTypeForName() {
+	case App:
+		return cmd.Actor.GetApplicationAnnotations(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+	case Buildpack:
+		return cmd.Actor.GetBuildpackAnnotations(cmd.RequiredArgs.ResourceName, cmd.BuildpackStack)
+	case Domain:
+		return cmd.Actor.GetDomainAnnotations(cmd.RequiredArgs.ResourceName)
+	case Org:
+		return cmd.Actor.GetOrganizationAnnotations(cmd.RequiredArgs.ResourceName)
+	case Route:
+		return cmd.Actor.GetRouteAnnotations(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+	case ServiceBroker:
+		return cmd.Actor.GetServiceBrokerAnnotations(cmd.RequiredArgs.ResourceName)
+	case ServiceInstance:
+		return cmd.Actor.GetServiceInstanceAnnotations(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+	case ServiceOffering:
+		return cmd.Actor.GetServiceOfferingAnnotations(cmd.RequiredArgs.ResourceName, cmd.ServiceBroker)
+	case ServicePlan:
+		return cmd.Actor.GetServicePlanAnnotations(cmd.RequiredArgs.ResourceName, cmd.ServiceOffering, cmd.ServiceBroker)
+	case Space:
+		return cmd.Actor.GetSpaceAnnotations(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID)
+	case Stack:
+		return cmd.Actor.GetStackAnnotations(cmd.RequiredArgs.ResourceName)
+	}
+	return nil, nil, nil
+}
+
 func (cmd LabelsCommand) canonicalResourceTypeForName() ResourceType {
 	return ResourceType(strings.ToLower(cmd.RequiredArgs.ResourceType))
 }
@@ -142,7 +189,7 @@ func (cmd LabelsCommand) printLabels(labels map[string]types.NullString) {
 	cmd.UI.DisplayNewline()
 
 	if len(labels) == 0 {
-		cmd.UI.DisplayText("No labels found.")
+		cmd.UI.DisplayText("No {{.MetadataType}}s found.", map[string]interface{}{"MetadataType": cmd.metadataType.String()})
 		return
 	}
 
@@ -207,7 +254,7 @@ func (cmd LabelsCommand) checkTarget() error {
 }
 
 func (cmd LabelsCommand) displayMessageDefault() {
-	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting labels for %s {{.ResourceName}} as {{.User}}...", cmd.RequiredArgs.ResourceType), map[string]interface{}{
+	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting %ss for %s {{.ResourceName}} as {{.User}}...", cmd.metadataType, cmd.RequiredArgs.ResourceType), map[string]interface{}{
 		"ResourceName": cmd.RequiredArgs.ResourceName,
 		"User":         cmd.username,
 	})
@@ -216,7 +263,7 @@ func (cmd LabelsCommand) displayMessageDefault() {
 }
 
 func (cmd LabelsCommand) displayMessageWithOrgAndSpace() {
-	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting labels for %s {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", cmd.RequiredArgs.ResourceType), map[string]interface{}{
+	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting %ss for %s {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", cmd.metadataType, cmd.RequiredArgs.ResourceType), map[string]interface{}{
 		"ResourceName": cmd.RequiredArgs.ResourceName,
 		"OrgName":      cmd.Config.TargetedOrganization().Name,
 		"SpaceName":    cmd.Config.TargetedSpace().Name,
@@ -225,7 +272,7 @@ func (cmd LabelsCommand) displayMessageWithOrgAndSpace() {
 }
 
 func (cmd LabelsCommand) displayMessageWithOrg() {
-	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting labels for %s {{.ResourceName}} in org {{.OrgName}} as {{.User}}...", cmd.RequiredArgs.ResourceType), map[string]interface{}{
+	cmd.UI.DisplayTextWithFlavor(fmt.Sprintf("Getting %ss for %s {{.ResourceName}} in org {{.OrgName}} as {{.User}}...", cmd.metadataType, cmd.RequiredArgs.ResourceType), map[string]interface{}{
 		"ResourceName": cmd.RequiredArgs.ResourceName,
 		"OrgName":      cmd.Config.TargetedOrganization().Name,
 		"User":         cmd.username,
@@ -235,9 +282,9 @@ func (cmd LabelsCommand) displayMessageWithOrg() {
 func (cmd LabelsCommand) displayMessageWithStack() {
 	var template string
 	if cmd.BuildpackStack == "" {
-		template = fmt.Sprintf("Getting labels for %s {{.ResourceName}} as {{.User}}...", cmd.RequiredArgs.ResourceType)
+		template = fmt.Sprintf("Getting %ss for %s {{.ResourceName}} as {{.User}}...", cmd.metadataType, cmd.RequiredArgs.ResourceType)
 	} else {
-		template = fmt.Sprintf("Getting labels for %s {{.ResourceName}} with stack {{.StackName}} as {{.User}}...", cmd.RequiredArgs.ResourceType)
+		template = fmt.Sprintf("Getting %ss for %s {{.ResourceName}} with stack {{.StackName}} as {{.User}}...", cmd.metadataType, cmd.RequiredArgs.ResourceType)
 	}
 
 	cmd.UI.DisplayTextWithFlavor(template, map[string]interface{}{
@@ -249,7 +296,7 @@ func (cmd LabelsCommand) displayMessageWithStack() {
 
 func (cmd LabelsCommand) displayMessageForServiceCommands() {
 	var template string
-	template = fmt.Sprintf("Getting labels for %s {{.ResourceName}}", cmd.RequiredArgs.ResourceType)
+	template = fmt.Sprintf("Getting %ss for %s {{.ResourceName}}", cmd.metadataType, cmd.RequiredArgs.ResourceType)
 
 	if cmd.ServiceOffering != "" || cmd.ServiceBroker != "" {
 		template += " from"
@@ -3,16 +3,18 @@ package v7
 import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 )
 
 type MapRouteCommand struct {
 	BaseCommand
 
-	RequiredArgs flag.AppDomain   `positional-args:"yes"`
-	Hostname     string           `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
-	Path         flag.V7RoutePath `long:"path" description:"Path for the HTTP route"`
-	Port         int              `long:"port" description:"Port for the TCP route (default: random port)"`
-	AppProtocol  string           `long:"app-protocol" description:"[Beta flag, subject to change] Protocol for the route destination (default: http1). Only applied to HTTP routes"`
+	RequiredArgs flag.AppDomain       `positional-args:"yes"`
+	Hostname     string               `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	Path         flag.V7RoutePath     `long:"path" description:"Path for the HTTP route"`
+	Port         int                  `long:"port" description:"Port for the TCP route (default: random port)"`
+	AppProtocol  string               `long:"app-protocol" description:"[Beta flag, subject to change] Protocol for the route destination (default: http1). Only applied to HTTP routes"`
+	Weight       flag.PositiveInteger `long:"weight" description:"Percentage of the route's traffic to send to the app (1-100). The route's other destinations share the rest"`
 
 	relatedCommands interface{} `related_commands:"create-route, routes, unmap-route"`
 }
@@ -20,7 +22,7 @@ type MapRouteCommand struct {
 func (cmd MapRouteCommand) Usage() string {
 	return `
 Map an HTTP route:
-   CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--app-protocol PROTOCOL]
+   CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--app-protocol PROTOCOL] [--weight WEIGHT]
 
 Map a TCP route:
    CF_NAME map-route APP_NAME DOMAIN [--port PORT]`
@@ -32,10 +34,17 @@ CF_NAME map-route my-app example.com
 CF_NAME map-route my-app example.com --hostname myhost                              # myhost.example.com
 CF_NAME map-route my-app example.com --hostname myhost --path foo                   # myhost.example.com/foo
 CF_NAME map-route my-app example.com --hostname myhost --app-protocol http2 # myhost.example.com
+CF_NAME map-route my-app example.com --hostname myhost --weight 20                  # myhost.example.com, 20% of traffic to my-app
 CF_NAME map-route my-app example.com --port 5000                                    # example.com:5000`
 }
 
 func (cmd MapRouteCommand) Execute(args []string) error {
+	if cmd.Weight.Value != 0 && cmd.Port != 0 {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--weight", "--port"},
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -88,7 +97,16 @@ func (cmd MapRouteCommand) Execute(args []string) error {
 		cmd.UI.DisplayOK()
 	}
 
-	if cmd.AppProtocol != "" {
+	if cmd.Weight.Value != 0 {
+		cmd.UI.DisplayTextWithFlavor("Mapping route {{.URL}} to app {{.AppName}} with {{.Weight}}% of its traffic in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
+			"URL":       route.URL,
+			"AppName":   cmd.RequiredArgs.App,
+			"Weight":    cmd.Weight.Value,
+			"User":      user.Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+		})
+	} else if cmd.AppProtocol != "" {
 		cmd.UI.DisplayTextWithFlavor("Mapping route {{.URL}} to app {{.AppName}} with protocol {{.Protocol}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...", map[string]interface{}{
 			"URL":       route.URL,
 			"AppName":   cmd.RequiredArgs.App,
@@ -121,7 +139,11 @@ func (cmd MapRouteCommand) Execute(args []string) error {
 		cmd.UI.DisplayOK()
 		return nil
 	}
-	warnings, err = cmd.Actor.MapRoute(route.GUID, app.GUID, cmd.AppProtocol)
+	if cmd.Weight.Value != 0 {
+		warnings, err = cmd.Actor.MapRouteWithWeight(route, app.GUID, cmd.AppProtocol, int(cmd.Weight.Value))
+	} else {
+		warnings, err = cmd.Actor.MapRoute(route.GUID, app.GUID, cmd.AppProtocol)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
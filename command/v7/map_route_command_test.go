@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -82,6 +83,20 @@ var _ = Describe("map-route Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("--weight is given for a TCP route", func() {
+		BeforeEach(func() {
+			cmd.Weight = flag.PositiveInteger{Value: 20}
+			cmd.Port = 5000
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--weight", "--port"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	When("checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
@@ -418,6 +433,44 @@ var _ = Describe("map-route Command", func() {
 								})
 							})
 						})
+
+						When("a weight is given", func() {
+							BeforeEach(func() {
+								cmd.Weight = flag.PositiveInteger{Value: 20}
+								fakeActor.GetRouteByAttributesReturns(
+									resources.Route{GUID: "route-guid", URL: "host.some-domain.compath"},
+									v7action.Warnings{"get-route-warnings"},
+									nil,
+								)
+								fakeActor.MapRouteWithWeightReturns(v7action.Warnings{"map-route-warnings"}, nil)
+							})
+
+							It("maps the route with the weight", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say(`Mapping route host\.some-domain\.compath to app my-app with 20% of its traffic in org some-org / space some-space as steve\.\.\.`))
+								Expect(testUI.Out).To(Say("OK"))
+								Expect(testUI.Err).To(Say("map-route-warnings"))
+
+								Expect(fakeActor.MapRouteCallCount()).To(Equal(0))
+								Expect(fakeActor.MapRouteWithWeightCallCount()).To(Equal(1))
+								actualRoute, actualAppGUID, actualAppProtocol, actualWeight := fakeActor.MapRouteWithWeightArgsForCall(0)
+								Expect(actualRoute.GUID).To(Equal("route-guid"))
+								Expect(actualAppGUID).To(Equal("app-guid"))
+								Expect(actualAppProtocol).To(Equal("http2"))
+								Expect(actualWeight).To(Equal(20))
+							})
+
+							When("the weight cannot be set", func() {
+								BeforeEach(func() {
+									fakeActor.MapRouteWithWeightReturns(v7action.Warnings{"map-route-warnings"}, actionerror.RouteDestinationWeightError{Weight: 20})
+								})
+
+								It("returns the error and displays warnings", func() {
+									Expect(executeErr).To(MatchError(actionerror.RouteDestinationWeightError{Weight: 20}))
+									Expect(testUI.Err).To(Say("map-route-warnings"))
+								})
+							})
+						})
 					})
 				})
 
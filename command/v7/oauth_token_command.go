@@ -20,7 +20,7 @@ func (cmd OauthTokenCommand) Execute(_ []string) error {
 		return err
 	}
 
-	if cmd.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) && cmd.Config.UAAOAuthClientSecret() == "" {
+	if cmd.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) && cmd.Config.UAAOAuthClientSecret() == "" && cmd.Config.UAAOAuthClientKeyFile() == "" {
 		token, err := cmd.Actor.ParseAccessToken(cmd.Config.AccessToken())
 		if err != nil {
 			return errors.New(cmd.UI.TranslateText("Access token is invalid."))
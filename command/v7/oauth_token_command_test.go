@@ -105,6 +105,21 @@ var _ = Describe("oauth-token command", func() {
 			})
 		})
 
+		When("the client authenticates with a private key", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAOAuthClientKeyFileReturns("/some/client-key.pem")
+				fakeActor.RefreshAccessTokenReturns("new-access-token", nil)
+			})
+
+			It("refreshes the access token", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("new-access-token"))
+
+				Expect(fakeActor.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeActor.ParseAccessTokenCallCount()).To(Equal(0))
+			})
+		})
 	})
 
 	When("logged in as a user", func() {
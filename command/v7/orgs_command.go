@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -8,9 +9,9 @@ import (
 type OrgsCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME orgs [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME orgs\n   CF_NAME orgs --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME orgs --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
-	relatedCommands interface{} `related_commands:"create-org, org, org-users, set-org-role"`
-	Labels          string      `long:"labels" description:"Selector to filter orgs by labels"`
+	usage           interface{}        `usage:"CF_NAME orgs [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME orgs\n   CF_NAME orgs --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME orgs --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
+	relatedCommands interface{}        `related_commands:"create-org, org, org-users, set-org-role"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter orgs by labels"`
 }
 
 func (cmd OrgsCommand) Execute(args []string) error {
@@ -29,7 +30,7 @@ func (cmd OrgsCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	orgs, warnings, err := cmd.Actor.GetOrganizations(cmd.Labels)
+	orgs, warnings, err := cmd.Actor.GetOrganizations(string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -0,0 +1,193 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// artifactPruner holds what prune-droplets and prune-packages have in
+// common.
+type artifactPruner struct {
+	BaseCommand
+
+	artifactType v7action.ArtifactType
+	appName      string
+	allApps      bool
+	policy       v7action.ArtifactRetentionPolicy
+	dryRun       bool
+	force        bool
+}
+
+func (pruner artifactPruner) execute() error {
+	err := pruner.validateFlags()
+	if err != nil {
+		return err
+	}
+
+	err = pruner.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := pruner.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	templateValues := map[string]interface{}{
+		"Artifacts": pruner.plural(),
+		"AppName":   pruner.appName,
+		"OrgName":   pruner.Config.TargetedOrganization().Name,
+		"SpaceName": pruner.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	}
+	if pruner.allApps {
+		pruner.UI.DisplayTextWithFlavor("Getting {{.Artifacts}} to prune for all apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	} else {
+		pruner.UI.DisplayTextWithFlavor("Getting {{.Artifacts}} to prune for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", templateValues)
+	}
+	pruner.UI.DisplayNewline()
+
+	artifacts, warnings, err := pruner.Actor.GetStaleArtifacts(pruner.artifactType, pruner.appName, pruner.Config.TargetedSpace().GUID, pruner.policy)
+	pruner.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(artifacts) == 0 {
+		pruner.UI.DisplayText("No {{.Artifacts}} to prune.", templateValues)
+		return nil
+	}
+
+	if pruner.dryRun {
+		pruner.displayDryRun(pruner.Actor.GetStaleArtifactSizes(artifacts))
+		return nil
+	}
+
+	pruner.displayArtifacts(artifacts, false)
+	pruner.UI.DisplayNewline()
+
+	if !pruner.force {
+		response, promptErr := pruner.UI.DisplayBoolPrompt(false, "Really delete {{.Count}} {{.Artifacts}}?", map[string]interface{}{
+			"Count":     len(artifacts),
+			"Artifacts": pruner.plural(),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !response {
+			pruner.UI.DisplayText("No {{.Artifacts}} have been deleted.", map[string]interface{}{
+				"Artifacts": pruner.plural(),
+			})
+			return nil
+		}
+	}
+
+	for _, artifact := range artifacts {
+		pruner.UI.DisplayText("Deleting {{.Artifact}} {{.GUID}} of app {{.AppName}}...", map[string]interface{}{
+			"Artifact": string(artifact.Type),
+			"GUID":     artifact.GUID,
+			"AppName":  artifact.AppName,
+		})
+
+		warnings, err := pruner.Actor.DeleteStaleArtifact(artifact)
+		pruner.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	pruner.UI.DisplayOK()
+	return nil
+}
+
+func (pruner artifactPruner) validateFlags() error {
+	switch {
+	case pruner.appName == "" && !pruner.allApps:
+		return translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	case pruner.appName != "" && pruner.allApps:
+		return translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--all-apps"}}
+	case pruner.policy.Keep == 0 && pruner.policy.MaxAge == 0:
+		return translatableerror.IncorrectUsageError{Message: "Provide --keep, --older-than or both."}
+	}
+	return nil
+}
+
+func (pruner artifactPruner) displayArtifacts(artifacts []v7action.StaleArtifact, withSizes bool) {
+	header := []string{
+		pruner.UI.TranslateText("app"),
+		pruner.UI.TranslateText("guid"),
+		pruner.UI.TranslateText("state"),
+		pruner.UI.TranslateText("created"),
+	}
+	if withSizes {
+		header = append(header, pruner.UI.TranslateText("size"))
+	}
+
+	table := [][]string{header}
+	for _, artifact := range artifacts {
+		row := []string{
+			artifact.AppName,
+			artifact.GUID,
+			pruner.UI.TranslateText(strings.ToLower(artifact.State)),
+			pruner.UI.UserFriendlyDate(artifact.CreatedAt),
+		}
+		if withSizes {
+			size := pruner.UI.TranslateText("unknown")
+			if artifact.Size.IsSet {
+				size = bytefmt.ByteSize(artifact.Size.Value)
+			}
+			row = append(row, size)
+		}
+		table = append(table, row)
+	}
+
+	pruner.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (pruner artifactPruner) displayDryRun(artifacts []v7action.StaleArtifact) {
+	pruner.displayArtifacts(artifacts, true)
+	pruner.UI.DisplayNewline()
+
+	var (
+		freed   uint64
+		unknown int
+	)
+	for _, artifact := range artifacts {
+		if artifact.Size.IsSet {
+			freed += artifact.Size.Value
+		} else {
+			unknown++
+		}
+	}
+
+	pruner.UI.DisplayText("{{.Count}} {{.Artifacts}} would be deleted, freeing {{.Size}} of blobstore space.", map[string]interface{}{
+		"Count":     len(artifacts),
+		"Artifacts": pruner.plural(),
+		"Size":      pruneSize(freed),
+	})
+	if unknown > 0 {
+		pruner.UI.DisplayWarning("The size of {{.Count}} {{.Artifacts}} could not be determined and is not included.", map[string]interface{}{
+			"Count":     unknown,
+			"Artifacts": pruner.plural(),
+		})
+	}
+	pruner.UI.DisplayText("Run the command without --dry-run to delete them.")
+}
+
+func (pruner artifactPruner) plural() string {
+	return fmt.Sprintf("%ss", pruner.artifactType)
+}
+
+func pruneSize(size uint64) string {
+	if size == 0 {
+		return "0B"
+	}
+	return bytefmt.ByteSize(size)
+}
@@ -0,0 +1,34 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type PruneDropletsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	AllApps         bool                 `long:"all-apps" description:"Prune the droplets of every app in the targeted space"`
+	Keep            flag.PositiveInteger `long:"keep" description:"Number of most recently created droplets to keep per app"`
+	OlderThan       flag.Age             `long:"older-than" description:"Keep droplets created within this age, e.g. 30d or 12h"`
+	DryRun          bool                 `long:"dry-run" description:"List the droplets that would be deleted and how much blobstore space would be freed, without deleting them"`
+	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}          `usage:"CF_NAME prune-droplets (APP_NAME | --all-apps) [--keep COUNT] [--older-than AGE] [--dry-run] [-f]\n\n   The current droplet and the droplets of deployable revisions are never deleted.\n   When both --keep and --older-than are given, only droplets outside of both are deleted.\n\nEXAMPLES:\n   CF_NAME prune-droplets my-app --keep 5\n   CF_NAME prune-droplets --all-apps --older-than 30d --dry-run"`
+	relatedCommands interface{}          `related_commands:"droplets, prune-packages, revisions"`
+}
+
+func (cmd PruneDropletsCommand) Execute(args []string) error {
+	return artifactPruner{
+		BaseCommand:  cmd.BaseCommand,
+		artifactType: v7action.DropletArtifact,
+		appName:      cmd.RequiredArgs.AppName,
+		allApps:      cmd.AllApps,
+		policy: v7action.ArtifactRetentionPolicy{
+			Keep:   int(cmd.Keep.Value),
+			MaxAge: cmd.OlderThan.Value,
+		},
+		dryRun: cmd.DryRun,
+		force:  cmd.Force,
+	}.execute()
+}
@@ -0,0 +1,252 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("prune-droplets Command", func() {
+	var (
+		cmd             PruneDropletsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		input           *Buffer
+		executeErr      error
+
+		staleDroplets []v7action.StaleArtifact
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = PruneDropletsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Keep: flag.PositiveInteger{Value: 3},
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		createdAt := time.Date(2026, 9, 1, 12, 0, 0, 0, time.UTC)
+		staleDroplets = []v7action.StaleArtifact{
+			{Type: v7action.DropletArtifact, GUID: "droplet-2", AppName: "some-app", State: "STAGED", CreatedAt: createdAt, HasBits: true},
+			{Type: v7action.DropletArtifact, GUID: "droplet-1", AppName: "some-app", State: "FAILED", CreatedAt: createdAt.Add(-time.Hour)},
+		}
+		fakeActor.GetStaleArtifactsReturns(staleDroplets, v7action.Warnings{"get-warning"}, nil)
+		fakeActor.DeleteStaleArtifactReturns(v7action.Warnings{"delete-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("neither an app name nor --all-apps is given", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+		})
+
+		It("returns a required argument error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("both an app name and --all-apps are given", func() {
+		BeforeEach(func() {
+			cmd.AllApps = true
+		})
+
+		It("returns an argument combination error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"APP_NAME", "--all-apps"}}))
+		})
+	})
+
+	When("neither --keep nor --older-than is given", func() {
+		BeforeEach(func() {
+			cmd.Keep = flag.PositiveInteger{}
+		})
+
+		It("returns an incorrect usage error", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "Provide --keep, --older-than or both."}))
+		})
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	It("gets the stale droplets of the app using the retention policy", func() {
+		Expect(fakeActor.GetStaleArtifactsCallCount()).To(Equal(1))
+		artifactType, appName, spaceGUID, policy := fakeActor.GetStaleArtifactsArgsForCall(0)
+		Expect(artifactType).To(Equal(v7action.DropletArtifact))
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(policy).To(Equal(v7action.ArtifactRetentionPolicy{Keep: 3}))
+
+		Expect(testUI.Out).To(Say(`Getting droplets to prune for app some-app in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Err).To(Say("get-warning"))
+	})
+
+	When("--older-than is given", func() {
+		BeforeEach(func() {
+			cmd.OlderThan = flag.Age{Value: 30 * 24 * time.Hour}
+		})
+
+		It("passes it on as the maximum age", func() {
+			_, _, _, policy := fakeActor.GetStaleArtifactsArgsForCall(0)
+			Expect(policy).To(Equal(v7action.ArtifactRetentionPolicy{Keep: 3, MaxAge: 30 * 24 * time.Hour}))
+		})
+	})
+
+	When("the user confirms the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("lists and deletes the stale droplets", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`app\s+guid\s+state\s+created`))
+			Expect(testUI.Out).To(Say(`some-app\s+droplet-2\s+staged`))
+			Expect(testUI.Out).To(Say(`some-app\s+droplet-1\s+failed`))
+			Expect(testUI.Out).To(Say(`Really delete 2 droplets\?`))
+			Expect(testUI.Out).To(Say(`Deleting droplet droplet-2 of app some-app\.\.\.`))
+			Expect(testUI.Out).To(Say(`Deleting droplet droplet-1 of app some-app\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+
+			Expect(fakeActor.DeleteStaleArtifactCallCount()).To(Equal(2))
+			Expect(fakeActor.DeleteStaleArtifactArgsForCall(0)).To(Equal(staleDroplets[0]))
+			Expect(fakeActor.DeleteStaleArtifactArgsForCall(1)).To(Equal(staleDroplets[1]))
+			Expect(fakeActor.GetStaleArtifactSizesCallCount()).To(Equal(0))
+		})
+
+		When("a deletion fails", func() {
+			BeforeEach(func() {
+				fakeActor.DeleteStaleArtifactReturnsOnCall(0, v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("stops and returns the error", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(fakeActor.DeleteStaleArtifactCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	When("the user declines the deletion", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not delete anything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No droplets have been deleted."))
+			Expect(fakeActor.DeleteStaleArtifactCallCount()).To(Equal(0))
+		})
+	})
+
+	When("-f is given", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("deletes without asking", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).NotTo(Say("Really delete"))
+			Expect(fakeActor.DeleteStaleArtifactCallCount()).To(Equal(2))
+		})
+	})
+
+	When("there is nothing to prune", func() {
+		BeforeEach(func() {
+			fakeActor.GetStaleArtifactsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No droplets to prune."))
+			Expect(fakeActor.DeleteStaleArtifactCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the stale droplets fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetStaleArtifactsReturns(nil, v7action.Warnings{"get-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-warning"))
+		})
+	})
+
+	When("--dry-run is given for the whole space", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppName = ""
+			cmd.AllApps = true
+			cmd.DryRun = true
+
+			sized := make([]v7action.StaleArtifact, len(staleDroplets))
+			copy(sized, staleDroplets)
+			sized[0].Size = types.NullUint64{IsSet: true, Value: 50 * 1024 * 1024}
+			fakeActor.GetStaleArtifactSizesReturns(sized)
+		})
+
+		It("reports the blobstore space that would be freed without deleting anything", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, appName, _, _ := fakeActor.GetStaleArtifactsArgsForCall(0)
+			Expect(appName).To(BeEmpty())
+			Expect(fakeActor.GetStaleArtifactSizesArgsForCall(0)).To(Equal(staleDroplets))
+
+			Expect(testUI.Out).To(Say(`Getting droplets to prune for all apps in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`app\s+guid\s+state\s+created\s+size`))
+			Expect(testUI.Out).To(Say(`some-app\s+droplet-2\s+staged\s+.*50M`))
+			Expect(testUI.Out).To(Say(`some-app\s+droplet-1\s+failed\s+.*unknown`))
+			Expect(testUI.Out).To(Say(`2 droplets would be deleted, freeing 50M of blobstore space\.`))
+			Expect(testUI.Err).To(Say(`The size of 1 droplets could not be determined and is not included\.`))
+			Expect(testUI.Out).To(Say(`Run the command without --dry-run to delete them\.`))
+
+			Expect(fakeActor.DeleteStaleArtifactCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,34 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type PrunePackagesCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.OptionalAppName `positional-args:"yes"`
+	AllApps         bool                 `long:"all-apps" description:"Prune the packages of every app in the targeted space"`
+	Keep            flag.PositiveInteger `long:"keep" description:"Number of most recently created packages to keep per app"`
+	OlderThan       flag.Age             `long:"older-than" description:"Keep packages created within this age, e.g. 30d or 12h"`
+	DryRun          bool                 `long:"dry-run" description:"List the packages that would be deleted and how much blobstore space would be freed, without deleting them"`
+	Force           bool                 `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{}          `usage:"CF_NAME prune-packages (APP_NAME | --all-apps) [--keep COUNT] [--older-than AGE] [--dry-run] [-f]\n\n   The newest ready package is never deleted.\n   When both --keep and --older-than are given, only packages outside of both are deleted.\n\nEXAMPLES:\n   CF_NAME prune-packages my-app --keep 5\n   CF_NAME prune-packages --all-apps --older-than 30d --dry-run"`
+	relatedCommands interface{}          `related_commands:"packages, prune-droplets, create-package"`
+}
+
+func (cmd PrunePackagesCommand) Execute(args []string) error {
+	return artifactPruner{
+		BaseCommand:  cmd.BaseCommand,
+		artifactType: v7action.PackageArtifact,
+		appName:      cmd.RequiredArgs.AppName,
+		allApps:      cmd.AllApps,
+		policy: v7action.ArtifactRetentionPolicy{
+			Keep:   int(cmd.Keep.Value),
+			MaxAge: cmd.OlderThan.Value,
+		},
+		dryRun: cmd.DryRun,
+		force:  cmd.Force,
+	}.execute()
+}
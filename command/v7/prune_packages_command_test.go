@@ -0,0 +1,71 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("prune-packages Command", func() {
+	var (
+		cmd             PrunePackagesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = PrunePackagesCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Keep:  flag.PositiveInteger{Value: 2},
+			Force: true,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeActor.GetStaleArtifactsReturns([]v7action.StaleArtifact{
+			{Type: v7action.PackageArtifact, GUID: "package-1", AppName: "some-app", State: "READY"},
+		}, nil, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("prunes the app's packages", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		artifactType, appName, spaceGUID, policy := fakeActor.GetStaleArtifactsArgsForCall(0)
+		Expect(artifactType).To(Equal(v7action.PackageArtifact))
+		Expect(appName).To(Equal("some-app"))
+		Expect(spaceGUID).To(Equal("some-space-guid"))
+		Expect(policy).To(Equal(v7action.ArtifactRetentionPolicy{Keep: 2}))
+
+		Expect(testUI.Out).To(Say(`Getting packages to prune for app some-app in org some-org / space some-space as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`Deleting package package-1 of app some-app\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(fakeActor.DeleteStaleArtifactCallCount()).To(Equal(1))
+	})
+})
@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
+	"code.cloudfoundry.org/bytefmt"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
@@ -26,6 +30,7 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ProgressBar
@@ -43,6 +48,9 @@ type PushActor interface {
 	CreatePushPlans(spaceGUID string, orgGUID string, manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
 	// Actualize applies any necessary changes.
 	Actualize(plan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent
+	ExplainUpload(plan v7pushaction.PushPlan) (v7pushaction.UploadReport, v7pushaction.Warnings, error)
+	// CheckQuotasForPush projects the quota usage once the manifest is pushed.
+	CheckQuotasForPush(orgGUID string, spaceGUID string, manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) (v7action.QuotaCheck, v7pushaction.Warnings, error)
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . V7ActorForPush
@@ -80,14 +88,18 @@ type PushCommand struct {
 
 	OptionalArgs            flag.OptionalAppName                `positional-args:"yes"`
 	HealthCheckTimeout      flag.PositiveInteger                `long:"app-start-timeout" short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
+	BlueGreen               bool                                `long:"blue-green" description:"Push the app as APP_NAME-new, move its routes over once it is healthy, then delete the old app. The new app is deleted if it does not become healthy"`
+	BlueGreenTimeout        flag.PositiveInteger                `long:"blue-green-timeout" default:"60" description:"Time (in seconds) the new app must stay healthy in a blue-green push before the old app is retired"`
 	Buildpacks              []string                            `long:"buildpack" short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
 	Disk                    string                              `long:"disk" short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	DockerImage             flag.DockerImage                    `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
 	DockerUsername          string                              `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	DropletPath             flag.PathWithExistenceCheck         `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
 	HealthCheckHTTPEndpoint string                              `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
+	ExplainUpload           bool                                `long:"explain-upload" description:"List the files that would be uploaded, which ones are ignored or already cached, and what makes up the upload size, without pushing"`
 	HealthCheckType         flag.HealthCheckType                `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
 	Instances               flag.Instances                      `long:"instances" short:"i" description:"Number of instances"`
+	KeepOldApp              bool                                `long:"keep-old-app" description:"Stop the old app and rename it to APP_NAME-old instead of deleting it in a blue-green push"`
 	LogRateLimit            string                              `long:"log-rate-limit" short:"l" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
 	PathToManifest          flag.ManifestPathWithExistenceCheck `long:"manifest" short:"f" description:"Path to manifest"`
 	Memory                  string                              `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
@@ -98,6 +110,7 @@ type PushCommand struct {
 	AppPath                 flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	RandomRoute             bool                                `long:"random-route" description:"Create a random route for this app (except when no-route is specified in the manifest)"`
 	RedactEnv               bool                                `long:"redact-env" description:"Do not print values for environment vars set in the application manifest"`
+	Force                   bool                                `long:"force" description:"Push even if the apps would exceed the memory, app instance, route or log rate limit of the org or space quota"`
 	Stack                   string                              `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	StartCommand            flag.Command                        `long:"start-command" short:"c" description:"Startup command, set to null to reset to default start command"`
 	Strategy                flag.DeploymentStrategy             `long:"strategy" description:"Deployment strategy, either rolling or null."`
@@ -105,7 +118,7 @@ type PushCommand struct {
 	Vars                    []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles        []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 	dockerPassword          interface{}                         `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route] [--explain-upload] [--force]\n   [--blue-green [--blue-green-timeout SECONDS] [--keep-old-app]]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ] [--force]\n   [--blue-green [--blue-green-timeout SECONDS] [--keep-old-app]]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
 	envCFStagingTimeout     interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout     interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -129,7 +142,10 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.ProgressBar = progressbar.NewProgressBar()
 	cmd.VersionActor = cmd.Actor
-	cmd.PushActor = v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
+	pushActor := v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
+	pushActor.BitsCache = v7pushaction.NewFileBitsCache(filepath.Join(filepath.Dir(configv3.ConfigFilePath()), "push-cache"))
+	pushActor.BitsCacheTarget = config.Target()
+	cmd.PushActor = pushActor
 
 	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
 	if err != nil {
@@ -178,12 +194,35 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.ExplainUpload {
+		return cmd.explainUpload(transformedManifest, flagOverrides, user)
+	}
+
+	if cmd.BlueGreen {
+		flagOverrides.BlueGreen, err = cmd.liveAppExists(transformedManifest)
+		if err != nil {
+			return err
+		}
+	}
+
 	flagOverrides.DockerPassword, err = cmd.GetDockerPassword(flagOverrides.DockerUsername, transformedManifest.ContainsPrivateDockerImages())
 	if err != nil {
 		return err
 	}
 
-	transformedRawManifest, err := cmd.ManifestParser.MarshalManifest(transformedManifest)
+	pushedManifest := transformedManifest
+	if flagOverrides.BlueGreen {
+		pushedManifest = v7pushaction.BlueGreenManifest(transformedManifest)
+	}
+
+	if !cmd.Force {
+		err = cmd.checkQuotas(pushedManifest, flagOverrides)
+		if err != nil {
+			return err
+		}
+	}
+
+	transformedRawManifest, err := cmd.ManifestParser.MarshalManifest(pushedManifest)
 	if err != nil {
 		return err
 	}
@@ -254,14 +293,19 @@ func (cmd PushCommand) Execute(args []string) error {
 		eventStream := cmd.PushActor.Actualize(plan, cmd.ProgressBar)
 		err := cmd.eventStreamHandler(eventStream)
 
+		appName := plan.Application.Name
+		if plan.BlueGreen {
+			appName = plan.LiveApplication.Name
+		}
+
 		if cmd.shouldDisplaySummary(err) {
-			summaryErr := cmd.displayAppSummary(plan)
+			summaryErr := cmd.displayAppSummary(appName)
 			if summaryErr != nil {
 				return summaryErr
 			}
 		}
 		if err != nil {
-			return cmd.mapErr(plan.Application.Name, err)
+			return cmd.mapErr(appName, err)
 		}
 	}
 
@@ -361,6 +405,9 @@ func (cmd PushCommand) GetFlagOverrides() (v7pushaction.FlagOverrides, error) {
 		NoManifest:          cmd.NoManifest,
 		Task:                cmd.Task,
 		LogRateLimit:        cmd.LogRateLimit,
+		BlueGreen:           cmd.BlueGreen,
+		BlueGreenTimeout:    time.Duration(cmd.BlueGreenTimeout.Value) * time.Second,
+		KeepOldApp:          cmd.KeepOldApp,
 	}, nil
 }
 
@@ -467,6 +514,60 @@ func (cmd PushCommand) ValidateFlags() error {
 				"--random-route",
 			},
 		}
+	case cmd.ExplainUpload && cmd.DockerImage.Path != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--explain-upload",
+				"--docker-image, -o",
+			},
+		}
+
+	case cmd.ExplainUpload && cmd.DropletPath != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--explain-upload",
+				"--droplet",
+			},
+		}
+
+	case cmd.BlueGreen && cmd.Strategy == flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--blue-green",
+				"--strategy=rolling",
+			},
+		}
+
+	case cmd.BlueGreen && cmd.NoStart:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--blue-green",
+				"--no-start",
+			},
+		}
+
+	case cmd.BlueGreen && cmd.Task:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--blue-green",
+				"--task",
+			},
+		}
+
+	case cmd.BlueGreen && cmd.ExplainUpload:
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{
+				"--blue-green",
+				"--explain-upload",
+			},
+		}
+
+	case cmd.KeepOldApp && !cmd.BlueGreen:
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--blue-green",
+			Arg2: "--keep-old-app",
+		}
+
 	case !cmd.validBuildpacks():
 		return translatableerror.InvalidBuildpacksError{}
 	}
@@ -483,6 +584,58 @@ func (cmd PushCommand) validBuildpacks() bool {
 	return true
 }
 
+// checkQuotas fails the push before anything changes when the apps would
+// exceed a limit of the org or space quota. When the usage cannot be
+// projected, for example because the user cannot read the quotas, it warns and
+// lets the push continue.
+func (cmd PushCommand) checkQuotas(manifest manifestparser.Manifest, overrides v7pushaction.FlagOverrides) error {
+	check, warnings, err := cmd.PushActor.CheckQuotasForPush(
+		cmd.Config.TargetedOrganization().GUID,
+		cmd.Config.TargetedSpace().GUID,
+		manifest,
+		overrides,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		shared.NewQuotaDisplayer(cmd.UI).DisplayQuotaCheckSkipped(err)
+		return nil
+	}
+
+	if !check.Exceeded() {
+		return nil
+	}
+
+	cmd.UI.DisplayText("Pushing would exceed these quota limits:")
+	shared.NewQuotaDisplayer(cmd.UI).DisplayQuotaCheck(check)
+	cmd.UI.DisplayNewline()
+
+	return translatableerror.QuotaCheckFailedError{Command: "push", Flag: "--force"}
+}
+
+// liveAppExists checks that a blue-green push has an app to take over from.
+// When the app does not exist yet, there are no routes to move and it is
+// pushed as usual.
+func (cmd PushCommand) liveAppExists(manifest manifestparser.Manifest) (bool, error) {
+	if len(manifest.Applications) > 1 {
+		return false, translatableerror.CommandLineArgsWithMultipleAppsError{}
+	}
+
+	appName := manifest.Applications[0].Name
+	_, warnings, err := cmd.VersionActor.GetApplicationByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
+			cmd.UI.DisplayText("App {{.AppName}} does not exist yet, so it is pushed without a blue-green deployment.", map[string]interface{}{
+				"AppName": appName,
+			})
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (cmd PushCommand) shouldDisplaySummary(err error) bool {
 	if err == nil {
 		return true
@@ -524,10 +677,117 @@ func (cmd PushCommand) announcePushing(appNames []string, user configv3.User) {
 	}
 }
 
-func (cmd PushCommand) displayAppSummary(plan v7pushaction.PushPlan) error {
+func (cmd PushCommand) explainUpload(manifest manifestparser.Manifest, flagOverrides v7pushaction.FlagOverrides, user configv3.User) error {
+	cmd.UI.DisplayTextWithFlavor("Explaining upload for {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   strings.Join(manifest.AppNames(), ", "),
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	pushPlans, warnings, err := cmd.PushActor.CreatePushPlans(
+		cmd.Config.TargetedSpace().GUID,
+		cmd.Config.TargetedOrganization().GUID,
+		manifest,
+		flagOverrides,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for _, plan := range pushPlans {
+		cmd.UI.DisplayNewline()
+		if plan.DropletPath != "" || plan.DockerImageCredentials.Path != "" || plan.Application.LifecycleType == constant.AppLifecycleTypeDocker {
+			cmd.UI.DisplayText("App {{.AppName}} is not pushed from source files, so nothing would be uploaded.", map[string]interface{}{
+				"AppName": plan.Application.Name,
+			})
+			continue
+		}
+
+		log.WithField("app_name", plan.Application.Name).Info("explaining upload")
+		report, warnings, err := cmd.PushActor.ExplainUpload(plan)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.displayUploadReport(plan, report)
+	}
+
+	return nil
+}
+
+func (cmd PushCommand) displayUploadReport(plan v7pushaction.PushPlan, report v7pushaction.UploadReport) {
+	cmd.UI.DisplayTextWithFlavor("Files for app {{.AppName}} from {{.Path}}:", map[string]interface{}{
+		"AppName": plan.Application.Name,
+		"Path":    plan.BitsPath,
+	})
+	cmd.UI.DisplayNewline()
+
+	cached := map[string]bool{}
+	for _, resource := range report.Matched {
+		cached[resource.Checksum.Value] = true
+	}
+
+	var files, uploads int
+	table := [][]string{{cmd.UI.TranslateText("included file"), cmd.UI.TranslateText("size"), cmd.UI.TranslateText("resource cache")}}
+	for _, resource := range report.Included {
+		// directories are only listed through the files in them
+		if resource.Checksum.Value == "" && resource.Mode&os.ModeSymlink == 0 {
+			continue
+		}
+		files++
+		status := cmd.UI.TranslateText("cached")
+		if !cached[resource.Checksum.Value] {
+			status = cmd.UI.TranslateText("upload")
+			uploads++
+		}
+		table = append(table, []string{resource.FilePath, bytefmt.ByteSize(uint64(resource.SizeInBytes)), status})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	if len(report.Ignored) == 0 {
+		cmd.UI.DisplayText("No files were ignored.")
+	} else {
+		table = [][]string{{cmd.UI.TranslateText("ignored file"), cmd.UI.TranslateText("rule"), cmd.UI.TranslateText("source")}}
+		for _, ignoredFile := range report.Ignored {
+			table = append(table, []string{ignoredFile.Filename, ignoredFile.Rule, ignoredFile.RuleSource})
+		}
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	}
+	cmd.UI.DisplayNewline()
+
+	if len(report.Unmatched) == 0 {
+		cmd.UI.DisplayText("All files found in remote cache; nothing to upload.")
+		return
+	}
+
+	cmd.UI.DisplayText("Largest contributors to the upload:")
+	table = [][]string{{cmd.UI.TranslateText("path"), cmd.UI.TranslateText("files"), cmd.UI.TranslateText("size"), cmd.UI.TranslateText("zipped")}}
+	for _, contributor := range report.LargestContributors {
+		table = append(table, []string{
+			contributor.Path,
+			strconv.Itoa(contributor.Files),
+			bytefmt.ByteSize(uint64(contributor.Size)),
+			bytefmt.ByteSize(uint64(contributor.CompressedSize)),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("{{.Count}} of {{.Total}} files would be uploaded in a {{.Size}} zip.", map[string]interface{}{
+		"Count": uploads,
+		"Total": files,
+		"Size":  bytefmt.ByteSize(uint64(report.ArchiveSize)),
+	})
+}
+
+func (cmd PushCommand) displayAppSummary(appName string) error {
 	log.Info("getting application summary info")
 	summary, warnings, err := cmd.VersionActor.GetDetailedAppSummary(
-		plan.Application.Name,
+		appName,
 		cmd.Config.TargetedSpace().GUID,
 		true,
 	)
@@ -547,7 +807,7 @@ func (cmd *PushCommand) eventStreamHandler(eventStream <-chan *v7pushaction.Push
 		if event.Err != nil {
 			return event.Err
 		}
-		err := cmd.processEvent(event.Event, event.Plan.Application.Name)
+		err := cmd.processEvent(event.Event, event.Plan)
 		if err != nil {
 			return err
 		}
@@ -555,7 +815,8 @@ func (cmd *PushCommand) eventStreamHandler(eventStream <-chan *v7pushaction.Push
 	return nil
 }
 
-func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) error {
+func (cmd *PushCommand) processEvent(event v7pushaction.Event, plan v7pushaction.PushPlan) error {
+	appName := plan.Application.Name
 	switch event {
 	case v7pushaction.CreatingArchive:
 		cmd.UI.DisplayText("Packaging files to upload...")
@@ -566,6 +827,8 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 	case v7pushaction.UploadingApplication:
 		cmd.UI.DisplayText("All files found in remote cache; nothing to upload.")
 		cmd.UI.DisplayText("Waiting for API to complete processing files...")
+	case v7pushaction.UsingCachedArchive:
+		cmd.UI.DisplayText("Reusing files packaged for a previous upload attempt...")
 	case v7pushaction.RetryUpload:
 		cmd.UI.DisplayText("Retrying upload due to an error...")
 	case v7pushaction.UploadWithArchiveComplete:
@@ -625,6 +888,46 @@ func (cmd *PushCommand) processEvent(event v7pushaction.Event, appName string) e
 	case v7pushaction.WaitingForDeployment:
 		cmd.UI.DisplayText("Waiting for app to deploy...")
 		cmd.UI.DisplayNewline()
+	case v7pushaction.MappingRoutes:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("Mapping routes to app {{.AppName}}...", map[string]interface{}{
+			"AppName": appName,
+		})
+	case v7pushaction.MonitoringHealth:
+		cmd.UI.DisplayText("Checking that app {{.AppName}} stays healthy for {{.Timeout}}...", map[string]interface{}{
+			"AppName": appName,
+			"Timeout": plan.BlueGreenTimeout,
+		})
+	case v7pushaction.UnmappingRoutes:
+		cmd.UI.DisplayText("Unmapping routes from app {{.AppName}}...", map[string]interface{}{
+			"AppName": plan.LiveApplication.Name,
+		})
+	case v7pushaction.DeletingApplication:
+		cmd.UI.DisplayText("Deleting app {{.AppName}}...", map[string]interface{}{
+			"AppName": plan.LiveApplication.Name,
+		})
+	case v7pushaction.DeletingApplicationComplete:
+		cmd.UI.DisplayText("App deleted")
+	case v7pushaction.RenamingOldApplication:
+		cmd.UI.DisplayText("Renaming app {{.AppName}} to {{.NewAppName}}...", map[string]interface{}{
+			"AppName":    plan.LiveApplication.Name,
+			"NewAppName": v7pushaction.OldAppName(plan.LiveApplication.Name),
+		})
+	case v7pushaction.RenamingApplication:
+		cmd.UI.DisplayText("Renaming app {{.AppName}} to {{.NewAppName}}...", map[string]interface{}{
+			"AppName":    appName,
+			"NewAppName": plan.LiveApplication.Name,
+		})
+	case v7pushaction.RollingBack:
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayWarning("Blue-green push failed. Deleting app {{.AppName}} and keeping app {{.LiveAppName}}...", map[string]interface{}{
+			"AppName":     appName,
+			"LiveAppName": plan.LiveApplication.Name,
+		})
+	case v7pushaction.RollingBackComplete:
+		cmd.UI.DisplayText("Rolled back to app {{.AppName}}", map[string]interface{}{
+			"AppName": plan.LiveApplication.Name,
+		})
 	default:
 		log.WithField("event", event).Debug("ignoring event")
 	}
@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -298,6 +299,284 @@ var _ = Describe("push Command", func() {
 							})
 						})
 
+						When("the --explain-upload flag is provided", func() {
+							BeforeEach(func() {
+								cmd.ExplainUpload = true
+								fakeActor.CreatePushPlansReturns(
+									[]v7pushaction.PushPlan{
+										{Application: resources.Application{Name: "some-app-name"}, BitsPath: "/some/app"},
+										{Application: resources.Application{Name: "some-docker-app"}, DockerImageCredentials: v7action.DockerImageCredentials{Path: "some-image"}},
+									},
+									v7action.Warnings{"plan-warning"},
+									nil,
+								)
+
+								cachedFile := sharedaction.V3Resource{FilePath: "cached.js", Checksum: ccv3.Checksum{Value: "cached-sha"}, SizeInBytes: 2048}
+								uploadedFile := sharedaction.V3Resource{FilePath: "node_modules/big.js", Checksum: ccv3.Checksum{Value: "big-sha"}, SizeInBytes: 5 * 1024 * 1024}
+								fakeActor.ExplainUploadReturns(
+									v7pushaction.UploadReport{
+										Included: []sharedaction.V3Resource{
+											cachedFile,
+											{FilePath: "node_modules", Mode: 0755},
+											uploadedFile,
+										},
+										Ignored: []sharedaction.IgnoredFile{
+											{Filename: "debug.log", Rule: "*.log", RuleSource: sharedaction.CFIgnoreRuleSource},
+										},
+										Matched:     []sharedaction.V3Resource{cachedFile},
+										Unmatched:   []sharedaction.V3Resource{{FilePath: "node_modules", Mode: 0755}, uploadedFile},
+										ArchiveSize: 1024 * 1024,
+										LargestContributors: []v7pushaction.UploadContributor{
+											{Path: "node_modules/", Files: 1, Size: 5 * 1024 * 1024, CompressedSize: 1024 * 1024},
+										},
+									},
+									v7pushaction.Warnings{"explain-warning"},
+									nil,
+								)
+							})
+
+							It("reports the upload for each app without pushing", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).To(Say(`Explaining upload for some-app-name to org some-org / space some-space as some-user\.\.\.`))
+								Expect(testUI.Err).To(Say("plan-warning"))
+
+								Expect(testUI.Out).To(Say(`Files for app some-app-name from /some/app:`))
+								Expect(testUI.Out).To(Say(`included file\s+size\s+resource cache`))
+								Expect(testUI.Out).To(Say(`cached\.js\s+2K\s+cached`))
+								Expect(testUI.Out).To(Say(`node_modules/big\.js\s+5M\s+upload`))
+								Expect(testUI.Out).To(Say(`ignored file\s+rule\s+source`))
+								Expect(testUI.Out).To(Say(`debug\.log\s+\*\.log\s+\.cfignore`))
+								Expect(testUI.Out).To(Say(`Largest contributors to the upload:`))
+								Expect(testUI.Out).To(Say(`path\s+files\s+size\s+zipped`))
+								Expect(testUI.Out).To(Say(`node_modules/\s+1\s+5M\s+1M`))
+								Expect(testUI.Out).To(Say(`1 of 2 files would be uploaded in a 1M zip\.`))
+								Expect(testUI.Err).To(Say("explain-warning"))
+
+								Expect(testUI.Out).To(Say(`App some-docker-app is not pushed from source files, so nothing would be uploaded\.`))
+
+								Expect(fakeActor.ExplainUploadCallCount()).To(Equal(1))
+								Expect(fakeActor.ExplainUploadArgsForCall(0).Application.Name).To(Equal("some-app-name"))
+								Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+								Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+							})
+
+							When("explaining the upload fails", func() {
+								BeforeEach(func() {
+									fakeActor.ExplainUploadReturns(v7pushaction.UploadReport{}, v7pushaction.Warnings{"explain-warning"}, errors.New("explain-error"))
+								})
+
+								It("returns the error and displays warnings", func() {
+									Expect(executeErr).To(MatchError("explain-error"))
+									Expect(testUI.Err).To(Say("explain-warning"))
+								})
+							})
+						})
+
+						When("the --blue-green flag is provided", func() {
+							BeforeEach(func() {
+								cmd.BlueGreen = true
+								cmd.KeepOldApp = true
+								fakeVersionActor.GetApplicationByNameAndSpaceReturns(
+									resources.Application{Name: "some-app-name", GUID: "live-guid"},
+									v7action.Warnings{"get-app-warning"},
+									nil,
+								)
+							})
+
+							It("pushes the app under its temporary name without routes", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Err).To(Say("get-app-warning"))
+
+								appName, spaceGUID := fakeVersionActor.GetApplicationByNameAndSpaceArgsForCall(0)
+								Expect(appName).To(Equal("some-app-name"))
+								Expect(spaceGUID).To(Equal("some-space-guid"))
+
+								Expect(fakeManifestParser.MarshalManifestCallCount()).To(Equal(1))
+								marshalledManifest := fakeManifestParser.MarshalManifestArgsForCall(0)
+								Expect(marshalledManifest.Applications).To(HaveLen(1))
+								Expect(marshalledManifest.Applications[0].Name).To(Equal("some-app-name-new"))
+								Expect(marshalledManifest.Applications[0].NoRoute).To(BeTrue())
+
+								_, _, manifest, overrides := fakeActor.CreatePushPlansArgsForCall(0)
+								Expect(manifest.Applications[0].Name).To(Equal("some-app-name"))
+								Expect(overrides.BlueGreen).To(BeTrue())
+								Expect(overrides.KeepOldApp).To(BeTrue())
+							})
+
+							When("the blue-green steps run", func() {
+								BeforeEach(func() {
+									fakeActor.CreatePushPlansReturns(
+										[]v7pushaction.PushPlan{
+											{
+												Application:     resources.Application{Name: "some-app-name-new", GUID: "new-guid"},
+												LiveApplication: resources.Application{Name: "some-app-name", GUID: "live-guid"},
+												BlueGreen:       true,
+											},
+										},
+										nil,
+										nil,
+									)
+									fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+										pushPlan.BlueGreenTimeout = time.Minute
+										return FillInEvents([]Step{
+											{Plan: pushPlan, Event: v7pushaction.MappingRoutes},
+											{Plan: pushPlan, Event: v7pushaction.MonitoringHealth},
+											{Plan: pushPlan, Event: v7pushaction.UnmappingRoutes},
+											{Plan: pushPlan, Event: v7pushaction.RenamingOldApplication},
+											{Plan: pushPlan, Event: v7pushaction.RenamingApplication},
+										})
+									}
+								})
+
+								It("reports each step and shows the summary of the live app", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+									Expect(testUI.Out).To(Say(`Mapping routes to app some-app-name-new\.\.\.`))
+									Expect(testUI.Out).To(Say(`Checking that app some-app-name-new stays healthy for 1m0s\.\.\.`))
+									Expect(testUI.Out).To(Say(`Unmapping routes from app some-app-name\.\.\.`))
+									Expect(testUI.Out).To(Say(`Renaming app some-app-name to some-app-name-old\.\.\.`))
+									Expect(testUI.Out).To(Say(`Renaming app some-app-name-new to some-app-name\.\.\.`))
+
+									Expect(fakeVersionActor.GetDetailedAppSummaryCallCount()).To(Equal(1))
+									appName, _, _ := fakeVersionActor.GetDetailedAppSummaryArgsForCall(0)
+									Expect(appName).To(Equal("some-app-name"))
+								})
+							})
+
+							When("the temporary app is rolled back", func() {
+								BeforeEach(func() {
+									fakeActor.CreatePushPlansReturns([]v7pushaction.PushPlan{{BlueGreen: true}}, nil, nil)
+									fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+										pushPlan.Application.Name = "some-app-name-new"
+										pushPlan.LiveApplication.Name = "some-app-name"
+										return FillInEvents([]Step{
+											{Plan: pushPlan, Event: v7pushaction.RollingBack},
+											{Plan: pushPlan, Event: v7pushaction.RollingBackComplete},
+											{Error: actionerror.ApplicationUnhealthyError{Name: "some-app-name-new"}},
+										})
+									}
+								})
+
+								It("reports the rollback and returns the error", func() {
+									Expect(executeErr).To(MatchError(actionerror.ApplicationUnhealthyError{Name: "some-app-name-new"}))
+									Expect(testUI.Err).To(Say(`Blue-green push failed\. Deleting app some-app-name-new and keeping app some-app-name\.\.\.`))
+									Expect(testUI.Out).To(Say(`Rolled back to app some-app-name`))
+								})
+							})
+
+							When("the app does not exist yet", func() {
+								BeforeEach(func() {
+									fakeVersionActor.GetApplicationByNameAndSpaceReturns(
+										resources.Application{},
+										nil,
+										actionerror.ApplicationNotFoundError{Name: "some-app-name"},
+									)
+								})
+
+								It("pushes it without a blue-green deployment", func() {
+									Expect(executeErr).ToNot(HaveOccurred())
+									Expect(testUI.Out).To(Say(`App some-app-name does not exist yet, so it is pushed without a blue-green deployment\.`))
+
+									Expect(fakeManifestParser.MarshalManifestArgsForCall(0).Applications[0].Name).To(Equal("some-app-name"))
+
+									_, _, _, overrides := fakeActor.CreatePushPlansArgsForCall(0)
+									Expect(overrides.BlueGreen).To(BeFalse())
+								})
+							})
+
+							When("getting the app fails", func() {
+								BeforeEach(func() {
+									fakeVersionActor.GetApplicationByNameAndSpaceReturns(
+										resources.Application{},
+										v7action.Warnings{"get-app-warning"},
+										errors.New("get-app-error"),
+									)
+								})
+
+								It("returns the error", func() {
+									Expect(executeErr).To(MatchError("get-app-error"))
+									Expect(testUI.Err).To(Say("get-app-warning"))
+									Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+								})
+							})
+
+							When("the manifest has more than one app", func() {
+								BeforeEach(func() {
+									fakeActor.HandleFlagOverridesReturns(
+										manifestparser.Manifest{
+											Applications: []manifestparser.Application{
+												{Name: "some-app-name"},
+												{Name: "other-app-name"},
+											},
+										},
+										nil,
+									)
+								})
+
+								It("returns an error", func() {
+									Expect(executeErr).To(MatchError(translatableerror.CommandLineArgsWithMultipleAppsError{}))
+									Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+								})
+							})
+						})
+
+						It("checks the quotas of the targeted org and space", func() {
+							Expect(fakeActor.CheckQuotasForPushCallCount()).To(Equal(1))
+							orgGUID, spaceGUID, manifest, _ := fakeActor.CheckQuotasForPushArgsForCall(0)
+							Expect(orgGUID).To(Equal("some-org-guid"))
+							Expect(spaceGUID).To(Equal("some-space-guid"))
+							Expect(manifest.AppNames()).To(Equal([]string{"some-app-name"}))
+						})
+
+						When("the push would exceed a quota limit", func() {
+							BeforeEach(func() {
+								fakeActor.CheckQuotasForPushReturns(
+									v7action.QuotaCheck{
+										{Scope: "space", QuotaName: "space-quota", Resource: v7action.QuotaResourceLogRate, Current: 1024, Change: 0, Limit: 4096, Unlimited: true},
+										{Scope: "space", QuotaName: "space-quota", Resource: v7action.QuotaResourceRoutes, Current: 10, Change: 1, Limit: 10},
+									},
+									v7pushaction.Warnings{"quota-warning"},
+									nil,
+								)
+							})
+
+							It("shows the breakdown and fails before changing anything", func() {
+								Expect(executeErr).To(MatchError(translatableerror.QuotaCheckFailedError{Command: "push", Flag: "--force"}))
+
+								Expect(testUI.Out).To(Say(`Pushing would exceed these quota limits:`))
+								Expect(testUI.Out).To(Say(`space space-quota\s+log rate\s+1K\s+unlimited\s+unlimited\s+4K\s+exceeds limit`))
+								Expect(testUI.Out).To(Say(`space space-quota\s+routes\s+10\s+\+1\s+11\s+10\s+exceeds limit`))
+								Expect(testUI.Err).To(Say("quota-warning"))
+
+								Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(0))
+								Expect(fakeActor.CreatePushPlansCallCount()).To(Equal(0))
+							})
+
+							When("--force is provided", func() {
+								BeforeEach(func() {
+									cmd.Force = true
+								})
+
+								It("skips the check", func() {
+									Expect(fakeActor.CheckQuotasForPushCallCount()).To(Equal(0))
+									Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(1))
+								})
+							})
+						})
+
+						When("checking the quotas fails", func() {
+							BeforeEach(func() {
+								fakeActor.CheckQuotasForPushReturns(nil, v7pushaction.Warnings{"quota-warning"}, errors.New("quota-error"))
+							})
+
+							It("warns and continues the push", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Err).To(Say("quota-warning"))
+								Expect(testUI.Err).To(Say("Unable to check the org and space quotas, continuing without the check: quota-error"))
+								Expect(fakeVersionActor.SetSpaceManifestCallCount()).To(Equal(1))
+							})
+						})
+
 						It("delegates to the manifest parser", func() {
 							Expect(fakeManifestParser.MarshalManifestCallCount()).To(Equal(1))
 							Expect(fakeManifestParser.MarshalManifestArgsForCall(0)).To(Equal(
@@ -482,6 +761,10 @@ var _ = Describe("push Command", func() {
 												BeforeEach(func() {
 													fakeActor.ActualizeStub = func(pushPlan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
 														return FillInEvents([]Step{
+															{
+																Plan:  v7pushaction.PushPlan{Application: resources.Application{GUID: pushPlan.Application.GUID, Name: pushPlan.Application.Name}},
+																Event: v7pushaction.UsingCachedArchive,
+															},
 															{
 																Plan:  v7pushaction.PushPlan{Application: resources.Application{GUID: pushPlan.Application.GUID, Name: pushPlan.Application.Name}},
 																Event: v7pushaction.CreatingArchive,
@@ -522,6 +805,7 @@ var _ = Describe("push Command", func() {
 													Expect(fakeProgressBar.ReadyCallCount()).Should(Equal(2))
 													Expect(fakeProgressBar.CompleteCallCount()).Should(Equal(2))
 
+													Expect(testUI.Out).To(Say("Reusing files packaged for a previous upload attempt..."))
 													Expect(testUI.Out).To(Say("Packaging files to upload..."))
 
 													Expect(testUI.Out).To(Say("Uploading files..."))
@@ -1079,6 +1363,9 @@ var _ = Describe("push Command", func() {
 			cmd.Vars = []template.VarKV{{Name: "key", Value: "val"}}
 			cmd.Task = true
 			cmd.LogRateLimit = "512M"
+			cmd.BlueGreen = true
+			cmd.BlueGreenTimeout = flag.PositiveInteger{Value: 90}
+			cmd.KeepOldApp = true
 		})
 
 		JustBeforeEach(func() {
@@ -1108,6 +1395,9 @@ var _ = Describe("push Command", func() {
 			Expect(overrides.Vars).To(Equal([]template.VarKV{{Name: "key", Value: "val"}}))
 			Expect(overrides.Task).To(BeTrue())
 			Expect(overrides.LogRateLimit).To(Equal("512M"))
+			Expect(overrides.BlueGreen).To(BeTrue())
+			Expect(overrides.BlueGreenTimeout).To(Equal(90 * time.Second))
+			Expect(overrides.KeepOldApp).To(BeTrue())
 		})
 
 		When("a docker image is provided", func() {
@@ -1258,6 +1548,28 @@ var _ = Describe("push Command", func() {
 			},
 			translatableerror.InvalidBuildpacksError{}),
 
+		Entry("when explain-upload and docker image flags are passed",
+			func() {
+				cmd.ExplainUpload = true
+				cmd.DockerImage.Path = "some-docker-image"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--explain-upload", "--docker-image, -o",
+				},
+			}),
+
+		Entry("when explain-upload and droplet flags are passed",
+			func() {
+				cmd.ExplainUpload = true
+				cmd.DropletPath = "some-droplet.tgz"
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--explain-upload", "--droplet",
+				},
+			}),
+
 		Entry("task and strategy flags are passed",
 			func() {
 				cmd.Task = true
@@ -1268,5 +1580,58 @@ var _ = Describe("push Command", func() {
 					"--task", "--strategy=rolling",
 				},
 			}),
+
+		Entry("when blue-green and strategy 'rolling' flags are passed",
+			func() {
+				cmd.BlueGreen = true
+				cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--blue-green", "--strategy=rolling",
+				},
+			}),
+
+		Entry("when blue-green and no-start flags are passed",
+			func() {
+				cmd.BlueGreen = true
+				cmd.NoStart = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--blue-green", "--no-start",
+				},
+			}),
+
+		Entry("when blue-green and task flags are passed",
+			func() {
+				cmd.BlueGreen = true
+				cmd.Task = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--blue-green", "--task",
+				},
+			}),
+
+		Entry("when blue-green and explain-upload flags are passed",
+			func() {
+				cmd.BlueGreen = true
+				cmd.ExplainUpload = true
+			},
+			translatableerror.ArgumentCombinationError{
+				Args: []string{
+					"--blue-green", "--explain-upload",
+				},
+			}),
+
+		Entry("when keep-old-app is passed without blue-green",
+			func() {
+				cmd.KeepOldApp = true
+			},
+			translatableerror.RequiredFlagsError{
+				Arg1: "--blue-green",
+				Arg2: "--keep-old-app",
+			}),
 	)
 })
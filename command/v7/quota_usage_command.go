@@ -0,0 +1,103 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+type QuotaUsageCommand struct {
+	BaseCommand
+
+	AllOrgs         bool                 `long:"all-orgs" description:"Report on every org instead of the targeted org and its spaces"`
+	Threshold       flag.PositiveInteger `long:"threshold" default:"80" description:"Percentage of a quota limit at which an org or space is flagged as near its limit (1-100)"`
+	usage           interface{}          `usage:"CF_NAME quota-usage [--all-orgs] [--threshold PERCENT]\n\nEXAMPLES:\n   CF_NAME quota-usage\n   CF_NAME quota-usage --all-orgs --threshold 90"`
+	relatedCommands interface{}          `related_commands:"org-quota, space-quota, update-org-quota, update-space-quota"`
+}
+
+func (cmd QuotaUsageCommand) Execute(args []string) error {
+	threshold := int(cmd.Threshold.Value)
+	if threshold > 100 {
+		return translatableerror.ParseArgumentError{
+			ArgumentName: "--threshold",
+			ExpectedType: "an integer between 1 and 100",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(!cmd.AllOrgs, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	quotaDisplayer := shared.NewQuotaDisplayer(cmd.UI)
+
+	var orgReports []v7action.QuotaUsageReport
+	if cmd.AllOrgs {
+		cmd.UI.DisplayTextWithFlavor("Getting quota usage for all orgs as {{.UserName}}...", map[string]interface{}{
+			"UserName": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+
+		reports, warnings, err := cmd.Actor.GetOrganizationsQuotaUsage()
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		if len(reports) == 0 {
+			cmd.UI.DisplayText("No orgs found.")
+			return nil
+		}
+
+		quotaDisplayer.DisplayQuotaUsageTable(reports, threshold)
+		orgReports = reports
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting quota usage for org {{.OrgName}} as {{.UserName}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"UserName": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+
+		usage, warnings, err := cmd.Actor.GetOrganizationQuotaUsage(cmd.Config.TargetedOrganization().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		quotaDisplayer.DisplayQuotaUsageTable([]v7action.QuotaUsageReport{usage.QuotaUsageReport}, threshold)
+		cmd.UI.DisplayNewline()
+
+		cmd.UI.DisplayText("Spaces:")
+		if len(usage.Spaces) == 0 {
+			cmd.UI.DisplayText("No spaces found.")
+		} else {
+			quotaDisplayer.DisplayQuotaUsageTable(usage.Spaces, threshold)
+		}
+		orgReports = []v7action.QuotaUsageReport{usage.QuotaUsageReport}
+	}
+
+	var flagged []string
+	for _, report := range orgReports {
+		if report.Exceeded() || report.NearLimit(threshold) {
+			flagged = append(flagged, report.Name)
+		}
+	}
+
+	if len(flagged) > 0 {
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayWarning("Orgs at or above {{.Threshold}}% of a quota limit: {{.OrgNames}}", map[string]interface{}{
+			"Threshold": threshold,
+			"OrgNames":  strings.Join(flagged, ", "),
+		})
+	}
+
+	return nil
+}
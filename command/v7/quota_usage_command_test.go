@@ -0,0 +1,206 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("quota-usage Command", func() {
+	var (
+		cmd             QuotaUsageCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+	)
+
+	limit := func(value int) types.NullInt {
+		return types.NullInt{IsSet: true, Value: value}
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = QuotaUsageCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Threshold: flag.PositiveInteger{Value: 80},
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetOrganizationQuotaUsageReturns(
+			v7action.OrganizationQuotaUsage{
+				QuotaUsageReport: v7action.QuotaUsageReport{
+					Name:             "some-org",
+					QuotaName:        "org-quota",
+					Memory:           v7action.QuotaUsage{Used: 1700, Limit: limit(2048)},
+					AppInstances:     v7action.QuotaUsage{Used: 4, Limit: limit(10)},
+					Routes:           v7action.QuotaUsage{Used: 2},
+					ReservedPorts:    v7action.QuotaUsage{Used: 0, Limit: limit(0)},
+					ServiceInstances: v7action.QuotaUsage{Used: 1, Limit: limit(4)},
+					PaidServicePlans: v7action.QuotaUsage{Used: 0, Limit: limit(0)},
+				},
+				Spaces: []v7action.QuotaUsageReport{
+					{
+						Name:      "space-a",
+						QuotaName: "space-quota",
+						Memory:    v7action.QuotaUsage{Used: 1024, Limit: limit(512)},
+					},
+					{
+						Name:   "space-b",
+						Memory: v7action.QuotaUsage{Used: 676},
+					},
+				},
+			},
+			v7action.Warnings{"usage-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("the threshold is above 100", func() {
+		BeforeEach(func() {
+			cmd.Threshold = flag.PositiveInteger{Value: 101}
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+				ArgumentName: "--threshold",
+				ExpectedType: "an integer between 1 and 100",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	It("displays the usage of the targeted org and its spaces", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeActor.GetOrganizationQuotaUsageArgsForCall(0)).To(Equal("some-org-guid"))
+
+		Expect(testUI.Out).To(Say(`Getting quota usage for org some-org as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`name\s+quota\s+memory\s+app instances\s+routes\s+route ports\s+service instances\s+paid service plans\s+status`))
+		Expect(testUI.Out).To(Say(`some-org\s+org-quota\s+1\.7G/2G \(83%\)\s+4/10 \(40%\)\s+2/unlimited\s+0/0 \(0%\)\s+1/4 \(25%\)\s+0/0 \(0%\)\s+near limit`))
+
+		Expect(testUI.Out).To(Say(`Spaces:`))
+		Expect(testUI.Out).To(Say(`name\s+quota\s+memory`))
+		Expect(testUI.Out).To(Say(`space-a\s+space-quota\s+1G/512M \(200%\)\s+0/unlimited.*over limit`))
+		Expect(testUI.Out).To(Say(`space-b\s+676M/unlimited.*ok`))
+
+		Expect(testUI.Err).To(Say("usage-warning"))
+		Expect(testUI.Err).To(Say(`Orgs at or above 80% of a quota limit: some-org`))
+	})
+
+	When("the org is below the threshold", func() {
+		BeforeEach(func() {
+			cmd.Threshold = flag.PositiveInteger{Value: 90}
+		})
+
+		It("does not flag the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`some-org\s+org-quota.*ok`))
+			Expect(testUI.Err).NotTo(Say(`Orgs at or above`))
+		})
+	})
+
+	When("--all-orgs is given", func() {
+		BeforeEach(func() {
+			cmd.AllOrgs = true
+			fakeActor.GetOrganizationsQuotaUsageReturns(
+				[]v7action.QuotaUsageReport{
+					{Name: "org-1", QuotaName: "default", Routes: v7action.QuotaUsage{Used: 10, Limit: limit(10)}},
+					{Name: "org-2", QuotaName: "default", Routes: v7action.QuotaUsage{Used: 1, Limit: limit(10)}},
+					{Name: "org-3", QuotaName: "default", ServiceInstances: v7action.QuotaUsage{Used: 5, Limit: limit(4)}},
+				},
+				v7action.Warnings{"orgs-usage-warning"},
+				nil,
+			)
+		})
+
+		It("displays the usage of every org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(fakeActor.GetOrganizationQuotaUsageCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say(`Getting quota usage for all orgs as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`org-1\s+default.*10/10 \(100%\).*near limit`))
+			Expect(testUI.Out).To(Say(`org-2\s+default.*1/10 \(10%\).*ok`))
+			Expect(testUI.Out).To(Say(`org-3\s+default.*5/4 \(125%\).*over limit`))
+			Expect(testUI.Out).NotTo(Say(`Spaces:`))
+
+			Expect(testUI.Err).To(Say("orgs-usage-warning"))
+			Expect(testUI.Err).To(Say(`Orgs at or above 80% of a quota limit: org-1, org-3`))
+		})
+
+		When("there are no orgs", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationsQuotaUsageReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`No orgs found\.`))
+			})
+		})
+	})
+
+	When("getting the usage fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationQuotaUsageReturns(v7action.OrganizationQuotaUsage{}, v7action.Warnings{"usage-warning"}, errors.New("usage-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("usage-error"))
+			Expect(testUI.Err).To(Say("usage-warning"))
+		})
+	})
+})
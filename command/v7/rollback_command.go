@@ -3,22 +3,27 @@ package v7
 import (
 	"fmt"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type RollbackCommand struct {
 	BaseCommand
 
-	Force           bool          `short:"f" description:"Force rollback without confirmation"`
-	RequiredArgs    flag.AppName  `positional-args:"yes"`
-	Version         flag.Revision `long:"version" required:"true" description:"Roll back to the specified revision"`
-	relatedCommands interface{}   `related_commands:"revisions"`
-	usage           interface{}   `usage:"CF_NAME rollback APP_NAME [--version VERSION] [-f]"`
+	Force           bool                    `short:"f" description:"Force rollback without confirmation"`
+	RequiredArgs    flag.AppName            `positional-args:"yes"`
+	Version         flag.Revision           `long:"version" required:"true" description:"Roll back to the specified revision"`
+	Strategy        flag.DeploymentStrategy `long:"strategy" default:"rolling" description:"Deployment strategy, either rolling or null. A null strategy stops the app before rolling back."`
+	NoWait          bool                    `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
+	relatedCommands interface{}             `related_commands:"diff-revisions, revisions"`
+	usage           interface{}             `usage:"CF_NAME rollback APP_NAME [--version VERSION] [--strategy STRATEGY] [--no-wait] [-f]\n\n   Before rolling back, the changes from the deployed revision are displayed for confirmation."`
 
 	LogCacheClient sharedaction.LogCacheClient
 	Stager         shared.AppStager
@@ -71,12 +76,21 @@ func (cmd RollbackCommand) Execute(args []string) error {
 		return err
 	}
 
+	revisionDetails, warnings, err := cmd.Actor.GetRevisionDetails(revision)
+	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	cmd.displaySafetyWarnings(revisionDetails)
+
 	// TODO Localization?
 	if !cmd.Force {
+		err = cmd.displayPreview(app, revisionDetails)
+		if err != nil {
+			return err
+		}
+
 		cmd.UI.DisplayTextWithFlavor("Rolling '{{.AppName}}' back to revision '{{.TargetRevision}}' will create a new revision. The new revision will use the settings from revision '{{.TargetRevision}}'.", map[string]interface{}{
 			"AppName":        cmd.RequiredArgs.AppName,
 			"TargetRevision": targetRevision,
@@ -109,8 +123,8 @@ func (cmd RollbackCommand) Execute(args []string) error {
 	startAppErr := cmd.Stager.StartApp(
 		app,
 		revision.GUID,
-		constant.DeploymentStrategyRolling,
-		false,
+		cmd.Strategy.Name,
+		cmd.NoWait,
 		cmd.Config.TargetedSpace(),
 		cmd.Config.TargetedOrganization(),
 		constant.ApplicationRollingBack,
@@ -123,3 +137,46 @@ func (cmd RollbackCommand) Execute(args []string) error {
 
 	return nil
 }
+
+// displaySafetyWarnings warns about revisions that cloud controller is
+// likely to refuse to deploy.
+func (cmd RollbackCommand) displaySafetyWarnings(details v7action.RevisionDetails) {
+	version := map[string]interface{}{"TargetRevision": details.Revision.Version}
+
+	switch details.Droplet.State {
+	case "":
+		cmd.UI.DisplayWarning("The droplet of revision {{.TargetRevision}} has been deleted.", version)
+	case constant.DropletExpired:
+		cmd.UI.DisplayWarning("The droplet of revision {{.TargetRevision}} has expired.", version)
+	}
+
+	if !details.Revision.Deployable {
+		cmd.UI.DisplayWarning("Revision {{.TargetRevision}} is not deployable. The rollback is likely to fail.", version)
+	}
+}
+
+// displayPreview shows how the app will change when it is rolled back.
+func (cmd RollbackCommand) displayPreview(app resources.Application, target v7action.RevisionDetails) error {
+	current, warnings, err := cmd.Actor.GetCurrentRevision(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.NoDeployedRevisionError); ok {
+			return nil
+		}
+		return err
+	}
+
+	currentDetails, warnings, err := cmd.Actor.GetRevisionDetails(current)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Changes from the deployed revision {{.CurrentRevision}} to revision {{.TargetRevision}}:", map[string]interface{}{
+		"CurrentRevision": current.Version,
+		"TargetRevision":  target.Revision.Version,
+	})
+	cmd.UI.DisplayNewline()
+
+	return shared.RevisionDiffDisplayer{UI: cmd.UI}.DisplayDiff(currentDetails, target)
+}
@@ -180,6 +180,131 @@ var _ = Describe("rollback Command", func() {
 				Expect(version).To(Equal(1))
 			})
 
+			When("the strategy and no-wait flags are provided", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+					cmd.Strategy = flag.DeploymentStrategy{Name: constant.DeploymentStrategyRolling}
+					cmd.NoWait = true
+				})
+
+				It("passes them to the stager", func() {
+					Expect(fakeAppStager.StartAppCallCount()).To(Equal(1))
+					_, _, strategy, noWait, _, _, _ := fakeAppStager.StartAppArgsForCall(0)
+					Expect(strategy).To(Equal(constant.DeploymentStrategyRolling))
+					Expect(noWait).To(BeTrue())
+				})
+			})
+
+			When("the target droplet has expired", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+					fakeActor.GetRevisionDetailsReturns(
+						v7action.RevisionDetails{
+							Revision: resources.Revision{Version: 1, GUID: "some-1-guid", Deployable: true},
+							Droplet:  resources.Droplet{GUID: "droplet-1", State: constant.DropletExpired},
+						},
+						v7action.Warnings{"details-warning"},
+						nil,
+					)
+				})
+
+				It("warns before rolling back", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(fakeActor.GetRevisionDetailsArgsForCall(0).GUID).To(Equal("some-1-guid"))
+
+					Expect(testUI.Err).To(Say("details-warning"))
+					Expect(testUI.Err).To(Say("The droplet of revision 1 has expired."))
+					Expect(testUI.Err).NotTo(Say("is not deployable"))
+					Expect(fakeAppStager.StartAppCallCount()).To(Equal(1))
+				})
+			})
+
+			When("the target revision is not deployable", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+					fakeActor.GetRevisionDetailsReturns(
+						v7action.RevisionDetails{
+							Revision: resources.Revision{Version: 1, GUID: "some-1-guid"},
+							Droplet:  resources.Droplet{GUID: "droplet-1"},
+						},
+						nil,
+						nil,
+					)
+				})
+
+				It("warns before rolling back", func() {
+					Expect(testUI.Err).To(Say("The droplet of revision 1 has been deleted."))
+					Expect(testUI.Err).To(Say("Revision 1 is not deployable. The rollback is likely to fail."))
+				})
+			})
+
+			When("getting the revision details fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetRevisionDetailsReturns(v7action.RevisionDetails{}, v7action.Warnings{"details-warning"}, errors.New("details-error"))
+				})
+
+				It("returns the error without rolling back", func() {
+					Expect(executeErr).To(MatchError("details-error"))
+					Expect(testUI.Err).To(Say("details-warning"))
+					Expect(fakeAppStager.StartAppCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the user is asked to confirm", func() {
+				BeforeEach(func() {
+					_, err := input.Write([]byte("n\n"))
+					Expect(err).NotTo(HaveOccurred())
+
+					fakeActor.GetCurrentRevisionReturns(
+						resources.Revision{Version: 2, GUID: "some-2-guid"},
+						v7action.Warnings{"current-revision-warning"},
+						nil,
+					)
+					fakeActor.GetRevisionDetailsStub = func(revision resources.Revision) (v7action.RevisionDetails, v7action.Warnings, error) {
+						return v7action.RevisionDetails{
+							Revision: revision,
+							Droplet:  resources.Droplet{GUID: "droplet-for-" + revision.GUID, State: constant.DropletStaged},
+						}, nil, nil
+					}
+				})
+
+				It("displays the changes from the deployed revision before the prompt", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+
+					Expect(fakeActor.GetCurrentRevisionArgsForCall(0)).To(Equal("123"))
+					Expect(testUI.Err).To(Say("current-revision-warning"))
+
+					Expect(testUI.Out).To(Say("Changes from the deployed revision 2 to revision 1:"))
+					Expect(testUI.Out).To(Say("droplet:"))
+					Expect(testUI.Out).To(Say(`- guid:\s+droplet-for-some-2-guid`))
+					Expect(testUI.Out).To(Say(`\+ guid:\s+droplet-for-some-1-guid`))
+					Expect(testUI.Out).To(Say("Are you sure you want to continue?"))
+				})
+
+				When("no revision is deployed", func() {
+					BeforeEach(func() {
+						fakeActor.GetCurrentRevisionReturns(resources.Revision{}, nil, actionerror.NoDeployedRevisionError{})
+					})
+
+					It("prompts without displaying changes", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("Changes from the deployed revision"))
+						Expect(testUI.Out).To(Say("Are you sure you want to continue?"))
+					})
+				})
+
+				When("getting the deployed revision fails", func() {
+					BeforeEach(func() {
+						fakeActor.GetCurrentRevisionReturns(resources.Revision{}, nil, errors.New("current-revision-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("current-revision-error"))
+						Expect(testUI.Out).NotTo(Say("Are you sure you want to continue?"))
+					})
+				})
+			})
+
 			When("the user passes the force flag", func() {
 				BeforeEach(func() {
 					cmd.Force = true
@@ -195,6 +320,7 @@ var _ = Describe("rollback Command", func() {
 
 					Expect(testUI.Out).ToNot(Say("Rolling '%s' back to revision '1' will create a new revision. The new revision '3' will use the settings from revision '1'.", app))
 					Expect(testUI.Out).ToNot(Say("Are you sure you want to continue?"))
+					Expect(fakeActor.GetCurrentRevisionCallCount()).To(Equal(0))
 
 					Expect(testUI.Out).To(Say("Rolling back to revision 1 for app some-app in org some-org / space some-space as steve..."))
 
@@ -3,6 +3,7 @@ package v7
 import (
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 
 	"strconv"
 )
@@ -111,28 +112,48 @@ func (cmd RouteCommand) Execute(args []string) error {
 func (cmd RouteCommand) displayDestinations(route resources.Route, appMap map[string]resources.Application) {
 	destinations := route.Destinations
 	if len(destinations) > 0 {
-		var keyValueTable = [][]string{
-			{
-				cmd.UI.TranslateText("app"),
-				cmd.UI.TranslateText("process"),
-				cmd.UI.TranslateText("port"),
-				cmd.UI.TranslateText("app-protocol"),
-			},
+		weighted := false
+		for _, destination := range destinations {
+			if destination.Weight.IsSet {
+				weighted = true
+			}
+		}
+
+		header := []string{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("process"),
+			cmd.UI.TranslateText("port"),
+			cmd.UI.TranslateText("app-protocol"),
+		}
+		if weighted {
+			header = append(header, cmd.UI.TranslateText("weight"))
 		}
+		var keyValueTable = [][]string{header}
 
 		for _, destination := range destinations {
 			port := ""
 			if destination.Port != 0 {
 				port = strconv.Itoa(destination.Port)
 			}
-			keyValueTable = append(keyValueTable, []string{
+			row := []string{
 				appMap[destination.App.GUID].Name,
 				destination.App.Process.Type,
 				port,
 				destination.Protocol,
-			})
+			}
+			if weighted {
+				row = append(row, formatDestinationWeight(destination.Weight))
+			}
+			keyValueTable = append(keyValueTable, row)
 		}
 
 		cmd.UI.DisplayKeyValueTable("\t", keyValueTable, 3)
 	}
 }
+
+func formatDestinationWeight(weight types.NullInt) string {
+	if !weight.IsSet {
+		return ""
+	}
+	return strconv.Itoa(weight.Value) + "%"
+}
@@ -9,6 +9,7 @@ import (
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo/v2"
@@ -223,6 +224,7 @@ var _ = Describe("route Command", func() {
 			Expect(testUI.Out).To(Say(`\s+app\s+process\s+port\s+app-protocol`))
 			Expect(testUI.Out).To(Say(`\s+app-name\s+web\s+8080\s+http1`))
 			Expect(testUI.Out).To(Say(`\s+other-app-name\s+web\s+1337\s+http2`))
+			Expect(testUI.Out).NotTo(Say(`weight`))
 
 			Expect(fakeActor.GetRouteByAttributesCallCount()).To(Equal(1))
 			givenDomain, givenHostname, givenPath, givenPort := fakeActor.GetRouteByAttributesArgsForCall(0)
@@ -231,6 +233,29 @@ var _ = Describe("route Command", func() {
 			Expect(givenPath).To(Equal("/some-path"))
 			Expect(givenPort).To(Equal(0))
 		})
+
+		When("the destinations are weighted", func() {
+			BeforeEach(func() {
+				destAppA := resources.RouteDestinationApp{GUID: "abc", Process: struct{ Type string }{"web"}}
+				destinationA := resources.RouteDestination{App: destAppA, Port: 8080, Protocol: "http1", Weight: types.NullInt{IsSet: true, Value: 80}}
+
+				destAppB := resources.RouteDestinationApp{GUID: "123", Process: struct{ Type string }{"web"}}
+				destinationB := resources.RouteDestination{App: destAppB, Port: 1337, Protocol: "http2", Weight: types.NullInt{IsSet: true, Value: 20}}
+
+				fakeActor.GetRouteByAttributesReturns(
+					resources.Route{GUID: "route-guid", Destinations: []resources.RouteDestination{destinationA, destinationB}},
+					nil,
+					nil,
+				)
+			})
+
+			It("displays the weight of each destination", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`\s+app\s+process\s+port\s+app-protocol\s+weight`))
+				Expect(testUI.Out).To(Say(`\s+app-name\s+web\s+8080\s+http1\s+80%`))
+				Expect(testUI.Out).To(Say(`\s+other-app-name\s+web\s+1337\s+http2\s+20%`))
+			})
+		})
 	})
 	Describe("RouteRetrieval display logic", func() {
 		When("passing in just a domain", func() {
@@ -0,0 +1,178 @@
+package v7
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+// certificateExpiryWarningPeriod is how close to expiry a probed route's
+// certificate has to be before a warning is shown.
+const certificateExpiryWarningPeriod = 30 * 24 * time.Hour
+
+type RouteHealthCommand struct {
+	BaseCommand
+
+	RequiredArgs      flag.Domain          `positional-args:"yes"`
+	Hostname          string               `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
+	Path              flag.V7RoutePath     `long:"path" description:"Path used to identify the HTTP route"`
+	Port              int                  `long:"port" description:"Port used to identify the TCP route"`
+	Probe             bool                 `long:"probe" description:"Make an HTTPS request to the route and show the response status, latency and certificate expiry"`
+	ProbeTimeout      flag.PositiveInteger `long:"probe-timeout" default:"10" description:"Time (in seconds) to wait for the probe response"`
+	SkipSSLValidation bool                 `long:"skip-ssl-validation" description:"Do not verify the route's certificate when probing it"`
+	relatedCommands   interface{}          `related_commands:"app, map-route, route, routes"`
+}
+
+func (cmd RouteHealthCommand) Usage() string {
+	return `
+Check the health of an HTTP route:
+   CF_NAME route-health DOMAIN [--hostname HOSTNAME] [--path PATH] [--probe [--probe-timeout SECONDS] [--skip-ssl-validation]]
+
+Check the health of a TCP route:
+   CF_NAME route-health DOMAIN --port PORT`
+}
+
+func (cmd RouteHealthCommand) Examples() string {
+	return `
+CF_NAME route-health example.com -n myhost --path foo  # myhost.example.com/foo
+CF_NAME route-health example.com -n myhost --probe     # also request https://myhost.example.com
+CF_NAME route-health example.com --port 5000           # example.com:5000`
+}
+
+func (cmd RouteHealthCommand) Execute(args []string) error {
+	if cmd.Probe && cmd.Port != 0 {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--probe", "--port"},
+		}
+	}
+	if cmd.SkipSSLValidation && !cmd.Probe {
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--probe",
+			Arg2: "--skip-ssl-validation",
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	url := desiredURL(cmd.RequiredArgs.Domain, cmd.Hostname, cmd.Path.Path, cmd.Port)
+	cmd.UI.DisplayTextWithFlavor("Checking health of route {{.URL}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"URL":       url,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	domain, warnings, err := cmd.Actor.GetDomainByName(cmd.RequiredArgs.Domain)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	route, warnings, err := cmd.Actor.GetRouteByAttributes(domain, cmd.Hostname, cmd.Path.Path, cmd.Port)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	healths, warnings, err := cmd.Actor.GetRouteDestinationsHealth(route)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(healths) == 0 {
+		cmd.UI.DisplayText("No destinations are mapped to this route.")
+	} else {
+		cmd.UI.DisplayText("Destinations:")
+		cmd.displayDestinationsHealth(healths)
+	}
+
+	if !cmd.Probe {
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	probe, err := cmd.Actor.ProbeRoute("https://"+url, time.Duration(cmd.ProbeTimeout.Value)*time.Second, cmd.SkipSSLValidation)
+	if err != nil {
+		return err
+	}
+	cmd.displayProbe(probe)
+
+	return nil
+}
+
+func (cmd RouteHealthCommand) displayDestinationsHealth(healths []v7action.RouteDestinationHealth) {
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("app"),
+			cmd.UI.TranslateText("process"),
+			cmd.UI.TranslateText("instances"),
+			cmd.UI.TranslateText("crashed"),
+			cmd.UI.TranslateText("health"),
+		},
+	}
+
+	for _, health := range healths {
+		running := health.RunningInstances()
+		total := len(health.Instances)
+
+		status := "healthy"
+		switch {
+		case running == 0:
+			status = "down"
+		case running < total:
+			status = "degraded"
+		}
+
+		table = append(table, []string{
+			health.App.Name,
+			health.Destination.App.Process.Type,
+			strconv.Itoa(running) + "/" + strconv.Itoa(total),
+			strconv.Itoa(health.CrashedInstances()),
+			cmd.UI.TranslateText(status),
+		})
+	}
+
+	cmd.UI.DisplayKeyValueTable("\t", table, 3)
+}
+
+func (cmd RouteHealthCommand) displayProbe(probe v7action.RouteProbe) {
+	cmd.UI.DisplayText("Probe:")
+
+	table := [][]string{
+		{cmd.UI.TranslateText("url:"), probe.URL},
+		{cmd.UI.TranslateText("status:"), probe.Status},
+		{cmd.UI.TranslateText("latency:"), probe.Latency.Round(time.Millisecond).String()},
+	}
+	if !probe.CertificateExpiry.IsZero() {
+		table = append(table, []string{cmd.UI.TranslateText("certificate expires:"), cmd.UI.UserFriendlyDate(probe.CertificateExpiry)})
+	}
+	cmd.UI.DisplayKeyValueTable("\t", table, 3)
+
+	if probe.CertificateExpiry.IsZero() {
+		return
+	}
+	switch untilExpiry := time.Until(probe.CertificateExpiry); {
+	case untilExpiry <= 0:
+		cmd.UI.DisplayWarning("The certificate for {{.URL}} has expired.", map[string]interface{}{
+			"URL": probe.URL,
+		})
+	case untilExpiry < certificateExpiryWarningPeriod:
+		cmd.UI.DisplayWarning("The certificate for {{.URL}} expires in {{.Days}} days.", map[string]interface{}{
+			"URL":  probe.URL,
+			"Days": int(untilExpiry.Hours() / 24),
+		})
+	}
+}
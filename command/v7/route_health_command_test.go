@@ -0,0 +1,255 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("route-health Command", func() {
+	var (
+		cmd             v7.RouteHealthCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	destinationHealth := func(appName string, processType string, states ...string) v7action.RouteDestinationHealth {
+		health := v7action.RouteDestinationHealth{
+			App: resources.Application{Name: appName},
+		}
+		health.Destination.App.Process.Type = processType
+		for i, state := range states {
+			health.Instances = append(health.Instances, ccv3.ProcessInstance{Index: int64(i), State: constant.ProcessInstanceState(state)})
+		}
+		return health
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.RouteHealthCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.Domain{Domain: "some-domain.com"},
+			Hostname:     "myhost",
+			ProbeTimeout: flag.PositiveInteger{Value: 10},
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetDomainByNameReturns(resources.Domain{Name: "some-domain.com", GUID: "domain-guid"}, v7action.Warnings{"get-domain-warning"}, nil)
+		fakeActor.GetRouteByAttributesReturns(resources.Route{GUID: "route-guid"}, v7action.Warnings{"get-route-warning"}, nil)
+		fakeActor.GetRouteDestinationsHealthReturns(
+			[]v7action.RouteDestinationHealth{
+				destinationHealth("app-1", "web", "RUNNING", "RUNNING"),
+				destinationHealth("app-2", "web", "RUNNING", "CRASHED", "STARTING"),
+				destinationHealth("app-3", "worker"),
+			},
+			v7action.Warnings{"get-health-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the target", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeTrue())
+		Expect(checkTargetedSpace).To(BeFalse())
+	})
+
+	It("displays the health of each destination", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Checking health of route myhost\.some-domain\.com in org some-org / space some-space as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say(`Destinations:`))
+		Expect(testUI.Out).To(Say(`app\s+process\s+instances\s+crashed\s+health`))
+		Expect(testUI.Out).To(Say(`app-1\s+web\s+2/2\s+0\s+healthy`))
+		Expect(testUI.Out).To(Say(`app-2\s+web\s+1/3\s+1\s+degraded`))
+		Expect(testUI.Out).To(Say(`app-3\s+worker\s+0/0\s+0\s+down`))
+
+		Expect(testUI.Err).To(Say("get-domain-warning"))
+		Expect(testUI.Err).To(Say("get-route-warning"))
+		Expect(testUI.Err).To(Say("get-health-warning"))
+
+		Expect(fakeActor.GetDomainByNameArgsForCall(0)).To(Equal("some-domain.com"))
+		domain, hostname, path, port := fakeActor.GetRouteByAttributesArgsForCall(0)
+		Expect(domain.GUID).To(Equal("domain-guid"))
+		Expect(hostname).To(Equal("myhost"))
+		Expect(path).To(BeEmpty())
+		Expect(port).To(Equal(0))
+		Expect(fakeActor.GetRouteDestinationsHealthArgsForCall(0).GUID).To(Equal("route-guid"))
+
+		Expect(fakeActor.ProbeRouteCallCount()).To(Equal(0))
+	})
+
+	When("the route has no destinations", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteDestinationsHealthReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No destinations are mapped to this route."))
+		})
+	})
+
+	When("the route does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteByAttributesReturns(resources.Route{}, v7action.Warnings{"get-route-warning"}, actionerror.RouteNotFoundError{Host: "myhost", DomainName: "some-domain.com"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{Host: "myhost", DomainName: "some-domain.com"}))
+			Expect(fakeActor.GetRouteDestinationsHealthCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the destinations health fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteDestinationsHealthReturns(nil, v7action.Warnings{"get-health-warning"}, errors.New("health-error"))
+		})
+
+		It("returns the error and warnings", func() {
+			Expect(executeErr).To(MatchError("health-error"))
+			Expect(testUI.Err).To(Say("get-health-warning"))
+		})
+	})
+
+	When("probing the route", func() {
+		BeforeEach(func() {
+			cmd.Probe = true
+			cmd.Path = flag.V7RoutePath{Path: "/foo"}
+			fakeActor.ProbeRouteReturns(v7action.RouteProbe{
+				URL:               "https://myhost.some-domain.com/foo",
+				StatusCode:        200,
+				Status:            "200 OK",
+				Latency:           123456 * time.Microsecond,
+				CertificateExpiry: time.Now().Add(365 * 24 * time.Hour),
+			}, nil)
+		})
+
+		It("displays the probe results", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			url, timeout, skipSSLValidation := fakeActor.ProbeRouteArgsForCall(0)
+			Expect(url).To(Equal("https://myhost.some-domain.com/foo"))
+			Expect(timeout).To(Equal(10 * time.Second))
+			Expect(skipSSLValidation).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Probe:`))
+			Expect(testUI.Out).To(Say(`url:\s+https://myhost\.some-domain\.com/foo`))
+			Expect(testUI.Out).To(Say(`status:\s+200 OK`))
+			Expect(testUI.Out).To(Say(`latency:\s+123ms`))
+			Expect(testUI.Out).To(Say(`certificate expires:\s+\w+`))
+			Expect(testUI.Err).ToNot(Say("certificate"))
+		})
+
+		When("skipping SSL validation", func() {
+			BeforeEach(func() {
+				cmd.SkipSSLValidation = true
+			})
+
+			It("tells the actor", func() {
+				_, _, skipSSLValidation := fakeActor.ProbeRouteArgsForCall(0)
+				Expect(skipSSLValidation).To(BeTrue())
+			})
+		})
+
+		When("the certificate expires soon", func() {
+			BeforeEach(func() {
+				fakeActor.ProbeRouteReturns(v7action.RouteProbe{
+					URL:               "https://myhost.some-domain.com/foo",
+					Status:            "200 OK",
+					CertificateExpiry: time.Now().Add(10*24*time.Hour + time.Hour),
+				}, nil)
+			})
+
+			It("warns", func() {
+				Expect(testUI.Err).To(Say(`The certificate for https://myhost\.some-domain\.com/foo expires in 10 days\.`))
+			})
+		})
+
+		When("the certificate has expired", func() {
+			BeforeEach(func() {
+				fakeActor.ProbeRouteReturns(v7action.RouteProbe{
+					URL:               "https://myhost.some-domain.com/foo",
+					Status:            "200 OK",
+					CertificateExpiry: time.Now().Add(-time.Hour),
+				}, nil)
+			})
+
+			It("warns", func() {
+				Expect(testUI.Err).To(Say(`The certificate for https://myhost\.some-domain\.com/foo has expired\.`))
+			})
+		})
+
+		When("the probe fails", func() {
+			BeforeEach(func() {
+				fakeActor.ProbeRouteReturns(v7action.RouteProbe{}, errors.New("connection refused"))
+			})
+
+			It("shows the destinations and returns the error", func() {
+				Expect(testUI.Out).To(Say(`app-1`))
+				Expect(executeErr).To(MatchError("connection refused"))
+			})
+		})
+	})
+
+	When("--probe is used with --port", func() {
+		BeforeEach(func() {
+			cmd.Probe = true
+			cmd.Port = 5000
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--probe", "--port"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--skip-ssl-validation is used without --probe", func() {
+		BeforeEach(func() {
+			cmd.SkipSSLValidation = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+				Arg1: "--probe",
+				Arg2: "--skip-ssl-validation",
+			}))
+		})
+	})
+})
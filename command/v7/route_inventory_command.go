@@ -0,0 +1,221 @@
+package v7
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type RouteInventoryCommand struct {
+	BaseCommand
+
+	AllOrgs         bool        `long:"all-orgs" description:"Report on the routes of every org instead of the targeted org"`
+	DeleteUnmapped  bool        `long:"delete-unmapped" description:"Delete the unmapped routes after the report, except the ones with the keep label or a route service"`
+	KeepLabel       string      `long:"keep-label" default:"keep" description:"Label that keeps an unmapped route from being deleted"`
+	Force           bool        `short:"f" description:"Force deletion without confirmation"`
+	usage           interface{} `usage:"CF_NAME route-inventory [--all-orgs] [--delete-unmapped [--keep-label LABEL] [-f]]\n\nEXAMPLES:\n   CF_NAME route-inventory\n   CF_NAME route-inventory --all-orgs\n   CF_NAME route-inventory --delete-unmapped --keep-label do-not-delete"`
+	relatedCommands interface{} `related_commands:"delete-orphaned-routes, delete-route, routes, set-label"`
+}
+
+func (cmd RouteInventoryCommand) Execute(args []string) error {
+	if cmd.Force && !cmd.DeleteUnmapped {
+		return translatableerror.RequiredFlagsError{Arg1: "-f", Arg2: "--delete-unmapped"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(!cmd.AllOrgs, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	orgGUID := ""
+	if cmd.AllOrgs {
+		cmd.UI.DisplayTextWithFlavor("Getting route inventory for all orgs as {{.UserName}}...", map[string]interface{}{
+			"UserName": user.Name,
+		})
+	} else {
+		orgGUID = cmd.Config.TargetedOrganization().GUID
+		cmd.UI.DisplayTextWithFlavor("Getting route inventory for org {{.OrgName}} as {{.UserName}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"UserName": user.Name,
+		})
+	}
+	cmd.UI.DisplayNewline()
+
+	inventory, warnings, err := cmd.Actor.GetRouteInventory(orgGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.displayUnmappedRoutes(inventory.UnmappedRoutes, time.Now())
+	cmd.UI.DisplayNewline()
+	cmd.displaySharedRoutes(inventory.SharedRoutes)
+	cmd.UI.DisplayNewline()
+	cmd.displayRouteServiceRoutes(inventory.RouteServiceRoutes)
+	cmd.UI.DisplayNewline()
+	cmd.displayHostnameCollisions(inventory.HostnameCollisions)
+
+	if !cmd.DeleteUnmapped {
+		return nil
+	}
+
+	cmd.UI.DisplayNewline()
+	return cmd.deleteUnmappedRoutes(inventory, user.Name)
+}
+
+func (cmd RouteInventoryCommand) deleteUnmappedRoutes(inventory v7action.RouteInventory, userName string) error {
+	toDelete, toKeep := inventory.UnmappedRoutesToDelete(cmd.KeepLabel)
+
+	if len(toKeep) > 0 {
+		cmd.UI.DisplayText("Keeping {{.Count}} unmapped route(s) with the label '{{.KeepLabel}}' or a route service.", map[string]interface{}{
+			"Count":     len(toKeep),
+			"KeepLabel": cmd.KeepLabel,
+		})
+	}
+
+	if len(toDelete) == 0 {
+		cmd.UI.DisplayText("There are no unmapped routes to delete.")
+		return nil
+	}
+
+	if !cmd.Force {
+		response, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete {{.Count}} unmapped route(s)?", map[string]interface{}{
+			"Count": len(toDelete),
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !response {
+			cmd.UI.DisplayText("Routes have not been deleted.")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting {{.Count}} unmapped route(s) as {{.UserName}}...", map[string]interface{}{
+		"Count":    len(toDelete),
+		"UserName": userName,
+	})
+
+	routeGUIDs := make([]string, 0, len(toDelete))
+	for _, route := range toDelete {
+		routeGUIDs = append(routeGUIDs, route.GUID)
+	}
+
+	warnings, err := cmd.Actor.DeleteRoutes(routeGUIDs)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd RouteInventoryCommand) displayUnmappedRoutes(routes []v7action.RouteSummary, now time.Time) {
+	cmd.UI.DisplayText("Unmapped routes:")
+	if len(routes) == 0 {
+		cmd.UI.DisplayText("No unmapped routes found.")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("route"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("age"),
+		},
+	}
+	for _, route := range routes {
+		table = append(table, []string{route.URL, route.SpaceName, cmd.routeAge(route.CreatedAt, now)})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd RouteInventoryCommand) displaySharedRoutes(routes []v7action.SharedRouteSummary) {
+	cmd.UI.DisplayText("Routes shared with other spaces:")
+	if len(routes) == 0 {
+		cmd.UI.DisplayText("No shared routes found.")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("route"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("shared with"),
+		},
+	}
+	for _, route := range routes {
+		table = append(table, []string{route.URL, route.SpaceName, strings.Join(route.SharedSpaces, ", ")})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd RouteInventoryCommand) displayRouteServiceRoutes(routes []v7action.RouteSummary) {
+	cmd.UI.DisplayText("Routes bound to route services:")
+	if len(routes) == 0 {
+		cmd.UI.DisplayText("No routes bound to route services found.")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("route"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("service instance"),
+		},
+	}
+	for _, route := range routes {
+		table = append(table, []string{route.URL, route.SpaceName, route.ServiceInstanceName})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd RouteInventoryCommand) displayHostnameCollisions(collisions []v7action.HostnameCollision) {
+	cmd.UI.DisplayText("Hostnames used on more than one domain:")
+	if len(collisions) == 0 {
+		cmd.UI.DisplayText("No hostname collisions found.")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("hostname"),
+			cmd.UI.TranslateText("routes"),
+		},
+	}
+	for _, collision := range collisions {
+		urls := make([]string, 0, len(collision.Routes))
+		for _, route := range collision.Routes {
+			urls = append(urls, route.URL)
+		}
+		table = append(table, []string{collision.Hostname, strings.Join(urls, ", ")})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd RouteInventoryCommand) routeAge(createdAt string, now time.Time) string {
+	created, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return ""
+	}
+
+	days := int(now.Sub(created).Hours() / 24)
+	switch days {
+	case 0:
+		return cmd.UI.TranslateText("less than a day")
+	case 1:
+		return cmd.UI.TranslateText("1 day")
+	default:
+		return cmd.UI.TranslateText("{{.Days}} days", map[string]interface{}{"Days": days})
+	}
+}
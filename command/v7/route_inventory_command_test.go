@@ -0,0 +1,263 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("route-inventory Command", func() {
+	var (
+		cmd             RouteInventoryCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+		inventory       v7action.RouteInventory
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = RouteInventoryCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			KeepLabel: "keep",
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		unmapped := v7action.RouteSummary{
+			Route: resources.Route{
+				GUID:      "unmapped-route-guid",
+				URL:       "old.example.com",
+				CreatedAt: time.Now().Add(-72 * time.Hour).Format(time.RFC3339),
+			},
+			SpaceName: "some-space",
+		}
+		kept := v7action.RouteSummary{
+			Route: resources.Route{
+				GUID: "kept-route-guid",
+				URL:  "kept.example.com",
+				Metadata: &resources.Metadata{
+					Labels: map[string]types.NullString{"keep": types.NewNullString("true")},
+				},
+			},
+			SpaceName: "some-space",
+		}
+		bound := v7action.RouteSummary{
+			Route:               resources.Route{GUID: "bound-route-guid", Host: "api", URL: "api.example.com"},
+			SpaceName:           "some-space",
+			ServiceInstanceName: "some-route-service",
+		}
+		other := v7action.RouteSummary{
+			Route:     resources.Route{GUID: "other-route-guid", Host: "api", URL: "api.example.org"},
+			SpaceName: "other-space",
+		}
+
+		inventory = v7action.RouteInventory{
+			Routes:             []v7action.RouteSummary{unmapped, kept, bound, other},
+			UnmappedRoutes:     []v7action.RouteSummary{unmapped, kept},
+			SharedRoutes:       []v7action.SharedRouteSummary{{RouteSummary: other, SharedSpaces: []string{"org-1/space-1", "org-2/space-2"}}},
+			RouteServiceRoutes: []v7action.RouteSummary{bound},
+			HostnameCollisions: []v7action.HostnameCollision{{Hostname: "api", Routes: []v7action.RouteSummary{bound, other}}},
+		}
+		fakeActor.GetRouteInventoryReturns(inventory, v7action.Warnings{"inventory-warning"}, nil)
+		fakeActor.DeleteRoutesReturns(v7action.Warnings{"delete-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("displays the route inventory of the targeted org", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeActor.GetRouteInventoryArgsForCall(0)).To(Equal("some-org-guid"))
+
+		Expect(testUI.Out).To(Say(`Getting route inventory for org some-org as steve\.\.\.`))
+
+		Expect(testUI.Out).To(Say(`Unmapped routes:`))
+		Expect(testUI.Out).To(Say(`route\s+space\s+age`))
+		Expect(testUI.Out).To(Say(`old\.example\.com\s+some-space\s+3 days`))
+		Expect(testUI.Out).To(Say(`kept\.example\.com\s+some-space`))
+
+		Expect(testUI.Out).To(Say(`Routes shared with other spaces:`))
+		Expect(testUI.Out).To(Say(`route\s+space\s+shared with`))
+		Expect(testUI.Out).To(Say(`api\.example\.org\s+other-space\s+org-1/space-1, org-2/space-2`))
+
+		Expect(testUI.Out).To(Say(`Routes bound to route services:`))
+		Expect(testUI.Out).To(Say(`route\s+space\s+service instance`))
+		Expect(testUI.Out).To(Say(`api\.example\.com\s+some-space\s+some-route-service`))
+
+		Expect(testUI.Out).To(Say(`Hostnames used on more than one domain:`))
+		Expect(testUI.Out).To(Say(`hostname\s+routes`))
+		Expect(testUI.Out).To(Say(`api\s+api\.example\.com, api\.example\.org`))
+
+		Expect(testUI.Err).To(Say("inventory-warning"))
+		Expect(fakeActor.DeleteRoutesCallCount()).To(Equal(0))
+	})
+
+	When("the inventory is empty", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteInventoryReturns(v7action.RouteInventory{}, nil, nil)
+		})
+
+		It("says that nothing was found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No unmapped routes found\.`))
+			Expect(testUI.Out).To(Say(`No shared routes found\.`))
+			Expect(testUI.Out).To(Say(`No routes bound to route services found\.`))
+			Expect(testUI.Out).To(Say(`No hostname collisions found\.`))
+		})
+	})
+
+	When("--all-orgs is given", func() {
+		BeforeEach(func() {
+			cmd.AllOrgs = true
+		})
+
+		It("reports on every org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(fakeActor.GetRouteInventoryArgsForCall(0)).To(Equal(""))
+			Expect(testUI.Out).To(Say(`Getting route inventory for all orgs as steve\.\.\.`))
+		})
+	})
+
+	When("-f is given without --delete-unmapped", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "-f", Arg2: "--delete-unmapped"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--delete-unmapped is given", func() {
+		BeforeEach(func() {
+			cmd.DeleteUnmapped = true
+		})
+
+		When("the user confirms", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the unmapped routes that are not kept", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Keeping 1 unmapped route\(s\) with the label 'keep' or a route service\.`))
+				Expect(testUI.Out).To(Say(`Really delete 1 unmapped route\(s\)\?`))
+				Expect(testUI.Out).To(Say(`Deleting 1 unmapped route\(s\) as steve\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Err).To(Say("delete-warning"))
+
+				Expect(fakeActor.DeleteRoutesCallCount()).To(Equal(1))
+				Expect(fakeActor.DeleteRoutesArgsForCall(0)).To(Equal([]string{"unmapped-route-guid"}))
+			})
+		})
+
+		When("the user declines", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not delete the routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Routes have not been deleted\.`))
+				Expect(fakeActor.DeleteRoutesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("-f is given", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+				fakeActor.DeleteRoutesReturns(v7action.Warnings{"delete-warning"}, errors.New("delete-error"))
+			})
+
+			It("deletes without asking and returns the error", func() {
+				Expect(executeErr).To(MatchError("delete-error"))
+				Expect(testUI.Out).NotTo(Say(`Really delete`))
+				Expect(testUI.Err).To(Say("delete-warning"))
+			})
+		})
+
+		When("every unmapped route is kept", func() {
+			BeforeEach(func() {
+				inventory.UnmappedRoutes = inventory.UnmappedRoutes[1:]
+				fakeActor.GetRouteInventoryReturns(inventory, nil, nil)
+			})
+
+			It("does not delete anything", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Keeping 1 unmapped route\(s\)`))
+				Expect(testUI.Out).To(Say(`There are no unmapped routes to delete\.`))
+				Expect(fakeActor.DeleteRoutesCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("getting the inventory fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteInventoryReturns(v7action.RouteInventory{}, v7action.Warnings{"inventory-warning"}, errors.New("inventory-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("inventory-error"))
+			Expect(testUI.Err).To(Say("inventory-warning"))
+		})
+	})
+})
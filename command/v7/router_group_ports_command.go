@@ -0,0 +1,131 @@
+package v7
+
+import (
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type RouterGroupPortsCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.RouterGroup `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME router-group-ports ROUTER_GROUP"`
+	relatedCommands interface{}      `related_commands:"org-quota, router-groups, space-quota, update-router-group"`
+}
+
+func (cmd RouterGroupPortsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	currentUser, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting port usage for router group {{.RouterGroup}} as {{.CurrentUser}}...", map[string]interface{}{
+		"RouterGroup": cmd.RequiredArgs.RouterGroup,
+		"CurrentUser": currentUser.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	usage, warnings, err := cmd.Actor.GetRouterGroupPortUsage(cmd.RequiredArgs.RouterGroup)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("reservable ports:"), usage.RouterGroup.ReservablePorts},
+		{cmd.UI.TranslateText("total ports:"), strconv.Itoa(usage.TotalPorts())},
+		{cmd.UI.TranslateText("in use:"), strconv.Itoa(len(usage.UsedPorts))},
+		{cmd.UI.TranslateText("reserved by quotas:"), strconv.Itoa(usage.ReservedPorts)},
+		{cmd.UI.TranslateText("free:"), strconv.Itoa(usage.FreePorts())},
+	}, 3)
+	cmd.UI.DisplayNewline()
+
+	cmd.displayUsedPorts(usage.UsedPorts)
+	cmd.UI.DisplayNewline()
+
+	cmd.displayQuotaReservations(usage.QuotaReservations)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Ports not in use: {{.Ports}}", map[string]interface{}{
+		"Ports": formatPortRanges(usage.UnusedPorts),
+	})
+
+	return nil
+}
+
+func (cmd RouterGroupPortsCommand) displayUsedPorts(usedPorts []v7action.RouterGroupPort) {
+	if len(usedPorts) == 0 {
+		cmd.UI.DisplayText("No ports are used by routes.")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("port"),
+			cmd.UI.TranslateText("route"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("org"),
+		},
+	}
+	for _, usedPort := range usedPorts {
+		table = append(table, []string{
+			strconv.Itoa(usedPort.Port),
+			usedPort.Route.URL,
+			usedPort.SpaceName,
+			usedPort.OrgName,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd RouterGroupPortsCommand) displayQuotaReservations(reservations []v7action.RouterGroupQuotaReservation) {
+	if len(reservations) == 0 {
+		cmd.UI.DisplayText("No quotas reserve route ports.")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("quota"),
+			cmd.UI.TranslateText("type"),
+			cmd.UI.TranslateText("reserved route ports"),
+			cmd.UI.TranslateText("applied to"),
+		},
+	}
+	for _, reservation := range reservations {
+		quotaType, appliedTo := "org", "{{.Count}} org(s)"
+		if reservation.SpaceQuota {
+			quotaType, appliedTo = "space", "{{.Count}} space(s)"
+		}
+		table = append(table, []string{
+			reservation.QuotaName,
+			cmd.UI.TranslateText(quotaType),
+			strconv.Itoa(reservation.ReservedPorts),
+			cmd.UI.TranslateText(appliedTo, map[string]interface{}{"Count": reservation.AppliedTo}),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func formatPortRanges(ranges []v7action.PortRange) string {
+	if len(ranges) == 0 {
+		return "none"
+	}
+
+	parts := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		parts = append(parts, r.String())
+	}
+	return strings.Join(parts, ", ")
+}
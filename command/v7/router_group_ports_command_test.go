@@ -0,0 +1,140 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("router-group-ports Command", func() {
+	var (
+		cmd             RouterGroupPortsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		binaryName      string
+		usage           v7action.RouterGroupPortUsage
+		usageErr        error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = RouterGroupPortsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.RouterGroup{RouterGroup: "default-tcp"},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+
+		usage = v7action.RouterGroupPortUsage{
+			RouterGroup:     v7action.RouterGroup{Name: "default-tcp", ReservablePorts: "1024-1033"},
+			ReservablePorts: []v7action.PortRange{{Start: 1024, End: 1033}},
+			UsedPorts: []v7action.RouterGroupPort{
+				{Port: 1025, Route: resources.Route{URL: "tcp.example.com:1025"}, SpaceName: "some-space", OrgName: "some-org"},
+			},
+			QuotaReservations: []v7action.RouterGroupQuotaReservation{
+				{QuotaName: "tcp-quota", ReservedPorts: 3, AppliedTo: 2},
+				{QuotaName: "space-tcp-quota", SpaceQuota: true, ReservedPorts: 1, AppliedTo: 1},
+			},
+			ReservedPorts: 5,
+			UnusedPorts:   []v7action.PortRange{{Start: 1024, End: 1024}, {Start: 1026, End: 1033}},
+		}
+		usageErr = nil
+	})
+
+	JustBeforeEach(func() {
+		fakeActor.GetRouterGroupPortUsageReturns(usage, v7action.Warnings{"usage-warning"}, usageErr)
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("displays the port usage of the router group", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(fakeActor.GetRouterGroupPortUsageArgsForCall(0)).To(Equal("default-tcp"))
+
+		Expect(testUI.Out).To(Say(`Getting port usage for router group default-tcp as banana\.\.\.`))
+		Expect(testUI.Out).To(Say(`reservable ports:\s+1024-1033`))
+		Expect(testUI.Out).To(Say(`total ports:\s+10`))
+		Expect(testUI.Out).To(Say(`in use:\s+1`))
+		Expect(testUI.Out).To(Say(`reserved by quotas:\s+5`))
+		Expect(testUI.Out).To(Say(`free:\s+4`))
+
+		Expect(testUI.Out).To(Say(`port\s+route\s+space\s+org`))
+		Expect(testUI.Out).To(Say(`1025\s+tcp\.example\.com:1025\s+some-space\s+some-org`))
+
+		Expect(testUI.Out).To(Say(`quota\s+type\s+reserved route ports\s+applied to`))
+		Expect(testUI.Out).To(Say(`tcp-quota\s+org\s+3\s+2 org\(s\)`))
+		Expect(testUI.Out).To(Say(`space-tcp-quota\s+space\s+1\s+1 space\(s\)`))
+
+		Expect(testUI.Out).To(Say(`Ports not in use: 1024, 1026-1033`))
+		Expect(testUI.Err).To(Say("usage-warning"))
+	})
+
+	When("no ports are used or reserved", func() {
+		BeforeEach(func() {
+			usage.UsedPorts = nil
+			usage.QuotaReservations = nil
+			usage.ReservedPorts = 0
+			usage.UnusedPorts = nil
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`No ports are used by routes\.`))
+			Expect(testUI.Out).To(Say(`No quotas reserve route ports\.`))
+			Expect(testUI.Out).To(Say(`Ports not in use: none`))
+		})
+	})
+
+	When("getting the port usage fails", func() {
+		BeforeEach(func() {
+			usageErr = errors.New("usage-error")
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("usage-error"))
+			Expect(testUI.Err).To(Say("usage-warning"))
+			Expect(testUI.Out).NotTo(Say("reservable ports:"))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -12,10 +13,10 @@ import (
 type RoutesCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME routes [--org-level]"`
-	relatedCommands interface{} `related_commands:"check-route, create-route, domains, map-route, unmap-route"`
-	Orglevel        bool        `long:"org-level" description:"List all the routes for all spaces of current organization"`
-	Labels          string      `long:"labels" description:"Selector to filter routes by labels"`
+	usage           interface{}        `usage:"CF_NAME routes [--org-level]"`
+	relatedCommands interface{}        `related_commands:"check-route, create-route, domains, map-route, unmap-route"`
+	Orglevel        bool               `long:"org-level" description:"List all the routes for all spaces of current organization"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter routes by labels"`
 }
 
 func (cmd RoutesCommand) Execute(args []string) error {
@@ -43,14 +44,14 @@ func (cmd RoutesCommand) Execute(args []string) error {
 			"CurrentOrg":  targetedOrg.Name,
 			"CurrentUser": currentUser.Name,
 		})
-		routes, warnings, err = cmd.Actor.GetRoutesByOrg(targetedOrg.GUID, cmd.Labels)
+		routes, warnings, err = cmd.Actor.GetRoutesByOrg(targetedOrg.GUID, string(cmd.Labels))
 	} else {
 		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
 			"CurrentOrg":   targetedOrg.Name,
 			"CurrentSpace": targetedSpace.Name,
 			"CurrentUser":  currentUser.Name,
 		})
-		routes, warnings, err = cmd.Actor.GetRoutesBySpace(targetedSpace.GUID, cmd.Labels)
+		routes, warnings, err = cmd.Actor.GetRoutesBySpace(targetedSpace.GUID, string(cmd.Labels))
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -89,6 +90,13 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 	}
 
 	for _, routeSummary := range routeSummaries {
+		appNames := append([]string{}, routeSummary.AppNames...)
+		for i, destination := range routeSummary.Destinations {
+			if destination.Weight.IsSet && i < len(appNames) {
+				appNames[i] += " (" + formatDestinationWeight(destination.Weight) + ")"
+			}
+		}
+
 		port := ""
 		if routeSummary.Port != 0 {
 			port = strconv.Itoa(routeSummary.Port)
@@ -101,7 +109,7 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 			routeSummary.Path,
 			routeSummary.Protocol,
 			strings.Join(routeSummary.AppProtocols, ", "),
-			strings.Join(routeSummary.AppNames, ", "),
+			strings.Join(appNames, ", "),
 			routeSummary.ServiceInstanceName,
 		})
 	}
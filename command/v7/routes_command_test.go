@@ -9,6 +9,7 @@ import (
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -148,8 +149,8 @@ var _ = Describe("routes Command", func() {
 							SpaceName:  "space-3",
 							Route: resources.Route{GUID: "route-guid-3", Host: "host-1",
 								Destinations: []resources.RouteDestination{
-									{GUID: "app1-guid", Protocol: "http1"},
-									{GUID: "app2-guid", Protocol: "http2"},
+									{GUID: "app1-guid", Protocol: "http1", Weight: types.NullInt{IsSet: true, Value: 90}},
+									{GUID: "app2-guid", Protocol: "http2", Weight: types.NullInt{IsSet: true, Value: 10}},
 								},
 							},
 							AppNames:            []string{"app1", "app2"},
@@ -192,7 +193,7 @@ var _ = Describe("routes Command", func() {
 					Expect(testUI.Out).To(Say(tableHeaders))
 					Expect(testUI.Out).To(Say(`space-1\s+domain1\s+si-1\s+`))
 					Expect(testUI.Out).To(Say(`space-2\s+host-3\s+domain2\s+\/path\/2`))
-					Expect(testUI.Out).To(Say(`space-3\s+host-1\s+domain3\s+http1, http2\s+app1, app2\s+si-3`))
+					Expect(testUI.Out).To(Say(`space-3\s+host-1\s+domain3\s+http1, http2\s+app1 \(90%\), app2 \(10%\)\s+si-3`))
 					Expect(testUI.Out).To(Say(`space-3\s+tcp\.domain\s+1024\s+app1, app2`))
 					Expect(testUI.Out).To(Say(`space-3\s+domain4\s+1024\s+http1\s+app1, app2`))
 				})
@@ -20,7 +20,8 @@ type ScaleCommand struct {
 	LogRateLimit        flag.BytesWithUnlimited `short:"l" required:"false" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
 	MemoryLimit         flag.Megabytes          `short:"m" required:"false" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
 	ProcessType         string                  `long:"process" default:"web" description:"App process to scale"`
-	usage               interface{}             `usage:"CF_NAME scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-f]\n\n   Modifying the app's disk, memory, or log rate will cause the app to restart."`
+	SkipQuotaCheck      bool                    `long:"skip-quota-check" description:"Scale even if the app would exceed the memory, app instance or log rate limit of the org or space quota"`
+	usage               interface{}             `usage:"CF_NAME scale APP_NAME [--process PROCESS] [-i INSTANCES] [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-f] [--skip-quota-check]\n\n   Modifying the app's disk, memory, or log rate will cause the app to restart."`
 	relatedCommands     interface{}             `related_commands:"push"`
 	envCFStartupTimeout interface{}             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 }
@@ -46,6 +47,13 @@ func (cmd ScaleCommand) Execute(args []string) error {
 		return cmd.showCurrentScale(user.Name, err)
 	}
 
+	if !cmd.SkipQuotaCheck {
+		err = cmd.checkQuotas(app)
+		if err != nil {
+			return err
+		}
+	}
+
 	scaled, err := cmd.scaleProcess(app.GUID, user.Name)
 	if err != nil {
 		return err
@@ -136,6 +144,48 @@ func (cmd ScaleCommand) scaleProcess(appGUID string, username string) (bool, err
 	return true, nil
 }
 
+// checkQuotas fails the scale before anything changes when the app would
+// exceed a limit of the org or space quota. When the usage cannot be
+// projected, it warns and lets the scale continue.
+func (cmd ScaleCommand) checkQuotas(app resources.Application) error {
+	change, warnings, err := cmd.Actor.GetApplicationQuotaChange(
+		app,
+		[]resources.Process{{
+			Type:              cmd.ProcessType,
+			Instances:         cmd.Instances.NullInt,
+			MemoryInMB:        cmd.MemoryLimit.NullUint64,
+			LogRateLimitInBPS: types.NullInt(cmd.LogRateLimit),
+		}},
+		cmd.shouldRestart() || app.State == constant.ApplicationStarted,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		shared.NewQuotaDisplayer(cmd.UI).DisplayQuotaCheckSkipped(err)
+		return nil
+	}
+
+	check, warnings, err := cmd.Actor.CheckQuotaChange(
+		cmd.Config.TargetedOrganization().GUID,
+		cmd.Config.TargetedSpace().GUID,
+		change,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		shared.NewQuotaDisplayer(cmd.UI).DisplayQuotaCheckSkipped(err)
+		return nil
+	}
+
+	if !check.Exceeded() {
+		return nil
+	}
+
+	cmd.UI.DisplayText("Scaling would exceed these quota limits:")
+	shared.NewQuotaDisplayer(cmd.UI).DisplayQuotaCheck(check)
+	cmd.UI.DisplayNewline()
+
+	return translatableerror.QuotaCheckFailedError{Command: "scale", Flag: "--skip-quota-check"}
+}
+
 func (cmd ScaleCommand) restartApplication(appGUID string, username string) error {
 	cmd.UI.DisplayTextWithFlavor("Stopping app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
 		"AppName":   cmd.RequiredArgs.AppName,
@@ -567,6 +567,22 @@ var _ = Describe("scale Command", func() {
 						Expect(fakeActor.StartApplicationCallCount()).To(Equal(1))
 						Expect(fakeActor.GetDetailedAppSummaryCallCount()).To(Equal(1))
 					})
+
+					It("still checks the quotas", func() {
+						Expect(fakeActor.GetApplicationQuotaChangeCallCount()).To(Equal(1))
+						Expect(fakeActor.CheckQuotaChangeCallCount()).To(Equal(1))
+					})
+				})
+
+				When("skip quota check flag is provided", func() {
+					BeforeEach(func() {
+						cmd.SkipQuotaCheck = true
+					})
+
+					It("does not check the quotas", func() {
+						Expect(fakeActor.GetApplicationQuotaChangeCallCount()).To(Equal(0))
+						Expect(fakeActor.CheckQuotaChangeCallCount()).To(Equal(0))
+					})
 				})
 			})
 
@@ -634,6 +650,69 @@ var _ = Describe("scale Command", func() {
 				})
 			})
 
+			When("the scale would exceed a quota limit", func() {
+				BeforeEach(func() {
+					cmd.Instances.Value = 5
+					cmd.Instances.IsSet = true
+					app.State = constant.ApplicationStarted
+					fakeActor.GetApplicationByNameAndSpaceReturns(app, nil, nil)
+					fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+
+					fakeActor.GetApplicationQuotaChangeReturns(
+						v7action.QuotaChange{MemoryInMB: 1024, AppInstances: 4},
+						v7action.Warnings{"change-warning"},
+						nil,
+					)
+					fakeActor.CheckQuotaChangeReturns(
+						v7action.QuotaCheck{
+							{Scope: "org", QuotaName: "org-quota", Resource: v7action.QuotaResourceMemory, Current: 1536, Change: 1024, Limit: 2048},
+							{Scope: "space", QuotaName: "space-quota", Resource: v7action.QuotaResourceAppInstances, Current: 2, Change: 4, Limit: 10},
+						},
+						v7action.Warnings{"check-warning"},
+						nil,
+					)
+				})
+
+				It("shows the breakdown and fails before scaling", func() {
+					Expect(executeErr).To(MatchError(translatableerror.QuotaCheckFailedError{Command: "scale", Flag: "--skip-quota-check"}))
+
+					app, processes, started := fakeActor.GetApplicationQuotaChangeArgsForCall(0)
+					Expect(app.GUID).To(Equal("some-app-guid"))
+					Expect(processes).To(Equal([]resources.Process{{
+						Type:      constant.ProcessTypeWeb,
+						Instances: types.NullInt{Value: 5, IsSet: true},
+					}}))
+					Expect(started).To(BeTrue())
+
+					orgGUID, spaceGUID, change := fakeActor.CheckQuotaChangeArgsForCall(0)
+					Expect(orgGUID).To(Equal("some-org-guid"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(change).To(Equal(v7action.QuotaChange{MemoryInMB: 1024, AppInstances: 4}))
+
+					Expect(testUI.Out).To(Say(`Scaling would exceed these quota limits:`))
+					Expect(testUI.Out).To(Say(`quota\s+resource\s+current\s+change\s+projected\s+limit\s+status`))
+					Expect(testUI.Out).To(Say(`org org-quota\s+memory\s+1\.5G\s+\+1G\s+2\.5G\s+2G\s+exceeds limit`))
+					Expect(testUI.Out).To(Say(`space space-quota\s+app instances\s+2\s+\+4\s+6\s+10\s+ok`))
+					Expect(testUI.Err).To(Say("change-warning"))
+					Expect(testUI.Err).To(Say("check-warning"))
+
+					Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(0))
+				})
+
+				When("the quotas cannot be checked", func() {
+					BeforeEach(func() {
+						fakeActor.CheckQuotaChangeReturns(nil, v7action.Warnings{"check-warning"}, errors.New("check-error"))
+					})
+
+					It("warns and continues the scale", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say("check-warning"))
+						Expect(testUI.Err).To(Say("Unable to check the org and space quotas, continuing without the check: check-error"))
+						Expect(fakeActor.ScaleProcessByApplicationCallCount()).To(Equal(1))
+					})
+				})
+			})
+
 			When("only the memory flag option is provided", func() {
 				BeforeEach(func() {
 					cmd.MemoryLimit.Value = 256
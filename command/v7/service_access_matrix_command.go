@@ -0,0 +1,62 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ServiceAccessMatrixCommand struct {
+	BaseCommand
+
+	Broker          string      `short:"b" description:"Access for plans of a particular broker"`
+	ServiceOffering string      `short:"e" description:"Access for plans of a particular service offering"`
+	usage           interface{} `usage:"CF_NAME service-access-matrix [-b BROKER] [-e SERVICE]\n\n   Each cell shows whether the plan is public, enabled for the org, from a space-scoped broker\n   in a space of the org, or disabled.\n\nEXAMPLES:\n   CF_NAME service-access-matrix -b my-broker"`
+	relatedCommands interface{} `related_commands:"apply-service-access, disable-service-access, enable-service-access, service-access"`
+}
+
+func (cmd ServiceAccessMatrixCommand) Execute(args []string) error {
+	if err := cmd.SharedActor.CheckTarget(false, false); err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	getServiceAccessMessage{
+		Broker:          cmd.Broker,
+		ServiceOffering: cmd.ServiceOffering,
+		User:            user.Name,
+	}.displayMessage(cmd.UI)
+	cmd.UI.DisplayNewline()
+
+	matrix, warnings, err := cmd.Actor.GetServiceAccessMatrix(cmd.ServiceOffering, cmd.Broker)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if len(matrix.Plans) == 0 {
+		cmd.UI.DisplayText("No service plans found.")
+		return nil
+	}
+
+	header := []string{
+		cmd.UI.TranslateText("broker"),
+		cmd.UI.TranslateText("offering"),
+		cmd.UI.TranslateText("plan"),
+	}
+	table := [][]string{append(header, matrix.OrganizationNames...)}
+
+	for _, plan := range matrix.Plans {
+		row := []string{plan.BrokerName, plan.ServiceOfferingName, plan.ServicePlanName}
+		for _, orgName := range matrix.OrganizationNames {
+			row = append(row, cmd.UI.TranslateText(string(plan.AccessFor(orgName))))
+		}
+		table = append(table, row)
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-access-matrix Command", func() {
+	var (
+		cmd             v7.ServiceAccessMatrixCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = v7.ServiceAccessMatrixCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			Broker: "broker",
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "admin"}, nil)
+		fakeActor.GetServiceAccessMatrixReturns(
+			v7action.ServiceAccessMatrix{
+				OrganizationNames: []string{"org-a", "org-b"},
+				Plans: []v7action.ServiceAccessMatrixRow{
+					{
+						BrokerName:          "broker",
+						ServiceOfferingName: "db",
+						ServicePlanName:     "large",
+						VisibilityType:      resources.ServicePlanVisibilityOrganization,
+						AccessByOrganization: map[string]v7action.ServiceAccessLevel{
+							"org-b": v7action.ServiceAccessOrganization,
+						},
+					},
+					{
+						BrokerName:          "broker",
+						ServiceOfferingName: "db",
+						ServicePlanName:     "small",
+						VisibilityType:      resources.ServicePlanVisibilityPublic,
+						AccessByOrganization: map[string]v7action.ServiceAccessLevel{
+							"org-a": v7action.ServiceAccessPublic,
+							"org-b": v7action.ServiceAccessPublic,
+						},
+					},
+				},
+			},
+			v7action.Warnings{"matrix-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("displays the access of every org to every plan", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		offering, broker := fakeActor.GetServiceAccessMatrixArgsForCall(0)
+		Expect(offering).To(BeEmpty())
+		Expect(broker).To(Equal("broker"))
+
+		Expect(testUI.Out).To(Say(`Getting service access for broker broker as admin\.\.\.`))
+		Expect(testUI.Out).To(Say(`broker\s+offering\s+plan\s+org-a\s+org-b`))
+		Expect(testUI.Out).To(Say(`broker\s+db\s+large\s+disabled\s+org`))
+		Expect(testUI.Out).To(Say(`broker\s+db\s+small\s+public\s+public`))
+		Expect(testUI.Err).To(Say("matrix-warning"))
+	})
+
+	When("there are no plans", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceAccessMatrixReturns(v7action.ServiceAccessMatrix{}, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No service plans found."))
+		})
+	})
+
+	When("getting the matrix fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetServiceAccessMatrixReturns(v7action.ServiceAccessMatrix{}, v7action.Warnings{"matrix-warning"}, errors.New("matrix-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("matrix-error"))
+			Expect(testUI.Err).To(Say("matrix-warning"))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -8,8 +9,9 @@ import (
 type ServiceBrokersCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME service-brokers"`
-	relatedCommands interface{} `related_commands:"delete-service-broker, disable-service-access, enable-service-access"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter service brokers by labels"`
+	usage           interface{}        `usage:"CF_NAME service-brokers [--labels SELECTOR]"`
+	relatedCommands interface{}        `related_commands:"delete-service-broker, disable-service-access, enable-service-access"`
 }
 
 func (cmd *ServiceBrokersCommand) Execute(args []string) error {
@@ -25,7 +27,7 @@ func (cmd *ServiceBrokersCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayTextWithFlavor("Getting service brokers as {{.Username}}...", map[string]interface{}{"Username": currentUser.Name})
 
-	serviceBrokers, warnings, err := cmd.Actor.GetServiceBrokers()
+	serviceBrokers, warnings, err := cmd.Actor.GetServiceBrokers(string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -101,6 +101,17 @@ var _ = Describe("service-brokers Command", func() {
 
 		It("calls the GetServiceBrokersActor", func() {
 			Expect(fakeActor.GetServiceBrokersCallCount()).To(Equal(1))
+			Expect(fakeActor.GetServiceBrokersArgsForCall(0)).To(BeEmpty())
+		})
+
+		When("the --labels flag is given", func() {
+			BeforeEach(func() {
+				cmd.Labels = "env=prod"
+			})
+
+			It("passes the label selector to the actor", func() {
+				Expect(fakeActor.GetServiceBrokersArgsForCall(0)).To(Equal("env=prod"))
+			})
 		})
 
 		When("there are no service brokers", func() {
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/resources"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -13,8 +14,9 @@ import (
 type ServicesCommand struct {
 	BaseCommand
 
-	OmitApps        bool        `long:"no-apps" description:"Do not retrieve bound apps information."`
-	relatedCommands interface{} `related_commands:"create-service, marketplace"`
+	OmitApps        bool               `long:"no-apps" description:"Do not retrieve bound apps information."`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter service instances by labels"`
+	relatedCommands interface{}        `related_commands:"create-service, marketplace"`
 }
 
 func (cmd ServicesCommand) Execute(args []string) error {
@@ -26,7 +28,7 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		return err
 	}
 
-	instances, warnings, err := cmd.Actor.GetServiceInstancesForSpace(cmd.Config.TargetedSpace().GUID, cmd.OmitApps)
+	instances, warnings, err := cmd.Actor.GetServiceInstancesForSpace(cmd.Config.TargetedSpace().GUID, string(cmd.Labels), cmd.OmitApps)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -37,7 +39,7 @@ func (cmd ServicesCommand) Execute(args []string) error {
 }
 
 func (cmd ServicesCommand) Usage() string {
-	return "CF_NAME services"
+	return "CF_NAME services [--labels SELECTOR]"
 }
 
 func (cmd ServicesCommand) displayMessage() error {
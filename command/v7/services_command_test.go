@@ -144,6 +144,17 @@ var _ = Describe("services command", func() {
 		))
 	})
 
+	When("the --labels flag is given", func() {
+		BeforeEach(func() {
+			cmd.Labels = "env=prod"
+		})
+
+		It("passes the label selector to the actor", func() {
+			_, labelSelector, _ := fakeActor.GetServiceInstancesForSpaceArgsForCall(0)
+			Expect(labelSelector).To(Equal("env=prod"))
+		})
+	})
+
 	When("omit apps is set", func() {
 		BeforeEach(func() {
 			cmd.OmitApps = true
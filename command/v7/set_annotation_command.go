@@ -0,0 +1,77 @@
+package v7
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/types"
+)
+
+type SetAnnotationCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.SetAnnotationArgs `positional-args:"yes"`
+	relatedCommands interface{}            `related_commands:"annotations, unset-annotation, set-label"`
+	BuildpackStack  string                 `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	ServiceBroker   string                 `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
+	ServiceOffering string                 `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+
+	AnnotationSetter LabelSetter
+}
+
+func (cmd *SetAnnotationCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.AnnotationSetter = &LabelUpdater{
+		UI:           ui,
+		Config:       config,
+		SharedActor:  cmd.SharedActor,
+		Actor:        cmd.Actor,
+		Action:       Set,
+		MetadataType: AnnotationMetadata,
+	}
+	return nil
+}
+
+func (cmd SetAnnotationCommand) Execute(args []string) error {
+	targetResource := TargetResource{
+		ResourceType:    cmd.RequiredArgs.ResourceType,
+		ResourceName:    cmd.RequiredArgs.ResourceName,
+		BuildpackStack:  cmd.BuildpackStack,
+		ServiceBroker:   cmd.ServiceBroker,
+		ServiceOffering: cmd.ServiceOffering,
+	}
+
+	// Annotation values are not subject to the label value limits; they are
+	// passed to the API as given, including any '=' after the first.
+	annotations := make(map[string]types.NullString)
+	for _, annotation := range cmd.RequiredArgs.Annotations {
+		parts := strings.SplitN(annotation, "=", 2)
+		if len(parts) < 2 {
+			return fmt.Errorf("Metadata error: no value provided for annotation '%s'", annotation)
+		}
+		annotations[parts[0]] = types.NewNullString(parts[1])
+	}
+
+	return cmd.AnnotationSetter.Execute(targetResource, annotations)
+}
+
+func (cmd SetAnnotationCommand) Usage() string {
+	return `CF_NAME set-annotation RESOURCE RESOURCE_NAME KEY=VALUE...`
+}
+
+func (cmd SetAnnotationCommand) Examples() string {
+	return `
+cf set-annotation app dora contact=team-dora@example.com
+cf set-annotation org business description="Business unit apps" cost-center=1234
+cf set-annotation buildpack go_buildpack owner=buildpacks-team -s cflinuxfs3`
+}
+
+func (cmd SetAnnotationCommand) Resources() string {
+	return SetLabelCommand{}.Resources()
+}
@@ -0,0 +1,80 @@
+package v7_test
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("set-annotation command", func() {
+	var (
+		cmd                  SetAnnotationCommand
+		fakeAnnotationSetter *v7fakes.FakeLabelSetter
+
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeAnnotationSetter = new(v7fakes.FakeLabelSetter)
+		cmd = SetAnnotationCommand{
+			AnnotationSetter: fakeAnnotationSetter,
+		}
+	})
+
+	When("some provided annotations do not have a value part", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetAnnotationArgs{
+				ResourceType: "anything",
+				ResourceName: "some-resource",
+				Annotations:  []string{"FOO=BAR", "MISSING_EQUALS"},
+			}
+		})
+
+		It("complains about the missing equal sign", func() {
+			err := cmd.Execute(nil)
+			Expect(err).To(MatchError("Metadata error: no value provided for annotation 'MISSING_EQUALS'"))
+			Expect(fakeAnnotationSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("all the provided annotations are valid", func() {
+		var longValue string
+
+		BeforeEach(func() {
+			longValue = strings.Repeat("x", 1000)
+			cmd.RequiredArgs = flag.SetAnnotationArgs{
+				ResourceType: "anything",
+				ResourceName: "some-resource",
+				Annotations:  []string{"contact=team@example.com", "query=a=b", "description=" + longValue},
+			}
+			cmd.BuildpackStack = "some-stack"
+			cmd.ServiceBroker = "some-service-broker"
+			cmd.ServiceOffering = "some-service-offering"
+		})
+
+		It("calls execute with the right parameters", func() {
+			executeErr = cmd.Execute(nil)
+
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeAnnotationSetter.ExecuteCallCount()).To(Equal(1))
+			targetResource, annotations := fakeAnnotationSetter.ExecuteArgsForCall(0)
+			Expect(targetResource).To(Equal(TargetResource{
+				ResourceType:    "anything",
+				ResourceName:    "some-resource",
+				BuildpackStack:  "some-stack",
+				ServiceBroker:   "some-service-broker",
+				ServiceOffering: "some-service-offering",
+			}))
+			Expect(annotations).To(Equal(map[string]types.NullString{
+				"contact":     types.NewNullString("team@example.com"),
+				"query":       types.NewNullString("a=b"),
+				"description": types.NewNullString(longValue),
+			}))
+		})
+	})
+})
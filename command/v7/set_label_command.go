@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/types"
 )
 
@@ -18,11 +19,14 @@ type LabelSetter interface {
 type SetLabelCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.SetLabelArgs `positional-args:"yes"`
-	relatedCommands interface{}       `related_commands:"labels, unset-label"`
-	BuildpackStack  string            `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
-	ServiceBroker   string            `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
-	ServiceOffering string            `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+	RequiredArgs    flag.SetLabelArgs        `positional-args:"yes"`
+	relatedCommands interface{}              `related_commands:"labels, unset-label"`
+	BuildpackStack  string                   `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	ServiceBroker   string                   `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
+	ServiceOffering string                   `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+	Selector        flag.LabelUpdateSelector `long:"selector" description:"Set the labels on every resource of this type matching a label selector, instead of a named resource"`
+	Scope           flag.LabelSelectorScope  `long:"scope" description:"Where to look for resources matching --selector: space, org or all (Default: space for apps, routes and service instances, org for spaces, all otherwise)"`
+	Force           bool                     `short:"f" description:"Update resources matching --selector without confirmation"`
 
 	LabelSetter LabelSetter
 }
@@ -44,16 +48,29 @@ func (cmd *SetLabelCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd SetLabelCommand) Execute(args []string) error {
+	// without --selector, a lone KEY=VALUE means the resource name is missing
+	if cmd.Selector.Selector == "" && len(cmd.RequiredArgs.Labels) == 0 && strings.Contains(cmd.RequiredArgs.ResourceName.Name, "=") {
+		return translatableerror.RequiredArgumentError{ArgumentName: "RESOURCE_NAME"}
+	}
+
+	resourceName, labelArgs, err := labelUpdateArgs(cmd.RequiredArgs.ResourceName, cmd.RequiredArgs.Labels, cmd.Selector, "KEY=VALUE")
+	if err != nil {
+		return err
+	}
+
 	targetResource := TargetResource{
 		ResourceType:    cmd.RequiredArgs.ResourceType,
-		ResourceName:    cmd.RequiredArgs.ResourceName,
+		ResourceName:    resourceName,
 		BuildpackStack:  cmd.BuildpackStack,
 		ServiceBroker:   cmd.ServiceBroker,
 		ServiceOffering: cmd.ServiceOffering,
+		LabelSelector:   string(cmd.Selector.Selector),
+		Scope:           cmd.Scope,
+		Force:           cmd.Force,
 	}
 
 	labels := make(map[string]types.NullString)
-	for _, label := range cmd.RequiredArgs.Labels {
+	for _, label := range labelArgs {
 		parts := strings.SplitN(label, "=", 2)
 		if len(parts) < 2 {
 			return fmt.Errorf("Metadata error: no value provided for label '%s'", label)
@@ -65,14 +82,17 @@ func (cmd SetLabelCommand) Execute(args []string) error {
 }
 
 func (cmd SetLabelCommand) Usage() string {
-	return `CF_NAME set-label RESOURCE RESOURCE_NAME KEY=VALUE...`
+	return `CF_NAME set-label RESOURCE RESOURCE_NAME KEY=VALUE...
+   CF_NAME set-label RESOURCE --selector SELECTOR [--scope (space | org | all)] [-f] KEY=VALUE...`
 }
 
 func (cmd SetLabelCommand) Examples() string {
 	return `
 cf set-label app dora env=production
 cf set-label org business pci=true public-facing=false
-cf set-label buildpack go_buildpack go=1.12 -s cflinuxfs3`
+cf set-label buildpack go_buildpack go=1.12 -s cflinuxfs3
+cf set-label app --selector 'team=payments' cost-center=1234
+cf set-label space --selector 'env in (dev,staging)' --scope all -f retention=short`
 }
 
 func (cmd SetLabelCommand) Resources() string {
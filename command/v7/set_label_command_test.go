@@ -2,9 +2,11 @@ package v7_test
 
 import (
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -19,6 +21,7 @@ var _ = Describe("set-label command", func() {
 	)
 
 	BeforeEach(func() {
+		resourceName = "some-resource"
 		fakeLabelSetter = new(v7fakes.FakeLabelSetter)
 		cmd = SetLabelCommand{
 			LabelSetter: fakeLabelSetter,
@@ -29,7 +32,7 @@ var _ = Describe("set-label command", func() {
 		BeforeEach(func() {
 			cmd.RequiredArgs = flag.SetLabelArgs{
 				ResourceType: "anything",
-				ResourceName: resourceName,
+				ResourceName: flag.LabelResourceName{Name: resourceName},
 				Labels:       []string{"FOO=BAR", "MISSING_EQUALS", "ENV=FAKE"},
 			}
 		})
@@ -45,7 +48,7 @@ var _ = Describe("set-label command", func() {
 		BeforeEach(func() {
 			cmd.RequiredArgs = flag.SetLabelArgs{
 				ResourceType: "anything",
-				ResourceName: resourceName,
+				ResourceName: flag.LabelResourceName{Name: resourceName},
 				Labels:       []string{"FOO=BAZ", "FOO=BAR", "ENV=FAKE"},
 			}
 			cmd.BuildpackStack = "some-stack"
@@ -60,7 +63,7 @@ var _ = Describe("set-label command", func() {
 			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(1))
 			targetResource, labels := fakeLabelSetter.ExecuteArgsForCall(0)
 			Expect(targetResource.ResourceType).To(Equal(cmd.RequiredArgs.ResourceType))
-			Expect(targetResource.ResourceName).To(Equal(cmd.RequiredArgs.ResourceName))
+			Expect(targetResource.ResourceName).To(Equal(cmd.RequiredArgs.ResourceName.Name))
 			Expect(targetResource.BuildpackStack).To(Equal(cmd.BuildpackStack))
 			Expect(targetResource.ServiceBroker).To(Equal(cmd.ServiceBroker))
 			Expect(targetResource.ServiceOffering).To(Equal(cmd.ServiceOffering))
@@ -70,4 +73,80 @@ var _ = Describe("set-label command", func() {
 			}))
 		})
 	})
+
+	When("no labels are provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetLabelArgs{
+				ResourceType: "app",
+				ResourceName: flag.LabelResourceName{Name: "some-app"},
+			}
+		})
+
+		It("returns a required argument error", func() {
+			executeErr = cmd.Execute(nil)
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "KEY=VALUE"}))
+			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("only a label is provided without a selector", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetLabelArgs{
+				ResourceType: "app",
+				ResourceName: flag.LabelResourceName{Name: "foo=bar"},
+			}
+		})
+
+		It("returns a required argument error for the resource name", func() {
+			executeErr = cmd.Execute(nil)
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "RESOURCE_NAME"}))
+			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a resource name is given before the label selector", func() {
+		BeforeEach(func() {
+			_, err := flags.ParseArgs(&cmd, []string{"app", "my-app", "--selector", "team=payments", "ENV=FAKE"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an argument combination error", func() {
+			executeErr = cmd.Execute(nil)
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"RESOURCE_NAME", "--selector"},
+			}))
+			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a label selector is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetLabelArgs{
+				ResourceType: "app",
+				ResourceName: flag.LabelResourceName{Name: "FOO=BAR"},
+				Labels:       []string{"ENV=FAKE"},
+			}
+			cmd.Selector = flag.LabelUpdateSelector{Selector: "team=payments"}
+			cmd.Scope = flag.OrgLabelSelectorScope
+			cmd.Force = true
+		})
+
+		It("treats every positional argument after the resource type as a label", func() {
+			executeErr = cmd.Execute(nil)
+
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(1))
+			targetResource, labels := fakeLabelSetter.ExecuteArgsForCall(0)
+			Expect(targetResource).To(Equal(TargetResource{
+				ResourceType:  "app",
+				LabelSelector: "team=payments",
+				Scope:         flag.OrgLabelSelectorScope,
+				Force:         true,
+			}))
+			Expect(labels).To(Equal(map[string]types.NullString{
+				"FOO": types.NewNullString("BAR"),
+				"ENV": types.NewNullString("FAKE"),
+			}))
+		})
+	})
 })
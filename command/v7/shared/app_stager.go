@@ -214,30 +214,43 @@ func (stager *Stager) StartApp(
 			}
 		}
 
-		if resourceGuid != "" {
-			// attach droplet to app
-			warnings, err := stager.Actor.SetApplicationDroplet(app.GUID, resourceGuid)
+		if appAction == constant.ApplicationRollingBack {
+			stager.UI.DisplayText("Waiting for app to start...")
+			stager.UI.DisplayNewline()
+
+			// a deployment of a stopped app starts every instance with the
+			// revision's settings straight away
+			_, warnings, err := stager.Actor.CreateDeploymentByApplicationAndRevision(app.GUID, resourceGuid)
 			stager.UI.DisplayWarnings(warnings)
 			if err != nil {
 				return err
 			}
-		}
+		} else {
+			if resourceGuid != "" {
+				// attach droplet to app
+				warnings, err := stager.Actor.SetApplicationDroplet(app.GUID, resourceGuid)
+				stager.UI.DisplayWarnings(warnings)
+				if err != nil {
+					return err
+				}
+			}
 
-		stager.UI.DisplayText("Waiting for app to start...")
-		stager.UI.DisplayNewline()
+			stager.UI.DisplayText("Waiting for app to start...")
+			stager.UI.DisplayNewline()
 
-		// start the application
-		warnings, err := stager.Actor.StartApplication(app.GUID)
-		stager.UI.DisplayWarnings(warnings)
-		if err != nil {
-			return err
+			// start the application
+			warnings, err := stager.Actor.StartApplication(app.GUID)
+			stager.UI.DisplayWarnings(warnings)
+			if err != nil {
+				return err
+			}
 		}
 
 		handleInstanceDetails := func(instanceDetails string) {
 			stager.UI.DisplayText(instanceDetails)
 		}
 
-		warnings, err = stager.Actor.PollStart(app, noWait, handleInstanceDetails)
+		warnings, err := stager.Actor.PollStart(app, noWait, handleInstanceDetails)
 		stager.UI.DisplayNewline()
 		stager.UI.DisplayWarnings(warnings)
 		if err != nil {
@@ -560,6 +560,54 @@ var _ = Describe("app stager", func() {
 				})
 			})
 
+			When("the app action is rolling back", func() {
+				BeforeEach(func() {
+					appAction = constant.ApplicationRollingBack
+					resourceGUID = "revision-guid"
+					fakeActor.CreateDeploymentByApplicationAndRevisionReturns(
+						"some-deployment-guid",
+						v7action.Warnings{"create-deployment-warning"},
+						nil,
+					)
+				})
+
+				It("stops the app and deploys the revision", func() {
+					Expect(executeErr).To(BeNil())
+
+					Expect(testUI.Out).To(Say("Stopping app..."))
+					Expect(fakeActor.StopApplicationCallCount()).To(Equal(1))
+					Expect(testUI.Err).To(Say("stop-app-warning"))
+
+					Expect(testUI.Out).To(Say("Waiting for app to start..."))
+					Expect(fakeActor.CreateDeploymentByApplicationAndRevisionCallCount()).To(Equal(1))
+					appGUID, revisionGUID := fakeActor.CreateDeploymentByApplicationAndRevisionArgsForCall(0)
+					Expect(appGUID).To(Equal(app.GUID))
+					Expect(revisionGUID).To(Equal("revision-guid"))
+					Expect(testUI.Err).To(Say("create-deployment-warning"))
+
+					Expect(fakeActor.SetApplicationDropletCallCount()).To(Equal(0))
+					Expect(fakeActor.StartApplicationCallCount()).To(Equal(0))
+
+					Expect(fakeActor.PollStartCallCount()).To(Equal(1))
+					Expect(testUI.Err).To(Say("poll-app-warning"))
+				})
+
+				When("creating the deployment fails", func() {
+					BeforeEach(func() {
+						fakeActor.CreateDeploymentByApplicationAndRevisionReturns(
+							"",
+							v7action.Warnings{"create-deployment-warning"},
+							errors.New("create-deployment-error"),
+						)
+					})
+
+					It("returns an error", func() {
+						Expect(executeErr).To(MatchError("create-deployment-error"))
+						Expect(fakeActor.PollStartCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			When("a droplet guid is not provided", func() {
 				BeforeEach(func() {
 					resourceGUID = ""
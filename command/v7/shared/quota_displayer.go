@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
@@ -68,6 +69,124 @@ func (displayer QuotaDisplayer) DisplaySingleQuota(quota resources.Quota) {
 	displayer.ui.DisplayKeyValueTable("", quotaTable, 3)
 }
 
+// DisplayQuotaUsageTable shows how much of each quota limit the orgs or spaces
+// use. Those using at least threshold percent of a limit are marked as near
+// their limit.
+func (displayer QuotaDisplayer) DisplayQuotaUsageTable(reports []v7action.QuotaUsageReport, threshold int) {
+	table := [][]string{
+		{
+			displayer.ui.TranslateText("name"),
+			displayer.ui.TranslateText("quota"),
+			displayer.ui.TranslateText("memory"),
+			displayer.ui.TranslateText("app instances"),
+			displayer.ui.TranslateText("routes"),
+			displayer.ui.TranslateText("route ports"),
+			displayer.ui.TranslateText("service instances"),
+			displayer.ui.TranslateText("paid service plans"),
+			displayer.ui.TranslateText("status"),
+		},
+	}
+
+	for _, report := range reports {
+		table = append(table, []string{
+			report.Name,
+			report.QuotaName,
+			displayer.presentQuotaUsage(report.Memory, func(value int) string {
+				return addMemoryUnits(float64(value) * MEGABYTE)
+			}),
+			displayer.presentQuotaUsage(report.AppInstances, strconv.Itoa),
+			displayer.presentQuotaUsage(report.Routes, strconv.Itoa),
+			displayer.presentQuotaUsage(report.ReservedPorts, strconv.Itoa),
+			displayer.presentQuotaUsage(report.ServiceInstances, strconv.Itoa),
+			displayer.presentQuotaUsage(report.PaidServicePlans, strconv.Itoa),
+			displayer.presentQuotaUsageStatus(report, threshold),
+		})
+	}
+
+	displayer.ui.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+// DisplayQuotaCheck shows how a push or scale changes the usage of each
+// limited resource and which limits it exceeds.
+func (displayer QuotaDisplayer) DisplayQuotaCheck(check v7action.QuotaCheck) {
+	table := [][]string{
+		{
+			displayer.ui.TranslateText("quota"),
+			displayer.ui.TranslateText("resource"),
+			displayer.ui.TranslateText("current"),
+			displayer.ui.TranslateText("change"),
+			displayer.ui.TranslateText("projected"),
+			displayer.ui.TranslateText("limit"),
+			displayer.ui.TranslateText("status"),
+		},
+	}
+
+	for _, usage := range check {
+		format := strconv.Itoa
+		switch usage.Resource {
+		case v7action.QuotaResourceMemory:
+			format = func(value int) string { return addMemoryUnits(float64(value) * MEGABYTE) }
+		case v7action.QuotaResourceLogRate:
+			format = func(value int) string { return addMemoryUnits(float64(value)) }
+		}
+
+		change := format(usage.Change)
+		projected := format(usage.Projected())
+		if usage.Unlimited {
+			change = "unlimited"
+			projected = "unlimited"
+		} else if usage.Change > 0 {
+			change = "+" + change
+		} else if usage.Change < 0 {
+			change = "-" + format(-usage.Change)
+		}
+
+		status := displayer.ui.TranslateText("ok")
+		if usage.Exceeded() {
+			status = displayer.ui.TranslateText("exceeds limit")
+		}
+
+		table = append(table, []string{
+			fmt.Sprintf("%s %s", usage.Scope, usage.QuotaName),
+			displayer.ui.TranslateText(string(usage.Resource)),
+			format(usage.Current),
+			change,
+			projected,
+			format(usage.Limit),
+			status,
+		})
+	}
+
+	displayer.ui.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+// DisplayQuotaCheckSkipped warns that a push or scale goes ahead without
+// checking the quotas because the projected usage could not be computed.
+func (displayer QuotaDisplayer) DisplayQuotaCheckSkipped(err error) {
+	displayer.ui.DisplayWarning("Unable to check the org and space quotas, continuing without the check: {{.Error}}", map[string]interface{}{
+		"Error": err.Error(),
+	})
+}
+
+func (displayer QuotaDisplayer) presentQuotaUsage(usage v7action.QuotaUsage, format func(int) string) string {
+	percent, limited := usage.Percent()
+	if !limited {
+		return fmt.Sprintf("%s/%s", format(usage.Used), "unlimited")
+	}
+	return fmt.Sprintf("%s/%s (%d%%)", format(usage.Used), format(usage.Limit.Value), percent)
+}
+
+func (displayer QuotaDisplayer) presentQuotaUsageStatus(report v7action.QuotaUsageReport, threshold int) string {
+	switch {
+	case report.Exceeded():
+		return displayer.ui.TranslateText("over limit")
+	case report.NearLimit(threshold):
+		return displayer.ui.TranslateText("near limit")
+	default:
+		return displayer.ui.TranslateText("ok")
+	}
+}
+
 func (displayer QuotaDisplayer) presentBooleanValue(limit bool) string {
 	if limit {
 		return "allowed"
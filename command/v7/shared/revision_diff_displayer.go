@@ -0,0 +1,211 @@
+package shared
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// RevisionDiffDisplayer shows how two revisions of an app differ, section by
+// section, with unchanged values for context.
+type RevisionDiffDisplayer struct {
+	UI command.UI
+	// ShowEnv displays environment variable values instead of redacting them.
+	ShowEnv bool
+}
+
+func (displayer RevisionDiffDisplayer) DisplayDiff(original v7action.RevisionDetails, other v7action.RevisionDetails) error {
+	sections := []struct {
+		header  string
+		changes []ui.Change
+	}{
+		{"droplet:", displayer.dropletChanges(original.Droplet, other.Droplet)},
+		{"environment variables:", displayer.envChanges(original.EnvironmentVariables, other.EnvironmentVariables)},
+		{"processes:", displayer.processChanges(original.Revision.Processes, other.Revision.Processes)},
+		{"sidecars:", displayer.sidecarChanges(original.Revision.Sidecars, other.Revision.Sidecars)},
+		{"metadata:", displayer.metadataChanges(original.Revision.Metadata, other.Revision.Metadata)},
+	}
+
+	for _, section := range sections {
+		changes := withoutEmptyChanges(section.changes)
+		if len(changes) == 0 {
+			continue
+		}
+
+		displayer.UI.DisplayText(section.header)
+		err := displayer.UI.DisplayChangesForPush(changes)
+		if err != nil {
+			return err
+		}
+		displayer.UI.DisplayNewline()
+	}
+
+	return nil
+}
+
+func (displayer RevisionDiffDisplayer) dropletChanges(original resources.Droplet, other resources.Droplet) []ui.Change {
+	return []ui.Change{
+		{Header: "guid:", CurrentValue: original.GUID, NewValue: other.GUID},
+		{Header: "state:", CurrentValue: dropletState(original), NewValue: dropletState(other)},
+		{Header: "stack:", CurrentValue: original.Stack, NewValue: other.Stack},
+		{Header: "image:", CurrentValue: original.Image, NewValue: other.Image},
+		{Header: "buildpacks:", CurrentValue: dropletBuildpacks(original), NewValue: dropletBuildpacks(other)},
+	}
+}
+
+func (displayer RevisionDiffDisplayer) envChanges(original resources.EnvironmentVariables, other resources.EnvironmentVariables) []ui.Change {
+	var names []string
+	for name := range original {
+		names = append(names, name)
+	}
+	for name := range other {
+		if _, ok := original[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var changes []ui.Change
+	for _, name := range names {
+		changes = append(changes, ui.Change{
+			Header:       name + ":",
+			CurrentValue: original[name].Value,
+			NewValue:     other[name].Value,
+			HiddenValue:  !displayer.ShowEnv,
+		})
+	}
+	return changes
+}
+
+func (displayer RevisionDiffDisplayer) processChanges(original map[string]resources.RevisionProcess, other map[string]resources.RevisionProcess) []ui.Change {
+	var processTypes []string
+	for processType := range original {
+		processTypes = append(processTypes, processType)
+	}
+	for processType := range other {
+		if _, ok := original[processType]; !ok {
+			processTypes = append(processTypes, processType)
+		}
+	}
+	sort.Strings(processTypes)
+
+	var changes []ui.Change
+	for _, processType := range processTypes {
+		changes = append(changes, ui.Change{
+			Header:       processType + ":",
+			CurrentValue: original[processType].Command,
+			NewValue:     other[processType].Command,
+		})
+	}
+	return changes
+}
+
+func (displayer RevisionDiffDisplayer) sidecarChanges(original []resources.RevisionSidecar, other []resources.RevisionSidecar) []ui.Change {
+	originalSidecars := sidecarDescriptions(original)
+	otherSidecars := sidecarDescriptions(other)
+
+	var names []string
+	for name := range originalSidecars {
+		names = append(names, name)
+	}
+	for name := range otherSidecars {
+		if _, ok := originalSidecars[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var changes []ui.Change
+	for _, name := range names {
+		changes = append(changes, ui.Change{
+			Header:       name + ":",
+			CurrentValue: originalSidecars[name],
+			NewValue:     otherSidecars[name],
+		})
+	}
+	return changes
+}
+
+func (displayer RevisionDiffDisplayer) metadataChanges(original *resources.Metadata, other *resources.Metadata) []ui.Change {
+	if original == nil {
+		original = &resources.Metadata{}
+	}
+	if other == nil {
+		other = &resources.Metadata{}
+	}
+
+	return []ui.Change{
+		{Header: "labels:", CurrentValue: metadataPairs(original.Labels), NewValue: metadataPairs(other.Labels)},
+		{Header: "annotations:", CurrentValue: metadataPairs(original.Annotations), NewValue: metadataPairs(other.Annotations)},
+	}
+}
+
+// withoutEmptyChanges drops values that are not set on either revision, so
+// that sections only list what the revisions use.
+func withoutEmptyChanges(changes []ui.Change) []ui.Change {
+	var nonEmpty []ui.Change
+	for _, change := range changes {
+		switch current := change.CurrentValue.(type) {
+		case string:
+			if current == "" && change.NewValue.(string) == "" {
+				continue
+			}
+		case []string:
+			if len(current) == 0 && len(change.NewValue.([]string)) == 0 {
+				continue
+			}
+		}
+		nonEmpty = append(nonEmpty, change)
+	}
+	return nonEmpty
+}
+
+func dropletState(droplet resources.Droplet) string {
+	if droplet.State == "" {
+		return "deleted"
+	}
+	return strings.ToLower(string(droplet.State))
+}
+
+func dropletBuildpacks(droplet resources.Droplet) []string {
+	buildpacks := []string{}
+	for _, buildpack := range droplet.Buildpacks {
+		name := buildpack.Name
+		if buildpack.BuildpackName != "" {
+			name = buildpack.BuildpackName
+		}
+		if buildpack.Version != "" {
+			name = fmt.Sprintf("%s %s", name, buildpack.Version)
+		}
+		buildpacks = append(buildpacks, name)
+	}
+	return buildpacks
+}
+
+func sidecarDescriptions(sidecars []resources.RevisionSidecar) map[string]string {
+	descriptions := map[string]string{}
+	for _, sidecar := range sidecars {
+		details := []string{"process types: " + strings.Join(sidecar.ProcessTypes, ", ")}
+		if sidecar.MemoryInMB > 0 {
+			details = append(details, "memory: "+bytefmt.ByteSize(sidecar.MemoryInMB*bytefmt.MEGABYTE))
+		}
+		descriptions[sidecar.Name] = fmt.Sprintf("%s (%s)", sidecar.Command, strings.Join(details, "; "))
+	}
+	return descriptions
+}
+
+func metadataPairs(values map[string]types.NullString) []string {
+	pairs := []string{}
+	for key, value := range values {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", key, value.Value))
+	}
+	sort.Strings(pairs)
+	return pairs
+}
@@ -0,0 +1,133 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("RevisionDiffDisplayer", func() {
+	var (
+		testUI    *ui.UI
+		displayer RevisionDiffDisplayer
+
+		original v7action.RevisionDetails
+		other    v7action.RevisionDetails
+		err      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		displayer = RevisionDiffDisplayer{UI: testUI}
+
+		original = v7action.RevisionDetails{
+			Revision: resources.Revision{
+				Version:   3,
+				Processes: map[string]resources.RevisionProcess{"web": {Command: "bundle exec rackup"}},
+				Sidecars: []resources.RevisionSidecar{
+					{Name: "proxy", Command: "./proxy", ProcessTypes: []string{"web"}, MemoryInMB: 64},
+				},
+				Metadata: &resources.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}},
+			},
+			Droplet: resources.Droplet{
+				GUID:       "droplet-3",
+				State:      constant.DropletStaged,
+				Stack:      "cflinuxfs4",
+				Buildpacks: []resources.DropletBuildpack{{Name: "ruby_buildpack", BuildpackName: "ruby", Version: "1.8.0"}},
+			},
+			EnvironmentVariables: resources.EnvironmentVariables{
+				"SECRET": {Value: "old-secret", IsSet: true},
+				"LEVEL":  {Value: "debug", IsSet: true},
+			},
+		}
+
+		other = v7action.RevisionDetails{
+			Revision: resources.Revision{
+				Version: 5,
+				Processes: map[string]resources.RevisionProcess{
+					"web":    {Command: "bundle exec puma"},
+					"worker": {Command: "bundle exec sidekiq"},
+				},
+				Metadata: &resources.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}},
+			},
+			Droplet: resources.Droplet{
+				GUID:       "droplet-5",
+				State:      constant.DropletStaged,
+				Stack:      "cflinuxfs4",
+				Buildpacks: []resources.DropletBuildpack{{Name: "ruby_buildpack", BuildpackName: "ruby", Version: "1.9.0"}},
+			},
+			EnvironmentVariables: resources.EnvironmentVariables{
+				"SECRET": {Value: "new-secret", IsSet: true},
+				"LEVEL":  {Value: "debug", IsSet: true},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		err = displayer.DisplayDiff(original, other)
+	})
+
+	It("displays the droplet, environment, process, sidecar and metadata changes", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`droplet:`))
+		Expect(testUI.Out).To(Say(`- guid:\s+droplet-3`))
+		Expect(testUI.Out).To(Say(`\+ guid:\s+droplet-5`))
+		Expect(testUI.Out).To(Say(`  state:\s+staged`))
+		Expect(testUI.Out).To(Say(`  stack:\s+cflinuxfs4`))
+		Expect(testUI.Out).To(Say(`  buildpacks:`))
+		Expect(testUI.Out).To(Say(`-   ruby 1.8.0`))
+		Expect(testUI.Out).To(Say(`\+   ruby 1.9.0`))
+
+		Expect(testUI.Out).To(Say(`environment variables:`))
+		Expect(testUI.Out).To(Say(`  LEVEL:\s+\[PRIVATE DATA HIDDEN\]`))
+		Expect(testUI.Out).To(Say(`- SECRET:\s+\[PRIVATE DATA HIDDEN\]`))
+		Expect(testUI.Out).To(Say(`\+ SECRET:\s+\[PRIVATE DATA HIDDEN\]`))
+
+		Expect(testUI.Out).To(Say(`processes:`))
+		Expect(testUI.Out).To(Say(`- web:\s+bundle exec rackup`))
+		Expect(testUI.Out).To(Say(`\+ web:\s+bundle exec puma`))
+		Expect(testUI.Out).To(Say(`\+ worker:\s+bundle exec sidekiq`))
+
+		Expect(testUI.Out).To(Say(`sidecars:`))
+		Expect(testUI.Out).To(Say(`- proxy:\s+\./proxy \(process types: web; memory: 64M\)`))
+
+		Expect(testUI.Out).To(Say(`metadata:`))
+		Expect(testUI.Out).To(Say(`  labels:`))
+		Expect(testUI.Out).To(Say(`    env=prod`))
+
+		Expect(testUI.Out).NotTo(Say(`image:`))
+		Expect(testUI.Out).NotTo(Say(`annotations:`))
+	})
+
+	When("environment variable values are requested", func() {
+		BeforeEach(func() {
+			displayer.ShowEnv = true
+		})
+
+		It("displays them", func() {
+			Expect(testUI.Out).To(Say(`environment variables:`))
+			Expect(testUI.Out).To(Say(`  LEVEL:\s+debug`))
+			Expect(testUI.Out).To(Say(`- SECRET:\s+old-secret`))
+			Expect(testUI.Out).To(Say(`\+ SECRET:\s+new-secret`))
+		})
+	})
+
+	When("a droplet has been deleted", func() {
+		BeforeEach(func() {
+			original.Droplet = resources.Droplet{GUID: "droplet-3"}
+		})
+
+		It("displays its state as deleted", func() {
+			Expect(testUI.Out).To(Say(`- state:\s+deleted`))
+			Expect(testUI.Out).To(Say(`\+ state:\s+staged`))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -8,9 +9,9 @@ import (
 type SpacesCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME spaces [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME spaces\n   CF_NAME spaces --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME spaces --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
-	relatedCommands interface{} `related_commands:"create-space, set-space-role, space, space-users"`
-	Labels          string      `long:"labels" description:"Selector to filter spaces by labels"`
+	usage           interface{}        `usage:"CF_NAME spaces [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME spaces\n   CF_NAME spaces --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME spaces --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
+	relatedCommands interface{}        `related_commands:"create-space, set-space-role, space, space-users"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter spaces by labels"`
 }
 
 func (cmd SpacesCommand) Execute([]string) error {
@@ -30,7 +31,7 @@ func (cmd SpacesCommand) Execute([]string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	spaces, warnings, err := cmd.Actor.GetOrganizationSpacesWithLabelSelector(cmd.Config.TargetedOrganization().GUID, cmd.Labels)
+	spaces, warnings, err := cmd.Actor.GetOrganizationSpacesWithLabelSelector(cmd.Config.TargetedOrganization().GUID, string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
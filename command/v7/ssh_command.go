@@ -1,6 +1,8 @@
 package v7
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -12,23 +14,33 @@ import (
 
 type SharedSSHActor interface {
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellTunnel(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, tunnelOptions sharedaction.SSHTunnelOptions) error
 }
 
+const (
+	tunnelInitialBackoff = time.Second
+	tunnelMaxBackoff     = time.Minute
+)
+
 type SSHCommand struct {
 	BaseCommand
 
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
-	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
-	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
-	ProcessType           string                   `long:"process" default:"web" description:"App process name"`
-	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	RequiredArgs            flag.AppName                    `positional-args:"yes"`
+	ProcessIndex            uint                            `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	Commands                []string                        `long:"command" short:"c" description:"Command to run"`
+	DisablePseudoTTY        bool                            `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	DynamicPortForwardSpecs []flag.SSHDynamicPortForwarding `short:"D" description:"Dynamic (SOCKS5) port forward specification"`
+	ForcePseudoTTY          bool                            `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	LocalPortForwardSpecs   []flag.SSHPortForwarding        `short:"L" description:"Local port forward specification"`
+	ProcessType             string                          `long:"process" default:"web" description:"App process name"`
+	RemotePortForwardSpecs  []flag.SSHRemotePortForwarding  `short:"R" description:"Remote port forward specification"`
+	RequestPseudoTTY        bool                            `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation      bool                            `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution     bool                            `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+	Tunnel                  bool                            `long:"tunnel" description:"Keep port forwards open, reconnecting with a new SSH code whenever the connection drops. Implies --skip-remote-execution"`
+	TunnelMaxAttempts       uint                            `long:"tunnel-max-attempts" description:"Give up after this many consecutive failed reconnect attempts. Retries forever by default"`
+
+	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]...\n   [-R [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT]... [-D [BIND_ADDRESS:]LOCAL_PORT]...\n   [--skip-remote-execution | --tunnel [--tunnel-max-attempts ATTEMPTS]] [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
@@ -62,11 +74,30 @@ func (cmd SSHCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Tunnel {
+		err = cmd.validateTunnelArgs()
+		if err != nil {
+			return err
+		}
+	} else if cmd.TunnelMaxAttempts > 0 {
+		return translatableerror.RequiredFlagsError{Arg1: "--tunnel", Arg2: "--tunnel-max-attempts"}
+	}
+
 	var forwardSpecs []sharedaction.LocalPortForward
 	for _, spec := range cmd.LocalPortForwardSpecs {
 		forwardSpecs = append(forwardSpecs, sharedaction.LocalPortForward(spec))
 	}
 
+	var remoteForwardSpecs []sharedaction.RemotePortForward
+	for _, spec := range cmd.RemotePortForwardSpecs {
+		remoteForwardSpecs = append(remoteForwardSpecs, sharedaction.RemotePortForward(spec))
+	}
+
+	var dynamicForwardSpecs []sharedaction.DynamicPortForward
+	for _, spec := range cmd.DynamicPortForwardSpecs {
+		dynamicForwardSpecs = append(dynamicForwardSpecs, sharedaction.DynamicPortForward(spec))
+	}
+
 	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -78,24 +109,32 @@ func (cmd SSHCommand) Execute(args []string) error {
 		return err
 	}
 
-	err = cmd.SSHActor.ExecuteSecureShell(
-		cmd.SSHClient,
-		sharedaction.SSHOptions{
-			Commands:              cmd.Commands,
-			Endpoint:              sshAuth.Endpoint,
-			HostKeyFingerprint:    sshAuth.HostKeyFingerprint,
-			LocalPortForwardSpecs: forwardSpecs,
-			Passcode:              sshAuth.Passcode,
-			SkipHostValidation:    cmd.SkipHostValidation,
-			SkipRemoteExecution:   cmd.SkipRemoteExecution,
-			TTYOption:             ttyOption,
-			Username:              sshAuth.Username,
+	sshOptions := sharedaction.SSHOptions{
+		Commands:                cmd.Commands,
+		DynamicPortForwardSpecs: dynamicForwardSpecs,
+		Endpoint:                sshAuth.Endpoint,
+		HostKeyFingerprint:      sshAuth.HostKeyFingerprint,
+		LocalPortForwardSpecs:   forwardSpecs,
+		Passcode:                sshAuth.Passcode,
+		RemotePortForwardSpecs:  remoteForwardSpecs,
+		SkipHostValidation:      cmd.SkipHostValidation,
+		SkipRemoteExecution:     cmd.SkipRemoteExecution,
+		TTYOption:               ttyOption,
+		Username:                sshAuth.Username,
+	}
+
+	if cmd.Tunnel {
+		return cmd.SSHActor.ExecuteSecureShellTunnel(cmd.SSHClient, sshOptions, sharedaction.SSHTunnelOptions{
+			RefreshCredentials: cmd.refreshTunnelCredentials,
+			Reconnecting:       cmd.displayTunnelReconnecting,
+			Reconnected:        cmd.displayTunnelReconnected,
+			InitialBackoff:     tunnelInitialBackoff,
+			MaxBackoff:         tunnelMaxBackoff,
+			MaxAttempts:        int(cmd.TunnelMaxAttempts),
 		})
-	if err != nil {
-		return err
 	}
 
-	return nil
+	return cmd.SSHActor.ExecuteSecureShell(cmd.SSHClient, sshOptions)
 }
 
 // EvaluateTTYOption determines which TTY options are mutually exclusive and
@@ -125,3 +164,54 @@ func (cmd SSHCommand) EvaluateTTYOption() (sharedaction.TTYOption, error) {
 
 	return option, nil
 }
+
+func (cmd SSHCommand) validateTunnelArgs() error {
+	if len(cmd.Commands) > 0 {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--tunnel", "--command"}}
+	}
+
+	if len(cmd.LocalPortForwardSpecs) == 0 && len(cmd.RemotePortForwardSpecs) == 0 && len(cmd.DynamicPortForwardSpecs) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: "--tunnel", Arg2: "-L, -R or -D"}
+	}
+
+	return nil
+}
+
+func (cmd SSHCommand) refreshTunnelCredentials() (sharedaction.SSHCredentials, error) {
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationForRunningInstance(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return sharedaction.SSHCredentials{}, err
+	}
+
+	return sharedaction.SSHCredentials{
+		Username:           sshAuth.Username,
+		Passcode:           sshAuth.Passcode,
+		Endpoint:           sshAuth.Endpoint,
+		HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+	}, nil
+}
+
+func (cmd SSHCommand) displayTunnelReconnecting(reason error, delay time.Duration) {
+	reasonText := "connection closed"
+	if reason != nil {
+		reasonText = reason.Error()
+	}
+
+	cmd.UI.DisplayWarning("SSH tunnel to {{.AppName}} lost ({{.Reason}}). Reconnecting in {{.Delay}}...", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+		"Reason":  reasonText,
+		"Delay":   delay,
+	})
+}
+
+func (cmd SSHCommand) displayTunnelReconnected() {
+	cmd.UI.DisplayWarning("SSH tunnel to {{.AppName}} reconnected.", map[string]interface{}{
+		"AppName": cmd.RequiredArgs.AppName,
+	})
+}
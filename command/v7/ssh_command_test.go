@@ -2,6 +2,7 @@ package v7_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -157,6 +158,168 @@ var _ = Describe("ssh Command", func() {
 							}))
 						})
 					})
+
+					When("working with remote and dynamic port forwarding", func() {
+						BeforeEach(func() {
+							cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{
+								{RemoteAddress: "localhost:9999", LocalAddress: "local:5432"},
+							}
+							cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{
+								{LocalAddress: "localhost:1080"},
+							}
+						})
+
+						It("passes along port forwarding information", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(1))
+							_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+							Expect(sshOptionsArg.RemotePortForwardSpecs).To(Equal([]sharedaction.RemotePortForward{
+								{RemoteAddress: "localhost:9999", LocalAddress: "local:5432"},
+							}))
+							Expect(sshOptionsArg.DynamicPortForwardSpecs).To(Equal([]sharedaction.DynamicPortForward{
+								{LocalAddress: "localhost:1080"},
+							}))
+						})
+					})
+				})
+
+				When("the tunnel flag is provided", func() {
+					BeforeEach(func() {
+						cmd.Tunnel = true
+						cmd.Commands = nil
+						cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{
+							{LocalAddress: "localhost:8888", RemoteAddress: "remote:4444"},
+						}
+					})
+
+					It("executes the secure shell tunnel", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+						Expect(fakeSSHActor.ExecuteSecureShellTunnelCallCount()).To(Equal(1))
+						_, sshOptionsArg, tunnelOptionsArg := fakeSSHActor.ExecuteSecureShellTunnelArgsForCall(0)
+						Expect(sshOptionsArg.Username).To(Equal("some-username"))
+						Expect(sshOptionsArg.LocalPortForwardSpecs).To(Equal([]sharedaction.LocalPortForward{
+							{LocalAddress: "localhost:8888", RemoteAddress: "remote:4444"},
+						}))
+						Expect(tunnelOptionsArg.InitialBackoff).To(Equal(time.Second))
+						Expect(tunnelOptionsArg.MaxBackoff).To(Equal(time.Minute))
+						Expect(tunnelOptionsArg.MaxAttempts).To(Equal(0))
+					})
+
+					When("a maximum number of reconnect attempts is provided", func() {
+						BeforeEach(func() {
+							cmd.TunnelMaxAttempts = 5
+						})
+
+						It("passes it to the secure shell tunnel", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							_, _, tunnelOptionsArg := fakeSSHActor.ExecuteSecureShellTunnelArgsForCall(0)
+							Expect(tunnelOptionsArg.MaxAttempts).To(Equal(5))
+						})
+					})
+
+					Describe("refreshing credentials", func() {
+						var (
+							credentials sharedaction.SSHCredentials
+							refreshErr  error
+						)
+
+						JustBeforeEach(func() {
+							_, _, tunnelOptionsArg := fakeSSHActor.ExecuteSecureShellTunnelArgsForCall(0)
+							credentials, refreshErr = tunnelOptionsArg.RefreshCredentials()
+						})
+
+						When("a running instance is found", func() {
+							BeforeEach(func() {
+								fakeActor.GetSecureShellConfigurationForRunningInstanceReturns(v7action.SSHAuthentication{
+									Endpoint:           "some-endpoint",
+									HostKeyFingerprint: "some-fingerprint",
+									Passcode:           "some-new-passcode",
+									Username:           "some-other-username",
+								}, v7action.Warnings{"some-refresh-warnings"}, nil)
+							})
+
+							It("fetches a new SSH code for a running instance", func() {
+								Expect(refreshErr).ToNot(HaveOccurred())
+								Expect(credentials).To(Equal(sharedaction.SSHCredentials{
+									Endpoint:           "some-endpoint",
+									HostKeyFingerprint: "some-fingerprint",
+									Passcode:           "some-new-passcode",
+									Username:           "some-other-username",
+								}))
+								Expect(testUI.Err).To(Say("some-refresh-warnings"))
+
+								Expect(fakeActor.GetSecureShellConfigurationForRunningInstanceCallCount()).To(Equal(1))
+								appNameArg, spaceGUIDArg, processTypeArg, processIndexArg := fakeActor.GetSecureShellConfigurationForRunningInstanceArgsForCall(0)
+								Expect(appNameArg).To(Equal(appName))
+								Expect(spaceGUIDArg).To(Equal("some-space-guid"))
+								Expect(processTypeArg).To(Equal("some-process-type"))
+								Expect(processIndexArg).To(Equal(uint(1)))
+							})
+						})
+
+						When("no running instance is found", func() {
+							BeforeEach(func() {
+								fakeActor.GetSecureShellConfigurationForRunningInstanceReturns(v7action.SSHAuthentication{}, nil, errors.New("not running"))
+							})
+
+							It("returns the error", func() {
+								Expect(refreshErr).To(MatchError("not running"))
+							})
+						})
+					})
+
+					It("reports reconnect attempts", func() {
+						_, _, tunnelOptionsArg := fakeSSHActor.ExecuteSecureShellTunnelArgsForCall(0)
+
+						tunnelOptionsArg.Reconnecting(errors.New("EOF"), 2*time.Second)
+						Expect(testUI.Err).To(Say(`SSH tunnel to some-app lost \(EOF\)\. Reconnecting in 2s\.\.\.`))
+
+						tunnelOptionsArg.Reconnected()
+						Expect(testUI.Err).To(Say(`SSH tunnel to some-app reconnected\.`))
+					})
+
+					When("a command is also provided", func() {
+						BeforeEach(func() {
+							cmd.Commands = []string{"some-command"}
+						})
+
+						It("returns an ArgumentCombinationError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+								Args: []string{"--tunnel", "--command"},
+							}))
+							Expect(fakeSSHActor.ExecuteSecureShellTunnelCallCount()).To(Equal(0))
+						})
+					})
+
+					When("no port forwards are provided", func() {
+						BeforeEach(func() {
+							cmd.LocalPortForwardSpecs = nil
+						})
+
+						It("returns a RequiredFlagsError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+								Arg1: "--tunnel",
+								Arg2: "-L, -R or -D",
+							}))
+						})
+					})
+				})
+
+				When("a maximum number of reconnect attempts is provided without the tunnel flag", func() {
+					BeforeEach(func() {
+						cmd.TunnelMaxAttempts = 5
+					})
+
+					It("returns a RequiredFlagsError", func() {
+						Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+							Arg1: "--tunnel",
+							Arg2: "--tunnel-max-attempts",
+						}))
+						Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+					})
 				})
 
 				When("executing the secure shell fails", func() {
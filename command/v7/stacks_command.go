@@ -3,6 +3,7 @@ package v7
 import (
 	"sort"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/sorting"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -11,9 +12,9 @@ import (
 type StacksCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME stacks [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME stacks\n   CF_NAME stacks --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME stacks --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
-	relatedCommands interface{} `related_commands:"create-buildpack, delete-buildpack, rename-buildpack, stack, update-buildpack"`
-	Labels          string      `long:"labels" description:"Selector to filter stacks by labels"`
+	usage           interface{}        `usage:"CF_NAME stacks [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME stacks\n   CF_NAME stacks --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME stacks --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
+	relatedCommands interface{}        `related_commands:"create-buildpack, delete-buildpack, rename-buildpack, stack, update-buildpack"`
+	Labels          flag.LabelSelector `long:"labels" description:"Selector to filter stacks by labels"`
 }
 
 func (cmd StacksCommand) Execute(args []string) error {
@@ -32,7 +33,7 @@ func (cmd StacksCommand) Execute(args []string) error {
 	})
 	cmd.UI.DisplayNewline()
 
-	stacks, warnings, err := cmd.Actor.GetStacks(cmd.Labels)
+	stacks, warnings, err := cmd.Actor.GetStacks(string(cmd.Labels))
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -107,7 +108,7 @@ var _ = Describe("stacks Command", func() {
 			When("the --labels flag is given", func() {
 				labelsFlagValue := "some-label-selector"
 				BeforeEach(func() {
-					cmd.Labels = labelsFlagValue
+					cmd.Labels = flag.LabelSelector(labelsFlagValue)
 				})
 				It("passes the label selector to GetStacks", func() {
 					labelSelector := fakeActor.GetStacksArgsForCall(0)
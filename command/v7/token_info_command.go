@@ -0,0 +1,126 @@
+package v7
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+)
+
+type TokenInfoCommand struct {
+	BaseCommand
+
+	Validate        bool        `long:"validate" description:"Verify the token signatures against the signing keys published by UAA"`
+	usage           interface{} `usage:"CF_NAME token-info [--validate]\n\nEXAMPLES:\n   CF_NAME token-info\n   CF_NAME token-info --validate"`
+	relatedCommands interface{} `related_commands:"auth, login, oauth-token"`
+}
+
+func (cmd TokenInfoCommand) Execute(_ []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	accessToken := cmd.Config.AccessToken()
+	accessTokenInfo, err := cmd.Actor.DecodeToken(accessToken)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Access token:")
+	signatureErr := cmd.displayToken(accessToken, accessTokenInfo)
+	if signatureErr != nil && !isTokenSignatureError(signatureErr) {
+		return signatureErr
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Refresh token:")
+
+	refreshToken := cmd.Config.RefreshToken()
+	if refreshToken == "" {
+		cmd.UI.DisplayText("No refresh token is stored.")
+		return signatureErr
+	}
+
+	refreshTokenInfo, err := cmd.Actor.DecodeToken(refreshToken)
+	if err != nil {
+		cmd.UI.DisplayText("The refresh token is opaque and can only be inspected by UAA.")
+		return signatureErr
+	}
+
+	err = cmd.displayToken(refreshToken, refreshTokenInfo)
+	if signatureErr == nil {
+		signatureErr = err
+	}
+	return signatureErr
+}
+
+// displayToken displays the decoded token. When --validate is set it also
+// checks the token signature, and returns the error if the signature is
+// invalid or could not be checked.
+func (cmd TokenInfoCommand) displayToken(token string, info v7action.TokenInfo) error {
+	user := info.UserName
+	if info.UserID != "" {
+		user = cmd.UI.TranslateText("{{.UserName}} ({{.UserID}})", map[string]interface{}{
+			"UserName": info.UserName,
+			"UserID":   info.UserID,
+		})
+	}
+
+	table := [][]string{
+		{cmd.UI.TranslateText("issuer:"), info.Issuer},
+		{cmd.UI.TranslateText("client:"), info.ClientID},
+		{cmd.UI.TranslateText("user:"), user},
+		{cmd.UI.TranslateText("origin:"), info.Origin},
+		{cmd.UI.TranslateText("grant type:"), info.GrantType},
+		{cmd.UI.TranslateText("scopes:"), strings.Join(info.Scopes, ", ")},
+		{cmd.UI.TranslateText("issued at:"), cmd.formatTime(info.IssuedAt)},
+		{cmd.UI.TranslateText("expires at:"), cmd.formatTime(info.ExpiresAt)},
+		{cmd.UI.TranslateText("time remaining:"), cmd.formatTimeRemaining(info, time.Now())},
+	}
+
+	var signatureErr error
+	if cmd.Validate {
+		signatureErr = cmd.Actor.ValidateTokenSignature(token)
+		if signatureErr != nil && !isTokenSignatureError(signatureErr) {
+			return signatureErr
+		}
+
+		signature := cmd.UI.TranslateText("valid")
+		if signatureErr != nil {
+			signature = cmd.UI.TranslateText("invalid")
+		}
+		table = append(table, []string{cmd.UI.TranslateText("signature:"), signature})
+	}
+
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+	return signatureErr
+}
+
+func (cmd TokenInfoCommand) formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return cmd.UI.UserFriendlyDate(t)
+}
+
+func (cmd TokenInfoCommand) formatTimeRemaining(info v7action.TokenInfo, now time.Time) string {
+	switch {
+	case info.ExpiresAt.IsZero():
+		return cmd.UI.TranslateText("does not expire")
+	case info.Expired(now):
+		return cmd.UI.TranslateText("expired")
+	default:
+		return info.TimeRemaining(now).Truncate(time.Second).String()
+	}
+}
+
+func isTokenSignatureError(err error) bool {
+	switch err.(type) {
+	case actionerror.InvalidTokenSignatureError, actionerror.TokenSigningKeyNotFoundError:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,190 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("token-info command", func() {
+	var (
+		cmd             TokenInfoCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		binaryName      string
+		executeErr      error
+
+		accessTokenInfo v7action.TokenInfo
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = TokenInfoCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.AccessTokenReturns("bearer some-access-token")
+		fakeConfig.RefreshTokenReturns("some-refresh-token")
+
+		accessTokenInfo = v7action.TokenInfo{
+			Issuer:    "https://uaa.example.com/oauth/token",
+			ClientID:  "cf",
+			UserName:  "some-user",
+			UserID:    "some-user-guid",
+			Origin:    "uaa",
+			GrantType: "password",
+			Scopes:    []string{"cloud_controller.read", "openid"},
+			IssuedAt:  time.Now().Add(-time.Minute),
+			ExpiresAt: time.Now().Add(time.Hour),
+		}
+
+		fakeActor.DecodeTokenStub = func(token string) (v7action.TokenInfo, error) {
+			if token == "bearer some-access-token" {
+				return accessTokenInfo, nil
+			}
+			return v7action.TokenInfo{}, errors.New("not a JWT")
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("displays the decoded access token", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Access token:`))
+		Expect(testUI.Out).To(Say(`issuer:\s+https://uaa.example.com/oauth/token`))
+		Expect(testUI.Out).To(Say(`client:\s+cf`))
+		Expect(testUI.Out).To(Say(`user:\s+some-user \(some-user-guid\)`))
+		Expect(testUI.Out).To(Say(`origin:\s+uaa`))
+		Expect(testUI.Out).To(Say(`grant type:\s+password`))
+		Expect(testUI.Out).To(Say(`scopes:\s+cloud_controller.read, openid`))
+		Expect(testUI.Out).To(Say(`issued at:\s+%s`, testUI.UserFriendlyDate(accessTokenInfo.IssuedAt)))
+		Expect(testUI.Out).To(Say(`expires at:\s+%s`, testUI.UserFriendlyDate(accessTokenInfo.ExpiresAt)))
+		Expect(testUI.Out).To(Say(`time remaining:\s+59m\d+s`))
+		Expect(testUI.Out).NotTo(Say(`signature:`))
+
+		Expect(fakeActor.ValidateTokenSignatureCallCount()).To(Equal(0))
+	})
+
+	It("explains that an opaque refresh token cannot be decoded", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Refresh token:\nThe refresh token is opaque and can only be inspected by UAA.`))
+	})
+
+	When("the access token cannot be decoded", func() {
+		BeforeEach(func() {
+			fakeActor.DecodeTokenStub = nil
+			fakeActor.DecodeTokenReturns(v7action.TokenInfo{}, errors.New("not a JWT"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not a JWT"))
+		})
+	})
+
+	When("the access token has expired", func() {
+		BeforeEach(func() {
+			accessTokenInfo.ExpiresAt = time.Now().Add(-time.Minute)
+		})
+
+		It("displays that it has expired", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`time remaining:\s+expired`))
+		})
+	})
+
+	When("the refresh token is a JWT", func() {
+		BeforeEach(func() {
+			fakeActor.DecodeTokenStub = func(token string) (v7action.TokenInfo, error) {
+				if token == "some-refresh-token" {
+					return v7action.TokenInfo{ClientID: "refresh-client"}, nil
+				}
+				return accessTokenInfo, nil
+			}
+		})
+
+		It("displays the decoded refresh token", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Refresh token:`))
+			Expect(testUI.Out).To(Say(`client:\s+refresh-client`))
+			Expect(testUI.Out).To(Say(`time remaining:\s+does not expire`))
+		})
+	})
+
+	When("--validate is passed", func() {
+		BeforeEach(func() {
+			cmd.Validate = true
+		})
+
+		When("the signature is valid", func() {
+			It("displays that the signature is valid", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(testUI.Out).To(Say(`signature:\s+valid`))
+
+				Expect(fakeActor.ValidateTokenSignatureCallCount()).To(Equal(1))
+				Expect(fakeActor.ValidateTokenSignatureArgsForCall(0)).To(Equal("bearer some-access-token"))
+			})
+		})
+
+		When("the signature is invalid", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateTokenSignatureReturns(actionerror.InvalidTokenSignatureError{Reason: "crypto/rsa: verification error"})
+			})
+
+			It("displays the token and returns the error", func() {
+				Expect(testUI.Out).To(Say(`signature:\s+invalid`))
+				Expect(testUI.Out).To(Say(`Refresh token:`))
+				Expect(executeErr).To(MatchError(actionerror.InvalidTokenSignatureError{Reason: "crypto/rsa: verification error"}))
+			})
+		})
+
+		When("the signing keys cannot be fetched", func() {
+			BeforeEach(func() {
+				fakeActor.ValidateTokenSignatureReturns(errors.New("uaa unavailable"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("uaa unavailable"))
+				Expect(testUI.Out).NotTo(Say(`issuer:`))
+			})
+		})
+	})
+})
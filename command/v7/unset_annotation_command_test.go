@@ -0,0 +1,46 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("unset-annotation command", func() {
+	var (
+		cmd                    UnsetAnnotationCommand
+		fakeAnnotationUnsetter *v7fakes.FakeLabelUnsetter
+	)
+
+	BeforeEach(func() {
+		fakeAnnotationUnsetter = new(v7fakes.FakeLabelUnsetter)
+		cmd = UnsetAnnotationCommand{
+			RequiredArgs: flag.UnsetAnnotationArgs{
+				ResourceType:   "app",
+				ResourceName:   "some-app",
+				AnnotationKeys: []string{"contact", "description"},
+			},
+			BuildpackStack:     "some-stack",
+			AnnotationUnsetter: fakeAnnotationUnsetter,
+		}
+	})
+
+	It("unsets the annotations", func() {
+		Expect(cmd.Execute(nil)).To(Succeed())
+
+		Expect(fakeAnnotationUnsetter.ExecuteCallCount()).To(Equal(1))
+		targetResource, annotations := fakeAnnotationUnsetter.ExecuteArgsForCall(0)
+		Expect(targetResource).To(Equal(TargetResource{
+			ResourceType:   "app",
+			ResourceName:   "some-app",
+			BuildpackStack: "some-stack",
+		}))
+		Expect(annotations).To(Equal(map[string]types.NullString{
+			"contact":     types.NewNullString(),
+			"description": types.NewNullString(),
+		}))
+	})
+})
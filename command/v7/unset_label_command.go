@@ -15,11 +15,14 @@ type LabelUnsetter interface {
 type UnsetLabelCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.UnsetLabelArgs `positional-args:"yes"`
-	relatedCommands interface{}         `related_commands:"labels, set-label"`
-	BuildpackStack  string              `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
-	ServiceBroker   string              `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
-	ServiceOffering string              `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+	RequiredArgs    flag.UnsetLabelArgs      `positional-args:"yes"`
+	relatedCommands interface{}              `related_commands:"labels, set-label"`
+	BuildpackStack  string                   `long:"stack" short:"s" description:"Specify stack to disambiguate buildpacks with the same name"`
+	ServiceBroker   string                   `long:"broker" short:"b" description:"Specify a service broker to disambiguate service offerings or service plans with the same name."`
+	ServiceOffering string                   `long:"offering" short:"e" description:"Specify a service offering to disambiguate service plans with the same name."`
+	Selector        flag.LabelUpdateSelector `long:"selector" description:"Unset the labels on every resource of this type matching a label selector, instead of a named resource"`
+	Scope           flag.LabelSelectorScope  `long:"scope" description:"Where to look for resources matching --selector: space, org or all (Default: space for apps, routes and service instances, org for spaces, all otherwise)"`
+	Force           bool                     `short:"f" description:"Update resources matching --selector without confirmation"`
 
 	LabelUnsetter LabelUnsetter
 }
@@ -41,30 +44,42 @@ func (cmd *UnsetLabelCommand) Setup(config command.Config, ui command.UI) error
 }
 
 func (cmd UnsetLabelCommand) Execute(args []string) error {
-	labels := make(map[string]types.NullString)
-	for _, value := range cmd.RequiredArgs.LabelKeys {
-		labels[value] = types.NewNullString()
+	resourceName, labelKeys, err := labelUpdateArgs(cmd.RequiredArgs.ResourceName, cmd.RequiredArgs.LabelKeys, cmd.Selector, "KEY")
+	if err != nil {
+		return err
 	}
 
 	targetResource := TargetResource{
 		ResourceType:    cmd.RequiredArgs.ResourceType,
-		ResourceName:    cmd.RequiredArgs.ResourceName,
+		ResourceName:    resourceName,
 		BuildpackStack:  cmd.BuildpackStack,
 		ServiceBroker:   cmd.ServiceBroker,
 		ServiceOffering: cmd.ServiceOffering,
+		LabelSelector:   string(cmd.Selector.Selector),
+		Scope:           cmd.Scope,
+		Force:           cmd.Force,
 	}
+
+	labels := make(map[string]types.NullString)
+	for _, value := range labelKeys {
+		labels[value] = types.NewNullString()
+	}
+
 	return cmd.LabelUnsetter.Execute(targetResource, labels)
 }
 
 func (cmd UnsetLabelCommand) Usage() string {
-	return `CF_NAME unset-label RESOURCE RESOURCE_NAME KEY...`
+	return `CF_NAME unset-label RESOURCE RESOURCE_NAME KEY...
+   CF_NAME unset-label RESOURCE --selector SELECTOR [--scope (space | org | all)] [-f] KEY...`
 }
 
 func (cmd UnsetLabelCommand) Examples() string {
 	return `
 cf unset-label app dora ci_signature_sha2
 cf unset-label org business pci public-facing
-cf unset-label buildpack go_buildpack go -s cflinuxfs3`
+cf unset-label buildpack go_buildpack go -s cflinuxfs3
+cf unset-label app --selector 'team=payments' cost-center
+cf unset-label route --selector 'env=dev' --scope org -f tier`
 }
 
 func (cmd UnsetLabelCommand) Resources() string {
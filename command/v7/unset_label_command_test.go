@@ -2,9 +2,11 @@ package v7_test
 
 import (
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -19,6 +21,7 @@ var _ = Describe("unset-label command", func() {
 	)
 
 	BeforeEach(func() {
+		resourceName = "some-resource"
 		fakeLabelSetter = new(v7fakes.FakeLabelUnsetter)
 		cmd = v7.UnsetLabelCommand{
 			LabelUnsetter: fakeLabelSetter,
@@ -26,7 +29,7 @@ var _ = Describe("unset-label command", func() {
 
 		cmd.RequiredArgs = flag.UnsetLabelArgs{
 			ResourceType: "anything",
-			ResourceName: resourceName,
+			ResourceName: flag.LabelResourceName{Name: resourceName},
 			LabelKeys:    []string{"FOO", "ENV"},
 		}
 		cmd.BuildpackStack = "some-stack"
@@ -41,7 +44,7 @@ var _ = Describe("unset-label command", func() {
 		Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(1))
 		targetResource, keys := fakeLabelSetter.ExecuteArgsForCall(0)
 		Expect(targetResource.ResourceType).To(Equal(cmd.RequiredArgs.ResourceType))
-		Expect(targetResource.ResourceName).To(Equal(cmd.RequiredArgs.ResourceName))
+		Expect(targetResource.ResourceName).To(Equal(cmd.RequiredArgs.ResourceName.Name))
 		Expect(targetResource.BuildpackStack).To(Equal(cmd.BuildpackStack))
 		Expect(targetResource.ServiceBroker).To(Equal(cmd.ServiceBroker))
 		Expect(targetResource.ServiceOffering).To(Equal(cmd.ServiceOffering))
@@ -50,4 +53,92 @@ var _ = Describe("unset-label command", func() {
 			"ENV": types.NewNullString(),
 		}))
 	})
+
+	When("no label keys are provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.LabelKeys = nil
+		})
+
+		It("returns a required argument error", func() {
+			executeErr = cmd.Execute(nil)
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "KEY"}))
+			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("no resource name is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.UnsetLabelArgs{
+				ResourceType: "app",
+			}
+		})
+
+		It("returns a required argument error for the resource name", func() {
+			executeErr = cmd.Execute(nil)
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "RESOURCE_NAME"}))
+			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a resource name is given before the label selector", func() {
+		BeforeEach(func() {
+			cmd = v7.UnsetLabelCommand{
+				LabelUnsetter: fakeLabelSetter,
+			}
+			_, err := flags.ParseArgs(&cmd, []string{"app", "my-app", "--selector", "env=dev", "ENV"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an argument combination error", func() {
+			executeErr = cmd.Execute(nil)
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"RESOURCE_NAME", "--selector"},
+			}))
+			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a label selector is given without label keys", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.UnsetLabelArgs{
+				ResourceType: "app",
+			}
+			cmd.Selector = flag.LabelUpdateSelector{Selector: "env=dev"}
+		})
+
+		It("returns a required argument error for the label keys", func() {
+			executeErr = cmd.Execute(nil)
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "KEY"}))
+			Expect(fakeLabelSetter.ExecuteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a label selector is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.UnsetLabelArgs{
+				ResourceType: "route",
+				ResourceName: flag.LabelResourceName{Name: "FOO"},
+				LabelKeys:    []string{"ENV"},
+			}
+			cmd.BuildpackStack = ""
+			cmd.ServiceBroker = ""
+			cmd.ServiceOffering = ""
+			cmd.Selector = flag.LabelUpdateSelector{Selector: "env=dev"}
+		})
+
+		It("treats every positional argument after the resource type as a label key", func() {
+			executeErr = cmd.Execute(nil)
+
+			Expect(executeErr).ToNot(HaveOccurred())
+			targetResource, keys := fakeLabelSetter.ExecuteArgsForCall(0)
+			Expect(targetResource).To(Equal(v7.TargetResource{
+				ResourceType:  "route",
+				LabelSelector: "env=dev",
+			}))
+			Expect(keys).To(Equal(map[string]types.NullString{
+				"FOO": types.NewNullString(),
+				"ENV": types.NewNullString(),
+			}))
+		})
+	})
 })
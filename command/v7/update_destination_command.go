@@ -5,15 +5,18 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
 )
 
 type UpdateDestinationCommand struct {
 	BaseCommand
 
-	RequiredArgs flag.AppDomain   `positional-args:"yes"`
-	Hostname     string           `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
-	AppProtocol  string           `long:"app-protocol" description:"New Protocol for the route destination (http1 or http2). Only applied to HTTP routes"`
-	Path         flag.V7RoutePath `long:"path" description:"Path for the HTTP route"`
+	RequiredArgs flag.AppDomain       `positional-args:"yes"`
+	Hostname     string               `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	AppProtocol  string               `long:"app-protocol" description:"New Protocol for the route destination (http1 or http2). Only applied to HTTP routes"`
+	Path         flag.V7RoutePath     `long:"path" description:"Path for the HTTP route"`
+	Weight       flag.PositiveInteger `long:"weight" description:"New percentage of the route's traffic to send to the app (1-100). The route's other destinations share the rest"`
 
 	relatedCommands interface{} `related_commands:"routes, map-route, create-route, unmap-route"`
 }
@@ -21,16 +24,26 @@ type UpdateDestinationCommand struct {
 func (cmd UpdateDestinationCommand) Usage() string {
 	return `
 Edit an existing HTTP route:
-   CF_NAME update-destination APP_NAME DOMAIN [--hostname HOSTNAME] [--app-protocol PROTOCOL] [--path PATH]`
+   CF_NAME update-destination APP_NAME DOMAIN [--hostname HOSTNAME] [--app-protocol PROTOCOL] [--path PATH]
+
+Change the share of an HTTP route's traffic sent to an app:
+   CF_NAME update-destination APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] --weight WEIGHT`
 }
 
 func (cmd UpdateDestinationCommand) Examples() string {
 	return `
 CF_NAME update-destination my-app example.com --hostname myhost --app-protocol http2                   # myhost.example.com
-CF_NAME update destination my-app example.com --hostname myhost --path foo --app-protocol http2        # myhost.example.com/foo`
+CF_NAME update destination my-app example.com --hostname myhost --path foo --app-protocol http2        # myhost.example.com/foo
+CF_NAME update-destination my-app example.com --hostname myhost --weight 50                            # myhost.example.com, 50% of traffic to my-app`
 }
 
 func (cmd UpdateDestinationCommand) Execute(args []string) error {
+	if cmd.Weight.Value != 0 && cmd.AppProtocol != "" {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--weight", "--app-protocol"},
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -74,6 +87,10 @@ func (cmd UpdateDestinationCommand) Execute(args []string) error {
 		}
 	}
 
+	if cmd.Weight.Value != 0 {
+		return cmd.updateWeight(route, dest, url, user.Name)
+	}
+
 	if cmd.AppProtocol == "" {
 		cmd.AppProtocol = "http1"
 	}
@@ -115,3 +132,34 @@ func (cmd UpdateDestinationCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd UpdateDestinationCommand) updateWeight(route resources.Route, dest resources.RouteDestination, url string, userName string) error {
+	if dest.Weight.IsSet && dest.Weight.Value == int(cmd.Weight.Value) {
+		cmd.UI.DisplayText("App '{{ .AppName }}' already gets {{ .Weight }}% of the traffic for route '{{ .URL }}'. Nothing has been updated.", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.App,
+			"Weight":  cmd.Weight.Value,
+			"URL":     url,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating destination weight to {{.Weight}}% for app {{.AppName}} on route {{.URL}} in org {{.OrgName}} / space {{.SpaceName}} as {{.User}}...",
+		map[string]interface{}{
+			"Weight":    cmd.Weight.Value,
+			"AppName":   cmd.RequiredArgs.App,
+			"URL":       url,
+			"User":      userName,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+		})
+
+	warnings, err := cmd.Actor.UpdateDestinationWeight(route, dest.GUID, int(cmd.Weight.Value))
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayOK()
+
+	return nil
+}
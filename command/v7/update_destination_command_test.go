@@ -6,9 +6,11 @@ import (
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo/v2"
@@ -74,6 +76,19 @@ var _ = Describe("update Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("--weight and --app-protocol are both given", func() {
+		BeforeEach(func() {
+			cmd.Weight = flag.PositiveInteger{Value: 30}
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--weight", "--app-protocol"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	It("checks the target", func() {
 		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
 		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
@@ -373,6 +388,63 @@ var _ = Describe("update Command", func() {
 							})
 						})
 					})
+
+					When("updating the weight", func() {
+						BeforeEach(func() {
+							cmd.AppProtocol = ""
+							cmd.Weight = flag.PositiveInteger{Value: 30}
+							fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+							fakeActor.GetRouteDestinationByAppGUIDReturns(
+								resources.RouteDestination{
+									GUID:   "route-dst-guid",
+									App:    resources.RouteDestinationApp{GUID: "app-guid"},
+									Weight: types.NullInt{IsSet: true, Value: 50},
+								},
+								nil,
+							)
+							fakeActor.UpdateDestinationWeightReturns(v7action.Warnings{"update-weight-warnings"}, nil)
+						})
+
+						It("updates the weight of the destination", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say(`Updating destination weight to 30% for app super-app on route hostname\.some-domain\.compath in org some-org / space some-space as some-user\.\.\.`))
+							Expect(testUI.Out).To(Say("OK"))
+							Expect(testUI.Err).To(Say("update-weight-warnings"))
+
+							Expect(fakeActor.UpdateDestinationWeightCallCount()).To(Equal(1))
+							route, destinationGUID, weight := fakeActor.UpdateDestinationWeightArgsForCall(0)
+							Expect(route.GUID).To(Equal("route-guid"))
+							Expect(destinationGUID).To(Equal("route-dst-guid"))
+							Expect(weight).To(Equal(30))
+
+							Expect(fakeActor.UpdateDestinationCallCount()).To(Equal(0))
+						})
+
+						When("the destination already has the weight", func() {
+							BeforeEach(func() {
+								cmd.Weight = flag.PositiveInteger{Value: 50}
+							})
+
+							It("does not update anything", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+								Expect(testUI.Out).To(Say(`App 'super-app' already gets 50% of the traffic for route 'hostname\.some-domain\.compath'\. Nothing has been updated\.`))
+								Expect(testUI.Out).To(Say("OK"))
+								Expect(fakeActor.UpdateDestinationWeightCallCount()).To(Equal(0))
+							})
+						})
+
+						When("the weight cannot be set", func() {
+							BeforeEach(func() {
+								fakeActor.UpdateDestinationWeightReturns(v7action.Warnings{"update-weight-warnings"}, actionerror.RouteDestinationWeightError{Weight: 30})
+							})
+
+							It("returns the error and displays warnings", func() {
+								Expect(executeErr).To(MatchError(actionerror.RouteDestinationWeightError{Weight: 30}))
+								Expect(testUI.Err).To(Say("update-weight-warnings"))
+							})
+						})
+					})
 				})
 			})
 		})
@@ -0,0 +1,46 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type UpdateRouterGroupCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.RouterGroup `positional-args:"yes"`
+	ReservablePorts string           `long:"reservable-ports" required:"true" description:"Comma-separated list of ports and port ranges that TCP routes can use, for example 1024-1199,1300"`
+
+	usage           interface{} `usage:"CF_NAME update-router-group ROUTER_GROUP --reservable-ports PORTS\n\nEXAMPLES:\n   CF_NAME update-router-group default-tcp --reservable-ports 1024-1199\n   CF_NAME update-router-group default-tcp --reservable-ports 1024-1199,2000-2099,3000"`
+	relatedCommands interface{} `related_commands:"router-group-ports, router-groups"`
+}
+
+func (cmd UpdateRouterGroupCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	currentUser, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating reservable ports of router group {{.RouterGroup}} to {{.ReservablePorts}} as {{.CurrentUser}}...", map[string]interface{}{
+		"RouterGroup":     cmd.RequiredArgs.RouterGroup,
+		"ReservablePorts": cmd.ReservablePorts,
+		"CurrentUser":     currentUser.Name,
+	})
+
+	routerGroup, warnings, err := cmd.Actor.UpdateRouterGroupReservablePorts(cmd.RequiredArgs.RouterGroup, cmd.ReservablePorts)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Reservable ports are now {{.ReservablePorts}}.", map[string]interface{}{
+		"ReservablePorts": routerGroup.ReservablePorts,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
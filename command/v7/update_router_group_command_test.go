@@ -0,0 +1,107 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-router-group Command", func() {
+	var (
+		cmd             UpdateRouterGroupCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		binaryName      string
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = UpdateRouterGroupCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs:    flag.RouterGroup{RouterGroup: "default-tcp"},
+			ReservablePorts: "1024-1199,2000",
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "banana"}, nil)
+		fakeActor.UpdateRouterGroupReservablePortsReturns(
+			v7action.RouterGroup{Name: "default-tcp", ReservablePorts: "1024-1199,2000"},
+			v7action.Warnings{"update-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("updates the reservable ports", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeActor.UpdateRouterGroupReservablePortsCallCount()).To(Equal(1))
+		routerGroupName, reservablePorts := fakeActor.UpdateRouterGroupReservablePortsArgsForCall(0)
+		Expect(routerGroupName).To(Equal("default-tcp"))
+		Expect(reservablePorts).To(Equal("1024-1199,2000"))
+
+		Expect(testUI.Out).To(Say(`Updating reservable ports of router group default-tcp to 1024-1199,2000 as banana\.\.\.`))
+		Expect(testUI.Out).To(Say(`Reservable ports are now 1024-1199,2000\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("update-warning"))
+	})
+
+	When("updating the router group fails", func() {
+		BeforeEach(func() {
+			fakeActor.UpdateRouterGroupReservablePortsReturns(
+				v7action.RouterGroup{},
+				v7action.Warnings{"update-warning"},
+				errors.New("update-error"),
+			)
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("update-error"))
+			Expect(testUI.Err).To(Say("update-warning"))
+			Expect(testUI.Out).NotTo(Say("OK"))
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	constanta "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/servicebroker"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	v7 "code.cloudfoundry.org/cli/command/v7"
@@ -106,6 +107,23 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	CheckQuotaChangeStub        func(string, string, v7action.QuotaChange) (v7action.QuotaCheck, v7action.Warnings, error)
+	checkQuotaChangeMutex       sync.RWMutex
+	checkQuotaChangeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.QuotaChange
+	}
+	checkQuotaChangeReturns struct {
+		result1 v7action.QuotaCheck
+		result2 v7action.Warnings
+		result3 error
+	}
+	checkQuotaChangeReturnsOnCall map[int]struct {
+		result1 v7action.QuotaCheck
+		result2 v7action.Warnings
+		result3 error
+	}
 	CheckRouteStub        func(string, string, string, int) (bool, v7action.Warnings, error)
 	checkRouteMutex       sync.RWMutex
 	checkRouteArgsForCall []struct {
@@ -560,6 +578,19 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DecodeTokenStub        func(string) (v7action.TokenInfo, error)
+	decodeTokenMutex       sync.RWMutex
+	decodeTokenArgsForCall []struct {
+		arg1 string
+	}
+	decodeTokenReturns struct {
+		result1 v7action.TokenInfo
+		result2 error
+	}
+	decodeTokenReturnsOnCall map[int]struct {
+		result1 v7action.TokenInfo
+		result2 error
+	}
 	DeleteApplicationByNameAndSpaceStub        func(string, string, bool) (v7action.Warnings, error)
 	deleteApplicationByNameAndSpaceMutex       sync.RWMutex
 	deleteApplicationByNameAndSpaceArgsForCall []struct {
@@ -732,6 +763,19 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	DeleteRoutesStub        func([]string) (v7action.Warnings, error)
+	deleteRoutesMutex       sync.RWMutex
+	deleteRoutesArgsForCall []struct {
+		arg1 []string
+	}
+	deleteRoutesReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteRoutesReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteSecurityGroupStub        func(string) (v7action.Warnings, error)
 	deleteSecurityGroupMutex       sync.RWMutex
 	deleteSecurityGroupArgsForCall []struct {
@@ -851,6 +895,19 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DeleteStaleArtifactStub        func(v7action.StaleArtifact) (v7action.Warnings, error)
+	deleteStaleArtifactMutex       sync.RWMutex
+	deleteStaleArtifactArgsForCall []struct {
+		arg1 v7action.StaleArtifact
+	}
+	deleteStaleArtifactReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	deleteStaleArtifactReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	DeleteUserStub        func(string) (v7action.Warnings, error)
 	deleteUserMutex       sync.RWMutex
 	deleteUserArgsForCall []struct {
@@ -864,6 +921,46 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	DiffDropletArchivesStub        func(v7action.DropletArchive, v7action.DropletArchive) []v7action.DropletFileDiff
+	diffDropletArchivesMutex       sync.RWMutex
+	diffDropletArchivesArgsForCall []struct {
+		arg1 v7action.DropletArchive
+		arg2 v7action.DropletArchive
+	}
+	diffDropletArchivesReturns struct {
+		result1 []v7action.DropletFileDiff
+	}
+	diffDropletArchivesReturnsOnCall map[int]struct {
+		result1 []v7action.DropletFileDiff
+	}
+	DiffEnvironmentVariableGroupsStub        func(v7action.EnvironmentVariableGroups, v7action.EnvironmentVariableGroups) v7action.EnvironmentVariableGroupsDiff
+	diffEnvironmentVariableGroupsMutex       sync.RWMutex
+	diffEnvironmentVariableGroupsArgsForCall []struct {
+		arg1 v7action.EnvironmentVariableGroups
+		arg2 v7action.EnvironmentVariableGroups
+	}
+	diffEnvironmentVariableGroupsReturns struct {
+		result1 v7action.EnvironmentVariableGroupsDiff
+	}
+	diffEnvironmentVariableGroupsReturnsOnCall map[int]struct {
+		result1 v7action.EnvironmentVariableGroupsDiff
+	}
+	DiffServiceBrokerCatalogStub        func(string, servicebroker.Catalog) (v7action.ServiceBrokerCatalogDiff, v7action.Warnings, error)
+	diffServiceBrokerCatalogMutex       sync.RWMutex
+	diffServiceBrokerCatalogArgsForCall []struct {
+		arg1 string
+		arg2 servicebroker.Catalog
+	}
+	diffServiceBrokerCatalogReturns struct {
+		result1 v7action.ServiceBrokerCatalogDiff
+		result2 v7action.Warnings
+		result3 error
+	}
+	diffServiceBrokerCatalogReturnsOnCall map[int]struct {
+		result1 v7action.ServiceBrokerCatalogDiff
+		result2 v7action.Warnings
+		result3 error
+	}
 	DiffSpaceManifestStub        func(string, []byte) (resources.ManifestDiff, v7action.Warnings, error)
 	diffSpaceManifestMutex       sync.RWMutex
 	diffSpaceManifestArgsForCall []struct {
@@ -991,6 +1088,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	ExtractDropletFilesStub        func([]byte, []string, string) ([]string, error)
+	extractDropletFilesMutex       sync.RWMutex
+	extractDropletFilesArgsForCall []struct {
+		arg1 []byte
+		arg2 []string
+		arg3 string
+	}
+	extractDropletFilesReturns struct {
+		result1 []string
+		result2 error
+	}
+	extractDropletFilesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	GetAppFeatureStub        func(string, string) (resources.ApplicationFeature, v7action.Warnings, error)
 	getAppFeatureMutex       sync.RWMutex
 	getAppFeatureArgsForCall []struct {
@@ -1024,6 +1136,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getApplicationAnnotationsMutex       sync.RWMutex
+	getApplicationAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, v7action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -1056,6 +1184,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationDropletsWithLabelSelectorStub        func(string, string, string) ([]resources.Droplet, v7action.Warnings, error)
+	getApplicationDropletsWithLabelSelectorMutex       sync.RWMutex
+	getApplicationDropletsWithLabelSelectorArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getApplicationDropletsWithLabelSelectorReturns struct {
+		result1 []resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationDropletsWithLabelSelectorReturnsOnCall map[int]struct {
+		result1 []resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getApplicationLabelsMutex       sync.RWMutex
 	getApplicationLabelsArgsForCall []struct {
@@ -1119,6 +1264,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationQuotaChangeStub        func(resources.Application, []resources.Process, bool) (v7action.QuotaChange, v7action.Warnings, error)
+	getApplicationQuotaChangeMutex       sync.RWMutex
+	getApplicationQuotaChangeArgsForCall []struct {
+		arg1 resources.Application
+		arg2 []resources.Process
+		arg3 bool
+	}
+	getApplicationQuotaChangeReturns struct {
+		result1 v7action.QuotaChange
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationQuotaChangeReturnsOnCall map[int]struct {
+		result1 v7action.QuotaChange
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationRevisionsDeployedStub        func(string) ([]resources.Revision, v7action.Warnings, error)
 	getApplicationRevisionsDeployedMutex       sync.RWMutex
 	getApplicationRevisionsDeployedArgsForCall []struct {
@@ -1181,6 +1343,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetBuildpackAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getBuildpackAnnotationsMutex       sync.RWMutex
+	getBuildpackAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getBuildpackAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getBuildpackAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetBuildpackLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getBuildpackLabelsMutex       sync.RWMutex
 	getBuildpackLabelsArgsForCall []struct {
@@ -1212,6 +1390,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetCurrentRevisionStub        func(string) (resources.Revision, v7action.Warnings, error)
+	getCurrentRevisionMutex       sync.RWMutex
+	getCurrentRevisionArgsForCall []struct {
+		arg1 string
+	}
+	getCurrentRevisionReturns struct {
+		result1 resources.Revision
+		result2 v7action.Warnings
+		result3 error
+	}
+	getCurrentRevisionReturnsOnCall map[int]struct {
+		result1 resources.Revision
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetCurrentUserStub        func() (configv3.User, error)
 	getCurrentUserMutex       sync.RWMutex
 	getCurrentUserArgsForCall []struct {
@@ -1271,6 +1464,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetDomainAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getDomainAnnotationsMutex       sync.RWMutex
+	getDomainAnnotationsArgsForCall []struct {
+		arg1 string
+	}
+	getDomainAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDomainAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetDomainByNameStub        func(string) (resources.Domain, v7action.Warnings, error)
 	getDomainByNameMutex       sync.RWMutex
 	getDomainByNameArgsForCall []struct {
@@ -1506,6 +1714,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetOrganizationAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getOrganizationAnnotationsMutex       sync.RWMutex
+	getOrganizationAnnotationsArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetOrganizationByNameStub        func(string) (resources.Organization, v7action.Warnings, error)
 	getOrganizationByNameMutex       sync.RWMutex
 	getOrganizationByNameArgsForCall []struct {
@@ -1567,6 +1790,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetOrganizationQuotaUsageStub        func(string) (v7action.OrganizationQuotaUsage, v7action.Warnings, error)
+	getOrganizationQuotaUsageMutex       sync.RWMutex
+	getOrganizationQuotaUsageArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationQuotaUsageReturns struct {
+		result1 v7action.OrganizationQuotaUsage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationQuotaUsageReturnsOnCall map[int]struct {
+		result1 v7action.OrganizationQuotaUsage
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetOrganizationQuotasStub        func() ([]resources.OrganizationQuota, v7action.Warnings, error)
 	getOrganizationQuotasMutex       sync.RWMutex
 	getOrganizationQuotasArgsForCall []struct {
@@ -1642,6 +1880,20 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetOrganizationsQuotaUsageStub        func() ([]v7action.QuotaUsageReport, v7action.Warnings, error)
+	getOrganizationsQuotaUsageMutex       sync.RWMutex
+	getOrganizationsQuotaUsageArgsForCall []struct {
+	}
+	getOrganizationsQuotaUsageReturns struct {
+		result1 []v7action.QuotaUsageReport
+		result2 v7action.Warnings
+		result3 error
+	}
+	getOrganizationsQuotaUsageReturnsOnCall map[int]struct {
+		result1 []v7action.QuotaUsageReport
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetProcessByTypeAndApplicationStub        func(string, string) (resources.Process, v7action.Warnings, error)
 	getProcessByTypeAndApplicationMutex       sync.RWMutex
 	getProcessByTypeAndApplicationArgsForCall []struct {
@@ -1707,6 +1959,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetResourcesByLabelSelectorStub        func(string, string, v7action.LabelSelectorScope) ([]v7action.MetadataResource, v7action.Warnings, error)
+	getResourcesByLabelSelectorMutex       sync.RWMutex
+	getResourcesByLabelSelectorArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.LabelSelectorScope
+	}
+	getResourcesByLabelSelectorReturns struct {
+		result1 []v7action.MetadataResource
+		result2 v7action.Warnings
+		result3 error
+	}
+	getResourcesByLabelSelectorReturnsOnCall map[int]struct {
+		result1 []v7action.MetadataResource
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRevisionByApplicationAndVersionStub        func(string, int) (resources.Revision, v7action.Warnings, error)
 	getRevisionByApplicationAndVersionMutex       sync.RWMutex
 	getRevisionByApplicationAndVersionArgsForCall []struct {
@@ -1723,6 +1992,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRevisionDetailsStub        func(resources.Revision) (v7action.RevisionDetails, v7action.Warnings, error)
+	getRevisionDetailsMutex       sync.RWMutex
+	getRevisionDetailsArgsForCall []struct {
+		arg1 resources.Revision
+	}
+	getRevisionDetailsReturns struct {
+		result1 v7action.RevisionDetails
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRevisionDetailsReturnsOnCall map[int]struct {
+		result1 v7action.RevisionDetails
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRevisionsByApplicationNameAndSpaceStub        func(string, string) ([]resources.Revision, v7action.Warnings, error)
 	getRevisionsByApplicationNameAndSpaceMutex       sync.RWMutex
 	getRevisionsByApplicationNameAndSpaceArgsForCall []struct {
@@ -1753,6 +2037,38 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRouteStub        func(string, string) (resources.Route, v7action.Warnings, error)
+	getRouteMutex       sync.RWMutex
+	getRouteArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getRouteReturns struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteReturnsOnCall map[int]struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRouteAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getRouteAnnotationsMutex       sync.RWMutex
+	getRouteAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getRouteAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteByAttributesStub        func(resources.Domain, string, string, int) (resources.Route, v7action.Warnings, error)
 	getRouteByAttributesMutex       sync.RWMutex
 	getRouteByAttributesArgsForCall []struct {
@@ -1785,6 +2101,36 @@ type FakeActor struct {
 		result1 resources.RouteDestination
 		result2 error
 	}
+	GetRouteDestinationsHealthStub        func(resources.Route) ([]v7action.RouteDestinationHealth, v7action.Warnings, error)
+	getRouteDestinationsHealthMutex       sync.RWMutex
+	getRouteDestinationsHealthArgsForCall []struct {
+		arg1 resources.Route
+	}
+	getRouteDestinationsHealthReturns struct {
+		result1 []v7action.RouteDestinationHealth
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteDestinationsHealthReturnsOnCall map[int]struct {
+		result1 []v7action.RouteDestinationHealth
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRouteInventoryStub        func(string) (v7action.RouteInventory, v7action.Warnings, error)
+	getRouteInventoryMutex       sync.RWMutex
+	getRouteInventoryArgsForCall []struct {
+		arg1 string
+	}
+	getRouteInventoryReturns struct {
+		result1 v7action.RouteInventory
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteInventoryReturnsOnCall map[int]struct {
+		result1 v7action.RouteInventory
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouteLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getRouteLabelsMutex       sync.RWMutex
 	getRouteLabelsArgsForCall []struct {
@@ -1816,6 +2162,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetRouterGroupPortUsageStub        func(string) (v7action.RouterGroupPortUsage, v7action.Warnings, error)
+	getRouterGroupPortUsageMutex       sync.RWMutex
+	getRouterGroupPortUsageArgsForCall []struct {
+		arg1 string
+	}
+	getRouterGroupPortUsageReturns struct {
+		result1 v7action.RouterGroupPortUsage
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouterGroupPortUsageReturnsOnCall map[int]struct {
+		result1 v7action.RouterGroupPortUsage
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetRouterGroupsStub        func() ([]v7action.RouterGroup, error)
 	getRouterGroupsMutex       sync.RWMutex
 	getRouterGroupsArgsForCall []struct {
@@ -1921,6 +2282,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSecureShellConfigurationForRunningInstanceStub        func(string, string, string, uint) (v7action.SSHAuthentication, v7action.Warnings, error)
+	getSecureShellConfigurationForRunningInstanceMutex       sync.RWMutex
+	getSecureShellConfigurationForRunningInstanceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 uint
+	}
+	getSecureShellConfigurationForRunningInstanceReturns struct {
+		result1 v7action.SSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSecureShellConfigurationForRunningInstanceReturnsOnCall map[int]struct {
+		result1 v7action.SSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSecurityGroupStub        func(string) (resources.SecurityGroup, v7action.Warnings, error)
 	getSecurityGroupMutex       sync.RWMutex
 	getSecurityGroupArgsForCall []struct {
@@ -1982,6 +2361,52 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceAccessChangesStub        func([]v7action.DesiredServiceAccess) ([]v7action.ServiceAccessChange, v7action.Warnings, error)
+	getServiceAccessChangesMutex       sync.RWMutex
+	getServiceAccessChangesArgsForCall []struct {
+		arg1 []v7action.DesiredServiceAccess
+	}
+	getServiceAccessChangesReturns struct {
+		result1 []v7action.ServiceAccessChange
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceAccessChangesReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceAccessChange
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceAccessMatrixStub        func(string, string) (v7action.ServiceAccessMatrix, v7action.Warnings, error)
+	getServiceAccessMatrixMutex       sync.RWMutex
+	getServiceAccessMatrixArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceAccessMatrixReturns struct {
+		result1 v7action.ServiceAccessMatrix
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceAccessMatrixReturnsOnCall map[int]struct {
+		result1 v7action.ServiceAccessMatrix
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceBrokerAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getServiceBrokerAnnotationsMutex       sync.RWMutex
+	getServiceBrokerAnnotationsArgsForCall []struct {
+		arg1 string
+	}
+	getServiceBrokerAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceBrokerAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceBrokerByNameStub        func(string) (resources.ServiceBroker, v7action.Warnings, error)
 	getServiceBrokerByNameMutex       sync.RWMutex
 	getServiceBrokerByNameArgsForCall []struct {
@@ -2012,9 +2437,10 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetServiceBrokersStub        func() ([]resources.ServiceBroker, v7action.Warnings, error)
+	GetServiceBrokersStub        func(string) ([]resources.ServiceBroker, v7action.Warnings, error)
 	getServiceBrokersMutex       sync.RWMutex
 	getServiceBrokersArgsForCall []struct {
+		arg1 string
 	}
 	getServiceBrokersReturns struct {
 		result1 []resources.ServiceBroker
@@ -2026,6 +2452,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceInstanceAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getServiceInstanceAnnotationsMutex       sync.RWMutex
+	getServiceInstanceAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceInstanceAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceInstanceAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServiceInstanceByNameAndSpaceStub        func(string, string) (resources.ServiceInstance, v7action.Warnings, error)
 	getServiceInstanceByNameAndSpaceMutex       sync.RWMutex
 	getServiceInstanceByNameAndSpaceArgsForCall []struct {
@@ -2091,11 +2533,12 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetServiceInstancesForSpaceStub        func(string, bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
+	GetServiceInstancesForSpaceStub        func(string, string, bool) ([]v7action.ServiceInstance, v7action.Warnings, error)
 	getServiceInstancesForSpaceMutex       sync.RWMutex
 	getServiceInstancesForSpaceArgsForCall []struct {
 		arg1 string
-		arg2 bool
+		arg2 string
+		arg3 bool
 	}
 	getServiceInstancesForSpaceReturns struct {
 		result1 []v7action.ServiceInstance
@@ -2157,8 +2600,24 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
-	GetServiceOfferingLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
-	getServiceOfferingLabelsMutex       sync.RWMutex
+	GetServiceOfferingAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getServiceOfferingAnnotationsMutex       sync.RWMutex
+	getServiceOfferingAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getServiceOfferingAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceOfferingAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceOfferingLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getServiceOfferingLabelsMutex       sync.RWMutex
 	getServiceOfferingLabelsArgsForCall []struct {
 		arg1 string
 		arg2 string
@@ -2173,6 +2632,23 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServicePlanAnnotationsStub        func(string, string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getServicePlanAnnotationsMutex       sync.RWMutex
+	getServicePlanAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getServicePlanAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServicePlanAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetServicePlanByNameOfferingAndBrokerStub        func(string, string, string) (resources.ServicePlan, v7action.Warnings, error)
 	getServicePlanByNameOfferingAndBrokerMutex       sync.RWMutex
 	getServicePlanByNameOfferingAndBrokerArgsForCall []struct {
@@ -2207,6 +2683,22 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSpaceAnnotationsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
+	getSpaceAnnotationsMutex       sync.RWMutex
+	getSpaceAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getSpaceAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSpaceAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSpaceByNameAndOrganizationStub        func(string, string) (resources.Space, v7action.Warnings, error)
 	getSpaceByNameAndOrganizationMutex       sync.RWMutex
 	getSpaceByNameAndOrganizationArgsForCall []struct {
@@ -2318,6 +2810,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetStackAnnotationsStub        func(string) (map[string]types.NullString, v7action.Warnings, error)
+	getStackAnnotationsMutex       sync.RWMutex
+	getStackAnnotationsArgsForCall []struct {
+		arg1 string
+	}
+	getStackAnnotationsReturns struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
+	getStackAnnotationsReturnsOnCall map[int]struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetStackByNameStub        func(string) (resources.Stack, v7action.Warnings, error)
 	getStackByNameMutex       sync.RWMutex
 	getStackByNameArgsForCall []struct {
@@ -2363,6 +2870,35 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetStaleArtifactSizesStub        func([]v7action.StaleArtifact) []v7action.StaleArtifact
+	getStaleArtifactSizesMutex       sync.RWMutex
+	getStaleArtifactSizesArgsForCall []struct {
+		arg1 []v7action.StaleArtifact
+	}
+	getStaleArtifactSizesReturns struct {
+		result1 []v7action.StaleArtifact
+	}
+	getStaleArtifactSizesReturnsOnCall map[int]struct {
+		result1 []v7action.StaleArtifact
+	}
+	GetStaleArtifactsStub        func(v7action.ArtifactType, string, string, v7action.ArtifactRetentionPolicy) ([]v7action.StaleArtifact, v7action.Warnings, error)
+	getStaleArtifactsMutex       sync.RWMutex
+	getStaleArtifactsArgsForCall []struct {
+		arg1 v7action.ArtifactType
+		arg2 string
+		arg3 string
+		arg4 v7action.ArtifactRetentionPolicy
+	}
+	getStaleArtifactsReturns struct {
+		result1 []v7action.StaleArtifact
+		result2 v7action.Warnings
+		result3 error
+	}
+	getStaleArtifactsReturnsOnCall map[int]struct {
+		result1 []v7action.StaleArtifact
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetStreamingLogsForApplicationByNameAndSpaceStub        func(string, string, sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error)
 	getStreamingLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getStreamingLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -2475,6 +3011,22 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	MapRouteWithWeightStub        func(resources.Route, string, string, int) (v7action.Warnings, error)
+	mapRouteWithWeightMutex       sync.RWMutex
+	mapRouteWithWeightArgsForCall []struct {
+		arg1 resources.Route
+		arg2 string
+		arg3 string
+		arg4 int
+	}
+	mapRouteWithWeightReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	mapRouteWithWeightReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	MarketplaceStub        func(v7action.MarketplaceFilter) ([]v7action.ServiceOfferingWithPlans, v7action.Warnings, error)
 	marketplaceMutex       sync.RWMutex
 	marketplaceArgsForCall []struct {
@@ -2490,6 +3042,37 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	MarshalEnvironmentVariableFileStub        func(v7action.EnvironmentVariableFileFormat, resources.EnvironmentVariables, bool) ([]byte, []string, error)
+	marshalEnvironmentVariableFileMutex       sync.RWMutex
+	marshalEnvironmentVariableFileArgsForCall []struct {
+		arg1 v7action.EnvironmentVariableFileFormat
+		arg2 resources.EnvironmentVariables
+		arg3 bool
+	}
+	marshalEnvironmentVariableFileReturns struct {
+		result1 []byte
+		result2 []string
+		result3 error
+	}
+	marshalEnvironmentVariableFileReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 []string
+		result3 error
+	}
+	MonitorApplicationHealthStub        func(resources.Application, time.Duration) (v7action.Warnings, error)
+	monitorApplicationHealthMutex       sync.RWMutex
+	monitorApplicationHealthArgsForCall []struct {
+		arg1 resources.Application
+		arg2 time.Duration
+	}
+	monitorApplicationHealthReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	monitorApplicationHealthReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	MoveRouteStub        func(string, string) (v7action.Warnings, error)
 	moveRouteMutex       sync.RWMutex
 	moveRouteArgsForCall []struct {
@@ -2517,6 +3100,33 @@ type FakeActor struct {
 		result1 jwt.JWT
 		result2 error
 	}
+	ParseEnvironmentVariableFileStub        func(v7action.EnvironmentVariableFileFormat, []byte) (resources.EnvironmentVariables, error)
+	parseEnvironmentVariableFileMutex       sync.RWMutex
+	parseEnvironmentVariableFileArgsForCall []struct {
+		arg1 v7action.EnvironmentVariableFileFormat
+		arg2 []byte
+	}
+	parseEnvironmentVariableFileReturns struct {
+		result1 resources.EnvironmentVariables
+		result2 error
+	}
+	parseEnvironmentVariableFileReturnsOnCall map[int]struct {
+		result1 resources.EnvironmentVariables
+		result2 error
+	}
+	ParseServiceAccessFileStub        func([]byte) ([]v7action.DesiredServiceAccess, error)
+	parseServiceAccessFileMutex       sync.RWMutex
+	parseServiceAccessFileArgsForCall []struct {
+		arg1 []byte
+	}
+	parseServiceAccessFileReturns struct {
+		result1 []v7action.DesiredServiceAccess
+		result2 error
+	}
+	parseServiceAccessFileReturnsOnCall map[int]struct {
+		result1 []v7action.DesiredServiceAccess
+		result2 error
+	}
 	PollBuildStub        func(string, string) (resources.Droplet, v7action.Warnings, error)
 	pollBuildMutex       sync.RWMutex
 	pollBuildArgsForCall []struct {
@@ -2622,6 +3232,21 @@ type FakeActor struct {
 		result1 string
 		result2 error
 	}
+	ProbeRouteStub        func(string, time.Duration, bool) (v7action.RouteProbe, error)
+	probeRouteMutex       sync.RWMutex
+	probeRouteArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+		arg3 bool
+	}
+	probeRouteReturns struct {
+		result1 v7action.RouteProbe
+		result2 error
+	}
+	probeRouteReturnsOnCall map[int]struct {
+		result1 v7action.RouteProbe
+		result2 error
+	}
 	PurgeServiceInstanceStub        func(string, string) (v7action.Warnings, error)
 	purgeServiceInstanceMutex       sync.RWMutex
 	purgeServiceInstanceArgsForCall []struct {
@@ -2650,6 +3275,19 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	ReadDropletArchiveStub        func([]byte) (v7action.DropletArchive, error)
+	readDropletArchiveMutex       sync.RWMutex
+	readDropletArchiveArgsForCall []struct {
+		arg1 []byte
+	}
+	readDropletArchiveReturns struct {
+		result1 v7action.DropletArchive
+		result2 error
+	}
+	readDropletArchiveReturnsOnCall map[int]struct {
+		result1 v7action.DropletArchive
+		result2 error
+	}
 	RefreshAccessTokenStub        func() (string, error)
 	refreshAccessTokenMutex       sync.RWMutex
 	refreshAccessTokenArgsForCall []struct {
@@ -2932,6 +3570,24 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	SetEnvironmentVariablesByApplicationNameAndSpaceStub        func(string, string, resources.EnvironmentVariables, bool) ([]string, v7action.Warnings, error)
+	setEnvironmentVariablesByApplicationNameAndSpaceMutex       sync.RWMutex
+	setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 resources.EnvironmentVariables
+		arg4 bool
+	}
+	setEnvironmentVariablesByApplicationNameAndSpaceReturns struct {
+		result1 []string
+		result2 v7action.Warnings
+		result3 error
+	}
+	setEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []string
+		result2 v7action.Warnings
+		result3 error
+	}
 	SetOrganizationDefaultIsolationSegmentStub        func(string, string) (v7action.Warnings, error)
 	setOrganizationDefaultIsolationSegmentMutex       sync.RWMutex
 	setOrganizationDefaultIsolationSegmentArgsForCall []struct {
@@ -3224,6 +3880,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	UpdateApplicationAnnotationsByApplicationNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateApplicationAnnotationsByApplicationNameMutex       sync.RWMutex
+	updateApplicationAnnotationsByApplicationNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateApplicationAnnotationsByApplicationNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateApplicationAnnotationsByApplicationNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateApplicationLabelsByApplicationNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateApplicationLabelsByApplicationNameMutex       sync.RWMutex
 	updateApplicationLabelsByApplicationNameArgsForCall []struct {
@@ -3239,6 +3910,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateBuildpackAnnotationsByBuildpackNameAndStackStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateBuildpackAnnotationsByBuildpackNameAndStackMutex       sync.RWMutex
+	updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateBuildpackAnnotationsByBuildpackNameAndStackReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateBuildpackByNameAndStackStub        func(string, string, resources.Buildpack) (resources.Buildpack, v7action.Warnings, error)
 	updateBuildpackByNameAndStackMutex       sync.RWMutex
 	updateBuildpackByNameAndStackArgsForCall []struct {
@@ -3286,6 +3972,35 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateDestinationWeightStub        func(resources.Route, string, int) (v7action.Warnings, error)
+	updateDestinationWeightMutex       sync.RWMutex
+	updateDestinationWeightArgsForCall []struct {
+		arg1 resources.Route
+		arg2 string
+		arg3 int
+	}
+	updateDestinationWeightReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateDestinationWeightReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	UpdateDomainAnnotationsByDomainNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateDomainAnnotationsByDomainNameMutex       sync.RWMutex
+	updateDomainAnnotationsByDomainNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateDomainAnnotationsByDomainNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateDomainAnnotationsByDomainNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateDomainLabelsByDomainNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateDomainLabelsByDomainNameMutex       sync.RWMutex
 	updateDomainLabelsByDomainNameArgsForCall []struct {
@@ -3315,6 +4030,20 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	UpdateOrganizationAnnotationsByOrganizationNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateOrganizationAnnotationsByOrganizationNameMutex       sync.RWMutex
+	updateOrganizationAnnotationsByOrganizationNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateOrganizationAnnotationsByOrganizationNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateOrganizationAnnotationsByOrganizationNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateOrganizationLabelsByOrganizationNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateOrganizationLabelsByOrganizationNameMutex       sync.RWMutex
 	updateOrganizationLabelsByOrganizationNameArgsForCall []struct {
@@ -3359,6 +4088,37 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateResourcesMetadataStub        func(string, []string, resources.Metadata, func(updated int)) (v7action.Warnings, error)
+	updateResourcesMetadataMutex       sync.RWMutex
+	updateResourcesMetadataArgsForCall []struct {
+		arg1 string
+		arg2 []string
+		arg3 resources.Metadata
+		arg4 func(updated int)
+	}
+	updateResourcesMetadataReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateResourcesMetadataReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	UpdateRouteAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateRouteAnnotationsMutex       sync.RWMutex
+	updateRouteAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateRouteAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateRouteAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateRouteLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateRouteLabelsMutex       sync.RWMutex
 	updateRouteLabelsArgsForCall []struct {
@@ -3374,6 +4134,22 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateRouterGroupReservablePortsStub        func(string, string) (v7action.RouterGroup, v7action.Warnings, error)
+	updateRouterGroupReservablePortsMutex       sync.RWMutex
+	updateRouterGroupReservablePortsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	updateRouterGroupReservablePortsReturns struct {
+		result1 v7action.RouterGroup
+		result2 v7action.Warnings
+		result3 error
+	}
+	updateRouterGroupReservablePortsReturnsOnCall map[int]struct {
+		result1 v7action.RouterGroup
+		result2 v7action.Warnings
+		result3 error
+	}
 	UpdateSecurityGroupStub        func(string, string) (v7action.Warnings, error)
 	updateSecurityGroupMutex       sync.RWMutex
 	updateSecurityGroupArgsForCall []struct {
@@ -3417,6 +4193,20 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceBrokerAnnotationsByServiceBrokerNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceBrokerAnnotationsByServiceBrokerNameMutex       sync.RWMutex
+	updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateServiceBrokerAnnotationsByServiceBrokerNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceBrokerLabelsByServiceBrokerNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceBrokerLabelsByServiceBrokerNameMutex       sync.RWMutex
 	updateServiceBrokerLabelsByServiceBrokerNameArgsForCall []struct {
@@ -3431,6 +4221,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceInstanceAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceInstanceAnnotationsMutex       sync.RWMutex
+	updateServiceInstanceAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateServiceInstanceAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceInstanceAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceInstanceLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceInstanceLabelsMutex       sync.RWMutex
 	updateServiceInstanceLabelsArgsForCall []struct {
@@ -3446,6 +4251,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceOfferingAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceOfferingAnnotationsMutex       sync.RWMutex
+	updateServiceOfferingAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateServiceOfferingAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceOfferingAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceOfferingLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceOfferingLabelsMutex       sync.RWMutex
 	updateServiceOfferingLabelsArgsForCall []struct {
@@ -3461,6 +4281,22 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServicePlanAnnotationsStub        func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServicePlanAnnotationsMutex       sync.RWMutex
+	updateServicePlanAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}
+	updateServicePlanAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServicePlanAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServicePlanLabelsStub        func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServicePlanLabelsMutex       sync.RWMutex
 	updateServicePlanLabelsArgsForCall []struct {
@@ -3477,6 +4313,21 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateSpaceAnnotationsBySpaceNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateSpaceAnnotationsBySpaceNameMutex       sync.RWMutex
+	updateSpaceAnnotationsBySpaceNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateSpaceAnnotationsBySpaceNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateSpaceAnnotationsBySpaceNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateSpaceFeatureStub        func(string, string, bool, string) (v7action.Warnings, error)
 	updateSpaceFeatureMutex       sync.RWMutex
 	updateSpaceFeatureArgsForCall []struct {
@@ -3524,6 +4375,20 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateStackAnnotationsByStackNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateStackAnnotationsByStackNameMutex       sync.RWMutex
+	updateStackAnnotationsByStackNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateStackAnnotationsByStackNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateStackAnnotationsByStackNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateStackLabelsByStackNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateStackLabelsByStackNameMutex       sync.RWMutex
 	updateStackLabelsByStackNameArgsForCall []struct {
@@ -3633,11 +4498,33 @@ type FakeActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
-}
-
-func (fake *FakeActor) ApplyOrganizationQuotaByName(arg1 string, arg2 string) (v7action.Warnings, error) {
+	ValidateServiceBrokerCatalogStub        func(servicebroker.Catalog) v7action.CatalogProblems
+	validateServiceBrokerCatalogMutex       sync.RWMutex
+	validateServiceBrokerCatalogArgsForCall []struct {
+		arg1 servicebroker.Catalog
+	}
+	validateServiceBrokerCatalogReturns struct {
+		result1 v7action.CatalogProblems
+	}
+	validateServiceBrokerCatalogReturnsOnCall map[int]struct {
+		result1 v7action.CatalogProblems
+	}
+	ValidateTokenSignatureStub        func(string) error
+	validateTokenSignatureMutex       sync.RWMutex
+	validateTokenSignatureArgsForCall []struct {
+		arg1 string
+	}
+	validateTokenSignatureReturns struct {
+		result1 error
+	}
+	validateTokenSignatureReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeActor) ApplyOrganizationQuotaByName(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.applyOrganizationQuotaByNameMutex.Lock()
 	ret, specificReturn := fake.applyOrganizationQuotaByNameReturnsOnCall[len(fake.applyOrganizationQuotaByNameArgsForCall)]
 	fake.applyOrganizationQuotaByNameArgsForCall = append(fake.applyOrganizationQuotaByNameArgsForCall, struct {
@@ -4031,6 +4918,75 @@ func (fake *FakeActor) CancelDeploymentReturnsOnCall(i int, result1 v7action.War
 	}{result1, result2}
 }
 
+func (fake *FakeActor) CheckQuotaChange(arg1 string, arg2 string, arg3 v7action.QuotaChange) (v7action.QuotaCheck, v7action.Warnings, error) {
+	fake.checkQuotaChangeMutex.Lock()
+	ret, specificReturn := fake.checkQuotaChangeReturnsOnCall[len(fake.checkQuotaChangeArgsForCall)]
+	fake.checkQuotaChangeArgsForCall = append(fake.checkQuotaChangeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.QuotaChange
+	}{arg1, arg2, arg3})
+	stub := fake.CheckQuotaChangeStub
+	fakeReturns := fake.checkQuotaChangeReturns
+	fake.recordInvocation("CheckQuotaChange", []interface{}{arg1, arg2, arg3})
+	fake.checkQuotaChangeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) CheckQuotaChangeCallCount() int {
+	fake.checkQuotaChangeMutex.RLock()
+	defer fake.checkQuotaChangeMutex.RUnlock()
+	return len(fake.checkQuotaChangeArgsForCall)
+}
+
+func (fake *FakeActor) CheckQuotaChangeCalls(stub func(string, string, v7action.QuotaChange) (v7action.QuotaCheck, v7action.Warnings, error)) {
+	fake.checkQuotaChangeMutex.Lock()
+	defer fake.checkQuotaChangeMutex.Unlock()
+	fake.CheckQuotaChangeStub = stub
+}
+
+func (fake *FakeActor) CheckQuotaChangeArgsForCall(i int) (string, string, v7action.QuotaChange) {
+	fake.checkQuotaChangeMutex.RLock()
+	defer fake.checkQuotaChangeMutex.RUnlock()
+	argsForCall := fake.checkQuotaChangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) CheckQuotaChangeReturns(result1 v7action.QuotaCheck, result2 v7action.Warnings, result3 error) {
+	fake.checkQuotaChangeMutex.Lock()
+	defer fake.checkQuotaChangeMutex.Unlock()
+	fake.CheckQuotaChangeStub = nil
+	fake.checkQuotaChangeReturns = struct {
+		result1 v7action.QuotaCheck
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) CheckQuotaChangeReturnsOnCall(i int, result1 v7action.QuotaCheck, result2 v7action.Warnings, result3 error) {
+	fake.checkQuotaChangeMutex.Lock()
+	defer fake.checkQuotaChangeMutex.Unlock()
+	fake.CheckQuotaChangeStub = nil
+	if fake.checkQuotaChangeReturnsOnCall == nil {
+		fake.checkQuotaChangeReturnsOnCall = make(map[int]struct {
+			result1 v7action.QuotaCheck
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.checkQuotaChangeReturnsOnCall[i] = struct {
+		result1 v7action.QuotaCheck
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) CheckRoute(arg1 string, arg2 string, arg3 string, arg4 int) (bool, v7action.Warnings, error) {
 	fake.checkRouteMutex.Lock()
 	ret, specificReturn := fake.checkRouteReturnsOnCall[len(fake.checkRouteArgsForCall)]
@@ -6003,6 +6959,70 @@ func (fake *FakeActor) CreateUserProvidedServiceInstanceReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DecodeToken(arg1 string) (v7action.TokenInfo, error) {
+	fake.decodeTokenMutex.Lock()
+	ret, specificReturn := fake.decodeTokenReturnsOnCall[len(fake.decodeTokenArgsForCall)]
+	fake.decodeTokenArgsForCall = append(fake.decodeTokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DecodeTokenStub
+	fakeReturns := fake.decodeTokenReturns
+	fake.recordInvocation("DecodeToken", []interface{}{arg1})
+	fake.decodeTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DecodeTokenCallCount() int {
+	fake.decodeTokenMutex.RLock()
+	defer fake.decodeTokenMutex.RUnlock()
+	return len(fake.decodeTokenArgsForCall)
+}
+
+func (fake *FakeActor) DecodeTokenCalls(stub func(string) (v7action.TokenInfo, error)) {
+	fake.decodeTokenMutex.Lock()
+	defer fake.decodeTokenMutex.Unlock()
+	fake.DecodeTokenStub = stub
+}
+
+func (fake *FakeActor) DecodeTokenArgsForCall(i int) string {
+	fake.decodeTokenMutex.RLock()
+	defer fake.decodeTokenMutex.RUnlock()
+	argsForCall := fake.decodeTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) DecodeTokenReturns(result1 v7action.TokenInfo, result2 error) {
+	fake.decodeTokenMutex.Lock()
+	defer fake.decodeTokenMutex.Unlock()
+	fake.DecodeTokenStub = nil
+	fake.decodeTokenReturns = struct {
+		result1 v7action.TokenInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DecodeTokenReturnsOnCall(i int, result1 v7action.TokenInfo, result2 error) {
+	fake.decodeTokenMutex.Lock()
+	defer fake.decodeTokenMutex.Unlock()
+	fake.DecodeTokenStub = nil
+	if fake.decodeTokenReturnsOnCall == nil {
+		fake.decodeTokenReturnsOnCall = make(map[int]struct {
+			result1 v7action.TokenInfo
+			result2 error
+		})
+	}
+	fake.decodeTokenReturnsOnCall[i] = struct {
+		result1 v7action.TokenInfo
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DeleteApplicationByNameAndSpace(arg1 string, arg2 string, arg3 bool) (v7action.Warnings, error) {
 	fake.deleteApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteApplicationByNameAndSpaceReturnsOnCall[len(fake.deleteApplicationByNameAndSpaceArgsForCall)]
@@ -6788,6 +7808,75 @@ func (fake *FakeActor) DeleteRouteBindingReturnsOnCall(i int, result1 chan v7act
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) DeleteRoutes(arg1 []string) (v7action.Warnings, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.deleteRoutesMutex.Lock()
+	ret, specificReturn := fake.deleteRoutesReturnsOnCall[len(fake.deleteRoutesArgsForCall)]
+	fake.deleteRoutesArgsForCall = append(fake.deleteRoutesArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	stub := fake.DeleteRoutesStub
+	fakeReturns := fake.deleteRoutesReturns
+	fake.recordInvocation("DeleteRoutes", []interface{}{arg1Copy})
+	fake.deleteRoutesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteRoutesCallCount() int {
+	fake.deleteRoutesMutex.RLock()
+	defer fake.deleteRoutesMutex.RUnlock()
+	return len(fake.deleteRoutesArgsForCall)
+}
+
+func (fake *FakeActor) DeleteRoutesCalls(stub func([]string) (v7action.Warnings, error)) {
+	fake.deleteRoutesMutex.Lock()
+	defer fake.deleteRoutesMutex.Unlock()
+	fake.DeleteRoutesStub = stub
+}
+
+func (fake *FakeActor) DeleteRoutesArgsForCall(i int) []string {
+	fake.deleteRoutesMutex.RLock()
+	defer fake.deleteRoutesMutex.RUnlock()
+	argsForCall := fake.deleteRoutesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) DeleteRoutesReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteRoutesMutex.Lock()
+	defer fake.deleteRoutesMutex.Unlock()
+	fake.DeleteRoutesStub = nil
+	fake.deleteRoutesReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteRoutesReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteRoutesMutex.Lock()
+	defer fake.deleteRoutesMutex.Unlock()
+	fake.DeleteRoutesStub = nil
+	if fake.deleteRoutesReturnsOnCall == nil {
+		fake.deleteRoutesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteRoutesReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DeleteSecurityGroup(arg1 string) (v7action.Warnings, error) {
 	fake.deleteSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.deleteSecurityGroupReturnsOnCall[len(fake.deleteSecurityGroupArgsForCall)]
@@ -7318,6 +8407,70 @@ func (fake *FakeActor) DeleteSpaceRoleReturnsOnCall(i int, result1 v7action.Warn
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DeleteStaleArtifact(arg1 v7action.StaleArtifact) (v7action.Warnings, error) {
+	fake.deleteStaleArtifactMutex.Lock()
+	ret, specificReturn := fake.deleteStaleArtifactReturnsOnCall[len(fake.deleteStaleArtifactArgsForCall)]
+	fake.deleteStaleArtifactArgsForCall = append(fake.deleteStaleArtifactArgsForCall, struct {
+		arg1 v7action.StaleArtifact
+	}{arg1})
+	stub := fake.DeleteStaleArtifactStub
+	fakeReturns := fake.deleteStaleArtifactReturns
+	fake.recordInvocation("DeleteStaleArtifact", []interface{}{arg1})
+	fake.deleteStaleArtifactMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) DeleteStaleArtifactCallCount() int {
+	fake.deleteStaleArtifactMutex.RLock()
+	defer fake.deleteStaleArtifactMutex.RUnlock()
+	return len(fake.deleteStaleArtifactArgsForCall)
+}
+
+func (fake *FakeActor) DeleteStaleArtifactCalls(stub func(v7action.StaleArtifact) (v7action.Warnings, error)) {
+	fake.deleteStaleArtifactMutex.Lock()
+	defer fake.deleteStaleArtifactMutex.Unlock()
+	fake.DeleteStaleArtifactStub = stub
+}
+
+func (fake *FakeActor) DeleteStaleArtifactArgsForCall(i int) v7action.StaleArtifact {
+	fake.deleteStaleArtifactMutex.RLock()
+	defer fake.deleteStaleArtifactMutex.RUnlock()
+	argsForCall := fake.deleteStaleArtifactArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) DeleteStaleArtifactReturns(result1 v7action.Warnings, result2 error) {
+	fake.deleteStaleArtifactMutex.Lock()
+	defer fake.deleteStaleArtifactMutex.Unlock()
+	fake.DeleteStaleArtifactStub = nil
+	fake.deleteStaleArtifactReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) DeleteStaleArtifactReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.deleteStaleArtifactMutex.Lock()
+	defer fake.deleteStaleArtifactMutex.Unlock()
+	fake.DeleteStaleArtifactStub = nil
+	if fake.deleteStaleArtifactReturnsOnCall == nil {
+		fake.deleteStaleArtifactReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.deleteStaleArtifactReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) DeleteUser(arg1 string) (v7action.Warnings, error) {
 	fake.deleteUserMutex.Lock()
 	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
@@ -7382,6 +8535,198 @@ func (fake *FakeActor) DeleteUserReturnsOnCall(i int, result1 v7action.Warnings,
 	}{result1, result2}
 }
 
+func (fake *FakeActor) DiffDropletArchives(arg1 v7action.DropletArchive, arg2 v7action.DropletArchive) []v7action.DropletFileDiff {
+	fake.diffDropletArchivesMutex.Lock()
+	ret, specificReturn := fake.diffDropletArchivesReturnsOnCall[len(fake.diffDropletArchivesArgsForCall)]
+	fake.diffDropletArchivesArgsForCall = append(fake.diffDropletArchivesArgsForCall, struct {
+		arg1 v7action.DropletArchive
+		arg2 v7action.DropletArchive
+	}{arg1, arg2})
+	stub := fake.DiffDropletArchivesStub
+	fakeReturns := fake.diffDropletArchivesReturns
+	fake.recordInvocation("DiffDropletArchives", []interface{}{arg1, arg2})
+	fake.diffDropletArchivesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) DiffDropletArchivesCallCount() int {
+	fake.diffDropletArchivesMutex.RLock()
+	defer fake.diffDropletArchivesMutex.RUnlock()
+	return len(fake.diffDropletArchivesArgsForCall)
+}
+
+func (fake *FakeActor) DiffDropletArchivesCalls(stub func(v7action.DropletArchive, v7action.DropletArchive) []v7action.DropletFileDiff) {
+	fake.diffDropletArchivesMutex.Lock()
+	defer fake.diffDropletArchivesMutex.Unlock()
+	fake.DiffDropletArchivesStub = stub
+}
+
+func (fake *FakeActor) DiffDropletArchivesArgsForCall(i int) (v7action.DropletArchive, v7action.DropletArchive) {
+	fake.diffDropletArchivesMutex.RLock()
+	defer fake.diffDropletArchivesMutex.RUnlock()
+	argsForCall := fake.diffDropletArchivesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) DiffDropletArchivesReturns(result1 []v7action.DropletFileDiff) {
+	fake.diffDropletArchivesMutex.Lock()
+	defer fake.diffDropletArchivesMutex.Unlock()
+	fake.DiffDropletArchivesStub = nil
+	fake.diffDropletArchivesReturns = struct {
+		result1 []v7action.DropletFileDiff
+	}{result1}
+}
+
+func (fake *FakeActor) DiffDropletArchivesReturnsOnCall(i int, result1 []v7action.DropletFileDiff) {
+	fake.diffDropletArchivesMutex.Lock()
+	defer fake.diffDropletArchivesMutex.Unlock()
+	fake.DiffDropletArchivesStub = nil
+	if fake.diffDropletArchivesReturnsOnCall == nil {
+		fake.diffDropletArchivesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.DropletFileDiff
+		})
+	}
+	fake.diffDropletArchivesReturnsOnCall[i] = struct {
+		result1 []v7action.DropletFileDiff
+	}{result1}
+}
+
+func (fake *FakeActor) DiffEnvironmentVariableGroups(arg1 v7action.EnvironmentVariableGroups, arg2 v7action.EnvironmentVariableGroups) v7action.EnvironmentVariableGroupsDiff {
+	fake.diffEnvironmentVariableGroupsMutex.Lock()
+	ret, specificReturn := fake.diffEnvironmentVariableGroupsReturnsOnCall[len(fake.diffEnvironmentVariableGroupsArgsForCall)]
+	fake.diffEnvironmentVariableGroupsArgsForCall = append(fake.diffEnvironmentVariableGroupsArgsForCall, struct {
+		arg1 v7action.EnvironmentVariableGroups
+		arg2 v7action.EnvironmentVariableGroups
+	}{arg1, arg2})
+	stub := fake.DiffEnvironmentVariableGroupsStub
+	fakeReturns := fake.diffEnvironmentVariableGroupsReturns
+	fake.recordInvocation("DiffEnvironmentVariableGroups", []interface{}{arg1, arg2})
+	fake.diffEnvironmentVariableGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) DiffEnvironmentVariableGroupsCallCount() int {
+	fake.diffEnvironmentVariableGroupsMutex.RLock()
+	defer fake.diffEnvironmentVariableGroupsMutex.RUnlock()
+	return len(fake.diffEnvironmentVariableGroupsArgsForCall)
+}
+
+func (fake *FakeActor) DiffEnvironmentVariableGroupsCalls(stub func(v7action.EnvironmentVariableGroups, v7action.EnvironmentVariableGroups) v7action.EnvironmentVariableGroupsDiff) {
+	fake.diffEnvironmentVariableGroupsMutex.Lock()
+	defer fake.diffEnvironmentVariableGroupsMutex.Unlock()
+	fake.DiffEnvironmentVariableGroupsStub = stub
+}
+
+func (fake *FakeActor) DiffEnvironmentVariableGroupsArgsForCall(i int) (v7action.EnvironmentVariableGroups, v7action.EnvironmentVariableGroups) {
+	fake.diffEnvironmentVariableGroupsMutex.RLock()
+	defer fake.diffEnvironmentVariableGroupsMutex.RUnlock()
+	argsForCall := fake.diffEnvironmentVariableGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) DiffEnvironmentVariableGroupsReturns(result1 v7action.EnvironmentVariableGroupsDiff) {
+	fake.diffEnvironmentVariableGroupsMutex.Lock()
+	defer fake.diffEnvironmentVariableGroupsMutex.Unlock()
+	fake.DiffEnvironmentVariableGroupsStub = nil
+	fake.diffEnvironmentVariableGroupsReturns = struct {
+		result1 v7action.EnvironmentVariableGroupsDiff
+	}{result1}
+}
+
+func (fake *FakeActor) DiffEnvironmentVariableGroupsReturnsOnCall(i int, result1 v7action.EnvironmentVariableGroupsDiff) {
+	fake.diffEnvironmentVariableGroupsMutex.Lock()
+	defer fake.diffEnvironmentVariableGroupsMutex.Unlock()
+	fake.DiffEnvironmentVariableGroupsStub = nil
+	if fake.diffEnvironmentVariableGroupsReturnsOnCall == nil {
+		fake.diffEnvironmentVariableGroupsReturnsOnCall = make(map[int]struct {
+			result1 v7action.EnvironmentVariableGroupsDiff
+		})
+	}
+	fake.diffEnvironmentVariableGroupsReturnsOnCall[i] = struct {
+		result1 v7action.EnvironmentVariableGroupsDiff
+	}{result1}
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalog(arg1 string, arg2 servicebroker.Catalog) (v7action.ServiceBrokerCatalogDiff, v7action.Warnings, error) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	ret, specificReturn := fake.diffServiceBrokerCatalogReturnsOnCall[len(fake.diffServiceBrokerCatalogArgsForCall)]
+	fake.diffServiceBrokerCatalogArgsForCall = append(fake.diffServiceBrokerCatalogArgsForCall, struct {
+		arg1 string
+		arg2 servicebroker.Catalog
+	}{arg1, arg2})
+	stub := fake.DiffServiceBrokerCatalogStub
+	fakeReturns := fake.diffServiceBrokerCatalogReturns
+	fake.recordInvocation("DiffServiceBrokerCatalog", []interface{}{arg1, arg2})
+	fake.diffServiceBrokerCatalogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogCallCount() int {
+	fake.diffServiceBrokerCatalogMutex.RLock()
+	defer fake.diffServiceBrokerCatalogMutex.RUnlock()
+	return len(fake.diffServiceBrokerCatalogArgsForCall)
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogCalls(stub func(string, servicebroker.Catalog) (v7action.ServiceBrokerCatalogDiff, v7action.Warnings, error)) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	defer fake.diffServiceBrokerCatalogMutex.Unlock()
+	fake.DiffServiceBrokerCatalogStub = stub
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogArgsForCall(i int) (string, servicebroker.Catalog) {
+	fake.diffServiceBrokerCatalogMutex.RLock()
+	defer fake.diffServiceBrokerCatalogMutex.RUnlock()
+	argsForCall := fake.diffServiceBrokerCatalogArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogReturns(result1 v7action.ServiceBrokerCatalogDiff, result2 v7action.Warnings, result3 error) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	defer fake.diffServiceBrokerCatalogMutex.Unlock()
+	fake.DiffServiceBrokerCatalogStub = nil
+	fake.diffServiceBrokerCatalogReturns = struct {
+		result1 v7action.ServiceBrokerCatalogDiff
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) DiffServiceBrokerCatalogReturnsOnCall(i int, result1 v7action.ServiceBrokerCatalogDiff, result2 v7action.Warnings, result3 error) {
+	fake.diffServiceBrokerCatalogMutex.Lock()
+	defer fake.diffServiceBrokerCatalogMutex.Unlock()
+	fake.DiffServiceBrokerCatalogStub = nil
+	if fake.diffServiceBrokerCatalogReturnsOnCall == nil {
+		fake.diffServiceBrokerCatalogReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceBrokerCatalogDiff
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.diffServiceBrokerCatalogReturnsOnCall[i] = struct {
+		result1 v7action.ServiceBrokerCatalogDiff
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) DiffSpaceManifest(arg1 string, arg2 []byte) (resources.ManifestDiff, v7action.Warnings, error) {
 	var arg2Copy []byte
 	if arg2 != nil {
@@ -7928,34 +9273,110 @@ func (fake *FakeActor) EntitleIsolationSegmentToOrganizationByNameReturnsOnCall(
 	}{result1, result2}
 }
 
-func (fake *FakeActor) GetAppFeature(arg1 string, arg2 string) (resources.ApplicationFeature, v7action.Warnings, error) {
-	fake.getAppFeatureMutex.Lock()
-	ret, specificReturn := fake.getAppFeatureReturnsOnCall[len(fake.getAppFeatureArgsForCall)]
-	fake.getAppFeatureArgsForCall = append(fake.getAppFeatureArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetAppFeatureStub
-	fakeReturns := fake.getAppFeatureReturns
-	fake.recordInvocation("GetAppFeature", []interface{}{arg1, arg2})
-	fake.getAppFeatureMutex.Unlock()
+func (fake *FakeActor) ExtractDropletFiles(arg1 []byte, arg2 []string, arg3 string) ([]string, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.extractDropletFilesMutex.Lock()
+	ret, specificReturn := fake.extractDropletFilesReturnsOnCall[len(fake.extractDropletFilesArgsForCall)]
+	fake.extractDropletFilesArgsForCall = append(fake.extractDropletFilesArgsForCall, struct {
+		arg1 []byte
+		arg2 []string
+		arg3 string
+	}{arg1Copy, arg2Copy, arg3})
+	stub := fake.ExtractDropletFilesStub
+	fakeReturns := fake.extractDropletFilesReturns
+	fake.recordInvocation("ExtractDropletFiles", []interface{}{arg1Copy, arg2Copy, arg3})
+	fake.extractDropletFilesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeActor) GetAppFeatureCallCount() int {
-	fake.getAppFeatureMutex.RLock()
-	defer fake.getAppFeatureMutex.RUnlock()
-	return len(fake.getAppFeatureArgsForCall)
+func (fake *FakeActor) ExtractDropletFilesCallCount() int {
+	fake.extractDropletFilesMutex.RLock()
+	defer fake.extractDropletFilesMutex.RUnlock()
+	return len(fake.extractDropletFilesArgsForCall)
 }
 
-func (fake *FakeActor) GetAppFeatureCalls(stub func(string, string) (resources.ApplicationFeature, v7action.Warnings, error)) {
-	fake.getAppFeatureMutex.Lock()
+func (fake *FakeActor) ExtractDropletFilesCalls(stub func([]byte, []string, string) ([]string, error)) {
+	fake.extractDropletFilesMutex.Lock()
+	defer fake.extractDropletFilesMutex.Unlock()
+	fake.ExtractDropletFilesStub = stub
+}
+
+func (fake *FakeActor) ExtractDropletFilesArgsForCall(i int) ([]byte, []string, string) {
+	fake.extractDropletFilesMutex.RLock()
+	defer fake.extractDropletFilesMutex.RUnlock()
+	argsForCall := fake.extractDropletFilesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) ExtractDropletFilesReturns(result1 []string, result2 error) {
+	fake.extractDropletFilesMutex.Lock()
+	defer fake.extractDropletFilesMutex.Unlock()
+	fake.ExtractDropletFilesStub = nil
+	fake.extractDropletFilesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ExtractDropletFilesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.extractDropletFilesMutex.Lock()
+	defer fake.extractDropletFilesMutex.Unlock()
+	fake.ExtractDropletFilesStub = nil
+	if fake.extractDropletFilesReturnsOnCall == nil {
+		fake.extractDropletFilesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.extractDropletFilesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) GetAppFeature(arg1 string, arg2 string) (resources.ApplicationFeature, v7action.Warnings, error) {
+	fake.getAppFeatureMutex.Lock()
+	ret, specificReturn := fake.getAppFeatureReturnsOnCall[len(fake.getAppFeatureArgsForCall)]
+	fake.getAppFeatureArgsForCall = append(fake.getAppFeatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAppFeatureStub
+	fakeReturns := fake.getAppFeatureReturns
+	fake.recordInvocation("GetAppFeature", []interface{}{arg1, arg2})
+	fake.getAppFeatureMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppFeatureCallCount() int {
+	fake.getAppFeatureMutex.RLock()
+	defer fake.getAppFeatureMutex.RUnlock()
+	return len(fake.getAppFeatureArgsForCall)
+}
+
+func (fake *FakeActor) GetAppFeatureCalls(stub func(string, string) (resources.ApplicationFeature, v7action.Warnings, error)) {
+	fake.getAppFeatureMutex.Lock()
 	defer fake.getAppFeatureMutex.Unlock()
 	fake.GetAppFeatureStub = stub
 }
@@ -8065,6 +9486,74 @@ func (fake *FakeActor) GetAppSummariesForSpaceReturnsOnCall(i int, result1 []v7a
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getApplicationAnnotationsReturnsOnCall[len(fake.getApplicationAnnotationsArgsForCall)]
+	fake.getApplicationAnnotationsArgsForCall = append(fake.getApplicationAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetApplicationAnnotationsStub
+	fakeReturns := fake.getApplicationAnnotationsReturns
+	fake.recordInvocation("GetApplicationAnnotations", []interface{}{arg1, arg2})
+	fake.getApplicationAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationAnnotationsCallCount() int {
+	fake.getApplicationAnnotationsMutex.RLock()
+	defer fake.getApplicationAnnotationsMutex.RUnlock()
+	return len(fake.getApplicationAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	defer fake.getApplicationAnnotationsMutex.Unlock()
+	fake.GetApplicationAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetApplicationAnnotationsArgsForCall(i int) (string, string) {
+	fake.getApplicationAnnotationsMutex.RLock()
+	defer fake.getApplicationAnnotationsMutex.RUnlock()
+	argsForCall := fake.getApplicationAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetApplicationAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	defer fake.getApplicationAnnotationsMutex.Unlock()
+	fake.GetApplicationAnnotationsStub = nil
+	fake.getApplicationAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationAnnotationsMutex.Lock()
+	defer fake.getApplicationAnnotationsMutex.Unlock()
+	fake.GetApplicationAnnotationsStub = nil
+	if fake.getApplicationAnnotationsReturnsOnCall == nil {
+		fake.getApplicationAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, v7action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -8201,6 +9690,75 @@ func (fake *FakeActor) GetApplicationDropletsReturnsOnCall(i int, result1 []reso
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationDropletsWithLabelSelector(arg1 string, arg2 string, arg3 string) ([]resources.Droplet, v7action.Warnings, error) {
+	fake.getApplicationDropletsWithLabelSelectorMutex.Lock()
+	ret, specificReturn := fake.getApplicationDropletsWithLabelSelectorReturnsOnCall[len(fake.getApplicationDropletsWithLabelSelectorArgsForCall)]
+	fake.getApplicationDropletsWithLabelSelectorArgsForCall = append(fake.getApplicationDropletsWithLabelSelectorArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetApplicationDropletsWithLabelSelectorStub
+	fakeReturns := fake.getApplicationDropletsWithLabelSelectorReturns
+	fake.recordInvocation("GetApplicationDropletsWithLabelSelector", []interface{}{arg1, arg2, arg3})
+	fake.getApplicationDropletsWithLabelSelectorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationDropletsWithLabelSelectorCallCount() int {
+	fake.getApplicationDropletsWithLabelSelectorMutex.RLock()
+	defer fake.getApplicationDropletsWithLabelSelectorMutex.RUnlock()
+	return len(fake.getApplicationDropletsWithLabelSelectorArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationDropletsWithLabelSelectorCalls(stub func(string, string, string) ([]resources.Droplet, v7action.Warnings, error)) {
+	fake.getApplicationDropletsWithLabelSelectorMutex.Lock()
+	defer fake.getApplicationDropletsWithLabelSelectorMutex.Unlock()
+	fake.GetApplicationDropletsWithLabelSelectorStub = stub
+}
+
+func (fake *FakeActor) GetApplicationDropletsWithLabelSelectorArgsForCall(i int) (string, string, string) {
+	fake.getApplicationDropletsWithLabelSelectorMutex.RLock()
+	defer fake.getApplicationDropletsWithLabelSelectorMutex.RUnlock()
+	argsForCall := fake.getApplicationDropletsWithLabelSelectorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetApplicationDropletsWithLabelSelectorReturns(result1 []resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationDropletsWithLabelSelectorMutex.Lock()
+	defer fake.getApplicationDropletsWithLabelSelectorMutex.Unlock()
+	fake.GetApplicationDropletsWithLabelSelectorStub = nil
+	fake.getApplicationDropletsWithLabelSelectorReturns = struct {
+		result1 []resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationDropletsWithLabelSelectorReturnsOnCall(i int, result1 []resources.Droplet, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationDropletsWithLabelSelectorMutex.Lock()
+	defer fake.getApplicationDropletsWithLabelSelectorMutex.Unlock()
+	fake.GetApplicationDropletsWithLabelSelectorStub = nil
+	if fake.getApplicationDropletsWithLabelSelectorReturnsOnCall == nil {
+		fake.getApplicationDropletsWithLabelSelectorReturnsOnCall = make(map[int]struct {
+			result1 []resources.Droplet
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationDropletsWithLabelSelectorReturnsOnCall[i] = struct {
+		result1 []resources.Droplet
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getApplicationLabelsMutex.Lock()
 	ret, specificReturn := fake.getApplicationLabelsReturnsOnCall[len(fake.getApplicationLabelsArgsForCall)]
@@ -8472,6 +10030,80 @@ func (fake *FakeActor) GetApplicationProcessHealthChecksByNameAndSpaceReturnsOnC
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetApplicationQuotaChange(arg1 resources.Application, arg2 []resources.Process, arg3 bool) (v7action.QuotaChange, v7action.Warnings, error) {
+	var arg2Copy []resources.Process
+	if arg2 != nil {
+		arg2Copy = make([]resources.Process, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.getApplicationQuotaChangeMutex.Lock()
+	ret, specificReturn := fake.getApplicationQuotaChangeReturnsOnCall[len(fake.getApplicationQuotaChangeArgsForCall)]
+	fake.getApplicationQuotaChangeArgsForCall = append(fake.getApplicationQuotaChangeArgsForCall, struct {
+		arg1 resources.Application
+		arg2 []resources.Process
+		arg3 bool
+	}{arg1, arg2Copy, arg3})
+	stub := fake.GetApplicationQuotaChangeStub
+	fakeReturns := fake.getApplicationQuotaChangeReturns
+	fake.recordInvocation("GetApplicationQuotaChange", []interface{}{arg1, arg2Copy, arg3})
+	fake.getApplicationQuotaChangeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetApplicationQuotaChangeCallCount() int {
+	fake.getApplicationQuotaChangeMutex.RLock()
+	defer fake.getApplicationQuotaChangeMutex.RUnlock()
+	return len(fake.getApplicationQuotaChangeArgsForCall)
+}
+
+func (fake *FakeActor) GetApplicationQuotaChangeCalls(stub func(resources.Application, []resources.Process, bool) (v7action.QuotaChange, v7action.Warnings, error)) {
+	fake.getApplicationQuotaChangeMutex.Lock()
+	defer fake.getApplicationQuotaChangeMutex.Unlock()
+	fake.GetApplicationQuotaChangeStub = stub
+}
+
+func (fake *FakeActor) GetApplicationQuotaChangeArgsForCall(i int) (resources.Application, []resources.Process, bool) {
+	fake.getApplicationQuotaChangeMutex.RLock()
+	defer fake.getApplicationQuotaChangeMutex.RUnlock()
+	argsForCall := fake.getApplicationQuotaChangeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetApplicationQuotaChangeReturns(result1 v7action.QuotaChange, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationQuotaChangeMutex.Lock()
+	defer fake.getApplicationQuotaChangeMutex.Unlock()
+	fake.GetApplicationQuotaChangeStub = nil
+	fake.getApplicationQuotaChangeReturns = struct {
+		result1 v7action.QuotaChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetApplicationQuotaChangeReturnsOnCall(i int, result1 v7action.QuotaChange, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationQuotaChangeMutex.Lock()
+	defer fake.getApplicationQuotaChangeMutex.Unlock()
+	fake.GetApplicationQuotaChangeStub = nil
+	if fake.getApplicationQuotaChangeReturnsOnCall == nil {
+		fake.getApplicationQuotaChangeReturnsOnCall = make(map[int]struct {
+			result1 v7action.QuotaChange
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationQuotaChangeReturnsOnCall[i] = struct {
+		result1 v7action.QuotaChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationRevisionsDeployed(arg1 string) ([]resources.Revision, v7action.Warnings, error) {
 	fake.getApplicationRevisionsDeployedMutex.Lock()
 	ret, specificReturn := fake.getApplicationRevisionsDeployedReturnsOnCall[len(fake.getApplicationRevisionsDeployedArgsForCall)]
@@ -8747,6 +10379,74 @@ func (fake *FakeActor) GetApplicationsByNamesAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetBuildpackAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getBuildpackAnnotationsReturnsOnCall[len(fake.getBuildpackAnnotationsArgsForCall)]
+	fake.getBuildpackAnnotationsArgsForCall = append(fake.getBuildpackAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetBuildpackAnnotationsStub
+	fakeReturns := fake.getBuildpackAnnotationsReturns
+	fake.recordInvocation("GetBuildpackAnnotations", []interface{}{arg1, arg2})
+	fake.getBuildpackAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetBuildpackAnnotationsCallCount() int {
+	fake.getBuildpackAnnotationsMutex.RLock()
+	defer fake.getBuildpackAnnotationsMutex.RUnlock()
+	return len(fake.getBuildpackAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetBuildpackAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	defer fake.getBuildpackAnnotationsMutex.Unlock()
+	fake.GetBuildpackAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetBuildpackAnnotationsArgsForCall(i int) (string, string) {
+	fake.getBuildpackAnnotationsMutex.RLock()
+	defer fake.getBuildpackAnnotationsMutex.RUnlock()
+	argsForCall := fake.getBuildpackAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetBuildpackAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	defer fake.getBuildpackAnnotationsMutex.Unlock()
+	fake.GetBuildpackAnnotationsStub = nil
+	fake.getBuildpackAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetBuildpackAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getBuildpackAnnotationsMutex.Lock()
+	defer fake.getBuildpackAnnotationsMutex.Unlock()
+	fake.GetBuildpackAnnotationsStub = nil
+	if fake.getBuildpackAnnotationsReturnsOnCall == nil {
+		fake.getBuildpackAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildpackAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetBuildpackLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getBuildpackLabelsMutex.Lock()
 	ret, specificReturn := fake.getBuildpackLabelsReturnsOnCall[len(fake.getBuildpackLabelsArgsForCall)]
@@ -8882,6 +10582,73 @@ func (fake *FakeActor) GetBuildpacksReturnsOnCall(i int, result1 []resources.Bui
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetCurrentRevision(arg1 string) (resources.Revision, v7action.Warnings, error) {
+	fake.getCurrentRevisionMutex.Lock()
+	ret, specificReturn := fake.getCurrentRevisionReturnsOnCall[len(fake.getCurrentRevisionArgsForCall)]
+	fake.getCurrentRevisionArgsForCall = append(fake.getCurrentRevisionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetCurrentRevisionStub
+	fakeReturns := fake.getCurrentRevisionReturns
+	fake.recordInvocation("GetCurrentRevision", []interface{}{arg1})
+	fake.getCurrentRevisionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetCurrentRevisionCallCount() int {
+	fake.getCurrentRevisionMutex.RLock()
+	defer fake.getCurrentRevisionMutex.RUnlock()
+	return len(fake.getCurrentRevisionArgsForCall)
+}
+
+func (fake *FakeActor) GetCurrentRevisionCalls(stub func(string) (resources.Revision, v7action.Warnings, error)) {
+	fake.getCurrentRevisionMutex.Lock()
+	defer fake.getCurrentRevisionMutex.Unlock()
+	fake.GetCurrentRevisionStub = stub
+}
+
+func (fake *FakeActor) GetCurrentRevisionArgsForCall(i int) string {
+	fake.getCurrentRevisionMutex.RLock()
+	defer fake.getCurrentRevisionMutex.RUnlock()
+	argsForCall := fake.getCurrentRevisionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetCurrentRevisionReturns(result1 resources.Revision, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentRevisionMutex.Lock()
+	defer fake.getCurrentRevisionMutex.Unlock()
+	fake.GetCurrentRevisionStub = nil
+	fake.getCurrentRevisionReturns = struct {
+		result1 resources.Revision
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetCurrentRevisionReturnsOnCall(i int, result1 resources.Revision, result2 v7action.Warnings, result3 error) {
+	fake.getCurrentRevisionMutex.Lock()
+	defer fake.getCurrentRevisionMutex.Unlock()
+	fake.GetCurrentRevisionStub = nil
+	if fake.getCurrentRevisionReturnsOnCall == nil {
+		fake.getCurrentRevisionReturnsOnCall = make(map[int]struct {
+			result1 resources.Revision
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getCurrentRevisionReturnsOnCall[i] = struct {
+		result1 resources.Revision
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetCurrentUser() (configv3.User, error) {
 	fake.getCurrentUserMutex.Lock()
 	ret, specificReturn := fake.getCurrentUserReturnsOnCall[len(fake.getCurrentUserArgsForCall)]
@@ -9141,16 +10908,16 @@ func (fake *FakeActor) GetDomainReturnsOnCall(i int, result1 resources.Domain, r
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetDomainByName(arg1 string) (resources.Domain, v7action.Warnings, error) {
-	fake.getDomainByNameMutex.Lock()
-	ret, specificReturn := fake.getDomainByNameReturnsOnCall[len(fake.getDomainByNameArgsForCall)]
-	fake.getDomainByNameArgsForCall = append(fake.getDomainByNameArgsForCall, struct {
+func (fake *FakeActor) GetDomainAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getDomainAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getDomainAnnotationsReturnsOnCall[len(fake.getDomainAnnotationsArgsForCall)]
+	fake.getDomainAnnotationsArgsForCall = append(fake.getDomainAnnotationsArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	stub := fake.GetDomainByNameStub
-	fakeReturns := fake.getDomainByNameReturns
-	fake.recordInvocation("GetDomainByName", []interface{}{arg1})
-	fake.getDomainByNameMutex.Unlock()
+	stub := fake.GetDomainAnnotationsStub
+	fakeReturns := fake.getDomainAnnotationsReturns
+	fake.recordInvocation("GetDomainAnnotations", []interface{}{arg1})
+	fake.getDomainAnnotationsMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -9160,41 +10927,108 @@ func (fake *FakeActor) GetDomainByName(arg1 string) (resources.Domain, v7action.
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetDomainByNameCallCount() int {
-	fake.getDomainByNameMutex.RLock()
-	defer fake.getDomainByNameMutex.RUnlock()
-	return len(fake.getDomainByNameArgsForCall)
+func (fake *FakeActor) GetDomainAnnotationsCallCount() int {
+	fake.getDomainAnnotationsMutex.RLock()
+	defer fake.getDomainAnnotationsMutex.RUnlock()
+	return len(fake.getDomainAnnotationsArgsForCall)
 }
 
-func (fake *FakeActor) GetDomainByNameCalls(stub func(string) (resources.Domain, v7action.Warnings, error)) {
-	fake.getDomainByNameMutex.Lock()
-	defer fake.getDomainByNameMutex.Unlock()
-	fake.GetDomainByNameStub = stub
+func (fake *FakeActor) GetDomainAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getDomainAnnotationsMutex.Lock()
+	defer fake.getDomainAnnotationsMutex.Unlock()
+	fake.GetDomainAnnotationsStub = stub
 }
 
-func (fake *FakeActor) GetDomainByNameArgsForCall(i int) string {
-	fake.getDomainByNameMutex.RLock()
-	defer fake.getDomainByNameMutex.RUnlock()
-	argsForCall := fake.getDomainByNameArgsForCall[i]
+func (fake *FakeActor) GetDomainAnnotationsArgsForCall(i int) string {
+	fake.getDomainAnnotationsMutex.RLock()
+	defer fake.getDomainAnnotationsMutex.RUnlock()
+	argsForCall := fake.getDomainAnnotationsArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeActor) GetDomainByNameReturns(result1 resources.Domain, result2 v7action.Warnings, result3 error) {
-	fake.getDomainByNameMutex.Lock()
-	defer fake.getDomainByNameMutex.Unlock()
-	fake.GetDomainByNameStub = nil
-	fake.getDomainByNameReturns = struct {
-		result1 resources.Domain
+func (fake *FakeActor) GetDomainAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getDomainAnnotationsMutex.Lock()
+	defer fake.getDomainAnnotationsMutex.Unlock()
+	fake.GetDomainAnnotationsStub = nil
+	fake.getDomainAnnotationsReturns = struct {
+		result1 map[string]types.NullString
 		result2 v7action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetDomainByNameReturnsOnCall(i int, result1 resources.Domain, result2 v7action.Warnings, result3 error) {
-	fake.getDomainByNameMutex.Lock()
-	defer fake.getDomainByNameMutex.Unlock()
-	fake.GetDomainByNameStub = nil
-	if fake.getDomainByNameReturnsOnCall == nil {
+func (fake *FakeActor) GetDomainAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getDomainAnnotationsMutex.Lock()
+	defer fake.getDomainAnnotationsMutex.Unlock()
+	fake.GetDomainAnnotationsStub = nil
+	if fake.getDomainAnnotationsReturnsOnCall == nil {
+		fake.getDomainAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDomainAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDomainByName(arg1 string) (resources.Domain, v7action.Warnings, error) {
+	fake.getDomainByNameMutex.Lock()
+	ret, specificReturn := fake.getDomainByNameReturnsOnCall[len(fake.getDomainByNameArgsForCall)]
+	fake.getDomainByNameArgsForCall = append(fake.getDomainByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetDomainByNameStub
+	fakeReturns := fake.getDomainByNameReturns
+	fake.recordInvocation("GetDomainByName", []interface{}{arg1})
+	fake.getDomainByNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetDomainByNameCallCount() int {
+	fake.getDomainByNameMutex.RLock()
+	defer fake.getDomainByNameMutex.RUnlock()
+	return len(fake.getDomainByNameArgsForCall)
+}
+
+func (fake *FakeActor) GetDomainByNameCalls(stub func(string) (resources.Domain, v7action.Warnings, error)) {
+	fake.getDomainByNameMutex.Lock()
+	defer fake.getDomainByNameMutex.Unlock()
+	fake.GetDomainByNameStub = stub
+}
+
+func (fake *FakeActor) GetDomainByNameArgsForCall(i int) string {
+	fake.getDomainByNameMutex.RLock()
+	defer fake.getDomainByNameMutex.RUnlock()
+	argsForCall := fake.getDomainByNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetDomainByNameReturns(result1 resources.Domain, result2 v7action.Warnings, result3 error) {
+	fake.getDomainByNameMutex.Lock()
+	defer fake.getDomainByNameMutex.Unlock()
+	fake.GetDomainByNameStub = nil
+	fake.getDomainByNameReturns = struct {
+		result1 resources.Domain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetDomainByNameReturnsOnCall(i int, result1 resources.Domain, result2 v7action.Warnings, result3 error) {
+	fake.getDomainByNameMutex.Lock()
+	defer fake.getDomainByNameMutex.Unlock()
+	fake.GetDomainByNameStub = nil
+	if fake.getDomainByNameReturnsOnCall == nil {
 		fake.getDomainByNameReturnsOnCall = make(map[int]struct {
 			result1 resources.Domain
 			result2 v7action.Warnings
@@ -10172,6 +12006,73 @@ func (fake *FakeActor) GetOrgUsersByRoleTypeReturnsOnCall(i int, result1 map[con
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetOrganizationAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationAnnotationsReturnsOnCall[len(fake.getOrganizationAnnotationsArgsForCall)]
+	fake.getOrganizationAnnotationsArgsForCall = append(fake.getOrganizationAnnotationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetOrganizationAnnotationsStub
+	fakeReturns := fake.getOrganizationAnnotationsReturns
+	fake.recordInvocation("GetOrganizationAnnotations", []interface{}{arg1})
+	fake.getOrganizationAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetOrganizationAnnotationsCallCount() int {
+	fake.getOrganizationAnnotationsMutex.RLock()
+	defer fake.getOrganizationAnnotationsMutex.RUnlock()
+	return len(fake.getOrganizationAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetOrganizationAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	defer fake.getOrganizationAnnotationsMutex.Unlock()
+	fake.GetOrganizationAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetOrganizationAnnotationsArgsForCall(i int) string {
+	fake.getOrganizationAnnotationsMutex.RLock()
+	defer fake.getOrganizationAnnotationsMutex.RUnlock()
+	argsForCall := fake.getOrganizationAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetOrganizationAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	defer fake.getOrganizationAnnotationsMutex.Unlock()
+	fake.GetOrganizationAnnotationsStub = nil
+	fake.getOrganizationAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationAnnotationsMutex.Lock()
+	defer fake.getOrganizationAnnotationsMutex.Unlock()
+	fake.GetOrganizationAnnotationsStub = nil
+	if fake.getOrganizationAnnotationsReturnsOnCall == nil {
+		fake.getOrganizationAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetOrganizationByName(arg1 string) (resources.Organization, v7action.Warnings, error) {
 	fake.getOrganizationByNameMutex.Lock()
 	ret, specificReturn := fake.getOrganizationByNameReturnsOnCall[len(fake.getOrganizationByNameArgsForCall)]
@@ -10441,6 +12342,73 @@ func (fake *FakeActor) GetOrganizationQuotaByNameReturnsOnCall(i int, result1 re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetOrganizationQuotaUsage(arg1 string) (v7action.OrganizationQuotaUsage, v7action.Warnings, error) {
+	fake.getOrganizationQuotaUsageMutex.Lock()
+	ret, specificReturn := fake.getOrganizationQuotaUsageReturnsOnCall[len(fake.getOrganizationQuotaUsageArgsForCall)]
+	fake.getOrganizationQuotaUsageArgsForCall = append(fake.getOrganizationQuotaUsageArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetOrganizationQuotaUsageStub
+	fakeReturns := fake.getOrganizationQuotaUsageReturns
+	fake.recordInvocation("GetOrganizationQuotaUsage", []interface{}{arg1})
+	fake.getOrganizationQuotaUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetOrganizationQuotaUsageCallCount() int {
+	fake.getOrganizationQuotaUsageMutex.RLock()
+	defer fake.getOrganizationQuotaUsageMutex.RUnlock()
+	return len(fake.getOrganizationQuotaUsageArgsForCall)
+}
+
+func (fake *FakeActor) GetOrganizationQuotaUsageCalls(stub func(string) (v7action.OrganizationQuotaUsage, v7action.Warnings, error)) {
+	fake.getOrganizationQuotaUsageMutex.Lock()
+	defer fake.getOrganizationQuotaUsageMutex.Unlock()
+	fake.GetOrganizationQuotaUsageStub = stub
+}
+
+func (fake *FakeActor) GetOrganizationQuotaUsageArgsForCall(i int) string {
+	fake.getOrganizationQuotaUsageMutex.RLock()
+	defer fake.getOrganizationQuotaUsageMutex.RUnlock()
+	argsForCall := fake.getOrganizationQuotaUsageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetOrganizationQuotaUsageReturns(result1 v7action.OrganizationQuotaUsage, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationQuotaUsageMutex.Lock()
+	defer fake.getOrganizationQuotaUsageMutex.Unlock()
+	fake.GetOrganizationQuotaUsageStub = nil
+	fake.getOrganizationQuotaUsageReturns = struct {
+		result1 v7action.OrganizationQuotaUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationQuotaUsageReturnsOnCall(i int, result1 v7action.OrganizationQuotaUsage, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationQuotaUsageMutex.Lock()
+	defer fake.getOrganizationQuotaUsageMutex.Unlock()
+	fake.GetOrganizationQuotaUsageStub = nil
+	if fake.getOrganizationQuotaUsageReturnsOnCall == nil {
+		fake.getOrganizationQuotaUsageReturnsOnCall = make(map[int]struct {
+			result1 v7action.OrganizationQuotaUsage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationQuotaUsageReturnsOnCall[i] = struct {
+		result1 v7action.OrganizationQuotaUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetOrganizationQuotas() ([]resources.OrganizationQuota, v7action.Warnings, error) {
 	fake.getOrganizationQuotasMutex.Lock()
 	ret, specificReturn := fake.getOrganizationQuotasReturnsOnCall[len(fake.getOrganizationQuotasArgsForCall)]
@@ -10769,6 +12737,65 @@ func (fake *FakeActor) GetOrganizationsReturnsOnCall(i int, result1 []resources.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetOrganizationsQuotaUsage() ([]v7action.QuotaUsageReport, v7action.Warnings, error) {
+	fake.getOrganizationsQuotaUsageMutex.Lock()
+	ret, specificReturn := fake.getOrganizationsQuotaUsageReturnsOnCall[len(fake.getOrganizationsQuotaUsageArgsForCall)]
+	fake.getOrganizationsQuotaUsageArgsForCall = append(fake.getOrganizationsQuotaUsageArgsForCall, struct {
+	}{})
+	stub := fake.GetOrganizationsQuotaUsageStub
+	fakeReturns := fake.getOrganizationsQuotaUsageReturns
+	fake.recordInvocation("GetOrganizationsQuotaUsage", []interface{}{})
+	fake.getOrganizationsQuotaUsageMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetOrganizationsQuotaUsageCallCount() int {
+	fake.getOrganizationsQuotaUsageMutex.RLock()
+	defer fake.getOrganizationsQuotaUsageMutex.RUnlock()
+	return len(fake.getOrganizationsQuotaUsageArgsForCall)
+}
+
+func (fake *FakeActor) GetOrganizationsQuotaUsageCalls(stub func() ([]v7action.QuotaUsageReport, v7action.Warnings, error)) {
+	fake.getOrganizationsQuotaUsageMutex.Lock()
+	defer fake.getOrganizationsQuotaUsageMutex.Unlock()
+	fake.GetOrganizationsQuotaUsageStub = stub
+}
+
+func (fake *FakeActor) GetOrganizationsQuotaUsageReturns(result1 []v7action.QuotaUsageReport, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationsQuotaUsageMutex.Lock()
+	defer fake.getOrganizationsQuotaUsageMutex.Unlock()
+	fake.GetOrganizationsQuotaUsageStub = nil
+	fake.getOrganizationsQuotaUsageReturns = struct {
+		result1 []v7action.QuotaUsageReport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetOrganizationsQuotaUsageReturnsOnCall(i int, result1 []v7action.QuotaUsageReport, result2 v7action.Warnings, result3 error) {
+	fake.getOrganizationsQuotaUsageMutex.Lock()
+	defer fake.getOrganizationsQuotaUsageMutex.Unlock()
+	fake.GetOrganizationsQuotaUsageStub = nil
+	if fake.getOrganizationsQuotaUsageReturnsOnCall == nil {
+		fake.getOrganizationsQuotaUsageReturnsOnCall = make(map[int]struct {
+			result1 []v7action.QuotaUsageReport
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationsQuotaUsageReturnsOnCall[i] = struct {
+		result1 []v7action.QuotaUsageReport
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetProcessByTypeAndApplication(arg1 string, arg2 string) (resources.Process, v7action.Warnings, error) {
 	fake.getProcessByTypeAndApplicationMutex.Lock()
 	ret, specificReturn := fake.getProcessByTypeAndApplicationReturnsOnCall[len(fake.getProcessByTypeAndApplicationArgsForCall)]
@@ -11042,6 +13069,75 @@ func (fake *FakeActor) GetRecentLogsForApplicationByNameAndSpaceReturnsOnCall(i
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetResourcesByLabelSelector(arg1 string, arg2 string, arg3 v7action.LabelSelectorScope) ([]v7action.MetadataResource, v7action.Warnings, error) {
+	fake.getResourcesByLabelSelectorMutex.Lock()
+	ret, specificReturn := fake.getResourcesByLabelSelectorReturnsOnCall[len(fake.getResourcesByLabelSelectorArgsForCall)]
+	fake.getResourcesByLabelSelectorArgsForCall = append(fake.getResourcesByLabelSelectorArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.LabelSelectorScope
+	}{arg1, arg2, arg3})
+	stub := fake.GetResourcesByLabelSelectorStub
+	fakeReturns := fake.getResourcesByLabelSelectorReturns
+	fake.recordInvocation("GetResourcesByLabelSelector", []interface{}{arg1, arg2, arg3})
+	fake.getResourcesByLabelSelectorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetResourcesByLabelSelectorCallCount() int {
+	fake.getResourcesByLabelSelectorMutex.RLock()
+	defer fake.getResourcesByLabelSelectorMutex.RUnlock()
+	return len(fake.getResourcesByLabelSelectorArgsForCall)
+}
+
+func (fake *FakeActor) GetResourcesByLabelSelectorCalls(stub func(string, string, v7action.LabelSelectorScope) ([]v7action.MetadataResource, v7action.Warnings, error)) {
+	fake.getResourcesByLabelSelectorMutex.Lock()
+	defer fake.getResourcesByLabelSelectorMutex.Unlock()
+	fake.GetResourcesByLabelSelectorStub = stub
+}
+
+func (fake *FakeActor) GetResourcesByLabelSelectorArgsForCall(i int) (string, string, v7action.LabelSelectorScope) {
+	fake.getResourcesByLabelSelectorMutex.RLock()
+	defer fake.getResourcesByLabelSelectorMutex.RUnlock()
+	argsForCall := fake.getResourcesByLabelSelectorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetResourcesByLabelSelectorReturns(result1 []v7action.MetadataResource, result2 v7action.Warnings, result3 error) {
+	fake.getResourcesByLabelSelectorMutex.Lock()
+	defer fake.getResourcesByLabelSelectorMutex.Unlock()
+	fake.GetResourcesByLabelSelectorStub = nil
+	fake.getResourcesByLabelSelectorReturns = struct {
+		result1 []v7action.MetadataResource
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetResourcesByLabelSelectorReturnsOnCall(i int, result1 []v7action.MetadataResource, result2 v7action.Warnings, result3 error) {
+	fake.getResourcesByLabelSelectorMutex.Lock()
+	defer fake.getResourcesByLabelSelectorMutex.Unlock()
+	fake.GetResourcesByLabelSelectorStub = nil
+	if fake.getResourcesByLabelSelectorReturnsOnCall == nil {
+		fake.getResourcesByLabelSelectorReturnsOnCall = make(map[int]struct {
+			result1 []v7action.MetadataResource
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getResourcesByLabelSelectorReturnsOnCall[i] = struct {
+		result1 []v7action.MetadataResource
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRevisionByApplicationAndVersion(arg1 string, arg2 int) (resources.Revision, v7action.Warnings, error) {
 	fake.getRevisionByApplicationAndVersionMutex.Lock()
 	ret, specificReturn := fake.getRevisionByApplicationAndVersionReturnsOnCall[len(fake.getRevisionByApplicationAndVersionArgsForCall)]
@@ -11110,6 +13206,73 @@ func (fake *FakeActor) GetRevisionByApplicationAndVersionReturnsOnCall(i int, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRevisionDetails(arg1 resources.Revision) (v7action.RevisionDetails, v7action.Warnings, error) {
+	fake.getRevisionDetailsMutex.Lock()
+	ret, specificReturn := fake.getRevisionDetailsReturnsOnCall[len(fake.getRevisionDetailsArgsForCall)]
+	fake.getRevisionDetailsArgsForCall = append(fake.getRevisionDetailsArgsForCall, struct {
+		arg1 resources.Revision
+	}{arg1})
+	stub := fake.GetRevisionDetailsStub
+	fakeReturns := fake.getRevisionDetailsReturns
+	fake.recordInvocation("GetRevisionDetails", []interface{}{arg1})
+	fake.getRevisionDetailsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRevisionDetailsCallCount() int {
+	fake.getRevisionDetailsMutex.RLock()
+	defer fake.getRevisionDetailsMutex.RUnlock()
+	return len(fake.getRevisionDetailsArgsForCall)
+}
+
+func (fake *FakeActor) GetRevisionDetailsCalls(stub func(resources.Revision) (v7action.RevisionDetails, v7action.Warnings, error)) {
+	fake.getRevisionDetailsMutex.Lock()
+	defer fake.getRevisionDetailsMutex.Unlock()
+	fake.GetRevisionDetailsStub = stub
+}
+
+func (fake *FakeActor) GetRevisionDetailsArgsForCall(i int) resources.Revision {
+	fake.getRevisionDetailsMutex.RLock()
+	defer fake.getRevisionDetailsMutex.RUnlock()
+	argsForCall := fake.getRevisionDetailsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRevisionDetailsReturns(result1 v7action.RevisionDetails, result2 v7action.Warnings, result3 error) {
+	fake.getRevisionDetailsMutex.Lock()
+	defer fake.getRevisionDetailsMutex.Unlock()
+	fake.GetRevisionDetailsStub = nil
+	fake.getRevisionDetailsReturns = struct {
+		result1 v7action.RevisionDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRevisionDetailsReturnsOnCall(i int, result1 v7action.RevisionDetails, result2 v7action.Warnings, result3 error) {
+	fake.getRevisionDetailsMutex.Lock()
+	defer fake.getRevisionDetailsMutex.Unlock()
+	fake.GetRevisionDetailsStub = nil
+	if fake.getRevisionDetailsReturnsOnCall == nil {
+		fake.getRevisionDetailsReturnsOnCall = make(map[int]struct {
+			result1 v7action.RevisionDetails
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRevisionDetailsReturnsOnCall[i] = struct {
+		result1 v7action.RevisionDetails
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRevisionsByApplicationNameAndSpace(arg1 string, arg2 string) ([]resources.Revision, v7action.Warnings, error) {
 	fake.getRevisionsByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getRevisionsByApplicationNameAndSpaceReturnsOnCall[len(fake.getRevisionsByApplicationNameAndSpaceArgsForCall)]
@@ -11237,21 +13400,19 @@ func (fake *FakeActor) GetRootResponseReturnsOnCall(i int, result1 v7action.Info
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetRouteByAttributes(arg1 resources.Domain, arg2 string, arg3 string, arg4 int) (resources.Route, v7action.Warnings, error) {
-	fake.getRouteByAttributesMutex.Lock()
-	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
-	fake.getRouteByAttributesArgsForCall = append(fake.getRouteByAttributesArgsForCall, struct {
-		arg1 resources.Domain
+func (fake *FakeActor) GetRoute(arg1 string, arg2 string) (resources.Route, v7action.Warnings, error) {
+	fake.getRouteMutex.Lock()
+	ret, specificReturn := fake.getRouteReturnsOnCall[len(fake.getRouteArgsForCall)]
+	fake.getRouteArgsForCall = append(fake.getRouteArgsForCall, struct {
+		arg1 string
 		arg2 string
-		arg3 string
-		arg4 int
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.GetRouteByAttributesStub
-	fakeReturns := fake.getRouteByAttributesReturns
-	fake.recordInvocation("GetRouteByAttributes", []interface{}{arg1, arg2, arg3, arg4})
-	fake.getRouteByAttributesMutex.Unlock()
+	}{arg1, arg2})
+	stub := fake.GetRouteStub
+	fakeReturns := fake.getRouteReturns
+	fake.recordInvocation("GetRoute", []interface{}{arg1, arg2})
+	fake.getRouteMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -11259,27 +13420,165 @@ func (fake *FakeActor) GetRouteByAttributes(arg1 resources.Domain, arg2 string,
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetRouteByAttributesCallCount() int {
-	fake.getRouteByAttributesMutex.RLock()
-	defer fake.getRouteByAttributesMutex.RUnlock()
-	return len(fake.getRouteByAttributesArgsForCall)
+func (fake *FakeActor) GetRouteCallCount() int {
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	return len(fake.getRouteArgsForCall)
 }
 
-func (fake *FakeActor) GetRouteByAttributesCalls(stub func(resources.Domain, string, string, int) (resources.Route, v7action.Warnings, error)) {
-	fake.getRouteByAttributesMutex.Lock()
-	defer fake.getRouteByAttributesMutex.Unlock()
-	fake.GetRouteByAttributesStub = stub
+func (fake *FakeActor) GetRouteCalls(stub func(string, string) (resources.Route, v7action.Warnings, error)) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = stub
 }
 
-func (fake *FakeActor) GetRouteByAttributesArgsForCall(i int) (resources.Domain, string, string, int) {
-	fake.getRouteByAttributesMutex.RLock()
-	defer fake.getRouteByAttributesMutex.RUnlock()
-	argsForCall := fake.getRouteByAttributesArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeActor) GetRouteArgsForCall(i int) (string, string) {
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	argsForCall := fake.getRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeActor) GetRouteByAttributesReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
-	fake.getRouteByAttributesMutex.Lock()
+func (fake *FakeActor) GetRouteReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = nil
+	fake.getRouteReturns = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteReturnsOnCall(i int, result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteMutex.Lock()
+	defer fake.getRouteMutex.Unlock()
+	fake.GetRouteStub = nil
+	if fake.getRouteReturnsOnCall == nil {
+		fake.getRouteReturnsOnCall = make(map[int]struct {
+			result1 resources.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteReturnsOnCall[i] = struct {
+		result1 resources.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getRouteAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getRouteAnnotationsReturnsOnCall[len(fake.getRouteAnnotationsArgsForCall)]
+	fake.getRouteAnnotationsArgsForCall = append(fake.getRouteAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetRouteAnnotationsStub
+	fakeReturns := fake.getRouteAnnotationsReturns
+	fake.recordInvocation("GetRouteAnnotations", []interface{}{arg1, arg2})
+	fake.getRouteAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouteAnnotationsCallCount() int {
+	fake.getRouteAnnotationsMutex.RLock()
+	defer fake.getRouteAnnotationsMutex.RUnlock()
+	return len(fake.getRouteAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetRouteAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getRouteAnnotationsMutex.Lock()
+	defer fake.getRouteAnnotationsMutex.Unlock()
+	fake.GetRouteAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetRouteAnnotationsArgsForCall(i int) (string, string) {
+	fake.getRouteAnnotationsMutex.RLock()
+	defer fake.getRouteAnnotationsMutex.RUnlock()
+	argsForCall := fake.getRouteAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetRouteAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getRouteAnnotationsMutex.Lock()
+	defer fake.getRouteAnnotationsMutex.Unlock()
+	fake.GetRouteAnnotationsStub = nil
+	fake.getRouteAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getRouteAnnotationsMutex.Lock()
+	defer fake.getRouteAnnotationsMutex.Unlock()
+	fake.GetRouteAnnotationsStub = nil
+	if fake.getRouteAnnotationsReturnsOnCall == nil {
+		fake.getRouteAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteByAttributes(arg1 resources.Domain, arg2 string, arg3 string, arg4 int) (resources.Route, v7action.Warnings, error) {
+	fake.getRouteByAttributesMutex.Lock()
+	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
+	fake.getRouteByAttributesArgsForCall = append(fake.getRouteByAttributesArgsForCall, struct {
+		arg1 resources.Domain
+		arg2 string
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetRouteByAttributesStub
+	fakeReturns := fake.getRouteByAttributesReturns
+	fake.recordInvocation("GetRouteByAttributes", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getRouteByAttributesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouteByAttributesCallCount() int {
+	fake.getRouteByAttributesMutex.RLock()
+	defer fake.getRouteByAttributesMutex.RUnlock()
+	return len(fake.getRouteByAttributesArgsForCall)
+}
+
+func (fake *FakeActor) GetRouteByAttributesCalls(stub func(resources.Domain, string, string, int) (resources.Route, v7action.Warnings, error)) {
+	fake.getRouteByAttributesMutex.Lock()
+	defer fake.getRouteByAttributesMutex.Unlock()
+	fake.GetRouteByAttributesStub = stub
+}
+
+func (fake *FakeActor) GetRouteByAttributesArgsForCall(i int) (resources.Domain, string, string, int) {
+	fake.getRouteByAttributesMutex.RLock()
+	defer fake.getRouteByAttributesMutex.RUnlock()
+	argsForCall := fake.getRouteByAttributesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetRouteByAttributesReturns(result1 resources.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteByAttributesMutex.Lock()
 	defer fake.getRouteByAttributesMutex.Unlock()
 	fake.GetRouteByAttributesStub = nil
 	fake.getRouteByAttributesReturns = struct {
@@ -11372,6 +13671,140 @@ func (fake *FakeActor) GetRouteDestinationByAppGUIDReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
+func (fake *FakeActor) GetRouteDestinationsHealth(arg1 resources.Route) ([]v7action.RouteDestinationHealth, v7action.Warnings, error) {
+	fake.getRouteDestinationsHealthMutex.Lock()
+	ret, specificReturn := fake.getRouteDestinationsHealthReturnsOnCall[len(fake.getRouteDestinationsHealthArgsForCall)]
+	fake.getRouteDestinationsHealthArgsForCall = append(fake.getRouteDestinationsHealthArgsForCall, struct {
+		arg1 resources.Route
+	}{arg1})
+	stub := fake.GetRouteDestinationsHealthStub
+	fakeReturns := fake.getRouteDestinationsHealthReturns
+	fake.recordInvocation("GetRouteDestinationsHealth", []interface{}{arg1})
+	fake.getRouteDestinationsHealthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouteDestinationsHealthCallCount() int {
+	fake.getRouteDestinationsHealthMutex.RLock()
+	defer fake.getRouteDestinationsHealthMutex.RUnlock()
+	return len(fake.getRouteDestinationsHealthArgsForCall)
+}
+
+func (fake *FakeActor) GetRouteDestinationsHealthCalls(stub func(resources.Route) ([]v7action.RouteDestinationHealth, v7action.Warnings, error)) {
+	fake.getRouteDestinationsHealthMutex.Lock()
+	defer fake.getRouteDestinationsHealthMutex.Unlock()
+	fake.GetRouteDestinationsHealthStub = stub
+}
+
+func (fake *FakeActor) GetRouteDestinationsHealthArgsForCall(i int) resources.Route {
+	fake.getRouteDestinationsHealthMutex.RLock()
+	defer fake.getRouteDestinationsHealthMutex.RUnlock()
+	argsForCall := fake.getRouteDestinationsHealthArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRouteDestinationsHealthReturns(result1 []v7action.RouteDestinationHealth, result2 v7action.Warnings, result3 error) {
+	fake.getRouteDestinationsHealthMutex.Lock()
+	defer fake.getRouteDestinationsHealthMutex.Unlock()
+	fake.GetRouteDestinationsHealthStub = nil
+	fake.getRouteDestinationsHealthReturns = struct {
+		result1 []v7action.RouteDestinationHealth
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteDestinationsHealthReturnsOnCall(i int, result1 []v7action.RouteDestinationHealth, result2 v7action.Warnings, result3 error) {
+	fake.getRouteDestinationsHealthMutex.Lock()
+	defer fake.getRouteDestinationsHealthMutex.Unlock()
+	fake.GetRouteDestinationsHealthStub = nil
+	if fake.getRouteDestinationsHealthReturnsOnCall == nil {
+		fake.getRouteDestinationsHealthReturnsOnCall = make(map[int]struct {
+			result1 []v7action.RouteDestinationHealth
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteDestinationsHealthReturnsOnCall[i] = struct {
+		result1 []v7action.RouteDestinationHealth
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteInventory(arg1 string) (v7action.RouteInventory, v7action.Warnings, error) {
+	fake.getRouteInventoryMutex.Lock()
+	ret, specificReturn := fake.getRouteInventoryReturnsOnCall[len(fake.getRouteInventoryArgsForCall)]
+	fake.getRouteInventoryArgsForCall = append(fake.getRouteInventoryArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetRouteInventoryStub
+	fakeReturns := fake.getRouteInventoryReturns
+	fake.recordInvocation("GetRouteInventory", []interface{}{arg1})
+	fake.getRouteInventoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouteInventoryCallCount() int {
+	fake.getRouteInventoryMutex.RLock()
+	defer fake.getRouteInventoryMutex.RUnlock()
+	return len(fake.getRouteInventoryArgsForCall)
+}
+
+func (fake *FakeActor) GetRouteInventoryCalls(stub func(string) (v7action.RouteInventory, v7action.Warnings, error)) {
+	fake.getRouteInventoryMutex.Lock()
+	defer fake.getRouteInventoryMutex.Unlock()
+	fake.GetRouteInventoryStub = stub
+}
+
+func (fake *FakeActor) GetRouteInventoryArgsForCall(i int) string {
+	fake.getRouteInventoryMutex.RLock()
+	defer fake.getRouteInventoryMutex.RUnlock()
+	argsForCall := fake.getRouteInventoryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRouteInventoryReturns(result1 v7action.RouteInventory, result2 v7action.Warnings, result3 error) {
+	fake.getRouteInventoryMutex.Lock()
+	defer fake.getRouteInventoryMutex.Unlock()
+	fake.GetRouteInventoryStub = nil
+	fake.getRouteInventoryReturns = struct {
+		result1 v7action.RouteInventory
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouteInventoryReturnsOnCall(i int, result1 v7action.RouteInventory, result2 v7action.Warnings, result3 error) {
+	fake.getRouteInventoryMutex.Lock()
+	defer fake.getRouteInventoryMutex.Unlock()
+	fake.GetRouteInventoryStub = nil
+	if fake.getRouteInventoryReturnsOnCall == nil {
+		fake.getRouteInventoryReturnsOnCall = make(map[int]struct {
+			result1 v7action.RouteInventory
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteInventoryReturnsOnCall[i] = struct {
+		result1 v7action.RouteInventory
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRouteLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getRouteLabelsMutex.Lock()
 	ret, specificReturn := fake.getRouteLabelsReturnsOnCall[len(fake.getRouteLabelsArgsForCall)]
@@ -11512,6 +13945,73 @@ func (fake *FakeActor) GetRouteSummariesReturnsOnCall(i int, result1 []v7action.
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetRouterGroupPortUsage(arg1 string) (v7action.RouterGroupPortUsage, v7action.Warnings, error) {
+	fake.getRouterGroupPortUsageMutex.Lock()
+	ret, specificReturn := fake.getRouterGroupPortUsageReturnsOnCall[len(fake.getRouterGroupPortUsageArgsForCall)]
+	fake.getRouterGroupPortUsageArgsForCall = append(fake.getRouterGroupPortUsageArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetRouterGroupPortUsageStub
+	fakeReturns := fake.getRouterGroupPortUsageReturns
+	fake.recordInvocation("GetRouterGroupPortUsage", []interface{}{arg1})
+	fake.getRouterGroupPortUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetRouterGroupPortUsageCallCount() int {
+	fake.getRouterGroupPortUsageMutex.RLock()
+	defer fake.getRouterGroupPortUsageMutex.RUnlock()
+	return len(fake.getRouterGroupPortUsageArgsForCall)
+}
+
+func (fake *FakeActor) GetRouterGroupPortUsageCalls(stub func(string) (v7action.RouterGroupPortUsage, v7action.Warnings, error)) {
+	fake.getRouterGroupPortUsageMutex.Lock()
+	defer fake.getRouterGroupPortUsageMutex.Unlock()
+	fake.GetRouterGroupPortUsageStub = stub
+}
+
+func (fake *FakeActor) GetRouterGroupPortUsageArgsForCall(i int) string {
+	fake.getRouterGroupPortUsageMutex.RLock()
+	defer fake.getRouterGroupPortUsageMutex.RUnlock()
+	argsForCall := fake.getRouterGroupPortUsageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetRouterGroupPortUsageReturns(result1 v7action.RouterGroupPortUsage, result2 v7action.Warnings, result3 error) {
+	fake.getRouterGroupPortUsageMutex.Lock()
+	defer fake.getRouterGroupPortUsageMutex.Unlock()
+	fake.GetRouterGroupPortUsageStub = nil
+	fake.getRouterGroupPortUsageReturns = struct {
+		result1 v7action.RouterGroupPortUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetRouterGroupPortUsageReturnsOnCall(i int, result1 v7action.RouterGroupPortUsage, result2 v7action.Warnings, result3 error) {
+	fake.getRouterGroupPortUsageMutex.Lock()
+	defer fake.getRouterGroupPortUsageMutex.Unlock()
+	fake.GetRouterGroupPortUsageStub = nil
+	if fake.getRouterGroupPortUsageReturnsOnCall == nil {
+		fake.getRouterGroupPortUsageReturnsOnCall = make(map[int]struct {
+			result1 v7action.RouterGroupPortUsage
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouterGroupPortUsageReturnsOnCall[i] = struct {
+		result1 v7action.RouterGroupPortUsage
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetRouterGroups() ([]v7action.RouterGroup, error) {
 	fake.getRouterGroupsMutex.Lock()
 	ret, specificReturn := fake.getRouterGroupsReturnsOnCall[len(fake.getRouterGroupsArgsForCall)]
@@ -11965,6 +14465,76 @@ func (fake *FakeActor) GetSecureShellConfigurationByApplicationNameSpaceProcessT
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetSecureShellConfigurationForRunningInstance(arg1 string, arg2 string, arg3 string, arg4 uint) (v7action.SSHAuthentication, v7action.Warnings, error) {
+	fake.getSecureShellConfigurationForRunningInstanceMutex.Lock()
+	ret, specificReturn := fake.getSecureShellConfigurationForRunningInstanceReturnsOnCall[len(fake.getSecureShellConfigurationForRunningInstanceArgsForCall)]
+	fake.getSecureShellConfigurationForRunningInstanceArgsForCall = append(fake.getSecureShellConfigurationForRunningInstanceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 uint
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetSecureShellConfigurationForRunningInstanceStub
+	fakeReturns := fake.getSecureShellConfigurationForRunningInstanceReturns
+	fake.recordInvocation("GetSecureShellConfigurationForRunningInstance", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getSecureShellConfigurationForRunningInstanceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationForRunningInstanceCallCount() int {
+	fake.getSecureShellConfigurationForRunningInstanceMutex.RLock()
+	defer fake.getSecureShellConfigurationForRunningInstanceMutex.RUnlock()
+	return len(fake.getSecureShellConfigurationForRunningInstanceArgsForCall)
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationForRunningInstanceCalls(stub func(string, string, string, uint) (v7action.SSHAuthentication, v7action.Warnings, error)) {
+	fake.getSecureShellConfigurationForRunningInstanceMutex.Lock()
+	defer fake.getSecureShellConfigurationForRunningInstanceMutex.Unlock()
+	fake.GetSecureShellConfigurationForRunningInstanceStub = stub
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationForRunningInstanceArgsForCall(i int) (string, string, string, uint) {
+	fake.getSecureShellConfigurationForRunningInstanceMutex.RLock()
+	defer fake.getSecureShellConfigurationForRunningInstanceMutex.RUnlock()
+	argsForCall := fake.getSecureShellConfigurationForRunningInstanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationForRunningInstanceReturns(result1 v7action.SSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationForRunningInstanceMutex.Lock()
+	defer fake.getSecureShellConfigurationForRunningInstanceMutex.Unlock()
+	fake.GetSecureShellConfigurationForRunningInstanceStub = nil
+	fake.getSecureShellConfigurationForRunningInstanceReturns = struct {
+		result1 v7action.SSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSecureShellConfigurationForRunningInstanceReturnsOnCall(i int, result1 v7action.SSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationForRunningInstanceMutex.Lock()
+	defer fake.getSecureShellConfigurationForRunningInstanceMutex.Unlock()
+	fake.GetSecureShellConfigurationForRunningInstanceStub = nil
+	if fake.getSecureShellConfigurationForRunningInstanceReturnsOnCall == nil {
+		fake.getSecureShellConfigurationForRunningInstanceReturnsOnCall = make(map[int]struct {
+			result1 v7action.SSHAuthentication
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecureShellConfigurationForRunningInstanceReturnsOnCall[i] = struct {
+		result1 v7action.SSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSecurityGroup(arg1 string) (resources.SecurityGroup, v7action.Warnings, error) {
 	fake.getSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.getSecurityGroupReturnsOnCall[len(fake.getSecurityGroupArgsForCall)]
@@ -12227,16 +14797,21 @@ func (fake *FakeActor) GetServiceAccessReturnsOnCall(i int, result1 []v7action.S
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetServiceBrokerByName(arg1 string) (resources.ServiceBroker, v7action.Warnings, error) {
-	fake.getServiceBrokerByNameMutex.Lock()
-	ret, specificReturn := fake.getServiceBrokerByNameReturnsOnCall[len(fake.getServiceBrokerByNameArgsForCall)]
-	fake.getServiceBrokerByNameArgsForCall = append(fake.getServiceBrokerByNameArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	stub := fake.GetServiceBrokerByNameStub
-	fakeReturns := fake.getServiceBrokerByNameReturns
-	fake.recordInvocation("GetServiceBrokerByName", []interface{}{arg1})
-	fake.getServiceBrokerByNameMutex.Unlock()
+func (fake *FakeActor) GetServiceAccessChanges(arg1 []v7action.DesiredServiceAccess) ([]v7action.ServiceAccessChange, v7action.Warnings, error) {
+	var arg1Copy []v7action.DesiredServiceAccess
+	if arg1 != nil {
+		arg1Copy = make([]v7action.DesiredServiceAccess, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getServiceAccessChangesMutex.Lock()
+	ret, specificReturn := fake.getServiceAccessChangesReturnsOnCall[len(fake.getServiceAccessChangesArgsForCall)]
+	fake.getServiceAccessChangesArgsForCall = append(fake.getServiceAccessChangesArgsForCall, struct {
+		arg1 []v7action.DesiredServiceAccess
+	}{arg1Copy})
+	stub := fake.GetServiceAccessChangesStub
+	fakeReturns := fake.getServiceAccessChangesReturns
+	fake.recordInvocation("GetServiceAccessChanges", []interface{}{arg1Copy})
+	fake.getServiceAccessChangesMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
@@ -12246,22 +14821,224 @@ func (fake *FakeActor) GetServiceBrokerByName(arg1 string) (resources.ServiceBro
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeActor) GetServiceBrokerByNameCallCount() int {
-	fake.getServiceBrokerByNameMutex.RLock()
-	defer fake.getServiceBrokerByNameMutex.RUnlock()
-	return len(fake.getServiceBrokerByNameArgsForCall)
+func (fake *FakeActor) GetServiceAccessChangesCallCount() int {
+	fake.getServiceAccessChangesMutex.RLock()
+	defer fake.getServiceAccessChangesMutex.RUnlock()
+	return len(fake.getServiceAccessChangesArgsForCall)
 }
 
-func (fake *FakeActor) GetServiceBrokerByNameCalls(stub func(string) (resources.ServiceBroker, v7action.Warnings, error)) {
-	fake.getServiceBrokerByNameMutex.Lock()
-	defer fake.getServiceBrokerByNameMutex.Unlock()
-	fake.GetServiceBrokerByNameStub = stub
+func (fake *FakeActor) GetServiceAccessChangesCalls(stub func([]v7action.DesiredServiceAccess) ([]v7action.ServiceAccessChange, v7action.Warnings, error)) {
+	fake.getServiceAccessChangesMutex.Lock()
+	defer fake.getServiceAccessChangesMutex.Unlock()
+	fake.GetServiceAccessChangesStub = stub
 }
 
-func (fake *FakeActor) GetServiceBrokerByNameArgsForCall(i int) string {
-	fake.getServiceBrokerByNameMutex.RLock()
-	defer fake.getServiceBrokerByNameMutex.RUnlock()
-	argsForCall := fake.getServiceBrokerByNameArgsForCall[i]
+func (fake *FakeActor) GetServiceAccessChangesArgsForCall(i int) []v7action.DesiredServiceAccess {
+	fake.getServiceAccessChangesMutex.RLock()
+	defer fake.getServiceAccessChangesMutex.RUnlock()
+	argsForCall := fake.getServiceAccessChangesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceAccessChangesReturns(result1 []v7action.ServiceAccessChange, result2 v7action.Warnings, result3 error) {
+	fake.getServiceAccessChangesMutex.Lock()
+	defer fake.getServiceAccessChangesMutex.Unlock()
+	fake.GetServiceAccessChangesStub = nil
+	fake.getServiceAccessChangesReturns = struct {
+		result1 []v7action.ServiceAccessChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceAccessChangesReturnsOnCall(i int, result1 []v7action.ServiceAccessChange, result2 v7action.Warnings, result3 error) {
+	fake.getServiceAccessChangesMutex.Lock()
+	defer fake.getServiceAccessChangesMutex.Unlock()
+	fake.GetServiceAccessChangesStub = nil
+	if fake.getServiceAccessChangesReturnsOnCall == nil {
+		fake.getServiceAccessChangesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceAccessChange
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceAccessChangesReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceAccessChange
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceAccessMatrix(arg1 string, arg2 string) (v7action.ServiceAccessMatrix, v7action.Warnings, error) {
+	fake.getServiceAccessMatrixMutex.Lock()
+	ret, specificReturn := fake.getServiceAccessMatrixReturnsOnCall[len(fake.getServiceAccessMatrixArgsForCall)]
+	fake.getServiceAccessMatrixArgsForCall = append(fake.getServiceAccessMatrixArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetServiceAccessMatrixStub
+	fakeReturns := fake.getServiceAccessMatrixReturns
+	fake.recordInvocation("GetServiceAccessMatrix", []interface{}{arg1, arg2})
+	fake.getServiceAccessMatrixMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceAccessMatrixCallCount() int {
+	fake.getServiceAccessMatrixMutex.RLock()
+	defer fake.getServiceAccessMatrixMutex.RUnlock()
+	return len(fake.getServiceAccessMatrixArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceAccessMatrixCalls(stub func(string, string) (v7action.ServiceAccessMatrix, v7action.Warnings, error)) {
+	fake.getServiceAccessMatrixMutex.Lock()
+	defer fake.getServiceAccessMatrixMutex.Unlock()
+	fake.GetServiceAccessMatrixStub = stub
+}
+
+func (fake *FakeActor) GetServiceAccessMatrixArgsForCall(i int) (string, string) {
+	fake.getServiceAccessMatrixMutex.RLock()
+	defer fake.getServiceAccessMatrixMutex.RUnlock()
+	argsForCall := fake.getServiceAccessMatrixArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetServiceAccessMatrixReturns(result1 v7action.ServiceAccessMatrix, result2 v7action.Warnings, result3 error) {
+	fake.getServiceAccessMatrixMutex.Lock()
+	defer fake.getServiceAccessMatrixMutex.Unlock()
+	fake.GetServiceAccessMatrixStub = nil
+	fake.getServiceAccessMatrixReturns = struct {
+		result1 v7action.ServiceAccessMatrix
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceAccessMatrixReturnsOnCall(i int, result1 v7action.ServiceAccessMatrix, result2 v7action.Warnings, result3 error) {
+	fake.getServiceAccessMatrixMutex.Lock()
+	defer fake.getServiceAccessMatrixMutex.Unlock()
+	fake.GetServiceAccessMatrixStub = nil
+	if fake.getServiceAccessMatrixReturnsOnCall == nil {
+		fake.getServiceAccessMatrixReturnsOnCall = make(map[int]struct {
+			result1 v7action.ServiceAccessMatrix
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceAccessMatrixReturnsOnCall[i] = struct {
+		result1 v7action.ServiceAccessMatrix
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getServiceBrokerAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokerAnnotationsReturnsOnCall[len(fake.getServiceBrokerAnnotationsArgsForCall)]
+	fake.getServiceBrokerAnnotationsArgsForCall = append(fake.getServiceBrokerAnnotationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceBrokerAnnotationsStub
+	fakeReturns := fake.getServiceBrokerAnnotationsReturns
+	fake.recordInvocation("GetServiceBrokerAnnotations", []interface{}{arg1})
+	fake.getServiceBrokerAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotationsCallCount() int {
+	fake.getServiceBrokerAnnotationsMutex.RLock()
+	defer fake.getServiceBrokerAnnotationsMutex.RUnlock()
+	return len(fake.getServiceBrokerAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getServiceBrokerAnnotationsMutex.Lock()
+	defer fake.getServiceBrokerAnnotationsMutex.Unlock()
+	fake.GetServiceBrokerAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotationsArgsForCall(i int) string {
+	fake.getServiceBrokerAnnotationsMutex.RLock()
+	defer fake.getServiceBrokerAnnotationsMutex.RUnlock()
+	argsForCall := fake.getServiceBrokerAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerAnnotationsMutex.Lock()
+	defer fake.getServiceBrokerAnnotationsMutex.Unlock()
+	fake.GetServiceBrokerAnnotationsStub = nil
+	fake.getServiceBrokerAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceBrokerAnnotationsMutex.Lock()
+	defer fake.getServiceBrokerAnnotationsMutex.Unlock()
+	fake.GetServiceBrokerAnnotationsStub = nil
+	if fake.getServiceBrokerAnnotationsReturnsOnCall == nil {
+		fake.getServiceBrokerAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBrokerAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceBrokerByName(arg1 string) (resources.ServiceBroker, v7action.Warnings, error) {
+	fake.getServiceBrokerByNameMutex.Lock()
+	ret, specificReturn := fake.getServiceBrokerByNameReturnsOnCall[len(fake.getServiceBrokerByNameArgsForCall)]
+	fake.getServiceBrokerByNameArgsForCall = append(fake.getServiceBrokerByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetServiceBrokerByNameStub
+	fakeReturns := fake.getServiceBrokerByNameReturns
+	fake.recordInvocation("GetServiceBrokerByName", []interface{}{arg1})
+	fake.getServiceBrokerByNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceBrokerByNameCallCount() int {
+	fake.getServiceBrokerByNameMutex.RLock()
+	defer fake.getServiceBrokerByNameMutex.RUnlock()
+	return len(fake.getServiceBrokerByNameArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceBrokerByNameCalls(stub func(string) (resources.ServiceBroker, v7action.Warnings, error)) {
+	fake.getServiceBrokerByNameMutex.Lock()
+	defer fake.getServiceBrokerByNameMutex.Unlock()
+	fake.GetServiceBrokerByNameStub = stub
+}
+
+func (fake *FakeActor) GetServiceBrokerByNameArgsForCall(i int) string {
+	fake.getServiceBrokerByNameMutex.RLock()
+	defer fake.getServiceBrokerByNameMutex.RUnlock()
+	argsForCall := fake.getServiceBrokerByNameArgsForCall[i]
 	return argsForCall.arg1
 }
 
@@ -12361,17 +15138,18 @@ func (fake *FakeActor) GetServiceBrokerLabelsReturnsOnCall(i int, result1 map[st
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetServiceBrokers() ([]resources.ServiceBroker, v7action.Warnings, error) {
+func (fake *FakeActor) GetServiceBrokers(arg1 string) ([]resources.ServiceBroker, v7action.Warnings, error) {
 	fake.getServiceBrokersMutex.Lock()
 	ret, specificReturn := fake.getServiceBrokersReturnsOnCall[len(fake.getServiceBrokersArgsForCall)]
 	fake.getServiceBrokersArgsForCall = append(fake.getServiceBrokersArgsForCall, struct {
-	}{})
+		arg1 string
+	}{arg1})
 	stub := fake.GetServiceBrokersStub
 	fakeReturns := fake.getServiceBrokersReturns
-	fake.recordInvocation("GetServiceBrokers", []interface{}{})
+	fake.recordInvocation("GetServiceBrokers", []interface{}{arg1})
 	fake.getServiceBrokersMutex.Unlock()
 	if stub != nil {
-		return stub()
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -12385,12 +15163,19 @@ func (fake *FakeActor) GetServiceBrokersCallCount() int {
 	return len(fake.getServiceBrokersArgsForCall)
 }
 
-func (fake *FakeActor) GetServiceBrokersCalls(stub func() ([]resources.ServiceBroker, v7action.Warnings, error)) {
+func (fake *FakeActor) GetServiceBrokersCalls(stub func(string) ([]resources.ServiceBroker, v7action.Warnings, error)) {
 	fake.getServiceBrokersMutex.Lock()
 	defer fake.getServiceBrokersMutex.Unlock()
 	fake.GetServiceBrokersStub = stub
 }
 
+func (fake *FakeActor) GetServiceBrokersArgsForCall(i int) string {
+	fake.getServiceBrokersMutex.RLock()
+	defer fake.getServiceBrokersMutex.RUnlock()
+	argsForCall := fake.getServiceBrokersArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeActor) GetServiceBrokersReturns(result1 []resources.ServiceBroker, result2 v7action.Warnings, result3 error) {
 	fake.getServiceBrokersMutex.Lock()
 	defer fake.getServiceBrokersMutex.Unlock()
@@ -12420,6 +15205,74 @@ func (fake *FakeActor) GetServiceBrokersReturnsOnCall(i int, result1 []resources
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceInstanceAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getServiceInstanceAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceAnnotationsReturnsOnCall[len(fake.getServiceInstanceAnnotationsArgsForCall)]
+	fake.getServiceInstanceAnnotationsArgsForCall = append(fake.getServiceInstanceAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetServiceInstanceAnnotationsStub
+	fakeReturns := fake.getServiceInstanceAnnotationsReturns
+	fake.recordInvocation("GetServiceInstanceAnnotations", []interface{}{arg1, arg2})
+	fake.getServiceInstanceAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotationsCallCount() int {
+	fake.getServiceInstanceAnnotationsMutex.RLock()
+	defer fake.getServiceInstanceAnnotationsMutex.RUnlock()
+	return len(fake.getServiceInstanceAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getServiceInstanceAnnotationsMutex.Lock()
+	defer fake.getServiceInstanceAnnotationsMutex.Unlock()
+	fake.GetServiceInstanceAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotationsArgsForCall(i int) (string, string) {
+	fake.getServiceInstanceAnnotationsMutex.RLock()
+	defer fake.getServiceInstanceAnnotationsMutex.RUnlock()
+	argsForCall := fake.getServiceInstanceAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceAnnotationsMutex.Lock()
+	defer fake.getServiceInstanceAnnotationsMutex.Unlock()
+	fake.GetServiceInstanceAnnotationsStub = nil
+	fake.getServiceInstanceAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceInstanceAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceInstanceAnnotationsMutex.Lock()
+	defer fake.getServiceInstanceAnnotationsMutex.Unlock()
+	fake.GetServiceInstanceAnnotationsStub = nil
+	if fake.getServiceInstanceAnnotationsReturnsOnCall == nil {
+		fake.getServiceInstanceAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceInstanceByNameAndSpace(arg1 string, arg2 string) (resources.ServiceInstance, v7action.Warnings, error) {
 	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getServiceInstanceByNameAndSpaceReturnsOnCall[len(fake.getServiceInstanceByNameAndSpaceArgsForCall)]
@@ -12693,19 +15546,20 @@ func (fake *FakeActor) GetServiceInstanceParametersReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetServiceInstancesForSpace(arg1 string, arg2 bool) ([]v7action.ServiceInstance, v7action.Warnings, error) {
+func (fake *FakeActor) GetServiceInstancesForSpace(arg1 string, arg2 string, arg3 bool) ([]v7action.ServiceInstance, v7action.Warnings, error) {
 	fake.getServiceInstancesForSpaceMutex.Lock()
 	ret, specificReturn := fake.getServiceInstancesForSpaceReturnsOnCall[len(fake.getServiceInstancesForSpaceArgsForCall)]
 	fake.getServiceInstancesForSpaceArgsForCall = append(fake.getServiceInstancesForSpaceArgsForCall, struct {
 		arg1 string
-		arg2 bool
-	}{arg1, arg2})
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
 	stub := fake.GetServiceInstancesForSpaceStub
 	fakeReturns := fake.getServiceInstancesForSpaceReturns
-	fake.recordInvocation("GetServiceInstancesForSpace", []interface{}{arg1, arg2})
+	fake.recordInvocation("GetServiceInstancesForSpace", []interface{}{arg1, arg2, arg3})
 	fake.getServiceInstancesForSpaceMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -12719,17 +15573,17 @@ func (fake *FakeActor) GetServiceInstancesForSpaceCallCount() int {
 	return len(fake.getServiceInstancesForSpaceArgsForCall)
 }
 
-func (fake *FakeActor) GetServiceInstancesForSpaceCalls(stub func(string, bool) ([]v7action.ServiceInstance, v7action.Warnings, error)) {
+func (fake *FakeActor) GetServiceInstancesForSpaceCalls(stub func(string, string, bool) ([]v7action.ServiceInstance, v7action.Warnings, error)) {
 	fake.getServiceInstancesForSpaceMutex.Lock()
 	defer fake.getServiceInstancesForSpaceMutex.Unlock()
 	fake.GetServiceInstancesForSpaceStub = stub
 }
 
-func (fake *FakeActor) GetServiceInstancesForSpaceArgsForCall(i int) (string, bool) {
+func (fake *FakeActor) GetServiceInstancesForSpaceArgsForCall(i int) (string, string, bool) {
 	fake.getServiceInstancesForSpaceMutex.RLock()
 	defer fake.getServiceInstancesForSpaceMutex.RUnlock()
 	argsForCall := fake.getServiceInstancesForSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeActor) GetServiceInstancesForSpaceReturns(result1 []v7action.ServiceInstance, result2 v7action.Warnings, result3 error) {
@@ -12967,6 +15821,74 @@ func (fake *FakeActor) GetServiceKeysByServiceInstanceReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceOfferingAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getServiceOfferingAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getServiceOfferingAnnotationsReturnsOnCall[len(fake.getServiceOfferingAnnotationsArgsForCall)]
+	fake.getServiceOfferingAnnotationsArgsForCall = append(fake.getServiceOfferingAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetServiceOfferingAnnotationsStub
+	fakeReturns := fake.getServiceOfferingAnnotationsReturns
+	fake.recordInvocation("GetServiceOfferingAnnotations", []interface{}{arg1, arg2})
+	fake.getServiceOfferingAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceOfferingAnnotationsCallCount() int {
+	fake.getServiceOfferingAnnotationsMutex.RLock()
+	defer fake.getServiceOfferingAnnotationsMutex.RUnlock()
+	return len(fake.getServiceOfferingAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceOfferingAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getServiceOfferingAnnotationsMutex.Lock()
+	defer fake.getServiceOfferingAnnotationsMutex.Unlock()
+	fake.GetServiceOfferingAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetServiceOfferingAnnotationsArgsForCall(i int) (string, string) {
+	fake.getServiceOfferingAnnotationsMutex.RLock()
+	defer fake.getServiceOfferingAnnotationsMutex.RUnlock()
+	argsForCall := fake.getServiceOfferingAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetServiceOfferingAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceOfferingAnnotationsMutex.Lock()
+	defer fake.getServiceOfferingAnnotationsMutex.Unlock()
+	fake.GetServiceOfferingAnnotationsStub = nil
+	fake.getServiceOfferingAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceOfferingAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServiceOfferingAnnotationsMutex.Lock()
+	defer fake.getServiceOfferingAnnotationsMutex.Unlock()
+	fake.GetServiceOfferingAnnotationsStub = nil
+	if fake.getServiceOfferingAnnotationsReturnsOnCall == nil {
+		fake.getServiceOfferingAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceOfferingAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServiceOfferingLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getServiceOfferingLabelsMutex.Lock()
 	ret, specificReturn := fake.getServiceOfferingLabelsReturnsOnCall[len(fake.getServiceOfferingLabelsArgsForCall)]
@@ -13035,6 +15957,75 @@ func (fake *FakeActor) GetServiceOfferingLabelsReturnsOnCall(i int, result1 map[
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServicePlanAnnotations(arg1 string, arg2 string, arg3 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getServicePlanAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getServicePlanAnnotationsReturnsOnCall[len(fake.getServicePlanAnnotationsArgsForCall)]
+	fake.getServicePlanAnnotationsArgsForCall = append(fake.getServicePlanAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetServicePlanAnnotationsStub
+	fakeReturns := fake.getServicePlanAnnotationsReturns
+	fake.recordInvocation("GetServicePlanAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.getServicePlanAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServicePlanAnnotationsCallCount() int {
+	fake.getServicePlanAnnotationsMutex.RLock()
+	defer fake.getServicePlanAnnotationsMutex.RUnlock()
+	return len(fake.getServicePlanAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetServicePlanAnnotationsCalls(stub func(string, string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getServicePlanAnnotationsMutex.Lock()
+	defer fake.getServicePlanAnnotationsMutex.Unlock()
+	fake.GetServicePlanAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetServicePlanAnnotationsArgsForCall(i int) (string, string, string) {
+	fake.getServicePlanAnnotationsMutex.RLock()
+	defer fake.getServicePlanAnnotationsMutex.RUnlock()
+	argsForCall := fake.getServicePlanAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) GetServicePlanAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServicePlanAnnotationsMutex.Lock()
+	defer fake.getServicePlanAnnotationsMutex.Unlock()
+	fake.GetServicePlanAnnotationsStub = nil
+	fake.getServicePlanAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServicePlanAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getServicePlanAnnotationsMutex.Lock()
+	defer fake.getServicePlanAnnotationsMutex.Unlock()
+	fake.GetServicePlanAnnotationsStub = nil
+	if fake.getServicePlanAnnotationsReturnsOnCall == nil {
+		fake.getServicePlanAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetServicePlanByNameOfferingAndBroker(arg1 string, arg2 string, arg3 string) (resources.ServicePlan, v7action.Warnings, error) {
 	fake.getServicePlanByNameOfferingAndBrokerMutex.Lock()
 	ret, specificReturn := fake.getServicePlanByNameOfferingAndBrokerReturnsOnCall[len(fake.getServicePlanByNameOfferingAndBrokerArgsForCall)]
@@ -13173,17 +16164,85 @@ func (fake *FakeActor) GetServicePlanLabelsReturnsOnCall(i int, result1 map[stri
 	}{result1, result2, result3}
 }
 
-func (fake *FakeActor) GetSpaceByNameAndOrganization(arg1 string, arg2 string) (resources.Space, v7action.Warnings, error) {
-	fake.getSpaceByNameAndOrganizationMutex.Lock()
-	ret, specificReturn := fake.getSpaceByNameAndOrganizationReturnsOnCall[len(fake.getSpaceByNameAndOrganizationArgsForCall)]
-	fake.getSpaceByNameAndOrganizationArgsForCall = append(fake.getSpaceByNameAndOrganizationArgsForCall, struct {
+func (fake *FakeActor) GetSpaceAnnotations(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getSpaceAnnotationsReturnsOnCall[len(fake.getSpaceAnnotationsArgsForCall)]
+	fake.getSpaceAnnotationsArgsForCall = append(fake.getSpaceAnnotationsArgsForCall, struct {
 		arg1 string
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.GetSpaceByNameAndOrganizationStub
-	fakeReturns := fake.getSpaceByNameAndOrganizationReturns
-	fake.recordInvocation("GetSpaceByNameAndOrganization", []interface{}{arg1, arg2})
-	fake.getSpaceByNameAndOrganizationMutex.Unlock()
+	stub := fake.GetSpaceAnnotationsStub
+	fakeReturns := fake.getSpaceAnnotationsReturns
+	fake.recordInvocation("GetSpaceAnnotations", []interface{}{arg1, arg2})
+	fake.getSpaceAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetSpaceAnnotationsCallCount() int {
+	fake.getSpaceAnnotationsMutex.RLock()
+	defer fake.getSpaceAnnotationsMutex.RUnlock()
+	return len(fake.getSpaceAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetSpaceAnnotationsCalls(stub func(string, string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	defer fake.getSpaceAnnotationsMutex.Unlock()
+	fake.GetSpaceAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetSpaceAnnotationsArgsForCall(i int) (string, string) {
+	fake.getSpaceAnnotationsMutex.RLock()
+	defer fake.getSpaceAnnotationsMutex.RUnlock()
+	argsForCall := fake.getSpaceAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) GetSpaceAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	defer fake.getSpaceAnnotationsMutex.Unlock()
+	fake.GetSpaceAnnotationsStub = nil
+	fake.getSpaceAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSpaceAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getSpaceAnnotationsMutex.Lock()
+	defer fake.getSpaceAnnotationsMutex.Unlock()
+	fake.GetSpaceAnnotationsStub = nil
+	if fake.getSpaceAnnotationsReturnsOnCall == nil {
+		fake.getSpaceAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetSpaceByNameAndOrganization(arg1 string, arg2 string) (resources.Space, v7action.Warnings, error) {
+	fake.getSpaceByNameAndOrganizationMutex.Lock()
+	ret, specificReturn := fake.getSpaceByNameAndOrganizationReturnsOnCall[len(fake.getSpaceByNameAndOrganizationArgsForCall)]
+	fake.getSpaceByNameAndOrganizationArgsForCall = append(fake.getSpaceByNameAndOrganizationArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetSpaceByNameAndOrganizationStub
+	fakeReturns := fake.getSpaceByNameAndOrganizationReturns
+	fake.recordInvocation("GetSpaceByNameAndOrganization", []interface{}{arg1, arg2})
+	fake.getSpaceByNameAndOrganizationMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -13648,6 +16707,73 @@ func (fake *FakeActor) GetSpaceUsersByRoleTypeReturnsOnCall(i int, result1 map[c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetStackAnnotations(arg1 string) (map[string]types.NullString, v7action.Warnings, error) {
+	fake.getStackAnnotationsMutex.Lock()
+	ret, specificReturn := fake.getStackAnnotationsReturnsOnCall[len(fake.getStackAnnotationsArgsForCall)]
+	fake.getStackAnnotationsArgsForCall = append(fake.getStackAnnotationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.GetStackAnnotationsStub
+	fakeReturns := fake.getStackAnnotationsReturns
+	fake.recordInvocation("GetStackAnnotations", []interface{}{arg1})
+	fake.getStackAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStackAnnotationsCallCount() int {
+	fake.getStackAnnotationsMutex.RLock()
+	defer fake.getStackAnnotationsMutex.RUnlock()
+	return len(fake.getStackAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) GetStackAnnotationsCalls(stub func(string) (map[string]types.NullString, v7action.Warnings, error)) {
+	fake.getStackAnnotationsMutex.Lock()
+	defer fake.getStackAnnotationsMutex.Unlock()
+	fake.GetStackAnnotationsStub = stub
+}
+
+func (fake *FakeActor) GetStackAnnotationsArgsForCall(i int) string {
+	fake.getStackAnnotationsMutex.RLock()
+	defer fake.getStackAnnotationsMutex.RUnlock()
+	argsForCall := fake.getStackAnnotationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetStackAnnotationsReturns(result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getStackAnnotationsMutex.Lock()
+	defer fake.getStackAnnotationsMutex.Unlock()
+	fake.GetStackAnnotationsStub = nil
+	fake.getStackAnnotationsReturns = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStackAnnotationsReturnsOnCall(i int, result1 map[string]types.NullString, result2 v7action.Warnings, result3 error) {
+	fake.getStackAnnotationsMutex.Lock()
+	defer fake.getStackAnnotationsMutex.Unlock()
+	fake.GetStackAnnotationsStub = nil
+	if fake.getStackAnnotationsReturnsOnCall == nil {
+		fake.getStackAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]types.NullString
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackAnnotationsReturnsOnCall[i] = struct {
+		result1 map[string]types.NullString
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetStackByName(arg1 string) (resources.Stack, v7action.Warnings, error) {
 	fake.getStackByNameMutex.Lock()
 	ret, specificReturn := fake.getStackByNameReturnsOnCall[len(fake.getStackByNameArgsForCall)]
@@ -13849,6 +16975,142 @@ func (fake *FakeActor) GetStacksReturnsOnCall(i int, result1 []resources.Stack,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetStaleArtifactSizes(arg1 []v7action.StaleArtifact) []v7action.StaleArtifact {
+	var arg1Copy []v7action.StaleArtifact
+	if arg1 != nil {
+		arg1Copy = make([]v7action.StaleArtifact, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getStaleArtifactSizesMutex.Lock()
+	ret, specificReturn := fake.getStaleArtifactSizesReturnsOnCall[len(fake.getStaleArtifactSizesArgsForCall)]
+	fake.getStaleArtifactSizesArgsForCall = append(fake.getStaleArtifactSizesArgsForCall, struct {
+		arg1 []v7action.StaleArtifact
+	}{arg1Copy})
+	stub := fake.GetStaleArtifactSizesStub
+	fakeReturns := fake.getStaleArtifactSizesReturns
+	fake.recordInvocation("GetStaleArtifactSizes", []interface{}{arg1Copy})
+	fake.getStaleArtifactSizesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) GetStaleArtifactSizesCallCount() int {
+	fake.getStaleArtifactSizesMutex.RLock()
+	defer fake.getStaleArtifactSizesMutex.RUnlock()
+	return len(fake.getStaleArtifactSizesArgsForCall)
+}
+
+func (fake *FakeActor) GetStaleArtifactSizesCalls(stub func([]v7action.StaleArtifact) []v7action.StaleArtifact) {
+	fake.getStaleArtifactSizesMutex.Lock()
+	defer fake.getStaleArtifactSizesMutex.Unlock()
+	fake.GetStaleArtifactSizesStub = stub
+}
+
+func (fake *FakeActor) GetStaleArtifactSizesArgsForCall(i int) []v7action.StaleArtifact {
+	fake.getStaleArtifactSizesMutex.RLock()
+	defer fake.getStaleArtifactSizesMutex.RUnlock()
+	argsForCall := fake.getStaleArtifactSizesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetStaleArtifactSizesReturns(result1 []v7action.StaleArtifact) {
+	fake.getStaleArtifactSizesMutex.Lock()
+	defer fake.getStaleArtifactSizesMutex.Unlock()
+	fake.GetStaleArtifactSizesStub = nil
+	fake.getStaleArtifactSizesReturns = struct {
+		result1 []v7action.StaleArtifact
+	}{result1}
+}
+
+func (fake *FakeActor) GetStaleArtifactSizesReturnsOnCall(i int, result1 []v7action.StaleArtifact) {
+	fake.getStaleArtifactSizesMutex.Lock()
+	defer fake.getStaleArtifactSizesMutex.Unlock()
+	fake.GetStaleArtifactSizesStub = nil
+	if fake.getStaleArtifactSizesReturnsOnCall == nil {
+		fake.getStaleArtifactSizesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.StaleArtifact
+		})
+	}
+	fake.getStaleArtifactSizesReturnsOnCall[i] = struct {
+		result1 []v7action.StaleArtifact
+	}{result1}
+}
+
+func (fake *FakeActor) GetStaleArtifacts(arg1 v7action.ArtifactType, arg2 string, arg3 string, arg4 v7action.ArtifactRetentionPolicy) ([]v7action.StaleArtifact, v7action.Warnings, error) {
+	fake.getStaleArtifactsMutex.Lock()
+	ret, specificReturn := fake.getStaleArtifactsReturnsOnCall[len(fake.getStaleArtifactsArgsForCall)]
+	fake.getStaleArtifactsArgsForCall = append(fake.getStaleArtifactsArgsForCall, struct {
+		arg1 v7action.ArtifactType
+		arg2 string
+		arg3 string
+		arg4 v7action.ArtifactRetentionPolicy
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetStaleArtifactsStub
+	fakeReturns := fake.getStaleArtifactsReturns
+	fake.recordInvocation("GetStaleArtifacts", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getStaleArtifactsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetStaleArtifactsCallCount() int {
+	fake.getStaleArtifactsMutex.RLock()
+	defer fake.getStaleArtifactsMutex.RUnlock()
+	return len(fake.getStaleArtifactsArgsForCall)
+}
+
+func (fake *FakeActor) GetStaleArtifactsCalls(stub func(v7action.ArtifactType, string, string, v7action.ArtifactRetentionPolicy) ([]v7action.StaleArtifact, v7action.Warnings, error)) {
+	fake.getStaleArtifactsMutex.Lock()
+	defer fake.getStaleArtifactsMutex.Unlock()
+	fake.GetStaleArtifactsStub = stub
+}
+
+func (fake *FakeActor) GetStaleArtifactsArgsForCall(i int) (v7action.ArtifactType, string, string, v7action.ArtifactRetentionPolicy) {
+	fake.getStaleArtifactsMutex.RLock()
+	defer fake.getStaleArtifactsMutex.RUnlock()
+	argsForCall := fake.getStaleArtifactsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) GetStaleArtifactsReturns(result1 []v7action.StaleArtifact, result2 v7action.Warnings, result3 error) {
+	fake.getStaleArtifactsMutex.Lock()
+	defer fake.getStaleArtifactsMutex.Unlock()
+	fake.GetStaleArtifactsStub = nil
+	fake.getStaleArtifactsReturns = struct {
+		result1 []v7action.StaleArtifact
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetStaleArtifactsReturnsOnCall(i int, result1 []v7action.StaleArtifact, result2 v7action.Warnings, result3 error) {
+	fake.getStaleArtifactsMutex.Lock()
+	defer fake.getStaleArtifactsMutex.Unlock()
+	fake.GetStaleArtifactsStub = nil
+	if fake.getStaleArtifactsReturnsOnCall == nil {
+		fake.getStaleArtifactsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.StaleArtifact
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getStaleArtifactsReturnsOnCall[i] = struct {
+		result1 []v7action.StaleArtifact
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetStreamingLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 sharedaction.LogCacheClient) (<-chan sharedaction.LogMessage, <-chan error, context.CancelFunc, v7action.Warnings, error) {
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getStreamingLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationByNameAndSpaceArgsForCall)]
@@ -14322,6 +17584,73 @@ func (fake *FakeActor) MapRouteReturnsOnCall(i int, result1 v7action.Warnings, r
 	}{result1, result2}
 }
 
+func (fake *FakeActor) MapRouteWithWeight(arg1 resources.Route, arg2 string, arg3 string, arg4 int) (v7action.Warnings, error) {
+	fake.mapRouteWithWeightMutex.Lock()
+	ret, specificReturn := fake.mapRouteWithWeightReturnsOnCall[len(fake.mapRouteWithWeightArgsForCall)]
+	fake.mapRouteWithWeightArgsForCall = append(fake.mapRouteWithWeightArgsForCall, struct {
+		arg1 resources.Route
+		arg2 string
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.MapRouteWithWeightStub
+	fakeReturns := fake.mapRouteWithWeightReturns
+	fake.recordInvocation("MapRouteWithWeight", []interface{}{arg1, arg2, arg3, arg4})
+	fake.mapRouteWithWeightMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) MapRouteWithWeightCallCount() int {
+	fake.mapRouteWithWeightMutex.RLock()
+	defer fake.mapRouteWithWeightMutex.RUnlock()
+	return len(fake.mapRouteWithWeightArgsForCall)
+}
+
+func (fake *FakeActor) MapRouteWithWeightCalls(stub func(resources.Route, string, string, int) (v7action.Warnings, error)) {
+	fake.mapRouteWithWeightMutex.Lock()
+	defer fake.mapRouteWithWeightMutex.Unlock()
+	fake.MapRouteWithWeightStub = stub
+}
+
+func (fake *FakeActor) MapRouteWithWeightArgsForCall(i int) (resources.Route, string, string, int) {
+	fake.mapRouteWithWeightMutex.RLock()
+	defer fake.mapRouteWithWeightMutex.RUnlock()
+	argsForCall := fake.mapRouteWithWeightArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) MapRouteWithWeightReturns(result1 v7action.Warnings, result2 error) {
+	fake.mapRouteWithWeightMutex.Lock()
+	defer fake.mapRouteWithWeightMutex.Unlock()
+	fake.MapRouteWithWeightStub = nil
+	fake.mapRouteWithWeightReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) MapRouteWithWeightReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.mapRouteWithWeightMutex.Lock()
+	defer fake.mapRouteWithWeightMutex.Unlock()
+	fake.MapRouteWithWeightStub = nil
+	if fake.mapRouteWithWeightReturnsOnCall == nil {
+		fake.mapRouteWithWeightReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.mapRouteWithWeightReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) Marketplace(arg1 v7action.MarketplaceFilter) ([]v7action.ServiceOfferingWithPlans, v7action.Warnings, error) {
 	fake.marketplaceMutex.Lock()
 	ret, specificReturn := fake.marketplaceReturnsOnCall[len(fake.marketplaceArgsForCall)]
@@ -14389,6 +17718,140 @@ func (fake *FakeActor) MarketplaceReturnsOnCall(i int, result1 []v7action.Servic
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) MarshalEnvironmentVariableFile(arg1 v7action.EnvironmentVariableFileFormat, arg2 resources.EnvironmentVariables, arg3 bool) ([]byte, []string, error) {
+	fake.marshalEnvironmentVariableFileMutex.Lock()
+	ret, specificReturn := fake.marshalEnvironmentVariableFileReturnsOnCall[len(fake.marshalEnvironmentVariableFileArgsForCall)]
+	fake.marshalEnvironmentVariableFileArgsForCall = append(fake.marshalEnvironmentVariableFileArgsForCall, struct {
+		arg1 v7action.EnvironmentVariableFileFormat
+		arg2 resources.EnvironmentVariables
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.MarshalEnvironmentVariableFileStub
+	fakeReturns := fake.marshalEnvironmentVariableFileReturns
+	fake.recordInvocation("MarshalEnvironmentVariableFile", []interface{}{arg1, arg2, arg3})
+	fake.marshalEnvironmentVariableFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) MarshalEnvironmentVariableFileCallCount() int {
+	fake.marshalEnvironmentVariableFileMutex.RLock()
+	defer fake.marshalEnvironmentVariableFileMutex.RUnlock()
+	return len(fake.marshalEnvironmentVariableFileArgsForCall)
+}
+
+func (fake *FakeActor) MarshalEnvironmentVariableFileCalls(stub func(v7action.EnvironmentVariableFileFormat, resources.EnvironmentVariables, bool) ([]byte, []string, error)) {
+	fake.marshalEnvironmentVariableFileMutex.Lock()
+	defer fake.marshalEnvironmentVariableFileMutex.Unlock()
+	fake.MarshalEnvironmentVariableFileStub = stub
+}
+
+func (fake *FakeActor) MarshalEnvironmentVariableFileArgsForCall(i int) (v7action.EnvironmentVariableFileFormat, resources.EnvironmentVariables, bool) {
+	fake.marshalEnvironmentVariableFileMutex.RLock()
+	defer fake.marshalEnvironmentVariableFileMutex.RUnlock()
+	argsForCall := fake.marshalEnvironmentVariableFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) MarshalEnvironmentVariableFileReturns(result1 []byte, result2 []string, result3 error) {
+	fake.marshalEnvironmentVariableFileMutex.Lock()
+	defer fake.marshalEnvironmentVariableFileMutex.Unlock()
+	fake.MarshalEnvironmentVariableFileStub = nil
+	fake.marshalEnvironmentVariableFileReturns = struct {
+		result1 []byte
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) MarshalEnvironmentVariableFileReturnsOnCall(i int, result1 []byte, result2 []string, result3 error) {
+	fake.marshalEnvironmentVariableFileMutex.Lock()
+	defer fake.marshalEnvironmentVariableFileMutex.Unlock()
+	fake.MarshalEnvironmentVariableFileStub = nil
+	if fake.marshalEnvironmentVariableFileReturnsOnCall == nil {
+		fake.marshalEnvironmentVariableFileReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 []string
+			result3 error
+		})
+	}
+	fake.marshalEnvironmentVariableFileReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 []string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) MonitorApplicationHealth(arg1 resources.Application, arg2 time.Duration) (v7action.Warnings, error) {
+	fake.monitorApplicationHealthMutex.Lock()
+	ret, specificReturn := fake.monitorApplicationHealthReturnsOnCall[len(fake.monitorApplicationHealthArgsForCall)]
+	fake.monitorApplicationHealthArgsForCall = append(fake.monitorApplicationHealthArgsForCall, struct {
+		arg1 resources.Application
+		arg2 time.Duration
+	}{arg1, arg2})
+	stub := fake.MonitorApplicationHealthStub
+	fakeReturns := fake.monitorApplicationHealthReturns
+	fake.recordInvocation("MonitorApplicationHealth", []interface{}{arg1, arg2})
+	fake.monitorApplicationHealthMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) MonitorApplicationHealthCallCount() int {
+	fake.monitorApplicationHealthMutex.RLock()
+	defer fake.monitorApplicationHealthMutex.RUnlock()
+	return len(fake.monitorApplicationHealthArgsForCall)
+}
+
+func (fake *FakeActor) MonitorApplicationHealthCalls(stub func(resources.Application, time.Duration) (v7action.Warnings, error)) {
+	fake.monitorApplicationHealthMutex.Lock()
+	defer fake.monitorApplicationHealthMutex.Unlock()
+	fake.MonitorApplicationHealthStub = stub
+}
+
+func (fake *FakeActor) MonitorApplicationHealthArgsForCall(i int) (resources.Application, time.Duration) {
+	fake.monitorApplicationHealthMutex.RLock()
+	defer fake.monitorApplicationHealthMutex.RUnlock()
+	argsForCall := fake.monitorApplicationHealthArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) MonitorApplicationHealthReturns(result1 v7action.Warnings, result2 error) {
+	fake.monitorApplicationHealthMutex.Lock()
+	defer fake.monitorApplicationHealthMutex.Unlock()
+	fake.MonitorApplicationHealthStub = nil
+	fake.monitorApplicationHealthReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) MonitorApplicationHealthReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.monitorApplicationHealthMutex.Lock()
+	defer fake.monitorApplicationHealthMutex.Unlock()
+	fake.MonitorApplicationHealthStub = nil
+	if fake.monitorApplicationHealthReturnsOnCall == nil {
+		fake.monitorApplicationHealthReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.monitorApplicationHealthReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) MoveRoute(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.moveRouteMutex.Lock()
 	ret, specificReturn := fake.moveRouteReturnsOnCall[len(fake.moveRouteArgsForCall)]
@@ -14518,22 +17981,161 @@ func (fake *FakeActor) ParseAccessTokenReturnsOnCall(i int, result1 jwt.JWT, res
 	}{result1, result2}
 }
 
-func (fake *FakeActor) PollBuild(arg1 string, arg2 string) (resources.Droplet, v7action.Warnings, error) {
-	fake.pollBuildMutex.Lock()
-	ret, specificReturn := fake.pollBuildReturnsOnCall[len(fake.pollBuildArgsForCall)]
-	fake.pollBuildArgsForCall = append(fake.pollBuildArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.PollBuildStub
-	fakeReturns := fake.pollBuildReturns
-	fake.recordInvocation("PollBuild", []interface{}{arg1, arg2})
-	fake.pollBuildMutex.Unlock()
-	if stub != nil {
-		return stub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+func (fake *FakeActor) ParseEnvironmentVariableFile(arg1 v7action.EnvironmentVariableFileFormat, arg2 []byte) (resources.EnvironmentVariables, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.parseEnvironmentVariableFileMutex.Lock()
+	ret, specificReturn := fake.parseEnvironmentVariableFileReturnsOnCall[len(fake.parseEnvironmentVariableFileArgsForCall)]
+	fake.parseEnvironmentVariableFileArgsForCall = append(fake.parseEnvironmentVariableFileArgsForCall, struct {
+		arg1 v7action.EnvironmentVariableFileFormat
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.ParseEnvironmentVariableFileStub
+	fakeReturns := fake.parseEnvironmentVariableFileReturns
+	fake.recordInvocation("ParseEnvironmentVariableFile", []interface{}{arg1, arg2Copy})
+	fake.parseEnvironmentVariableFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ParseEnvironmentVariableFileCallCount() int {
+	fake.parseEnvironmentVariableFileMutex.RLock()
+	defer fake.parseEnvironmentVariableFileMutex.RUnlock()
+	return len(fake.parseEnvironmentVariableFileArgsForCall)
+}
+
+func (fake *FakeActor) ParseEnvironmentVariableFileCalls(stub func(v7action.EnvironmentVariableFileFormat, []byte) (resources.EnvironmentVariables, error)) {
+	fake.parseEnvironmentVariableFileMutex.Lock()
+	defer fake.parseEnvironmentVariableFileMutex.Unlock()
+	fake.ParseEnvironmentVariableFileStub = stub
+}
+
+func (fake *FakeActor) ParseEnvironmentVariableFileArgsForCall(i int) (v7action.EnvironmentVariableFileFormat, []byte) {
+	fake.parseEnvironmentVariableFileMutex.RLock()
+	defer fake.parseEnvironmentVariableFileMutex.RUnlock()
+	argsForCall := fake.parseEnvironmentVariableFileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) ParseEnvironmentVariableFileReturns(result1 resources.EnvironmentVariables, result2 error) {
+	fake.parseEnvironmentVariableFileMutex.Lock()
+	defer fake.parseEnvironmentVariableFileMutex.Unlock()
+	fake.ParseEnvironmentVariableFileStub = nil
+	fake.parseEnvironmentVariableFileReturns = struct {
+		result1 resources.EnvironmentVariables
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ParseEnvironmentVariableFileReturnsOnCall(i int, result1 resources.EnvironmentVariables, result2 error) {
+	fake.parseEnvironmentVariableFileMutex.Lock()
+	defer fake.parseEnvironmentVariableFileMutex.Unlock()
+	fake.ParseEnvironmentVariableFileStub = nil
+	if fake.parseEnvironmentVariableFileReturnsOnCall == nil {
+		fake.parseEnvironmentVariableFileReturnsOnCall = make(map[int]struct {
+			result1 resources.EnvironmentVariables
+			result2 error
+		})
+	}
+	fake.parseEnvironmentVariableFileReturnsOnCall[i] = struct {
+		result1 resources.EnvironmentVariables
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ParseServiceAccessFile(arg1 []byte) ([]v7action.DesiredServiceAccess, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.parseServiceAccessFileMutex.Lock()
+	ret, specificReturn := fake.parseServiceAccessFileReturnsOnCall[len(fake.parseServiceAccessFileArgsForCall)]
+	fake.parseServiceAccessFileArgsForCall = append(fake.parseServiceAccessFileArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	stub := fake.ParseServiceAccessFileStub
+	fakeReturns := fake.parseServiceAccessFileReturns
+	fake.recordInvocation("ParseServiceAccessFile", []interface{}{arg1Copy})
+	fake.parseServiceAccessFileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ParseServiceAccessFileCallCount() int {
+	fake.parseServiceAccessFileMutex.RLock()
+	defer fake.parseServiceAccessFileMutex.RUnlock()
+	return len(fake.parseServiceAccessFileArgsForCall)
+}
+
+func (fake *FakeActor) ParseServiceAccessFileCalls(stub func([]byte) ([]v7action.DesiredServiceAccess, error)) {
+	fake.parseServiceAccessFileMutex.Lock()
+	defer fake.parseServiceAccessFileMutex.Unlock()
+	fake.ParseServiceAccessFileStub = stub
+}
+
+func (fake *FakeActor) ParseServiceAccessFileArgsForCall(i int) []byte {
+	fake.parseServiceAccessFileMutex.RLock()
+	defer fake.parseServiceAccessFileMutex.RUnlock()
+	argsForCall := fake.parseServiceAccessFileArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ParseServiceAccessFileReturns(result1 []v7action.DesiredServiceAccess, result2 error) {
+	fake.parseServiceAccessFileMutex.Lock()
+	defer fake.parseServiceAccessFileMutex.Unlock()
+	fake.ParseServiceAccessFileStub = nil
+	fake.parseServiceAccessFileReturns = struct {
+		result1 []v7action.DesiredServiceAccess
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ParseServiceAccessFileReturnsOnCall(i int, result1 []v7action.DesiredServiceAccess, result2 error) {
+	fake.parseServiceAccessFileMutex.Lock()
+	defer fake.parseServiceAccessFileMutex.Unlock()
+	fake.ParseServiceAccessFileStub = nil
+	if fake.parseServiceAccessFileReturnsOnCall == nil {
+		fake.parseServiceAccessFileReturnsOnCall = make(map[int]struct {
+			result1 []v7action.DesiredServiceAccess
+			result2 error
+		})
+	}
+	fake.parseServiceAccessFileReturnsOnCall[i] = struct {
+		result1 []v7action.DesiredServiceAccess
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) PollBuild(arg1 string, arg2 string) (resources.Droplet, v7action.Warnings, error) {
+	fake.pollBuildMutex.Lock()
+	ret, specificReturn := fake.pollBuildReturnsOnCall[len(fake.pollBuildArgsForCall)]
+	fake.pollBuildArgsForCall = append(fake.pollBuildArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.PollBuildStub
+	fakeReturns := fake.pollBuildReturns
+	fake.recordInvocation("PollBuild", []interface{}{arg1, arg2})
+	fake.pollBuildMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
 	}
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
@@ -14983,6 +18585,72 @@ func (fake *FakeActor) PrepareBuildpackBitsReturnsOnCall(i int, result1 string,
 	}{result1, result2}
 }
 
+func (fake *FakeActor) ProbeRoute(arg1 string, arg2 time.Duration, arg3 bool) (v7action.RouteProbe, error) {
+	fake.probeRouteMutex.Lock()
+	ret, specificReturn := fake.probeRouteReturnsOnCall[len(fake.probeRouteArgsForCall)]
+	fake.probeRouteArgsForCall = append(fake.probeRouteArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.ProbeRouteStub
+	fakeReturns := fake.probeRouteReturns
+	fake.recordInvocation("ProbeRoute", []interface{}{arg1, arg2, arg3})
+	fake.probeRouteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ProbeRouteCallCount() int {
+	fake.probeRouteMutex.RLock()
+	defer fake.probeRouteMutex.RUnlock()
+	return len(fake.probeRouteArgsForCall)
+}
+
+func (fake *FakeActor) ProbeRouteCalls(stub func(string, time.Duration, bool) (v7action.RouteProbe, error)) {
+	fake.probeRouteMutex.Lock()
+	defer fake.probeRouteMutex.Unlock()
+	fake.ProbeRouteStub = stub
+}
+
+func (fake *FakeActor) ProbeRouteArgsForCall(i int) (string, time.Duration, bool) {
+	fake.probeRouteMutex.RLock()
+	defer fake.probeRouteMutex.RUnlock()
+	argsForCall := fake.probeRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) ProbeRouteReturns(result1 v7action.RouteProbe, result2 error) {
+	fake.probeRouteMutex.Lock()
+	defer fake.probeRouteMutex.Unlock()
+	fake.ProbeRouteStub = nil
+	fake.probeRouteReturns = struct {
+		result1 v7action.RouteProbe
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ProbeRouteReturnsOnCall(i int, result1 v7action.RouteProbe, result2 error) {
+	fake.probeRouteMutex.Lock()
+	defer fake.probeRouteMutex.Unlock()
+	fake.ProbeRouteStub = nil
+	if fake.probeRouteReturnsOnCall == nil {
+		fake.probeRouteReturnsOnCall = make(map[int]struct {
+			result1 v7action.RouteProbe
+			result2 error
+		})
+	}
+	fake.probeRouteReturnsOnCall[i] = struct {
+		result1 v7action.RouteProbe
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) PurgeServiceInstance(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.purgeServiceInstanceMutex.Lock()
 	ret, specificReturn := fake.purgeServiceInstanceReturnsOnCall[len(fake.purgeServiceInstanceArgsForCall)]
@@ -15113,6 +18781,75 @@ func (fake *FakeActor) PurgeServiceOfferingByNameAndBrokerReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeActor) ReadDropletArchive(arg1 []byte) (v7action.DropletArchive, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.readDropletArchiveMutex.Lock()
+	ret, specificReturn := fake.readDropletArchiveReturnsOnCall[len(fake.readDropletArchiveArgsForCall)]
+	fake.readDropletArchiveArgsForCall = append(fake.readDropletArchiveArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	stub := fake.ReadDropletArchiveStub
+	fakeReturns := fake.readDropletArchiveReturns
+	fake.recordInvocation("ReadDropletArchive", []interface{}{arg1Copy})
+	fake.readDropletArchiveMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) ReadDropletArchiveCallCount() int {
+	fake.readDropletArchiveMutex.RLock()
+	defer fake.readDropletArchiveMutex.RUnlock()
+	return len(fake.readDropletArchiveArgsForCall)
+}
+
+func (fake *FakeActor) ReadDropletArchiveCalls(stub func([]byte) (v7action.DropletArchive, error)) {
+	fake.readDropletArchiveMutex.Lock()
+	defer fake.readDropletArchiveMutex.Unlock()
+	fake.ReadDropletArchiveStub = stub
+}
+
+func (fake *FakeActor) ReadDropletArchiveArgsForCall(i int) []byte {
+	fake.readDropletArchiveMutex.RLock()
+	defer fake.readDropletArchiveMutex.RUnlock()
+	argsForCall := fake.readDropletArchiveArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ReadDropletArchiveReturns(result1 v7action.DropletArchive, result2 error) {
+	fake.readDropletArchiveMutex.Lock()
+	defer fake.readDropletArchiveMutex.Unlock()
+	fake.ReadDropletArchiveStub = nil
+	fake.readDropletArchiveReturns = struct {
+		result1 v7action.DropletArchive
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) ReadDropletArchiveReturnsOnCall(i int, result1 v7action.DropletArchive, result2 error) {
+	fake.readDropletArchiveMutex.Lock()
+	defer fake.readDropletArchiveMutex.Unlock()
+	fake.ReadDropletArchiveStub = nil
+	if fake.readDropletArchiveReturnsOnCall == nil {
+		fake.readDropletArchiveReturnsOnCall = make(map[int]struct {
+			result1 v7action.DropletArchive
+			result2 error
+		})
+	}
+	fake.readDropletArchiveReturnsOnCall[i] = struct {
+		result1 v7action.DropletArchive
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) RefreshAccessToken() (string, error) {
 	fake.refreshAccessTokenMutex.Lock()
 	ret, specificReturn := fake.refreshAccessTokenReturnsOnCall[len(fake.refreshAccessTokenArgsForCall)]
@@ -16366,6 +20103,76 @@ func (fake *FakeActor) SetEnvironmentVariableGroupReturnsOnCall(i int, result1 v
 	}{result1, result2}
 }
 
+func (fake *FakeActor) SetEnvironmentVariablesByApplicationNameAndSpace(arg1 string, arg2 string, arg3 resources.EnvironmentVariables, arg4 bool) ([]string, v7action.Warnings, error) {
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.setEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall[len(fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall)]
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall = append(fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 resources.EnvironmentVariables
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetEnvironmentVariablesByApplicationNameAndSpaceStub
+	fakeReturns := fake.setEnvironmentVariablesByApplicationNameAndSpaceReturns
+	fake.recordInvocation("SetEnvironmentVariablesByApplicationNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) SetEnvironmentVariablesByApplicationNameAndSpaceCallCount() int {
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	return len(fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeActor) SetEnvironmentVariablesByApplicationNameAndSpaceCalls(stub func(string, string, resources.EnvironmentVariables, bool) ([]string, v7action.Warnings, error)) {
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.SetEnvironmentVariablesByApplicationNameAndSpaceStub = stub
+}
+
+func (fake *FakeActor) SetEnvironmentVariablesByApplicationNameAndSpaceArgsForCall(i int) (string, string, resources.EnvironmentVariables, bool) {
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.setEnvironmentVariablesByApplicationNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) SetEnvironmentVariablesByApplicationNameAndSpaceReturns(result1 []string, result2 v7action.Warnings, result3 error) {
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.SetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceReturns = struct {
+		result1 []string
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) SetEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall(i int, result1 []string, result2 v7action.Warnings, result3 error) {
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.Lock()
+	defer fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.Unlock()
+	fake.SetEnvironmentVariablesByApplicationNameAndSpaceStub = nil
+	if fake.setEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall == nil {
+		fake.setEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []string
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) SetOrganizationDefaultIsolationSegment(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.setOrganizationDefaultIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.setOrganizationDefaultIsolationSegmentReturnsOnCall[len(fake.setOrganizationDefaultIsolationSegmentArgsForCall)]
@@ -17687,6 +21494,72 @@ func (fake *FakeActor) UpdateApplicationReturnsOnCall(i int, result1 resources.A
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	ret, specificReturn := fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall[len(fake.updateApplicationAnnotationsByApplicationNameArgsForCall)]
+	fake.updateApplicationAnnotationsByApplicationNameArgsForCall = append(fake.updateApplicationAnnotationsByApplicationNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateApplicationAnnotationsByApplicationNameStub
+	fakeReturns := fake.updateApplicationAnnotationsByApplicationNameReturns
+	fake.recordInvocation("UpdateApplicationAnnotationsByApplicationName", []interface{}{arg1, arg2, arg3})
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationNameCallCount() int {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	return len(fake.updateApplicationAnnotationsByApplicationNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationNameCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = stub
+}
+
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationNameArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	argsForCall := fake.updateApplicationAnnotationsByApplicationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = nil
+	fake.updateApplicationAnnotationsByApplicationNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateApplicationAnnotationsByApplicationNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = nil
+	if fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall == nil {
+		fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateApplicationLabelsByApplicationName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateApplicationLabelsByApplicationNameMutex.Lock()
 	ret, specificReturn := fake.updateApplicationLabelsByApplicationNameReturnsOnCall[len(fake.updateApplicationLabelsByApplicationNameArgsForCall)]
@@ -17753,6 +21626,72 @@ func (fake *FakeActor) UpdateApplicationLabelsByApplicationNameReturnsOnCall(i i
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStack(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	ret, specificReturn := fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall[len(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall)]
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall = append(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub
+	fakeReturns := fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturns
+	fake.recordInvocation("UpdateBuildpackAnnotationsByBuildpackNameAndStack", []interface{}{arg1, arg2, arg3})
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackCallCount() int {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	return len(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall)
+}
+
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = stub
+}
+
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	argsForCall := fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = nil
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = nil
+	if fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall == nil {
+		fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateBuildpackByNameAndStack(arg1 string, arg2 string, arg3 resources.Buildpack) (resources.Buildpack, v7action.Warnings, error) {
 	fake.updateBuildpackByNameAndStackMutex.Lock()
 	ret, specificReturn := fake.updateBuildpackByNameAndStackReturnsOnCall[len(fake.updateBuildpackByNameAndStackArgsForCall)]
@@ -17954,6 +21893,137 @@ func (fake *FakeActor) UpdateDestinationReturnsOnCall(i int, result1 v7action.Wa
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateDestinationWeight(arg1 resources.Route, arg2 string, arg3 int) (v7action.Warnings, error) {
+	fake.updateDestinationWeightMutex.Lock()
+	ret, specificReturn := fake.updateDestinationWeightReturnsOnCall[len(fake.updateDestinationWeightArgsForCall)]
+	fake.updateDestinationWeightArgsForCall = append(fake.updateDestinationWeightArgsForCall, struct {
+		arg1 resources.Route
+		arg2 string
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateDestinationWeightStub
+	fakeReturns := fake.updateDestinationWeightReturns
+	fake.recordInvocation("UpdateDestinationWeight", []interface{}{arg1, arg2, arg3})
+	fake.updateDestinationWeightMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateDestinationWeightCallCount() int {
+	fake.updateDestinationWeightMutex.RLock()
+	defer fake.updateDestinationWeightMutex.RUnlock()
+	return len(fake.updateDestinationWeightArgsForCall)
+}
+
+func (fake *FakeActor) UpdateDestinationWeightCalls(stub func(resources.Route, string, int) (v7action.Warnings, error)) {
+	fake.updateDestinationWeightMutex.Lock()
+	defer fake.updateDestinationWeightMutex.Unlock()
+	fake.UpdateDestinationWeightStub = stub
+}
+
+func (fake *FakeActor) UpdateDestinationWeightArgsForCall(i int) (resources.Route, string, int) {
+	fake.updateDestinationWeightMutex.RLock()
+	defer fake.updateDestinationWeightMutex.RUnlock()
+	argsForCall := fake.updateDestinationWeightArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateDestinationWeightReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateDestinationWeightMutex.Lock()
+	defer fake.updateDestinationWeightMutex.Unlock()
+	fake.UpdateDestinationWeightStub = nil
+	fake.updateDestinationWeightReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateDestinationWeightReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateDestinationWeightMutex.Lock()
+	defer fake.updateDestinationWeightMutex.Unlock()
+	fake.UpdateDestinationWeightStub = nil
+	if fake.updateDestinationWeightReturnsOnCall == nil {
+		fake.updateDestinationWeightReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateDestinationWeightReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	ret, specificReturn := fake.updateDomainAnnotationsByDomainNameReturnsOnCall[len(fake.updateDomainAnnotationsByDomainNameArgsForCall)]
+	fake.updateDomainAnnotationsByDomainNameArgsForCall = append(fake.updateDomainAnnotationsByDomainNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateDomainAnnotationsByDomainNameStub
+	fakeReturns := fake.updateDomainAnnotationsByDomainNameReturns
+	fake.recordInvocation("UpdateDomainAnnotationsByDomainName", []interface{}{arg1, arg2})
+	fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainNameCallCount() int {
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
+	return len(fake.updateDomainAnnotationsByDomainNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = stub
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
+	argsForCall := fake.updateDomainAnnotationsByDomainNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = nil
+	fake.updateDomainAnnotationsByDomainNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateDomainAnnotationsByDomainNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = nil
+	if fake.updateDomainAnnotationsByDomainNameReturnsOnCall == nil {
+		fake.updateDomainAnnotationsByDomainNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateDomainAnnotationsByDomainNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateDomainLabelsByDomainName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateDomainLabelsByDomainNameMutex.Lock()
 	ret, specificReturn := fake.updateDomainLabelsByDomainNameReturnsOnCall[len(fake.updateDomainLabelsByDomainNameArgsForCall)]
@@ -18086,6 +22156,71 @@ func (fake *FakeActor) UpdateManagedServiceInstanceReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall[len(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall)]
+	fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall = append(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateOrganizationAnnotationsByOrganizationNameStub
+	fakeReturns := fake.updateOrganizationAnnotationsByOrganizationNameReturns
+	fake.recordInvocation("UpdateOrganizationAnnotationsByOrganizationName", []interface{}{arg1, arg2})
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationNameCallCount() int {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	return len(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = stub
+}
+
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	argsForCall := fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = nil
+	fake.updateOrganizationAnnotationsByOrganizationNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateOrganizationAnnotationsByOrganizationNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = nil
+	if fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall == nil {
+		fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateOrganizationLabelsByOrganizationName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateOrganizationLabelsByOrganizationNameMutex.Lock()
 	ret, specificReturn := fake.updateOrganizationLabelsByOrganizationNameReturnsOnCall[len(fake.updateOrganizationLabelsByOrganizationNameArgsForCall)]
@@ -18283,6 +22418,144 @@ func (fake *FakeActor) UpdateProcessByTypeAndApplicationReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateResourcesMetadata(arg1 string, arg2 []string, arg3 resources.Metadata, arg4 func(updated int)) (v7action.Warnings, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.updateResourcesMetadataMutex.Lock()
+	ret, specificReturn := fake.updateResourcesMetadataReturnsOnCall[len(fake.updateResourcesMetadataArgsForCall)]
+	fake.updateResourcesMetadataArgsForCall = append(fake.updateResourcesMetadataArgsForCall, struct {
+		arg1 string
+		arg2 []string
+		arg3 resources.Metadata
+		arg4 func(updated int)
+	}{arg1, arg2Copy, arg3, arg4})
+	stub := fake.UpdateResourcesMetadataStub
+	fakeReturns := fake.updateResourcesMetadataReturns
+	fake.recordInvocation("UpdateResourcesMetadata", []interface{}{arg1, arg2Copy, arg3, arg4})
+	fake.updateResourcesMetadataMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateResourcesMetadataCallCount() int {
+	fake.updateResourcesMetadataMutex.RLock()
+	defer fake.updateResourcesMetadataMutex.RUnlock()
+	return len(fake.updateResourcesMetadataArgsForCall)
+}
+
+func (fake *FakeActor) UpdateResourcesMetadataCalls(stub func(string, []string, resources.Metadata, func(updated int)) (v7action.Warnings, error)) {
+	fake.updateResourcesMetadataMutex.Lock()
+	defer fake.updateResourcesMetadataMutex.Unlock()
+	fake.UpdateResourcesMetadataStub = stub
+}
+
+func (fake *FakeActor) UpdateResourcesMetadataArgsForCall(i int) (string, []string, resources.Metadata, func(updated int)) {
+	fake.updateResourcesMetadataMutex.RLock()
+	defer fake.updateResourcesMetadataMutex.RUnlock()
+	argsForCall := fake.updateResourcesMetadataArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) UpdateResourcesMetadataReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateResourcesMetadataMutex.Lock()
+	defer fake.updateResourcesMetadataMutex.Unlock()
+	fake.UpdateResourcesMetadataStub = nil
+	fake.updateResourcesMetadataReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateResourcesMetadataReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateResourcesMetadataMutex.Lock()
+	defer fake.updateResourcesMetadataMutex.Unlock()
+	fake.UpdateResourcesMetadataStub = nil
+	if fake.updateResourcesMetadataReturnsOnCall == nil {
+		fake.updateResourcesMetadataReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateResourcesMetadataReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateRouteAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateRouteAnnotationsReturnsOnCall[len(fake.updateRouteAnnotationsArgsForCall)]
+	fake.updateRouteAnnotationsArgsForCall = append(fake.updateRouteAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateRouteAnnotationsStub
+	fakeReturns := fake.updateRouteAnnotationsReturns
+	fake.recordInvocation("UpdateRouteAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateRouteAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateRouteAnnotationsCallCount() int {
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
+	return len(fake.updateRouteAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) UpdateRouteAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = stub
+}
+
+func (fake *FakeActor) UpdateRouteAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateRouteAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateRouteAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = nil
+	fake.updateRouteAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateRouteAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = nil
+	if fake.updateRouteAnnotationsReturnsOnCall == nil {
+		fake.updateRouteAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateRouteAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateRouteLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateRouteLabelsMutex.Lock()
 	ret, specificReturn := fake.updateRouteLabelsReturnsOnCall[len(fake.updateRouteLabelsArgsForCall)]
@@ -18349,6 +22622,74 @@ func (fake *FakeActor) UpdateRouteLabelsReturnsOnCall(i int, result1 v7action.Wa
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateRouterGroupReservablePorts(arg1 string, arg2 string) (v7action.RouterGroup, v7action.Warnings, error) {
+	fake.updateRouterGroupReservablePortsMutex.Lock()
+	ret, specificReturn := fake.updateRouterGroupReservablePortsReturnsOnCall[len(fake.updateRouterGroupReservablePortsArgsForCall)]
+	fake.updateRouterGroupReservablePortsArgsForCall = append(fake.updateRouterGroupReservablePortsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.UpdateRouterGroupReservablePortsStub
+	fakeReturns := fake.updateRouterGroupReservablePortsReturns
+	fake.recordInvocation("UpdateRouterGroupReservablePorts", []interface{}{arg1, arg2})
+	fake.updateRouterGroupReservablePortsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) UpdateRouterGroupReservablePortsCallCount() int {
+	fake.updateRouterGroupReservablePortsMutex.RLock()
+	defer fake.updateRouterGroupReservablePortsMutex.RUnlock()
+	return len(fake.updateRouterGroupReservablePortsArgsForCall)
+}
+
+func (fake *FakeActor) UpdateRouterGroupReservablePortsCalls(stub func(string, string) (v7action.RouterGroup, v7action.Warnings, error)) {
+	fake.updateRouterGroupReservablePortsMutex.Lock()
+	defer fake.updateRouterGroupReservablePortsMutex.Unlock()
+	fake.UpdateRouterGroupReservablePortsStub = stub
+}
+
+func (fake *FakeActor) UpdateRouterGroupReservablePortsArgsForCall(i int) (string, string) {
+	fake.updateRouterGroupReservablePortsMutex.RLock()
+	defer fake.updateRouterGroupReservablePortsMutex.RUnlock()
+	argsForCall := fake.updateRouterGroupReservablePortsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateRouterGroupReservablePortsReturns(result1 v7action.RouterGroup, result2 v7action.Warnings, result3 error) {
+	fake.updateRouterGroupReservablePortsMutex.Lock()
+	defer fake.updateRouterGroupReservablePortsMutex.Unlock()
+	fake.UpdateRouterGroupReservablePortsStub = nil
+	fake.updateRouterGroupReservablePortsReturns = struct {
+		result1 v7action.RouterGroup
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) UpdateRouterGroupReservablePortsReturnsOnCall(i int, result1 v7action.RouterGroup, result2 v7action.Warnings, result3 error) {
+	fake.updateRouterGroupReservablePortsMutex.Lock()
+	defer fake.updateRouterGroupReservablePortsMutex.Unlock()
+	fake.UpdateRouterGroupReservablePortsStub = nil
+	if fake.updateRouterGroupReservablePortsReturnsOnCall == nil {
+		fake.updateRouterGroupReservablePortsReturnsOnCall = make(map[int]struct {
+			result1 v7action.RouterGroup
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.updateRouterGroupReservablePortsReturnsOnCall[i] = struct {
+		result1 v7action.RouterGroup
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) UpdateSecurityGroup(arg1 string, arg2 string) (v7action.Warnings, error) {
 	fake.updateSecurityGroupMutex.Lock()
 	ret, specificReturn := fake.updateSecurityGroupReturnsOnCall[len(fake.updateSecurityGroupArgsForCall)]
@@ -18485,12 +22826,77 @@ func (fake *FakeActor) UpdateServiceBroker(arg1 string, arg2 resources.ServiceBr
 	ret, specificReturn := fake.updateServiceBrokerReturnsOnCall[len(fake.updateServiceBrokerArgsForCall)]
 	fake.updateServiceBrokerArgsForCall = append(fake.updateServiceBrokerArgsForCall, struct {
 		arg1 string
-		arg2 resources.ServiceBroker
+		arg2 resources.ServiceBroker
+	}{arg1, arg2})
+	stub := fake.UpdateServiceBrokerStub
+	fakeReturns := fake.updateServiceBrokerReturns
+	fake.recordInvocation("UpdateServiceBroker", []interface{}{arg1, arg2})
+	fake.updateServiceBrokerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateServiceBrokerCallCount() int {
+	fake.updateServiceBrokerMutex.RLock()
+	defer fake.updateServiceBrokerMutex.RUnlock()
+	return len(fake.updateServiceBrokerArgsForCall)
+}
+
+func (fake *FakeActor) UpdateServiceBrokerCalls(stub func(string, resources.ServiceBroker) (v7action.Warnings, error)) {
+	fake.updateServiceBrokerMutex.Lock()
+	defer fake.updateServiceBrokerMutex.Unlock()
+	fake.UpdateServiceBrokerStub = stub
+}
+
+func (fake *FakeActor) UpdateServiceBrokerArgsForCall(i int) (string, resources.ServiceBroker) {
+	fake.updateServiceBrokerMutex.RLock()
+	defer fake.updateServiceBrokerMutex.RUnlock()
+	argsForCall := fake.updateServiceBrokerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateServiceBrokerReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceBrokerMutex.Lock()
+	defer fake.updateServiceBrokerMutex.Unlock()
+	fake.UpdateServiceBrokerStub = nil
+	fake.updateServiceBrokerReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateServiceBrokerReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceBrokerMutex.Lock()
+	defer fake.updateServiceBrokerMutex.Unlock()
+	fake.UpdateServiceBrokerStub = nil
+	if fake.updateServiceBrokerReturnsOnCall == nil {
+		fake.updateServiceBrokerReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceBrokerReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	ret, specificReturn := fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall[len(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall)]
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall = append(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
 	}{arg1, arg2})
-	stub := fake.UpdateServiceBrokerStub
-	fakeReturns := fake.updateServiceBrokerReturns
-	fake.recordInvocation("UpdateServiceBroker", []interface{}{arg1, arg2})
-	fake.updateServiceBrokerMutex.Unlock()
+	stub := fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub
+	fakeReturns := fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturns
+	fake.recordInvocation("UpdateServiceBrokerAnnotationsByServiceBrokerName", []interface{}{arg1, arg2})
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -18500,46 +22906,46 @@ func (fake *FakeActor) UpdateServiceBroker(arg1 string, arg2 resources.ServiceBr
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeActor) UpdateServiceBrokerCallCount() int {
-	fake.updateServiceBrokerMutex.RLock()
-	defer fake.updateServiceBrokerMutex.RUnlock()
-	return len(fake.updateServiceBrokerArgsForCall)
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameCallCount() int {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
+	return len(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall)
 }
 
-func (fake *FakeActor) UpdateServiceBrokerCalls(stub func(string, resources.ServiceBroker) (v7action.Warnings, error)) {
-	fake.updateServiceBrokerMutex.Lock()
-	defer fake.updateServiceBrokerMutex.Unlock()
-	fake.UpdateServiceBrokerStub = stub
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = stub
 }
 
-func (fake *FakeActor) UpdateServiceBrokerArgsForCall(i int) (string, resources.ServiceBroker) {
-	fake.updateServiceBrokerMutex.RLock()
-	defer fake.updateServiceBrokerMutex.RUnlock()
-	argsForCall := fake.updateServiceBrokerArgsForCall[i]
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
+	argsForCall := fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeActor) UpdateServiceBrokerReturns(result1 v7action.Warnings, result2 error) {
-	fake.updateServiceBrokerMutex.Lock()
-	defer fake.updateServiceBrokerMutex.Unlock()
-	fake.UpdateServiceBrokerStub = nil
-	fake.updateServiceBrokerReturns = struct {
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = nil
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturns = struct {
 		result1 v7action.Warnings
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeActor) UpdateServiceBrokerReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
-	fake.updateServiceBrokerMutex.Lock()
-	defer fake.updateServiceBrokerMutex.Unlock()
-	fake.UpdateServiceBrokerStub = nil
-	if fake.updateServiceBrokerReturnsOnCall == nil {
-		fake.updateServiceBrokerReturnsOnCall = make(map[int]struct {
+func (fake *FakeActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = nil
+	if fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall == nil {
+		fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall = make(map[int]struct {
 			result1 v7action.Warnings
 			result2 error
 		})
 	}
-	fake.updateServiceBrokerReturnsOnCall[i] = struct {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall[i] = struct {
 		result1 v7action.Warnings
 		result2 error
 	}{result1, result2}
@@ -18610,6 +23016,72 @@ func (fake *FakeActor) UpdateServiceBrokerLabelsByServiceBrokerNameReturnsOnCall
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateServiceInstanceAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceAnnotationsReturnsOnCall[len(fake.updateServiceInstanceAnnotationsArgsForCall)]
+	fake.updateServiceInstanceAnnotationsArgsForCall = append(fake.updateServiceInstanceAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateServiceInstanceAnnotationsStub
+	fakeReturns := fake.updateServiceInstanceAnnotationsReturns
+	fake.recordInvocation("UpdateServiceInstanceAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateServiceInstanceAnnotationsCallCount() int {
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
+	return len(fake.updateServiceInstanceAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) UpdateServiceInstanceAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = stub
+}
+
+func (fake *FakeActor) UpdateServiceInstanceAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServiceInstanceAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateServiceInstanceAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = nil
+	fake.updateServiceInstanceAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateServiceInstanceAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = nil
+	if fake.updateServiceInstanceAnnotationsReturnsOnCall == nil {
+		fake.updateServiceInstanceAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceInstanceAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateServiceInstanceLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceInstanceLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServiceInstanceLabelsReturnsOnCall[len(fake.updateServiceInstanceLabelsArgsForCall)]
@@ -18676,6 +23148,72 @@ func (fake *FakeActor) UpdateServiceInstanceLabelsReturnsOnCall(i int, result1 v
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateServiceOfferingAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServiceOfferingAnnotationsReturnsOnCall[len(fake.updateServiceOfferingAnnotationsArgsForCall)]
+	fake.updateServiceOfferingAnnotationsArgsForCall = append(fake.updateServiceOfferingAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateServiceOfferingAnnotationsStub
+	fakeReturns := fake.updateServiceOfferingAnnotationsReturns
+	fake.recordInvocation("UpdateServiceOfferingAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateServiceOfferingAnnotationsCallCount() int {
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
+	return len(fake.updateServiceOfferingAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) UpdateServiceOfferingAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = stub
+}
+
+func (fake *FakeActor) UpdateServiceOfferingAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServiceOfferingAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateServiceOfferingAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = nil
+	fake.updateServiceOfferingAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateServiceOfferingAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = nil
+	if fake.updateServiceOfferingAnnotationsReturnsOnCall == nil {
+		fake.updateServiceOfferingAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceOfferingAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateServiceOfferingLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceOfferingLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServiceOfferingLabelsReturnsOnCall[len(fake.updateServiceOfferingLabelsArgsForCall)]
@@ -18742,6 +23280,73 @@ func (fake *FakeActor) UpdateServiceOfferingLabelsReturnsOnCall(i int, result1 v
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateServicePlanAnnotations(arg1 string, arg2 string, arg3 string, arg4 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServicePlanAnnotationsReturnsOnCall[len(fake.updateServicePlanAnnotationsArgsForCall)]
+	fake.updateServicePlanAnnotationsArgsForCall = append(fake.updateServicePlanAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateServicePlanAnnotationsStub
+	fakeReturns := fake.updateServicePlanAnnotationsReturns
+	fake.recordInvocation("UpdateServicePlanAnnotations", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateServicePlanAnnotationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateServicePlanAnnotationsCallCount() int {
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
+	return len(fake.updateServicePlanAnnotationsArgsForCall)
+}
+
+func (fake *FakeActor) UpdateServicePlanAnnotationsCalls(stub func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = stub
+}
+
+func (fake *FakeActor) UpdateServicePlanAnnotationsArgsForCall(i int) (string, string, string, map[string]types.NullString) {
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServicePlanAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActor) UpdateServicePlanAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = nil
+	fake.updateServicePlanAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateServicePlanAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = nil
+	if fake.updateServicePlanAnnotationsReturnsOnCall == nil {
+		fake.updateServicePlanAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServicePlanAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateServicePlanLabels(arg1 string, arg2 string, arg3 string, arg4 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServicePlanLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServicePlanLabelsReturnsOnCall[len(fake.updateServicePlanLabelsArgsForCall)]
@@ -18809,6 +23414,72 @@ func (fake *FakeActor) UpdateServicePlanLabelsReturnsOnCall(i int, result1 v7act
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall[len(fake.updateSpaceAnnotationsBySpaceNameArgsForCall)]
+	fake.updateSpaceAnnotationsBySpaceNameArgsForCall = append(fake.updateSpaceAnnotationsBySpaceNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateSpaceAnnotationsBySpaceNameStub
+	fakeReturns := fake.updateSpaceAnnotationsBySpaceNameReturns
+	fake.recordInvocation("UpdateSpaceAnnotationsBySpaceName", []interface{}{arg1, arg2, arg3})
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceNameCallCount() int {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	return len(fake.updateSpaceAnnotationsBySpaceNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceNameCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = stub
+}
+
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceNameArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	argsForCall := fake.updateSpaceAnnotationsBySpaceNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = nil
+	fake.updateSpaceAnnotationsBySpaceNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateSpaceAnnotationsBySpaceNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = nil
+	if fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall == nil {
+		fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateSpaceFeature(arg1 string, arg2 string, arg3 bool, arg4 string) (v7action.Warnings, error) {
 	fake.updateSpaceFeatureMutex.Lock()
 	ret, specificReturn := fake.updateSpaceFeatureReturnsOnCall[len(fake.updateSpaceFeatureArgsForCall)]
@@ -19009,6 +23680,71 @@ func (fake *FakeActor) UpdateSpaceQuotaReturnsOnCall(i int, result1 v7action.War
 	}{result1, result2}
 }
 
+func (fake *FakeActor) UpdateStackAnnotationsByStackName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	ret, specificReturn := fake.updateStackAnnotationsByStackNameReturnsOnCall[len(fake.updateStackAnnotationsByStackNameArgsForCall)]
+	fake.updateStackAnnotationsByStackNameArgsForCall = append(fake.updateStackAnnotationsByStackNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	stub := fake.UpdateStackAnnotationsByStackNameStub
+	fakeReturns := fake.updateStackAnnotationsByStackNameReturns
+	fake.recordInvocation("UpdateStackAnnotationsByStackName", []interface{}{arg1, arg2})
+	fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActor) UpdateStackAnnotationsByStackNameCallCount() int {
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	return len(fake.updateStackAnnotationsByStackNameArgsForCall)
+}
+
+func (fake *FakeActor) UpdateStackAnnotationsByStackNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = stub
+}
+
+func (fake *FakeActor) UpdateStackAnnotationsByStackNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	argsForCall := fake.updateStackAnnotationsByStackNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeActor) UpdateStackAnnotationsByStackNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = nil
+	fake.updateStackAnnotationsByStackNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActor) UpdateStackAnnotationsByStackNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = nil
+	if fake.updateStackAnnotationsByStackNameReturnsOnCall == nil {
+		fake.updateStackAnnotationsByStackNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateStackAnnotationsByStackNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeActor) UpdateStackLabelsByStackName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateStackLabelsByStackNameMutex.Lock()
 	ret, specificReturn := fake.updateStackLabelsByStackNameReturnsOnCall[len(fake.updateStackLabelsByStackNameArgsForCall)]
@@ -19482,6 +24218,128 @@ func (fake *FakeActor) UploadDropletReturnsOnCall(i int, result1 v7action.Warnin
 	}{result1, result2}
 }
 
+func (fake *FakeActor) ValidateServiceBrokerCatalog(arg1 servicebroker.Catalog) v7action.CatalogProblems {
+	fake.validateServiceBrokerCatalogMutex.Lock()
+	ret, specificReturn := fake.validateServiceBrokerCatalogReturnsOnCall[len(fake.validateServiceBrokerCatalogArgsForCall)]
+	fake.validateServiceBrokerCatalogArgsForCall = append(fake.validateServiceBrokerCatalogArgsForCall, struct {
+		arg1 servicebroker.Catalog
+	}{arg1})
+	stub := fake.ValidateServiceBrokerCatalogStub
+	fakeReturns := fake.validateServiceBrokerCatalogReturns
+	fake.recordInvocation("ValidateServiceBrokerCatalog", []interface{}{arg1})
+	fake.validateServiceBrokerCatalogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) ValidateServiceBrokerCatalogCallCount() int {
+	fake.validateServiceBrokerCatalogMutex.RLock()
+	defer fake.validateServiceBrokerCatalogMutex.RUnlock()
+	return len(fake.validateServiceBrokerCatalogArgsForCall)
+}
+
+func (fake *FakeActor) ValidateServiceBrokerCatalogCalls(stub func(servicebroker.Catalog) v7action.CatalogProblems) {
+	fake.validateServiceBrokerCatalogMutex.Lock()
+	defer fake.validateServiceBrokerCatalogMutex.Unlock()
+	fake.ValidateServiceBrokerCatalogStub = stub
+}
+
+func (fake *FakeActor) ValidateServiceBrokerCatalogArgsForCall(i int) servicebroker.Catalog {
+	fake.validateServiceBrokerCatalogMutex.RLock()
+	defer fake.validateServiceBrokerCatalogMutex.RUnlock()
+	argsForCall := fake.validateServiceBrokerCatalogArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ValidateServiceBrokerCatalogReturns(result1 v7action.CatalogProblems) {
+	fake.validateServiceBrokerCatalogMutex.Lock()
+	defer fake.validateServiceBrokerCatalogMutex.Unlock()
+	fake.ValidateServiceBrokerCatalogStub = nil
+	fake.validateServiceBrokerCatalogReturns = struct {
+		result1 v7action.CatalogProblems
+	}{result1}
+}
+
+func (fake *FakeActor) ValidateServiceBrokerCatalogReturnsOnCall(i int, result1 v7action.CatalogProblems) {
+	fake.validateServiceBrokerCatalogMutex.Lock()
+	defer fake.validateServiceBrokerCatalogMutex.Unlock()
+	fake.ValidateServiceBrokerCatalogStub = nil
+	if fake.validateServiceBrokerCatalogReturnsOnCall == nil {
+		fake.validateServiceBrokerCatalogReturnsOnCall = make(map[int]struct {
+			result1 v7action.CatalogProblems
+		})
+	}
+	fake.validateServiceBrokerCatalogReturnsOnCall[i] = struct {
+		result1 v7action.CatalogProblems
+	}{result1}
+}
+
+func (fake *FakeActor) ValidateTokenSignature(arg1 string) error {
+	fake.validateTokenSignatureMutex.Lock()
+	ret, specificReturn := fake.validateTokenSignatureReturnsOnCall[len(fake.validateTokenSignatureArgsForCall)]
+	fake.validateTokenSignatureArgsForCall = append(fake.validateTokenSignatureArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateTokenSignatureStub
+	fakeReturns := fake.validateTokenSignatureReturns
+	fake.recordInvocation("ValidateTokenSignature", []interface{}{arg1})
+	fake.validateTokenSignatureMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActor) ValidateTokenSignatureCallCount() int {
+	fake.validateTokenSignatureMutex.RLock()
+	defer fake.validateTokenSignatureMutex.RUnlock()
+	return len(fake.validateTokenSignatureArgsForCall)
+}
+
+func (fake *FakeActor) ValidateTokenSignatureCalls(stub func(string) error) {
+	fake.validateTokenSignatureMutex.Lock()
+	defer fake.validateTokenSignatureMutex.Unlock()
+	fake.ValidateTokenSignatureStub = stub
+}
+
+func (fake *FakeActor) ValidateTokenSignatureArgsForCall(i int) string {
+	fake.validateTokenSignatureMutex.RLock()
+	defer fake.validateTokenSignatureMutex.RUnlock()
+	argsForCall := fake.validateTokenSignatureArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) ValidateTokenSignatureReturns(result1 error) {
+	fake.validateTokenSignatureMutex.Lock()
+	defer fake.validateTokenSignatureMutex.Unlock()
+	fake.ValidateTokenSignatureStub = nil
+	fake.validateTokenSignatureReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActor) ValidateTokenSignatureReturnsOnCall(i int, result1 error) {
+	fake.validateTokenSignatureMutex.Lock()
+	defer fake.validateTokenSignatureMutex.Unlock()
+	fake.ValidateTokenSignatureStub = nil
+	if fake.validateTokenSignatureReturnsOnCall == nil {
+		fake.validateTokenSignatureReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateTokenSignatureReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -19497,6 +24355,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.bindSecurityGroupToSpacesMutex.RUnlock()
 	fake.cancelDeploymentMutex.RLock()
 	defer fake.cancelDeploymentMutex.RUnlock()
+	fake.checkQuotaChangeMutex.RLock()
+	defer fake.checkQuotaChangeMutex.RUnlock()
 	fake.checkRouteMutex.RLock()
 	defer fake.checkRouteMutex.RUnlock()
 	fake.clearTargetMutex.RLock()
@@ -19557,6 +24417,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.createUserMutex.RUnlock()
 	fake.createUserProvidedServiceInstanceMutex.RLock()
 	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	fake.decodeTokenMutex.RLock()
+	defer fake.decodeTokenMutex.RUnlock()
 	fake.deleteApplicationByNameAndSpaceMutex.RLock()
 	defer fake.deleteApplicationByNameAndSpaceMutex.RUnlock()
 	fake.deleteBuildpackByNameAndStackMutex.RLock()
@@ -19581,6 +24443,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.deleteRouteMutex.RUnlock()
 	fake.deleteRouteBindingMutex.RLock()
 	defer fake.deleteRouteBindingMutex.RUnlock()
+	fake.deleteRoutesMutex.RLock()
+	defer fake.deleteRoutesMutex.RUnlock()
 	fake.deleteSecurityGroupMutex.RLock()
 	defer fake.deleteSecurityGroupMutex.RUnlock()
 	fake.deleteServiceAppBindingMutex.RLock()
@@ -19597,8 +24461,16 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.deleteSpaceQuotaByNameMutex.RUnlock()
 	fake.deleteSpaceRoleMutex.RLock()
 	defer fake.deleteSpaceRoleMutex.RUnlock()
+	fake.deleteStaleArtifactMutex.RLock()
+	defer fake.deleteStaleArtifactMutex.RUnlock()
 	fake.deleteUserMutex.RLock()
 	defer fake.deleteUserMutex.RUnlock()
+	fake.diffDropletArchivesMutex.RLock()
+	defer fake.diffDropletArchivesMutex.RUnlock()
+	fake.diffEnvironmentVariableGroupsMutex.RLock()
+	defer fake.diffEnvironmentVariableGroupsMutex.RUnlock()
+	fake.diffServiceBrokerCatalogMutex.RLock()
+	defer fake.diffServiceBrokerCatalogMutex.RUnlock()
 	fake.diffSpaceManifestMutex.RLock()
 	defer fake.diffSpaceManifestMutex.RUnlock()
 	fake.disableFeatureFlagMutex.RLock()
@@ -19615,14 +24487,20 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.enableServiceAccessMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationByNameMutex.RLock()
 	defer fake.entitleIsolationSegmentToOrganizationByNameMutex.RUnlock()
+	fake.extractDropletFilesMutex.RLock()
+	defer fake.extractDropletFilesMutex.RUnlock()
 	fake.getAppFeatureMutex.RLock()
 	defer fake.getAppFeatureMutex.RUnlock()
 	fake.getAppSummariesForSpaceMutex.RLock()
 	defer fake.getAppSummariesForSpaceMutex.RUnlock()
+	fake.getApplicationAnnotationsMutex.RLock()
+	defer fake.getApplicationAnnotationsMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationDropletsMutex.RLock()
 	defer fake.getApplicationDropletsMutex.RUnlock()
+	fake.getApplicationDropletsWithLabelSelectorMutex.RLock()
+	defer fake.getApplicationDropletsWithLabelSelectorMutex.RUnlock()
 	fake.getApplicationLabelsMutex.RLock()
 	defer fake.getApplicationLabelsMutex.RUnlock()
 	fake.getApplicationMapForRouteMutex.RLock()
@@ -19631,6 +24509,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationPackagesMutex.RUnlock()
 	fake.getApplicationProcessHealthChecksByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationProcessHealthChecksByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationQuotaChangeMutex.RLock()
+	defer fake.getApplicationQuotaChangeMutex.RUnlock()
 	fake.getApplicationRevisionsDeployedMutex.RLock()
 	defer fake.getApplicationRevisionsDeployedMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
@@ -19639,10 +24519,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
 	defer fake.getApplicationsByNamesAndSpaceMutex.RUnlock()
+	fake.getBuildpackAnnotationsMutex.RLock()
+	defer fake.getBuildpackAnnotationsMutex.RUnlock()
 	fake.getBuildpackLabelsMutex.RLock()
 	defer fake.getBuildpackLabelsMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
 	defer fake.getBuildpacksMutex.RUnlock()
+	fake.getCurrentRevisionMutex.RLock()
+	defer fake.getCurrentRevisionMutex.RUnlock()
 	fake.getCurrentUserMutex.RLock()
 	defer fake.getCurrentUserMutex.RUnlock()
 	fake.getDefaultDomainMutex.RLock()
@@ -19651,6 +24535,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getDetailedAppSummaryMutex.RUnlock()
 	fake.getDomainMutex.RLock()
 	defer fake.getDomainMutex.RUnlock()
+	fake.getDomainAnnotationsMutex.RLock()
+	defer fake.getDomainAnnotationsMutex.RUnlock()
 	fake.getDomainByNameMutex.RLock()
 	defer fake.getDomainByNameMutex.RUnlock()
 	fake.getDomainLabelsMutex.RLock()
@@ -19683,6 +24569,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getNewestReadyPackageForApplicationMutex.RUnlock()
 	fake.getOrgUsersByRoleTypeMutex.RLock()
 	defer fake.getOrgUsersByRoleTypeMutex.RUnlock()
+	fake.getOrganizationAnnotationsMutex.RLock()
+	defer fake.getOrganizationAnnotationsMutex.RUnlock()
 	fake.getOrganizationByNameMutex.RLock()
 	defer fake.getOrganizationByNameMutex.RUnlock()
 	fake.getOrganizationDomainsMutex.RLock()
@@ -19691,6 +24579,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getOrganizationLabelsMutex.RUnlock()
 	fake.getOrganizationQuotaByNameMutex.RLock()
 	defer fake.getOrganizationQuotaByNameMutex.RUnlock()
+	fake.getOrganizationQuotaUsageMutex.RLock()
+	defer fake.getOrganizationQuotaUsageMutex.RUnlock()
 	fake.getOrganizationQuotasMutex.RLock()
 	defer fake.getOrganizationQuotasMutex.RUnlock()
 	fake.getOrganizationSpacesMutex.RLock()
@@ -19701,6 +24591,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getOrganizationSummaryByNameMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
+	fake.getOrganizationsQuotaUsageMutex.RLock()
+	defer fake.getOrganizationsQuotaUsageMutex.RUnlock()
 	fake.getProcessByTypeAndApplicationMutex.RLock()
 	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
 	fake.getRawApplicationManifestByNameAndSpaceMutex.RLock()
@@ -19709,20 +24601,34 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getRecentEventsByApplicationNameAndSpaceMutex.RUnlock()
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getResourcesByLabelSelectorMutex.RLock()
+	defer fake.getResourcesByLabelSelectorMutex.RUnlock()
 	fake.getRevisionByApplicationAndVersionMutex.RLock()
 	defer fake.getRevisionByApplicationAndVersionMutex.RUnlock()
+	fake.getRevisionDetailsMutex.RLock()
+	defer fake.getRevisionDetailsMutex.RUnlock()
 	fake.getRevisionsByApplicationNameAndSpaceMutex.RLock()
 	defer fake.getRevisionsByApplicationNameAndSpaceMutex.RUnlock()
 	fake.getRootResponseMutex.RLock()
 	defer fake.getRootResponseMutex.RUnlock()
+	fake.getRouteMutex.RLock()
+	defer fake.getRouteMutex.RUnlock()
+	fake.getRouteAnnotationsMutex.RLock()
+	defer fake.getRouteAnnotationsMutex.RUnlock()
 	fake.getRouteByAttributesMutex.RLock()
 	defer fake.getRouteByAttributesMutex.RUnlock()
 	fake.getRouteDestinationByAppGUIDMutex.RLock()
 	defer fake.getRouteDestinationByAppGUIDMutex.RUnlock()
+	fake.getRouteDestinationsHealthMutex.RLock()
+	defer fake.getRouteDestinationsHealthMutex.RUnlock()
+	fake.getRouteInventoryMutex.RLock()
+	defer fake.getRouteInventoryMutex.RUnlock()
 	fake.getRouteLabelsMutex.RLock()
 	defer fake.getRouteLabelsMutex.RUnlock()
 	fake.getRouteSummariesMutex.RLock()
 	defer fake.getRouteSummariesMutex.RUnlock()
+	fake.getRouterGroupPortUsageMutex.RLock()
+	defer fake.getRouterGroupPortUsageMutex.RUnlock()
 	fake.getRouterGroupsMutex.RLock()
 	defer fake.getRouterGroupsMutex.RUnlock()
 	fake.getRoutesByOrgMutex.RLock()
@@ -19737,6 +24643,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.getSecureShellConfigurationForRunningInstanceMutex.RLock()
+	defer fake.getSecureShellConfigurationForRunningInstanceMutex.RUnlock()
 	fake.getSecurityGroupMutex.RLock()
 	defer fake.getSecurityGroupMutex.RUnlock()
 	fake.getSecurityGroupSummaryMutex.RLock()
@@ -19745,12 +24653,20 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSecurityGroupsMutex.RUnlock()
 	fake.getServiceAccessMutex.RLock()
 	defer fake.getServiceAccessMutex.RUnlock()
+	fake.getServiceAccessChangesMutex.RLock()
+	defer fake.getServiceAccessChangesMutex.RUnlock()
+	fake.getServiceAccessMatrixMutex.RLock()
+	defer fake.getServiceAccessMatrixMutex.RUnlock()
+	fake.getServiceBrokerAnnotationsMutex.RLock()
+	defer fake.getServiceBrokerAnnotationsMutex.RUnlock()
 	fake.getServiceBrokerByNameMutex.RLock()
 	defer fake.getServiceBrokerByNameMutex.RUnlock()
 	fake.getServiceBrokerLabelsMutex.RLock()
 	defer fake.getServiceBrokerLabelsMutex.RUnlock()
 	fake.getServiceBrokersMutex.RLock()
 	defer fake.getServiceBrokersMutex.RUnlock()
+	fake.getServiceInstanceAnnotationsMutex.RLock()
+	defer fake.getServiceInstanceAnnotationsMutex.RUnlock()
 	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
 	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
 	fake.getServiceInstanceDetailsMutex.RLock()
@@ -19767,12 +24683,18 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getServiceKeyDetailsByServiceInstanceAndNameMutex.RUnlock()
 	fake.getServiceKeysByServiceInstanceMutex.RLock()
 	defer fake.getServiceKeysByServiceInstanceMutex.RUnlock()
+	fake.getServiceOfferingAnnotationsMutex.RLock()
+	defer fake.getServiceOfferingAnnotationsMutex.RUnlock()
 	fake.getServiceOfferingLabelsMutex.RLock()
 	defer fake.getServiceOfferingLabelsMutex.RUnlock()
+	fake.getServicePlanAnnotationsMutex.RLock()
+	defer fake.getServicePlanAnnotationsMutex.RUnlock()
 	fake.getServicePlanByNameOfferingAndBrokerMutex.RLock()
 	defer fake.getServicePlanByNameOfferingAndBrokerMutex.RUnlock()
 	fake.getServicePlanLabelsMutex.RLock()
 	defer fake.getServicePlanLabelsMutex.RUnlock()
+	fake.getSpaceAnnotationsMutex.RLock()
+	defer fake.getSpaceAnnotationsMutex.RUnlock()
 	fake.getSpaceByNameAndOrganizationMutex.RLock()
 	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
 	fake.getSpaceFeatureMutex.RLock()
@@ -19787,12 +24709,18 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getSpaceSummaryByNameAndOrganizationMutex.RUnlock()
 	fake.getSpaceUsersByRoleTypeMutex.RLock()
 	defer fake.getSpaceUsersByRoleTypeMutex.RUnlock()
+	fake.getStackAnnotationsMutex.RLock()
+	defer fake.getStackAnnotationsMutex.RUnlock()
 	fake.getStackByNameMutex.RLock()
 	defer fake.getStackByNameMutex.RUnlock()
 	fake.getStackLabelsMutex.RLock()
 	defer fake.getStackLabelsMutex.RUnlock()
 	fake.getStacksMutex.RLock()
 	defer fake.getStacksMutex.RUnlock()
+	fake.getStaleArtifactSizesMutex.RLock()
+	defer fake.getStaleArtifactSizesMutex.RUnlock()
+	fake.getStaleArtifactsMutex.RLock()
+	defer fake.getStaleArtifactsMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getTaskBySequenceIDAndApplicationMutex.RLock()
@@ -19807,12 +24735,22 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.makeCurlRequestMutex.RUnlock()
 	fake.mapRouteMutex.RLock()
 	defer fake.mapRouteMutex.RUnlock()
+	fake.mapRouteWithWeightMutex.RLock()
+	defer fake.mapRouteWithWeightMutex.RUnlock()
 	fake.marketplaceMutex.RLock()
 	defer fake.marketplaceMutex.RUnlock()
+	fake.marshalEnvironmentVariableFileMutex.RLock()
+	defer fake.marshalEnvironmentVariableFileMutex.RUnlock()
+	fake.monitorApplicationHealthMutex.RLock()
+	defer fake.monitorApplicationHealthMutex.RUnlock()
 	fake.moveRouteMutex.RLock()
 	defer fake.moveRouteMutex.RUnlock()
 	fake.parseAccessTokenMutex.RLock()
 	defer fake.parseAccessTokenMutex.RUnlock()
+	fake.parseEnvironmentVariableFileMutex.RLock()
+	defer fake.parseEnvironmentVariableFileMutex.RUnlock()
+	fake.parseServiceAccessFileMutex.RLock()
+	defer fake.parseServiceAccessFileMutex.RUnlock()
 	fake.pollBuildMutex.RLock()
 	defer fake.pollBuildMutex.RUnlock()
 	fake.pollPackageMutex.RLock()
@@ -19827,10 +24765,14 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.pollUploadBuildpackJobMutex.RUnlock()
 	fake.prepareBuildpackBitsMutex.RLock()
 	defer fake.prepareBuildpackBitsMutex.RUnlock()
+	fake.probeRouteMutex.RLock()
+	defer fake.probeRouteMutex.RUnlock()
 	fake.purgeServiceInstanceMutex.RLock()
 	defer fake.purgeServiceInstanceMutex.RUnlock()
 	fake.purgeServiceOfferingByNameAndBrokerMutex.RLock()
 	defer fake.purgeServiceOfferingByNameAndBrokerMutex.RUnlock()
+	fake.readDropletArchiveMutex.RLock()
+	defer fake.readDropletArchiveMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
 	fake.renameApplicationByNameAndSpaceGUIDMutex.RLock()
@@ -19869,6 +24811,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.setEnvironmentVariableByApplicationNameAndSpaceMutex.RUnlock()
 	fake.setEnvironmentVariableGroupMutex.RLock()
 	defer fake.setEnvironmentVariableGroupMutex.RUnlock()
+	fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RLock()
+	defer fake.setEnvironmentVariablesByApplicationNameAndSpaceMutex.RUnlock()
 	fake.setOrganizationDefaultIsolationSegmentMutex.RLock()
 	defer fake.setOrganizationDefaultIsolationSegmentMutex.RUnlock()
 	fake.setSpaceManifestMutex.RLock()
@@ -19909,46 +24853,74 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.updateAppFeatureMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
 	fake.updateApplicationLabelsByApplicationNameMutex.RLock()
 	defer fake.updateApplicationLabelsByApplicationNameMutex.RUnlock()
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
 	fake.updateBuildpackByNameAndStackMutex.RLock()
 	defer fake.updateBuildpackByNameAndStackMutex.RUnlock()
 	fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RLock()
 	defer fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RUnlock()
 	fake.updateDestinationMutex.RLock()
 	defer fake.updateDestinationMutex.RUnlock()
+	fake.updateDestinationWeightMutex.RLock()
+	defer fake.updateDestinationWeightMutex.RUnlock()
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
 	fake.updateDomainLabelsByDomainNameMutex.RLock()
 	defer fake.updateDomainLabelsByDomainNameMutex.RUnlock()
 	fake.updateManagedServiceInstanceMutex.RLock()
 	defer fake.updateManagedServiceInstanceMutex.RUnlock()
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
 	fake.updateOrganizationLabelsByOrganizationNameMutex.RLock()
 	defer fake.updateOrganizationLabelsByOrganizationNameMutex.RUnlock()
 	fake.updateOrganizationQuotaMutex.RLock()
 	defer fake.updateOrganizationQuotaMutex.RUnlock()
 	fake.updateProcessByTypeAndApplicationMutex.RLock()
 	defer fake.updateProcessByTypeAndApplicationMutex.RUnlock()
+	fake.updateResourcesMetadataMutex.RLock()
+	defer fake.updateResourcesMetadataMutex.RUnlock()
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
 	fake.updateRouteLabelsMutex.RLock()
 	defer fake.updateRouteLabelsMutex.RUnlock()
+	fake.updateRouterGroupReservablePortsMutex.RLock()
+	defer fake.updateRouterGroupReservablePortsMutex.RUnlock()
 	fake.updateSecurityGroupMutex.RLock()
 	defer fake.updateSecurityGroupMutex.RUnlock()
 	fake.updateSecurityGroupGloballyEnabledMutex.RLock()
 	defer fake.updateSecurityGroupGloballyEnabledMutex.RUnlock()
 	fake.updateServiceBrokerMutex.RLock()
 	defer fake.updateServiceBrokerMutex.RUnlock()
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
 	fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.RLock()
 	defer fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.RUnlock()
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
 	fake.updateServiceInstanceLabelsMutex.RLock()
 	defer fake.updateServiceInstanceLabelsMutex.RUnlock()
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
 	fake.updateServiceOfferingLabelsMutex.RLock()
 	defer fake.updateServiceOfferingLabelsMutex.RUnlock()
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
 	fake.updateServicePlanLabelsMutex.RLock()
 	defer fake.updateServicePlanLabelsMutex.RUnlock()
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
 	fake.updateSpaceFeatureMutex.RLock()
 	defer fake.updateSpaceFeatureMutex.RUnlock()
 	fake.updateSpaceLabelsBySpaceNameMutex.RLock()
 	defer fake.updateSpaceLabelsBySpaceNameMutex.RUnlock()
 	fake.updateSpaceQuotaMutex.RLock()
 	defer fake.updateSpaceQuotaMutex.RUnlock()
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
 	fake.updateStackLabelsByStackNameMutex.RLock()
 	defer fake.updateStackLabelsByStackNameMutex.RUnlock()
 	fake.updateUserPasswordMutex.RLock()
@@ -19963,6 +24935,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.uploadBuildpackMutex.RUnlock()
 	fake.uploadDropletMutex.RLock()
 	defer fake.uploadDropletMutex.RUnlock()
+	fake.validateServiceBrokerCatalogMutex.RLock()
+	defer fake.validateServiceBrokerCatalogMutex.RUnlock()
+	fake.validateTokenSignatureMutex.RLock()
+	defer fake.validateTokenSignatureMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
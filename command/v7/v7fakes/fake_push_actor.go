@@ -23,6 +23,24 @@ type FakePushActor struct {
 	actualizeReturnsOnCall map[int]struct {
 		result1 <-chan *v7pushaction.PushEvent
 	}
+	CheckQuotasForPushStub        func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) (v7action.QuotaCheck, v7pushaction.Warnings, error)
+	checkQuotasForPushMutex       sync.RWMutex
+	checkQuotasForPushArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 manifestparser.Manifest
+		arg4 v7pushaction.FlagOverrides
+	}
+	checkQuotasForPushReturns struct {
+		result1 v7action.QuotaCheck
+		result2 v7pushaction.Warnings
+		result3 error
+	}
+	checkQuotasForPushReturnsOnCall map[int]struct {
+		result1 v7action.QuotaCheck
+		result2 v7pushaction.Warnings
+		result3 error
+	}
 	CreatePushPlansStub        func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error)
 	createPushPlansMutex       sync.RWMutex
 	createPushPlansArgsForCall []struct {
@@ -41,6 +59,21 @@ type FakePushActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	ExplainUploadStub        func(v7pushaction.PushPlan) (v7pushaction.UploadReport, v7pushaction.Warnings, error)
+	explainUploadMutex       sync.RWMutex
+	explainUploadArgsForCall []struct {
+		arg1 v7pushaction.PushPlan
+	}
+	explainUploadReturns struct {
+		result1 v7pushaction.UploadReport
+		result2 v7pushaction.Warnings
+		result3 error
+	}
+	explainUploadReturnsOnCall map[int]struct {
+		result1 v7pushaction.UploadReport
+		result2 v7pushaction.Warnings
+		result3 error
+	}
 	HandleFlagOverridesStub        func(manifestparser.Manifest, v7pushaction.FlagOverrides) (manifestparser.Manifest, error)
 	handleFlagOverridesMutex       sync.RWMutex
 	handleFlagOverridesArgsForCall []struct {
@@ -120,6 +153,75 @@ func (fake *FakePushActor) ActualizeReturnsOnCall(i int, result1 <-chan *v7pusha
 	}{result1}
 }
 
+func (fake *FakePushActor) CheckQuotasForPush(arg1 string, arg2 string, arg3 manifestparser.Manifest, arg4 v7pushaction.FlagOverrides) (v7action.QuotaCheck, v7pushaction.Warnings, error) {
+	fake.checkQuotasForPushMutex.Lock()
+	ret, specificReturn := fake.checkQuotasForPushReturnsOnCall[len(fake.checkQuotasForPushArgsForCall)]
+	fake.checkQuotasForPushArgsForCall = append(fake.checkQuotasForPushArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 manifestparser.Manifest
+		arg4 v7pushaction.FlagOverrides
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CheckQuotasForPush", []interface{}{arg1, arg2, arg3, arg4})
+	fake.checkQuotasForPushMutex.Unlock()
+	if fake.CheckQuotasForPushStub != nil {
+		return fake.CheckQuotasForPushStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.checkQuotasForPushReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePushActor) CheckQuotasForPushCallCount() int {
+	fake.checkQuotasForPushMutex.RLock()
+	defer fake.checkQuotasForPushMutex.RUnlock()
+	return len(fake.checkQuotasForPushArgsForCall)
+}
+
+func (fake *FakePushActor) CheckQuotasForPushCalls(stub func(string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) (v7action.QuotaCheck, v7pushaction.Warnings, error)) {
+	fake.checkQuotasForPushMutex.Lock()
+	defer fake.checkQuotasForPushMutex.Unlock()
+	fake.CheckQuotasForPushStub = stub
+}
+
+func (fake *FakePushActor) CheckQuotasForPushArgsForCall(i int) (string, string, manifestparser.Manifest, v7pushaction.FlagOverrides) {
+	fake.checkQuotasForPushMutex.RLock()
+	defer fake.checkQuotasForPushMutex.RUnlock()
+	argsForCall := fake.checkQuotasForPushArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakePushActor) CheckQuotasForPushReturns(result1 v7action.QuotaCheck, result2 v7pushaction.Warnings, result3 error) {
+	fake.checkQuotasForPushMutex.Lock()
+	defer fake.checkQuotasForPushMutex.Unlock()
+	fake.CheckQuotasForPushStub = nil
+	fake.checkQuotasForPushReturns = struct {
+		result1 v7action.QuotaCheck
+		result2 v7pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) CheckQuotasForPushReturnsOnCall(i int, result1 v7action.QuotaCheck, result2 v7pushaction.Warnings, result3 error) {
+	fake.checkQuotasForPushMutex.Lock()
+	defer fake.checkQuotasForPushMutex.Unlock()
+	fake.CheckQuotasForPushStub = nil
+	if fake.checkQuotasForPushReturnsOnCall == nil {
+		fake.checkQuotasForPushReturnsOnCall = make(map[int]struct {
+			result1 v7action.QuotaCheck
+			result2 v7pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.checkQuotasForPushReturnsOnCall[i] = struct {
+		result1 v7action.QuotaCheck
+		result2 v7pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePushActor) CreatePushPlans(arg1 string, arg2 string, arg3 manifestparser.Manifest, arg4 v7pushaction.FlagOverrides) ([]v7pushaction.PushPlan, v7action.Warnings, error) {
 	fake.createPushPlansMutex.Lock()
 	ret, specificReturn := fake.createPushPlansReturnsOnCall[len(fake.createPushPlansArgsForCall)]
@@ -189,6 +291,72 @@ func (fake *FakePushActor) CreatePushPlansReturnsOnCall(i int, result1 []v7pusha
 	}{result1, result2, result3}
 }
 
+func (fake *FakePushActor) ExplainUpload(arg1 v7pushaction.PushPlan) (v7pushaction.UploadReport, v7pushaction.Warnings, error) {
+	fake.explainUploadMutex.Lock()
+	ret, specificReturn := fake.explainUploadReturnsOnCall[len(fake.explainUploadArgsForCall)]
+	fake.explainUploadArgsForCall = append(fake.explainUploadArgsForCall, struct {
+		arg1 v7pushaction.PushPlan
+	}{arg1})
+	fake.recordInvocation("ExplainUpload", []interface{}{arg1})
+	fake.explainUploadMutex.Unlock()
+	if fake.ExplainUploadStub != nil {
+		return fake.ExplainUploadStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.explainUploadReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePushActor) ExplainUploadCallCount() int {
+	fake.explainUploadMutex.RLock()
+	defer fake.explainUploadMutex.RUnlock()
+	return len(fake.explainUploadArgsForCall)
+}
+
+func (fake *FakePushActor) ExplainUploadCalls(stub func(v7pushaction.PushPlan) (v7pushaction.UploadReport, v7pushaction.Warnings, error)) {
+	fake.explainUploadMutex.Lock()
+	defer fake.explainUploadMutex.Unlock()
+	fake.ExplainUploadStub = stub
+}
+
+func (fake *FakePushActor) ExplainUploadArgsForCall(i int) v7pushaction.PushPlan {
+	fake.explainUploadMutex.RLock()
+	defer fake.explainUploadMutex.RUnlock()
+	argsForCall := fake.explainUploadArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePushActor) ExplainUploadReturns(result1 v7pushaction.UploadReport, result2 v7pushaction.Warnings, result3 error) {
+	fake.explainUploadMutex.Lock()
+	defer fake.explainUploadMutex.Unlock()
+	fake.ExplainUploadStub = nil
+	fake.explainUploadReturns = struct {
+		result1 v7pushaction.UploadReport
+		result2 v7pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) ExplainUploadReturnsOnCall(i int, result1 v7pushaction.UploadReport, result2 v7pushaction.Warnings, result3 error) {
+	fake.explainUploadMutex.Lock()
+	defer fake.explainUploadMutex.Unlock()
+	fake.ExplainUploadStub = nil
+	if fake.explainUploadReturnsOnCall == nil {
+		fake.explainUploadReturnsOnCall = make(map[int]struct {
+			result1 v7pushaction.UploadReport
+			result2 v7pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.explainUploadReturnsOnCall[i] = struct {
+		result1 v7pushaction.UploadReport
+		result2 v7pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePushActor) HandleFlagOverrides(arg1 manifestparser.Manifest, arg2 v7pushaction.FlagOverrides) (manifestparser.Manifest, error) {
 	fake.handleFlagOverridesMutex.Lock()
 	ret, specificReturn := fake.handleFlagOverridesReturnsOnCall[len(fake.handleFlagOverridesArgsForCall)]
@@ -258,8 +426,12 @@ func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualizeMutex.RLock()
 	defer fake.actualizeMutex.RUnlock()
+	fake.checkQuotasForPushMutex.RLock()
+	defer fake.checkQuotasForPushMutex.RUnlock()
 	fake.createPushPlansMutex.RLock()
 	defer fake.createPushPlansMutex.RUnlock()
+	fake.explainUploadMutex.RLock()
+	defer fake.explainUploadMutex.RUnlock()
 	fake.handleFlagOverridesMutex.RLock()
 	defer fake.handleFlagOverridesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
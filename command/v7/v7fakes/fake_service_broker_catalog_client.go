@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/api/servicebroker"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeServiceBrokerCatalogClient struct {
+	GetCatalogStub        func(string, string, string) (servicebroker.Catalog, error)
+	getCatalogMutex       sync.RWMutex
+	getCatalogArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	getCatalogReturns struct {
+		result1 servicebroker.Catalog
+		result2 error
+	}
+	getCatalogReturnsOnCall map[int]struct {
+		result1 servicebroker.Catalog
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeServiceBrokerCatalogClient) GetCatalog(arg1 string, arg2 string, arg3 string) (servicebroker.Catalog, error) {
+	fake.getCatalogMutex.Lock()
+	ret, specificReturn := fake.getCatalogReturnsOnCall[len(fake.getCatalogArgsForCall)]
+	fake.getCatalogArgsForCall = append(fake.getCatalogArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetCatalog", []interface{}{arg1, arg2, arg3})
+	fake.getCatalogMutex.Unlock()
+	if fake.GetCatalogStub != nil {
+		return fake.GetCatalogStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getCatalogReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceBrokerCatalogClient) GetCatalogCallCount() int {
+	fake.getCatalogMutex.RLock()
+	defer fake.getCatalogMutex.RUnlock()
+	return len(fake.getCatalogArgsForCall)
+}
+
+func (fake *FakeServiceBrokerCatalogClient) GetCatalogCalls(stub func(string, string, string) (servicebroker.Catalog, error)) {
+	fake.getCatalogMutex.Lock()
+	defer fake.getCatalogMutex.Unlock()
+	fake.GetCatalogStub = stub
+}
+
+func (fake *FakeServiceBrokerCatalogClient) GetCatalogArgsForCall(i int) (string, string, string) {
+	fake.getCatalogMutex.RLock()
+	defer fake.getCatalogMutex.RUnlock()
+	argsForCall := fake.getCatalogArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeServiceBrokerCatalogClient) GetCatalogReturns(result1 servicebroker.Catalog, result2 error) {
+	fake.getCatalogMutex.Lock()
+	defer fake.getCatalogMutex.Unlock()
+	fake.GetCatalogStub = nil
+	fake.getCatalogReturns = struct {
+		result1 servicebroker.Catalog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceBrokerCatalogClient) GetCatalogReturnsOnCall(i int, result1 servicebroker.Catalog, result2 error) {
+	fake.getCatalogMutex.Lock()
+	defer fake.getCatalogMutex.Unlock()
+	fake.GetCatalogStub = nil
+	if fake.getCatalogReturnsOnCall == nil {
+		fake.getCatalogReturnsOnCall = make(map[int]struct {
+			result1 servicebroker.Catalog
+			result2 error
+		})
+	}
+	fake.getCatalogReturnsOnCall[i] = struct {
+		result1 servicebroker.Catalog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceBrokerCatalogClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getCatalogMutex.RLock()
+	defer fake.getCatalogMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeServiceBrokerCatalogClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.ServiceBrokerCatalogClient = new(FakeServiceBrokerCatalogClient)
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v7action"
 	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
@@ -23,6 +24,38 @@ type FakeSetLabelActor struct {
 		result1 configv3.User
 		result2 error
 	}
+	GetResourcesByLabelSelectorStub        func(string, string, v7action.LabelSelectorScope) ([]v7action.MetadataResource, v7action.Warnings, error)
+	getResourcesByLabelSelectorMutex       sync.RWMutex
+	getResourcesByLabelSelectorArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.LabelSelectorScope
+	}
+	getResourcesByLabelSelectorReturns struct {
+		result1 []v7action.MetadataResource
+		result2 v7action.Warnings
+		result3 error
+	}
+	getResourcesByLabelSelectorReturnsOnCall map[int]struct {
+		result1 []v7action.MetadataResource
+		result2 v7action.Warnings
+		result3 error
+	}
+	UpdateApplicationAnnotationsByApplicationNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateApplicationAnnotationsByApplicationNameMutex       sync.RWMutex
+	updateApplicationAnnotationsByApplicationNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateApplicationAnnotationsByApplicationNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateApplicationAnnotationsByApplicationNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateApplicationLabelsByApplicationNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateApplicationLabelsByApplicationNameMutex       sync.RWMutex
 	updateApplicationLabelsByApplicationNameArgsForCall []struct {
@@ -38,6 +71,21 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateBuildpackAnnotationsByBuildpackNameAndStackStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateBuildpackAnnotationsByBuildpackNameAndStackMutex       sync.RWMutex
+	updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateBuildpackAnnotationsByBuildpackNameAndStackReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateBuildpackLabelsByBuildpackNameAndStackStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateBuildpackLabelsByBuildpackNameAndStackMutex       sync.RWMutex
 	updateBuildpackLabelsByBuildpackNameAndStackArgsForCall []struct {
@@ -53,6 +101,20 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateDomainAnnotationsByDomainNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateDomainAnnotationsByDomainNameMutex       sync.RWMutex
+	updateDomainAnnotationsByDomainNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateDomainAnnotationsByDomainNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateDomainAnnotationsByDomainNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateDomainLabelsByDomainNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateDomainLabelsByDomainNameMutex       sync.RWMutex
 	updateDomainLabelsByDomainNameArgsForCall []struct {
@@ -67,6 +129,20 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateOrganizationAnnotationsByOrganizationNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateOrganizationAnnotationsByOrganizationNameMutex       sync.RWMutex
+	updateOrganizationAnnotationsByOrganizationNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateOrganizationAnnotationsByOrganizationNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateOrganizationAnnotationsByOrganizationNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateOrganizationLabelsByOrganizationNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateOrganizationLabelsByOrganizationNameMutex       sync.RWMutex
 	updateOrganizationLabelsByOrganizationNameArgsForCall []struct {
@@ -81,6 +157,37 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateResourcesMetadataStub        func(string, []string, resources.Metadata, func(updated int)) (v7action.Warnings, error)
+	updateResourcesMetadataMutex       sync.RWMutex
+	updateResourcesMetadataArgsForCall []struct {
+		arg1 string
+		arg2 []string
+		arg3 resources.Metadata
+		arg4 func(updated int)
+	}
+	updateResourcesMetadataReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateResourcesMetadataReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	UpdateRouteAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateRouteAnnotationsMutex       sync.RWMutex
+	updateRouteAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateRouteAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateRouteAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateRouteLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateRouteLabelsMutex       sync.RWMutex
 	updateRouteLabelsArgsForCall []struct {
@@ -96,6 +203,20 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceBrokerAnnotationsByServiceBrokerNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceBrokerAnnotationsByServiceBrokerNameMutex       sync.RWMutex
+	updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateServiceBrokerAnnotationsByServiceBrokerNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceBrokerLabelsByServiceBrokerNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceBrokerLabelsByServiceBrokerNameMutex       sync.RWMutex
 	updateServiceBrokerLabelsByServiceBrokerNameArgsForCall []struct {
@@ -110,6 +231,21 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceInstanceAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceInstanceAnnotationsMutex       sync.RWMutex
+	updateServiceInstanceAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateServiceInstanceAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceInstanceAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceInstanceLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceInstanceLabelsMutex       sync.RWMutex
 	updateServiceInstanceLabelsArgsForCall []struct {
@@ -125,6 +261,21 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServiceOfferingAnnotationsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServiceOfferingAnnotationsMutex       sync.RWMutex
+	updateServiceOfferingAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateServiceOfferingAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServiceOfferingAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServiceOfferingLabelsStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServiceOfferingLabelsMutex       sync.RWMutex
 	updateServiceOfferingLabelsArgsForCall []struct {
@@ -140,6 +291,22 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateServicePlanAnnotationsStub        func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateServicePlanAnnotationsMutex       sync.RWMutex
+	updateServicePlanAnnotationsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}
+	updateServicePlanAnnotationsReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateServicePlanAnnotationsReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateServicePlanLabelsStub        func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateServicePlanLabelsMutex       sync.RWMutex
 	updateServicePlanLabelsArgsForCall []struct {
@@ -156,6 +323,21 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateSpaceAnnotationsBySpaceNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
+	updateSpaceAnnotationsBySpaceNameMutex       sync.RWMutex
+	updateSpaceAnnotationsBySpaceNameArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}
+	updateSpaceAnnotationsBySpaceNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateSpaceAnnotationsBySpaceNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateSpaceLabelsBySpaceNameStub        func(string, string, map[string]types.NullString) (v7action.Warnings, error)
 	updateSpaceLabelsBySpaceNameMutex       sync.RWMutex
 	updateSpaceLabelsBySpaceNameArgsForCall []struct {
@@ -171,6 +353,20 @@ type FakeSetLabelActor struct {
 		result1 v7action.Warnings
 		result2 error
 	}
+	UpdateStackAnnotationsByStackNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
+	updateStackAnnotationsByStackNameMutex       sync.RWMutex
+	updateStackAnnotationsByStackNameArgsForCall []struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}
+	updateStackAnnotationsByStackNameReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	updateStackAnnotationsByStackNameReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	UpdateStackLabelsByStackNameStub        func(string, map[string]types.NullString) (v7action.Warnings, error)
 	updateStackLabelsByStackNameMutex       sync.RWMutex
 	updateStackLabelsByStackNameArgsForCall []struct {
@@ -244,6 +440,139 @@ func (fake *FakeSetLabelActor) GetCurrentUserReturnsOnCall(i int, result1 config
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) GetResourcesByLabelSelector(arg1 string, arg2 string, arg3 v7action.LabelSelectorScope) ([]v7action.MetadataResource, v7action.Warnings, error) {
+	fake.getResourcesByLabelSelectorMutex.Lock()
+	ret, specificReturn := fake.getResourcesByLabelSelectorReturnsOnCall[len(fake.getResourcesByLabelSelectorArgsForCall)]
+	fake.getResourcesByLabelSelectorArgsForCall = append(fake.getResourcesByLabelSelectorArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v7action.LabelSelectorScope
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("GetResourcesByLabelSelector", []interface{}{arg1, arg2, arg3})
+	fake.getResourcesByLabelSelectorMutex.Unlock()
+	if fake.GetResourcesByLabelSelectorStub != nil {
+		return fake.GetResourcesByLabelSelectorStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getResourcesByLabelSelectorReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSetLabelActor) GetResourcesByLabelSelectorCallCount() int {
+	fake.getResourcesByLabelSelectorMutex.RLock()
+	defer fake.getResourcesByLabelSelectorMutex.RUnlock()
+	return len(fake.getResourcesByLabelSelectorArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) GetResourcesByLabelSelectorCalls(stub func(string, string, v7action.LabelSelectorScope) ([]v7action.MetadataResource, v7action.Warnings, error)) {
+	fake.getResourcesByLabelSelectorMutex.Lock()
+	defer fake.getResourcesByLabelSelectorMutex.Unlock()
+	fake.GetResourcesByLabelSelectorStub = stub
+}
+
+func (fake *FakeSetLabelActor) GetResourcesByLabelSelectorArgsForCall(i int) (string, string, v7action.LabelSelectorScope) {
+	fake.getResourcesByLabelSelectorMutex.RLock()
+	defer fake.getResourcesByLabelSelectorMutex.RUnlock()
+	argsForCall := fake.getResourcesByLabelSelectorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) GetResourcesByLabelSelectorReturns(result1 []v7action.MetadataResource, result2 v7action.Warnings, result3 error) {
+	fake.getResourcesByLabelSelectorMutex.Lock()
+	defer fake.getResourcesByLabelSelectorMutex.Unlock()
+	fake.GetResourcesByLabelSelectorStub = nil
+	fake.getResourcesByLabelSelectorReturns = struct {
+		result1 []v7action.MetadataResource
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetLabelActor) GetResourcesByLabelSelectorReturnsOnCall(i int, result1 []v7action.MetadataResource, result2 v7action.Warnings, result3 error) {
+	fake.getResourcesByLabelSelectorMutex.Lock()
+	defer fake.getResourcesByLabelSelectorMutex.Unlock()
+	fake.GetResourcesByLabelSelectorStub = nil
+	if fake.getResourcesByLabelSelectorReturnsOnCall == nil {
+		fake.getResourcesByLabelSelectorReturnsOnCall = make(map[int]struct {
+			result1 []v7action.MetadataResource
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getResourcesByLabelSelectorReturnsOnCall[i] = struct {
+		result1 []v7action.MetadataResource
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	ret, specificReturn := fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall[len(fake.updateApplicationAnnotationsByApplicationNameArgsForCall)]
+	fake.updateApplicationAnnotationsByApplicationNameArgsForCall = append(fake.updateApplicationAnnotationsByApplicationNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateApplicationAnnotationsByApplicationName", []interface{}{arg1, arg2, arg3})
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	if fake.UpdateApplicationAnnotationsByApplicationNameStub != nil {
+		return fake.UpdateApplicationAnnotationsByApplicationNameStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateApplicationAnnotationsByApplicationNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationNameCallCount() int {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	return len(fake.updateApplicationAnnotationsByApplicationNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationNameCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationNameArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
+	argsForCall := fake.updateApplicationAnnotationsByApplicationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = nil
+	fake.updateApplicationAnnotationsByApplicationNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateApplicationAnnotationsByApplicationNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateApplicationAnnotationsByApplicationNameMutex.Lock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.Unlock()
+	fake.UpdateApplicationAnnotationsByApplicationNameStub = nil
+	if fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall == nil {
+		fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateApplicationAnnotationsByApplicationNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateApplicationLabelsByApplicationName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateApplicationLabelsByApplicationNameMutex.Lock()
 	ret, specificReturn := fake.updateApplicationLabelsByApplicationNameReturnsOnCall[len(fake.updateApplicationLabelsByApplicationNameArgsForCall)]
@@ -309,6 +638,71 @@ func (fake *FakeSetLabelActor) UpdateApplicationLabelsByApplicationNameReturnsOn
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStack(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	ret, specificReturn := fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall[len(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall)]
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall = append(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateBuildpackAnnotationsByBuildpackNameAndStack", []interface{}{arg1, arg2, arg3})
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	if fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub != nil {
+		return fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackCallCount() int {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	return len(fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
+	argsForCall := fake.updateBuildpackAnnotationsByBuildpackNameAndStackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = nil
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Lock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.Unlock()
+	fake.UpdateBuildpackAnnotationsByBuildpackNameAndStackStub = nil
+	if fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall == nil {
+		fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateBuildpackLabelsByBuildpackNameAndStack(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.Lock()
 	ret, specificReturn := fake.updateBuildpackLabelsByBuildpackNameAndStackReturnsOnCall[len(fake.updateBuildpackLabelsByBuildpackNameAndStackArgsForCall)]
@@ -374,6 +768,70 @@ func (fake *FakeSetLabelActor) UpdateBuildpackLabelsByBuildpackNameAndStackRetur
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	ret, specificReturn := fake.updateDomainAnnotationsByDomainNameReturnsOnCall[len(fake.updateDomainAnnotationsByDomainNameArgsForCall)]
+	fake.updateDomainAnnotationsByDomainNameArgsForCall = append(fake.updateDomainAnnotationsByDomainNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateDomainAnnotationsByDomainName", []interface{}{arg1, arg2})
+	fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	if fake.UpdateDomainAnnotationsByDomainNameStub != nil {
+		return fake.UpdateDomainAnnotationsByDomainNameStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateDomainAnnotationsByDomainNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainNameCallCount() int {
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
+	return len(fake.updateDomainAnnotationsByDomainNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
+	argsForCall := fake.updateDomainAnnotationsByDomainNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = nil
+	fake.updateDomainAnnotationsByDomainNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateDomainAnnotationsByDomainNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateDomainAnnotationsByDomainNameMutex.Lock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.Unlock()
+	fake.UpdateDomainAnnotationsByDomainNameStub = nil
+	if fake.updateDomainAnnotationsByDomainNameReturnsOnCall == nil {
+		fake.updateDomainAnnotationsByDomainNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateDomainAnnotationsByDomainNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateDomainLabelsByDomainName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateDomainLabelsByDomainNameMutex.Lock()
 	ret, specificReturn := fake.updateDomainLabelsByDomainNameReturnsOnCall[len(fake.updateDomainLabelsByDomainNameArgsForCall)]
@@ -432,7 +890,71 @@ func (fake *FakeSetLabelActor) UpdateDomainLabelsByDomainNameReturnsOnCall(i int
 			result2 error
 		})
 	}
-	fake.updateDomainLabelsByDomainNameReturnsOnCall[i] = struct {
+	fake.updateDomainLabelsByDomainNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	ret, specificReturn := fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall[len(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall)]
+	fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall = append(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateOrganizationAnnotationsByOrganizationName", []interface{}{arg1, arg2})
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	if fake.UpdateOrganizationAnnotationsByOrganizationNameStub != nil {
+		return fake.UpdateOrganizationAnnotationsByOrganizationNameStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateOrganizationAnnotationsByOrganizationNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationNameCallCount() int {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	return len(fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
+	argsForCall := fake.updateOrganizationAnnotationsByOrganizationNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = nil
+	fake.updateOrganizationAnnotationsByOrganizationNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateOrganizationAnnotationsByOrganizationNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.Lock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.Unlock()
+	fake.UpdateOrganizationAnnotationsByOrganizationNameStub = nil
+	if fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall == nil {
+		fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateOrganizationAnnotationsByOrganizationNameReturnsOnCall[i] = struct {
 		result1 v7action.Warnings
 		result2 error
 	}{result1, result2}
@@ -502,6 +1024,142 @@ func (fake *FakeSetLabelActor) UpdateOrganizationLabelsByOrganizationNameReturns
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateResourcesMetadata(arg1 string, arg2 []string, arg3 resources.Metadata, arg4 func(updated int)) (v7action.Warnings, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.updateResourcesMetadataMutex.Lock()
+	ret, specificReturn := fake.updateResourcesMetadataReturnsOnCall[len(fake.updateResourcesMetadataArgsForCall)]
+	fake.updateResourcesMetadataArgsForCall = append(fake.updateResourcesMetadataArgsForCall, struct {
+		arg1 string
+		arg2 []string
+		arg3 resources.Metadata
+		arg4 func(updated int)
+	}{arg1, arg2Copy, arg3, arg4})
+	fake.recordInvocation("UpdateResourcesMetadata", []interface{}{arg1, arg2Copy, arg3, arg4})
+	fake.updateResourcesMetadataMutex.Unlock()
+	if fake.UpdateResourcesMetadataStub != nil {
+		return fake.UpdateResourcesMetadataStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateResourcesMetadataReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateResourcesMetadataCallCount() int {
+	fake.updateResourcesMetadataMutex.RLock()
+	defer fake.updateResourcesMetadataMutex.RUnlock()
+	return len(fake.updateResourcesMetadataArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateResourcesMetadataCalls(stub func(string, []string, resources.Metadata, func(updated int)) (v7action.Warnings, error)) {
+	fake.updateResourcesMetadataMutex.Lock()
+	defer fake.updateResourcesMetadataMutex.Unlock()
+	fake.UpdateResourcesMetadataStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateResourcesMetadataArgsForCall(i int) (string, []string, resources.Metadata, func(updated int)) {
+	fake.updateResourcesMetadataMutex.RLock()
+	defer fake.updateResourcesMetadataMutex.RUnlock()
+	argsForCall := fake.updateResourcesMetadataArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSetLabelActor) UpdateResourcesMetadataReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateResourcesMetadataMutex.Lock()
+	defer fake.updateResourcesMetadataMutex.Unlock()
+	fake.UpdateResourcesMetadataStub = nil
+	fake.updateResourcesMetadataReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateResourcesMetadataReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateResourcesMetadataMutex.Lock()
+	defer fake.updateResourcesMetadataMutex.Unlock()
+	fake.UpdateResourcesMetadataStub = nil
+	if fake.updateResourcesMetadataReturnsOnCall == nil {
+		fake.updateResourcesMetadataReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateResourcesMetadataReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateRouteAnnotationsReturnsOnCall[len(fake.updateRouteAnnotationsArgsForCall)]
+	fake.updateRouteAnnotationsArgsForCall = append(fake.updateRouteAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateRouteAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateRouteAnnotationsMutex.Unlock()
+	if fake.UpdateRouteAnnotationsStub != nil {
+		return fake.UpdateRouteAnnotationsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateRouteAnnotationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotationsCallCount() int {
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
+	return len(fake.updateRouteAnnotationsArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateRouteAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = nil
+	fake.updateRouteAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateRouteAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateRouteAnnotationsMutex.Lock()
+	defer fake.updateRouteAnnotationsMutex.Unlock()
+	fake.UpdateRouteAnnotationsStub = nil
+	if fake.updateRouteAnnotationsReturnsOnCall == nil {
+		fake.updateRouteAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateRouteAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateRouteLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateRouteLabelsMutex.Lock()
 	ret, specificReturn := fake.updateRouteLabelsReturnsOnCall[len(fake.updateRouteLabelsArgsForCall)]
@@ -567,6 +1225,70 @@ func (fake *FakeSetLabelActor) UpdateRouteLabelsReturnsOnCall(i int, result1 v7a
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	ret, specificReturn := fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall[len(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall)]
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall = append(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateServiceBrokerAnnotationsByServiceBrokerName", []interface{}{arg1, arg2})
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	if fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub != nil {
+		return fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameCallCount() int {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
+	return len(fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
+	argsForCall := fake.updateServiceBrokerAnnotationsByServiceBrokerNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = nil
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Lock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.Unlock()
+	fake.UpdateServiceBrokerAnnotationsByServiceBrokerNameStub = nil
+	if fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall == nil {
+		fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateServiceBrokerLabelsByServiceBrokerName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.Lock()
 	ret, specificReturn := fake.updateServiceBrokerLabelsByServiceBrokerNameReturnsOnCall[len(fake.updateServiceBrokerLabelsByServiceBrokerNameArgsForCall)]
@@ -631,6 +1353,71 @@ func (fake *FakeSetLabelActor) UpdateServiceBrokerLabelsByServiceBrokerNameRetur
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceAnnotationsReturnsOnCall[len(fake.updateServiceInstanceAnnotationsArgsForCall)]
+	fake.updateServiceInstanceAnnotationsArgsForCall = append(fake.updateServiceInstanceAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateServiceInstanceAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	if fake.UpdateServiceInstanceAnnotationsStub != nil {
+		return fake.UpdateServiceInstanceAnnotationsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateServiceInstanceAnnotationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotationsCallCount() int {
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
+	return len(fake.updateServiceInstanceAnnotationsArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServiceInstanceAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = nil
+	fake.updateServiceInstanceAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceInstanceAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceInstanceAnnotationsMutex.Lock()
+	defer fake.updateServiceInstanceAnnotationsMutex.Unlock()
+	fake.UpdateServiceInstanceAnnotationsStub = nil
+	if fake.updateServiceInstanceAnnotationsReturnsOnCall == nil {
+		fake.updateServiceInstanceAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceInstanceAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateServiceInstanceLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceInstanceLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServiceInstanceLabelsReturnsOnCall[len(fake.updateServiceInstanceLabelsArgsForCall)]
@@ -696,6 +1483,71 @@ func (fake *FakeSetLabelActor) UpdateServiceInstanceLabelsReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotations(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServiceOfferingAnnotationsReturnsOnCall[len(fake.updateServiceOfferingAnnotationsArgsForCall)]
+	fake.updateServiceOfferingAnnotationsArgsForCall = append(fake.updateServiceOfferingAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateServiceOfferingAnnotations", []interface{}{arg1, arg2, arg3})
+	fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	if fake.UpdateServiceOfferingAnnotationsStub != nil {
+		return fake.UpdateServiceOfferingAnnotationsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateServiceOfferingAnnotationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotationsCallCount() int {
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
+	return len(fake.updateServiceOfferingAnnotationsArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotationsCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotationsArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServiceOfferingAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = nil
+	fake.updateServiceOfferingAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateServiceOfferingAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServiceOfferingAnnotationsMutex.Lock()
+	defer fake.updateServiceOfferingAnnotationsMutex.Unlock()
+	fake.UpdateServiceOfferingAnnotationsStub = nil
+	if fake.updateServiceOfferingAnnotationsReturnsOnCall == nil {
+		fake.updateServiceOfferingAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServiceOfferingAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateServiceOfferingLabels(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServiceOfferingLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServiceOfferingLabelsReturnsOnCall[len(fake.updateServiceOfferingLabelsArgsForCall)]
@@ -761,6 +1613,72 @@ func (fake *FakeSetLabelActor) UpdateServiceOfferingLabelsReturnsOnCall(i int, r
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotations(arg1 string, arg2 string, arg3 string, arg4 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	ret, specificReturn := fake.updateServicePlanAnnotationsReturnsOnCall[len(fake.updateServicePlanAnnotationsArgsForCall)]
+	fake.updateServicePlanAnnotationsArgsForCall = append(fake.updateServicePlanAnnotationsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 map[string]types.NullString
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("UpdateServicePlanAnnotations", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateServicePlanAnnotationsMutex.Unlock()
+	if fake.UpdateServicePlanAnnotationsStub != nil {
+		return fake.UpdateServicePlanAnnotationsStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateServicePlanAnnotationsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotationsCallCount() int {
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
+	return len(fake.updateServicePlanAnnotationsArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotationsCalls(stub func(string, string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotationsArgsForCall(i int) (string, string, string, map[string]types.NullString) {
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
+	argsForCall := fake.updateServicePlanAnnotationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotationsReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = nil
+	fake.updateServicePlanAnnotationsReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateServicePlanAnnotationsReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateServicePlanAnnotationsMutex.Lock()
+	defer fake.updateServicePlanAnnotationsMutex.Unlock()
+	fake.UpdateServicePlanAnnotationsStub = nil
+	if fake.updateServicePlanAnnotationsReturnsOnCall == nil {
+		fake.updateServicePlanAnnotationsReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateServicePlanAnnotationsReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateServicePlanLabels(arg1 string, arg2 string, arg3 string, arg4 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateServicePlanLabelsMutex.Lock()
 	ret, specificReturn := fake.updateServicePlanLabelsReturnsOnCall[len(fake.updateServicePlanLabelsArgsForCall)]
@@ -827,6 +1745,71 @@ func (fake *FakeSetLabelActor) UpdateServicePlanLabelsReturnsOnCall(i int, resul
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	ret, specificReturn := fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall[len(fake.updateSpaceAnnotationsBySpaceNameArgsForCall)]
+	fake.updateSpaceAnnotationsBySpaceNameArgsForCall = append(fake.updateSpaceAnnotationsBySpaceNameArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 map[string]types.NullString
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateSpaceAnnotationsBySpaceName", []interface{}{arg1, arg2, arg3})
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	if fake.UpdateSpaceAnnotationsBySpaceNameStub != nil {
+		return fake.UpdateSpaceAnnotationsBySpaceNameStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateSpaceAnnotationsBySpaceNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceNameCallCount() int {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	return len(fake.updateSpaceAnnotationsBySpaceNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceNameCalls(stub func(string, string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceNameArgsForCall(i int) (string, string, map[string]types.NullString) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
+	argsForCall := fake.updateSpaceAnnotationsBySpaceNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = nil
+	fake.updateSpaceAnnotationsBySpaceNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateSpaceAnnotationsBySpaceNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateSpaceAnnotationsBySpaceNameMutex.Lock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.Unlock()
+	fake.UpdateSpaceAnnotationsBySpaceNameStub = nil
+	if fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall == nil {
+		fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateSpaceAnnotationsBySpaceNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateSpaceLabelsBySpaceName(arg1 string, arg2 string, arg3 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateSpaceLabelsBySpaceNameMutex.Lock()
 	ret, specificReturn := fake.updateSpaceLabelsBySpaceNameReturnsOnCall[len(fake.updateSpaceLabelsBySpaceNameArgsForCall)]
@@ -892,6 +1875,70 @@ func (fake *FakeSetLabelActor) UpdateSpaceLabelsBySpaceNameReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	ret, specificReturn := fake.updateStackAnnotationsByStackNameReturnsOnCall[len(fake.updateStackAnnotationsByStackNameArgsForCall)]
+	fake.updateStackAnnotationsByStackNameArgsForCall = append(fake.updateStackAnnotationsByStackNameArgsForCall, struct {
+		arg1 string
+		arg2 map[string]types.NullString
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateStackAnnotationsByStackName", []interface{}{arg1, arg2})
+	fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	if fake.UpdateStackAnnotationsByStackNameStub != nil {
+		return fake.UpdateStackAnnotationsByStackNameStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.updateStackAnnotationsByStackNameReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackNameCallCount() int {
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	return len(fake.updateStackAnnotationsByStackNameArgsForCall)
+}
+
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackNameCalls(stub func(string, map[string]types.NullString) (v7action.Warnings, error)) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = stub
+}
+
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackNameArgsForCall(i int) (string, map[string]types.NullString) {
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
+	argsForCall := fake.updateStackAnnotationsByStackNameArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackNameReturns(result1 v7action.Warnings, result2 error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = nil
+	fake.updateStackAnnotationsByStackNameReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSetLabelActor) UpdateStackAnnotationsByStackNameReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.updateStackAnnotationsByStackNameMutex.Lock()
+	defer fake.updateStackAnnotationsByStackNameMutex.Unlock()
+	fake.UpdateStackAnnotationsByStackNameStub = nil
+	if fake.updateStackAnnotationsByStackNameReturnsOnCall == nil {
+		fake.updateStackAnnotationsByStackNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.updateStackAnnotationsByStackNameReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSetLabelActor) UpdateStackLabelsByStackName(arg1 string, arg2 map[string]types.NullString) (v7action.Warnings, error) {
 	fake.updateStackLabelsByStackNameMutex.Lock()
 	ret, specificReturn := fake.updateStackLabelsByStackNameReturnsOnCall[len(fake.updateStackLabelsByStackNameArgsForCall)]
@@ -961,26 +2008,52 @@ func (fake *FakeSetLabelActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.getCurrentUserMutex.RLock()
 	defer fake.getCurrentUserMutex.RUnlock()
+	fake.getResourcesByLabelSelectorMutex.RLock()
+	defer fake.getResourcesByLabelSelectorMutex.RUnlock()
+	fake.updateApplicationAnnotationsByApplicationNameMutex.RLock()
+	defer fake.updateApplicationAnnotationsByApplicationNameMutex.RUnlock()
 	fake.updateApplicationLabelsByApplicationNameMutex.RLock()
 	defer fake.updateApplicationLabelsByApplicationNameMutex.RUnlock()
+	fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RLock()
+	defer fake.updateBuildpackAnnotationsByBuildpackNameAndStackMutex.RUnlock()
 	fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RLock()
 	defer fake.updateBuildpackLabelsByBuildpackNameAndStackMutex.RUnlock()
+	fake.updateDomainAnnotationsByDomainNameMutex.RLock()
+	defer fake.updateDomainAnnotationsByDomainNameMutex.RUnlock()
 	fake.updateDomainLabelsByDomainNameMutex.RLock()
 	defer fake.updateDomainLabelsByDomainNameMutex.RUnlock()
+	fake.updateOrganizationAnnotationsByOrganizationNameMutex.RLock()
+	defer fake.updateOrganizationAnnotationsByOrganizationNameMutex.RUnlock()
 	fake.updateOrganizationLabelsByOrganizationNameMutex.RLock()
 	defer fake.updateOrganizationLabelsByOrganizationNameMutex.RUnlock()
+	fake.updateResourcesMetadataMutex.RLock()
+	defer fake.updateResourcesMetadataMutex.RUnlock()
+	fake.updateRouteAnnotationsMutex.RLock()
+	defer fake.updateRouteAnnotationsMutex.RUnlock()
 	fake.updateRouteLabelsMutex.RLock()
 	defer fake.updateRouteLabelsMutex.RUnlock()
+	fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RLock()
+	defer fake.updateServiceBrokerAnnotationsByServiceBrokerNameMutex.RUnlock()
 	fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.RLock()
 	defer fake.updateServiceBrokerLabelsByServiceBrokerNameMutex.RUnlock()
+	fake.updateServiceInstanceAnnotationsMutex.RLock()
+	defer fake.updateServiceInstanceAnnotationsMutex.RUnlock()
 	fake.updateServiceInstanceLabelsMutex.RLock()
 	defer fake.updateServiceInstanceLabelsMutex.RUnlock()
+	fake.updateServiceOfferingAnnotationsMutex.RLock()
+	defer fake.updateServiceOfferingAnnotationsMutex.RUnlock()
 	fake.updateServiceOfferingLabelsMutex.RLock()
 	defer fake.updateServiceOfferingLabelsMutex.RUnlock()
+	fake.updateServicePlanAnnotationsMutex.RLock()
+	defer fake.updateServicePlanAnnotationsMutex.RUnlock()
 	fake.updateServicePlanLabelsMutex.RLock()
 	defer fake.updateServicePlanLabelsMutex.RUnlock()
+	fake.updateSpaceAnnotationsBySpaceNameMutex.RLock()
+	defer fake.updateSpaceAnnotationsBySpaceNameMutex.RUnlock()
 	fake.updateSpaceLabelsBySpaceNameMutex.RLock()
 	defer fake.updateSpaceLabelsBySpaceNameMutex.RUnlock()
+	fake.updateStackAnnotationsByStackNameMutex.RLock()
+	defer fake.updateStackAnnotationsByStackNameMutex.RUnlock()
 	fake.updateStackLabelsByStackNameMutex.RLock()
 	defer fake.updateStackLabelsByStackNameMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -21,6 +21,19 @@ type FakeSharedSSHActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExecuteSecureShellTunnelStub        func(sharedaction.SecureShellClient, sharedaction.SSHOptions, sharedaction.SSHTunnelOptions) error
+	executeSecureShellTunnelMutex       sync.RWMutex
+	executeSecureShellTunnelArgsForCall []struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 sharedaction.SSHTunnelOptions
+	}
+	executeSecureShellTunnelReturns struct {
+		result1 error
+	}
+	executeSecureShellTunnelReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -86,11 +99,75 @@ func (fake *FakeSharedSSHActor) ExecuteSecureShellReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureShellTunnel(arg1 sharedaction.SecureShellClient, arg2 sharedaction.SSHOptions, arg3 sharedaction.SSHTunnelOptions) error {
+	fake.executeSecureShellTunnelMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellTunnelReturnsOnCall[len(fake.executeSecureShellTunnelArgsForCall)]
+	fake.executeSecureShellTunnelArgsForCall = append(fake.executeSecureShellTunnelArgsForCall, struct {
+		arg1 sharedaction.SecureShellClient
+		arg2 sharedaction.SSHOptions
+		arg3 sharedaction.SSHTunnelOptions
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ExecuteSecureShellTunnel", []interface{}{arg1, arg2, arg3})
+	fake.executeSecureShellTunnelMutex.Unlock()
+	if fake.ExecuteSecureShellTunnelStub != nil {
+		return fake.ExecuteSecureShellTunnelStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.executeSecureShellTunnelReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellTunnelCallCount() int {
+	fake.executeSecureShellTunnelMutex.RLock()
+	defer fake.executeSecureShellTunnelMutex.RUnlock()
+	return len(fake.executeSecureShellTunnelArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellTunnelCalls(stub func(sharedaction.SecureShellClient, sharedaction.SSHOptions, sharedaction.SSHTunnelOptions) error) {
+	fake.executeSecureShellTunnelMutex.Lock()
+	defer fake.executeSecureShellTunnelMutex.Unlock()
+	fake.ExecuteSecureShellTunnelStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellTunnelArgsForCall(i int) (sharedaction.SecureShellClient, sharedaction.SSHOptions, sharedaction.SSHTunnelOptions) {
+	fake.executeSecureShellTunnelMutex.RLock()
+	defer fake.executeSecureShellTunnelMutex.RUnlock()
+	argsForCall := fake.executeSecureShellTunnelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellTunnelReturns(result1 error) {
+	fake.executeSecureShellTunnelMutex.Lock()
+	defer fake.executeSecureShellTunnelMutex.Unlock()
+	fake.ExecuteSecureShellTunnelStub = nil
+	fake.executeSecureShellTunnelReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellTunnelReturnsOnCall(i int, result1 error) {
+	fake.executeSecureShellTunnelMutex.Lock()
+	defer fake.executeSecureShellTunnelMutex.Unlock()
+	fake.ExecuteSecureShellTunnelStub = nil
+	if fake.executeSecureShellTunnelReturnsOnCall == nil {
+		fake.executeSecureShellTunnelReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.executeSecureShellTunnelReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSharedSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.executeSecureShellMutex.RLock()
 	defer fake.executeSecureShellMutex.RUnlock()
+	fake.executeSecureShellTunnelMutex.RLock()
+	defer fake.executeSecureShellTunnelMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
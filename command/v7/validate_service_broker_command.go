@@ -0,0 +1,164 @@
+package v7
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/servicebroker"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ServiceBrokerCatalogClient
+
+type ServiceBrokerCatalogClient interface {
+	GetCatalog(brokerURL string, username string, password string) (servicebroker.Catalog, error)
+}
+
+type ValidateServiceBrokerCommand struct {
+	BaseCommand
+
+	PositionalArgs  flag.ServiceBrokerArgs `positional-args:"yes"`
+	usage           any                    `usage:"CF_NAME validate-service-broker SERVICE_BROKER USERNAME PASSWORD URL\n   CF_NAME validate-service-broker SERVICE_BROKER USERNAME URL (omit password to specify interactively or via environment variable)\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history"`
+	relatedCommands any                    `related_commands:"create-service-broker, service-access, update-service-broker"`
+	envPassword     any                    `environmentName:"CF_BROKER_PASSWORD" environmentDescription:"Password associated with user. Overridden if PASSWORD argument is provided" environmentDefault:"password"`
+
+	CatalogClient ServiceBrokerCatalogClient
+}
+
+func (cmd *ValidateServiceBrokerCommand) Setup(config command.Config, ui command.UI) error {
+	err := cmd.BaseCommand.Setup(config, ui)
+	if err != nil {
+		return err
+	}
+
+	cmd.CatalogClient = servicebroker.NewClient(servicebroker.Config{
+		AppName:           config.BinaryName(),
+		AppVersion:        config.BinaryVersion(),
+		DialTimeout:       config.DialTimeout(),
+		SkipSSLValidation: config.SkipSSLValidation(),
+	})
+	return nil
+}
+
+func (cmd ValidateServiceBrokerCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	brokerName, username, password, url, err := promptUserForBrokerPasswordIfRequired(cmd.PositionalArgs, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting catalog of service broker {{.ServiceBroker}} from {{.URL}} as {{.Username}}...", map[string]any{
+		"ServiceBroker": brokerName,
+		"URL":           url,
+		"Username":      user.Name,
+	})
+
+	catalog, err := cmd.CatalogClient.GetCatalog(url, username, password)
+	if err != nil {
+		return err
+	}
+
+	problems := cmd.Actor.ValidateServiceBrokerCatalog(catalog)
+
+	diff, warnings, err := cmd.Actor.DiffServiceBrokerCatalog(brokerName, catalog)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.displayProblems(problems)
+
+	cmd.UI.DisplayNewline()
+	cmd.displayDiff(brokerName, diff)
+
+	for _, change := range diff.RemovedPlansWithInstances() {
+		cmd.UI.DisplayWarning("Plan {{.Plan}} of service offering {{.Offering}} would be removed while it has {{.Count}} service instance(s).", map[string]any{
+			"Plan":     change.PlanName,
+			"Offering": change.OfferingName,
+			"Count":    change.ServiceInstances,
+		})
+	}
+
+	if errors := problems.Errors(); len(errors) > 0 {
+		return translatableerror.InvalidServiceBrokerCatalogError{Errors: len(errors)}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func (cmd ValidateServiceBrokerCommand) displayProblems(problems v7action.CatalogProblems) {
+	if len(problems) == 0 {
+		cmd.UI.DisplayText("The catalog follows the Open Service Broker API.")
+		return
+	}
+
+	cmd.UI.DisplayText("Catalog problems:")
+	table := [][]string{{
+		cmd.UI.TranslateText("severity"),
+		cmd.UI.TranslateText("field"),
+		cmd.UI.TranslateText("problem"),
+	}}
+	for _, problem := range problems {
+		table = append(table, []string{
+			cmd.UI.TranslateText(string(problem.Severity)),
+			problem.Field,
+			problem.Message,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd ValidateServiceBrokerCommand) displayDiff(brokerName string, diff v7action.ServiceBrokerCatalogDiff) {
+	switch {
+	case !diff.BrokerRegistered:
+		cmd.UI.DisplayText("Service broker {{.ServiceBroker}} is not registered. Creating it would add:", map[string]any{
+			"ServiceBroker": brokerName,
+		})
+	case len(diff.Changes) == 0:
+		cmd.UI.DisplayText("The catalog matches the offerings and plans registered for service broker {{.ServiceBroker}}.", map[string]any{
+			"ServiceBroker": brokerName,
+		})
+		return
+	default:
+		cmd.UI.DisplayText("Updating service broker {{.ServiceBroker}} would make these changes:", map[string]any{
+			"ServiceBroker": brokerName,
+		})
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("change"),
+		cmd.UI.TranslateText("offering"),
+		cmd.UI.TranslateText("plan"),
+		cmd.UI.TranslateText("old name"),
+		cmd.UI.TranslateText("service instances"),
+	}}
+	for _, change := range diff.Changes {
+		instances := ""
+		if change.Type == v7action.CatalogChangeRemoved && change.PlanName != "" {
+			instances = strconv.Itoa(change.ServiceInstances)
+		}
+		table = append(table, []string{
+			cmd.UI.TranslateText(string(change.Type)),
+			change.OfferingName,
+			change.PlanName,
+			change.OldName,
+			instances,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
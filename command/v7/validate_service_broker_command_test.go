@@ -0,0 +1,193 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/servicebroker"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("validate-service-broker Command", func() {
+	const (
+		serviceBrokerName = "fake-service-broker-name"
+		username          = "fake-username"
+		password          = "fake-password"
+		url               = "https://broker.example.com"
+	)
+
+	var (
+		cmd               *v7.ValidateServiceBrokerCommand
+		testUI            *ui.UI
+		fakeConfig        *commandfakes.FakeConfig
+		fakeSharedActor   *commandfakes.FakeSharedActor
+		fakeActor         *v7fakes.FakeActor
+		fakeCatalogClient *v7fakes.FakeServiceBrokerCatalogClient
+		catalog           servicebroker.Catalog
+		executeErr        error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+		fakeCatalogClient = new(v7fakes.FakeServiceBrokerCatalogClient)
+
+		cmd = &v7.ValidateServiceBrokerCommand{
+			BaseCommand: v7.BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			CatalogClient: fakeCatalogClient,
+		}
+		setPositionalFlags(cmd, serviceBrokerName, username, password, url)
+
+		catalog = servicebroker.Catalog{Services: []servicebroker.CatalogService{{ID: "service-id", Name: "service-name"}}}
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+		fakeCatalogClient.GetCatalogReturns(catalog, nil)
+		fakeActor.DiffServiceBrokerCatalogReturns(
+			v7action.ServiceBrokerCatalogDiff{
+				BrokerRegistered: true,
+				Changes: []v7action.CatalogChange{
+					{Type: v7action.CatalogChangeAdded, OfferingName: "service-name", PlanName: "new-plan"},
+					{Type: v7action.CatalogChangeRenamed, OfferingName: "service-name", PlanName: "large", OldName: "big"},
+					{Type: v7action.CatalogChangeRemoved, OfferingName: "service-name", PlanName: "old-plan", ServiceInstances: 3},
+				},
+			},
+			v7action.Warnings{"diff-warning"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("checks the user is logged in", func() {
+		Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+		checkOrg, checkSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkOrg).To(BeFalse())
+		Expect(checkSpace).To(BeFalse())
+	})
+
+	It("fetches the catalog, validates it and compares it with the registered broker", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(fakeCatalogClient.GetCatalogCallCount()).To(Equal(1))
+		brokerURL, brokerUser, brokerPassword := fakeCatalogClient.GetCatalogArgsForCall(0)
+		Expect(brokerURL).To(Equal(url))
+		Expect(brokerUser).To(Equal(username))
+		Expect(brokerPassword).To(Equal(password))
+
+		Expect(fakeActor.ValidateServiceBrokerCatalogArgsForCall(0)).To(Equal(catalog))
+		name, diffCatalog := fakeActor.DiffServiceBrokerCatalogArgsForCall(0)
+		Expect(name).To(Equal(serviceBrokerName))
+		Expect(diffCatalog).To(Equal(catalog))
+
+		Expect(testUI.Out).To(Say(`Getting catalog of service broker fake-service-broker-name from https://broker.example.com as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`The catalog follows the Open Service Broker API\.`))
+		Expect(testUI.Out).To(Say(`Updating service broker fake-service-broker-name would make these changes:`))
+		Expect(testUI.Out).To(Say(`change\s+offering\s+plan\s+old name\s+service instances`))
+		Expect(testUI.Out).To(Say(`added\s+service-name\s+new-plan`))
+		Expect(testUI.Out).To(Say(`renamed\s+service-name\s+large\s+big`))
+		Expect(testUI.Out).To(Say(`removed\s+service-name\s+old-plan\s+3`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(testUI.Err).To(Say("diff-warning"))
+		Expect(testUI.Err).To(Say(`Plan old-plan of service offering service-name would be removed while it has 3 service instance\(s\)\.`))
+	})
+
+	When("the catalog has problems", func() {
+		BeforeEach(func() {
+			fakeActor.ValidateServiceBrokerCatalogReturns(v7action.CatalogProblems{
+				{Severity: v7action.CatalogProblemError, Field: "services[0].bindable", Message: "is required"},
+				{Severity: v7action.CatalogProblemWarning, Field: "services[0].name", Message: `"Service" should be lowercase with no spaces`},
+			})
+		})
+
+		It("displays them and fails when some are errors", func() {
+			Expect(executeErr).To(MatchError(translatableerror.InvalidServiceBrokerCatalogError{Errors: 1}))
+
+			Expect(testUI.Out).To(Say(`Catalog problems:`))
+			Expect(testUI.Out).To(Say(`severity\s+field\s+problem`))
+			Expect(testUI.Out).To(Say(`error\s+services\[0\]\.bindable\s+is required`))
+			Expect(testUI.Out).To(Say(`warning\s+services\[0\]\.name\s+"Service" should be lowercase with no spaces`))
+			Expect(testUI.Out).To(Say(`Updating service broker fake-service-broker-name would make these changes:`))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+
+	When("the broker is not registered", func() {
+		BeforeEach(func() {
+			fakeActor.DiffServiceBrokerCatalogReturns(
+				v7action.ServiceBrokerCatalogDiff{
+					Changes: []v7action.CatalogChange{{Type: v7action.CatalogChangeAdded, OfferingName: "service-name"}},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("displays what creating it adds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Service broker fake-service-broker-name is not registered\. Creating it would add:`))
+			Expect(testUI.Out).To(Say(`added\s+service-name`))
+		})
+	})
+
+	When("the catalog matches the registered broker", func() {
+		BeforeEach(func() {
+			fakeActor.DiffServiceBrokerCatalogReturns(v7action.ServiceBrokerCatalogDiff{BrokerRegistered: true}, nil, nil)
+		})
+
+		It("says there are no changes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`The catalog matches the offerings and plans registered for service broker fake-service-broker-name\.`))
+			Expect(testUI.Out).ToNot(Say("change"))
+		})
+	})
+
+	When("fetching the catalog fails", func() {
+		BeforeEach(func() {
+			fakeCatalogClient.GetCatalogReturns(servicebroker.Catalog{}, errors.New("catalog-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("catalog-error"))
+			Expect(fakeActor.DiffServiceBrokerCatalogCallCount()).To(Equal(0))
+		})
+	})
+
+	When("comparing the catalog fails", func() {
+		BeforeEach(func() {
+			fakeActor.DiffServiceBrokerCatalogReturns(v7action.ServiceBrokerCatalogDiff{}, v7action.Warnings{"diff-warning"}, errors.New("diff-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("diff-error"))
+			Expect(testUI.Err).To(Say("diff-warning"))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(errors.New("not-logged-in"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("not-logged-in"))
+			Expect(fakeCatalogClient.GetCatalogCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,96 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("annotations command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("annotations", "METADATA", "List all annotations (key-value pairs) for an API resource"))
+			})
+
+			It("Displays command usage to output", func() {
+				session := helpers.CF("annotations", "--help")
+
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say(`\s+annotations - List all annotations \(key-value pairs\) for an API resource`))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`\s+cf annotations RESOURCE RESOURCE_NAME`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say(`\s+cf annotations app dora`))
+				Eventually(session).Should(Say(`\s+cf annotations org business`))
+				Eventually(session).Should(Say(`\s+cf annotations buildpack go_buildpack --stack cflinuxfs3`))
+				Eventually(session).Should(Say("RESOURCES:"))
+				Eventually(session).Should(Say(`\s+app`))
+				Eventually(session).Should(Say(`\s+buildpack`))
+				Eventually(session).Should(Say(`\s+domain`))
+				Eventually(session).Should(Say(`\s+org`))
+				Eventually(session).Should(Say(`\s+route`))
+				Eventually(session).Should(Say(`\s+service-broker`))
+				Eventually(session).Should(Say(`\s+service-instance`))
+				Eventually(session).Should(Say(`\s+service-offering`))
+				Eventually(session).Should(Say(`\s+service-plan`))
+				Eventually(session).Should(Say(`\s+space`))
+				Eventually(session).Should(Say(`\s+stack`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`\s+--stack, -s\s+Specify stack to disambiguate buildpacks with the same name`))
+				Eventually(session).Should(Say(`\s+--broker, -b\s+Specify a service broker to disambiguate service offerings or service plans with the same name`))
+				Eventually(session).Should(Say(`\s+--offering, -e\s+Specify a service offering to disambiguate service plans with the same name`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say(`\s+set-annotation, unset-annotation, labels`))
+
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the environment is set up correctly", func() {
+		var (
+			orgName   string
+			spaceName string
+			appName   string
+		)
+
+		BeforeEach(func() {
+			orgName = helpers.NewOrgName()
+			spaceName = helpers.NewSpaceName()
+			appName = helpers.PrefixedRandomName("app")
+			helpers.SetupCF(orgName, spaceName)
+			helpers.WithHelloWorldApp(func(appDir string) {
+				Eventually(helpers.CF("push", appName, "-p", appDir, "--no-start")).Should(Exit(0))
+			})
+		})
+
+		AfterEach(func() {
+			helpers.QuickDeleteOrg(orgName)
+		})
+
+		It("sets, lists and unsets annotations on an app", func() {
+			Eventually(helpers.CF("set-annotation", "app", appName, "contact=team@example.com", "description=a description=with equals")).Should(Exit(0))
+
+			session := helpers.CF("annotations", "app", appName)
+			Eventually(session).Should(Say(`contact\s+team@example.com`))
+			Eventually(session).Should(Say(`description\s+a description=with equals`))
+			Eventually(session).Should(Exit(0))
+
+			session = helpers.CF("labels", "app", appName)
+			Eventually(session).Should(Say("No labels found."))
+			Eventually(session).Should(Exit(0))
+
+			Eventually(helpers.CF("unset-annotation", "app", appName, "contact", "description")).Should(Exit(0))
+
+			session = helpers.CF("annotations", "app", appName)
+			Eventually(session).Should(Say("No annotations found."))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+})
@@ -0,0 +1,50 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("apply-service-access command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("apply-service-access", "SERVICE ADMIN", "Change service plan access to match a YAML file of the desired access"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("apply-service-access", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("apply-service-access - Change service plan access to match a YAML file of the desired access"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf apply-service-access FILE_PATH \[--dry-run\]`))
+				Eventually(session).Should(Say("Plans that are not listed are left alone."))
+				Eventually(session).Should(Say("service_access:"))
+				Eventually(session).Should(Say("access: orgs"))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("cf apply-service-access service-access.yml --dry-run"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--dry-run\s+Show the access changes without making them`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("disable-service-access, enable-service-access, service-access, service-access-matrix"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the file does not exist", func() {
+		It("tells the user the file does not exist and exits 1", func() {
+			session := helpers.CF("apply-service-access", "does-not-exist.yml")
+			Eventually(session.Err).Should(Say("Incorrect Usage: The specified path 'does-not-exist.yml' does not exist."))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
@@ -27,7 +27,9 @@ var _ = Describe("auth command", func() {
 
 			Eventually(session).Should(Say("USAGE:"))
 			Eventually(session).Should(Say("cf auth USERNAME PASSWORD\n"))
-			Eventually(session).Should(Say("cf auth CLIENT_ID CLIENT_SECRET --client-credentials\n\n"))
+			Eventually(session).Should(Say("cf auth CLIENT_ID CLIENT_SECRET --client-credentials\n"))
+			Eventually(session).Should(Say("cf auth CLIENT_ID --client-credentials --private-key-jwt KEY_FILE\n"))
+			Eventually(session).Should(Say(`cf auth CLIENT_ID \[CLIENT_SECRET\] --assertion TOKEN_FILE \[--token-exchange\] \[--private-key-jwt KEY_FILE\]\n\n`))
 
 			Eventually(session).Should(Say("ENVIRONMENT VARIABLES:"))
 			Eventually(session).Should(Say(`CF_USERNAME=user\s+Authenticating user. Overridden if USERNAME argument is provided.`))
@@ -40,11 +42,15 @@ var _ = Describe("auth command", func() {
 
 			Eventually(session).Should(Say("EXAMPLES:"))
 			Eventually(session).Should(Say("cf auth name@example\\.com \"my password\" \\(use quotes for passwords with a space\\)"))
-			Eventually(session).Should(Say("cf auth name@example\\.com \\\"\\\\\"password\\\\\"\\\" \\(escape quotes if used in password\\)\n\n"))
+			Eventually(session).Should(Say("cf auth name@example\\.com \\\"\\\\\"password\\\\\"\\\" \\(escape quotes if used in password\\)\n"))
+			Eventually(session).Should(Say("cf auth ci-client --assertion /var/run/secrets/tokens/cf-token --private-key-jwt ci-client-key.pem\n\n"))
 
 			Eventually(session).Should(Say("OPTIONS:"))
 			Eventually(session).Should(Say("--client-credentials\\s+Use \\(non-user\\) service account \\(also called client credentials\\)\n"))
-			Eventually(session).Should(Say("--origin\\s+Indicates the identity provider to be used for authentication\n\n"))
+			Eventually(session).Should(Say("--origin\\s+Indicates the identity provider to be used for authentication\n"))
+			Eventually(session).Should(Say("--assertion\\s+Path to a file containing a signed JWT, such as a workload identity token, to exchange for an access token\n"))
+			Eventually(session).Should(Say("--token-exchange\\s+Exchange the --assertion token using the token exchange grant instead of the JWT bearer grant\n"))
+			Eventually(session).Should(Say("--private-key-jwt\\s+Path to a private key used to authenticate the client with a signed JWT instead of a client secret\n\n"))
 
 			Eventually(session).Should(Say("SEE ALSO:"))
 			Eventually(session).Should(Say("api, login, target"))
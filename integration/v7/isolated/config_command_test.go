@@ -19,10 +19,11 @@ var _ = Describe("config command", func() {
 			Eventually(session).Should(Say(`NAME:`))
 			Eventually(session).Should(Say(`config - Write default values to the config`))
 			Eventually(session).Should(Say("USAGE:"))
-			Eventually(session).Should(Say(`cf config \[--async-timeout TIMEOUT_IN_MINUTES\] \[--trace \(true | false | path/to/file\)\] \[--color \(true | false\)\] \[--locale \(LOCALE | CLEAR\)\]`))
+			Eventually(session).Should(Say(`cf config \[--async-timeout TIMEOUT_IN_MINUTES\] \[--trace \(true | false | path/to/file\)\] \[--color \(true | false\)\] \[--locale \(LOCALE | CLEAR\)\] \[--credential-store \(file | HELPER | none\)\]`))
 			Eventually(session).Should(Say("OPTIONS:"))
 			Eventually(session).Should(Say(`--async-timeout\s+Timeout in minutes for async HTTP requests`))
 			Eventually(session).Should(Say(`--color\s+Enable or disable color in CLI output`))
+			Eventually(session).Should(Say(`--credential-store\s+Store tokens and secrets outside of config.json. Use 'file' for an encrypted file, the name of a cf-credential-NAME helper on the PATH, or 'none' to store them in config.json`))
 			Eventually(session).Should(Say(`--locale\s+Set default locale. If LOCALE is 'CLEAR', previous locale is deleted.`))
 			Eventually(session).Should(Say(`--trace\s+Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created.`))
 		}
@@ -0,0 +1,49 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("diff-env command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("diff-env", "APPS", "Compare the env variables of two apps"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("diff-env", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("diff-env - Compare the env variables of two apps"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf diff-env APP_NAME OTHER_APP_NAME \[-s OTHER_SPACE \[-o OTHER_ORG\]\] \[--show-values\]`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say(`cf diff-env my-app my-app -s staging --show-values`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--organization, -o\s+Org that contains the other application`))
+				Eventually(session).Should(Say(`--space, -s\s+Space that contains the other application`))
+				Eventually(session).Should(Say(`--show-values\s+Show the values of environment variables instead of redacting them`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("env, export-env, running-environment-variable-group, staging-environment-variable-group"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("no arguments are provided", func() {
+		It("tells the user that the arguments are required, prints help text, and exits 1", func() {
+			session := helpers.CF("diff-env")
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required arguments `APP_NAME` and `OTHER_APP_NAME` were not provided"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
@@ -0,0 +1,41 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("diff-revisions command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("diff-revisions", "EXPERIMENTAL COMMANDS", "Show what changed between two revisions of an app"))
+			})
+
+			It("Displays diff-revisions command usage to output", func() {
+				session := helpers.CF("diff-revisions", "--help")
+
+				Eventually(session).Should(Exit(0))
+
+				Expect(session).To(Say("NAME:"))
+				Expect(session).To(Say("diff-revisions - Show what changed between two revisions of an app"))
+				Expect(session).To(Say("USAGE:"))
+				Expect(session).To(Say(`cf diff-revisions APP_NAME --from VERSION \[--to VERSION\] \[--show-env\]`))
+				Expect(session).To(Say("EXAMPLES:"))
+				Expect(session).To(Say(`cf diff-revisions my-app --from 3`))
+				Expect(session).To(Say("OPTIONS:"))
+				Expect(session).To(Say(`--from\s+The revision to compare from`))
+				Expect(session).To(Say(`--to\s+The revision to compare to \(default: the deployed revision\)`))
+				Expect(session).To(Say(`--show-env\s+Show the values of environment variables instead of redacting them`))
+				Expect(session).To(Say("SEE ALSO:"))
+				Expect(session).To(Say("revision, revisions, rollback"))
+			})
+		})
+	})
+})
@@ -36,7 +36,9 @@ var _ = Describe("droplets command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("droplets - List droplets of an app"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf droplets APP_NAME"))
+				Eventually(session).Should(Say(`cf droplets APP_NAME \[--labels SELECTOR\]`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--labels\s+Selector to filter droplets by labels`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("app, create-package, packages, push, set-droplet"))
 
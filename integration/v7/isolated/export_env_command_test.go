@@ -0,0 +1,47 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("export-env command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("export-env", "APPS", "Write an app's user-provided env variables to a file"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("export-env", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("export-env - Write an app's user-provided env variables to a file"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf export-env APP_NAME FILE_PATH \[--reveal-secrets\]`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say(`cf export-env my-app .env`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--reveal-secrets\s+Write the values of secret-looking env variables instead of masking them`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("env, import-env, set-env"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("no arguments are provided", func() {
+		It("tells the user that the arguments are required, prints help text, and exits 1", func() {
+			session := helpers.CF("export-env")
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required arguments `APP_NAME` and `FILE_PATH` were not provided"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
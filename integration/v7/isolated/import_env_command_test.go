@@ -0,0 +1,47 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("import-env command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("import-env", "APPS", "Set an app's env variables from a .env or YAML file"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("import-env", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("import-env - Set an app's env variables from a .env or YAML file"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf import-env APP_NAME FILE_PATH \[--prune\]`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say(`cf import-env my-app .env`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--prune\s+Remove the app's env variables that are not in the file`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("env, export-env, restage, set-env, unset-env"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("no arguments are provided", func() {
+		It("tells the user that the arguments are required, prints help text, and exits 1", func() {
+			session := helpers.CF("import-env")
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required arguments `APP_NAME` and `FILE_PATH` were not provided"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
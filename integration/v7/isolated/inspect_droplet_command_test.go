@@ -0,0 +1,53 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("inspect-droplet command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("inspect-droplet", "APPS", "Inspect, compare or extract files from an application droplet"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("inspect-droplet", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("inspect-droplet - Inspect, compare or extract files from an application droplet"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf inspect-droplet \(APP_NAME \[--droplet DROPLET_GUID\] \| --file DROPLET_PATH\) \[--files\]`))
+				Eventually(session).Should(Say(`\[--diff OTHER_DROPLET_PATH\] \[--extract PATTERN\]\.\.\. \[-p DIRECTORY\]`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say(`cf inspect-droplet my-app --files`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--droplet\s+The guid of the droplet to inspect \(default: app's current droplet\)`))
+				Eventually(session).Should(Say(`--file\s+Path to a downloaded droplet to inspect instead of an app's droplet`))
+				Eventually(session).Should(Say(`--files\s+List every file in the droplet`))
+				Eventually(session).Should(Say(`--diff\s+Path to a downloaded droplet to compare with, file by file`))
+				Eventually(session).Should(Say(`--extract\s+Extract the files matching a path or glob`))
+				Eventually(session).Should(Say(`--path, -p\s+Directory to extract files to`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("download-droplet, droplets, set-droplet"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("neither an app name nor a droplet file is provided", func() {
+		It("tells the user that the app name is required, prints help text, and exits 1", func() {
+			session := helpers.CF("inspect-droplet")
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required argument `APP_NAME` was not provided"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
@@ -0,0 +1,54 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("prune-droplets command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("prune-droplets", "APPS", "Delete old droplets of an app or of every app in a space"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("prune-droplets", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("prune-droplets - Delete old droplets of an app or of every app in a space"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf prune-droplets \(APP_NAME \| --all-apps\) \[--keep COUNT\] \[--older-than AGE\] \[--dry-run\] \[-f\]`))
+				Eventually(session).Should(Say(`The current droplet and the droplets of deployable revisions are never deleted\.`))
+				Eventually(session).Should(Say(`When both --keep and --older-than are given, only droplets outside of both are deleted\.`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say(`cf prune-droplets my-app --keep 5`))
+				Eventually(session).Should(Say(`cf prune-droplets --all-apps --older-than 30d --dry-run`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--all-apps\s+Prune the droplets of every app in the targeted space`))
+				Eventually(session).Should(Say(`--keep\s+Number of most recently created droplets to keep per app`))
+				Eventually(session).Should(Say(`--older-than\s+Keep droplets created within this age, e.g. 30d or 12h`))
+				Eventually(session).Should(Say(`--dry-run\s+List the droplets that would be deleted and how much blobstore space would be freed, without deleting them`))
+				Eventually(session).Should(Say(`-f\s+Force deletion without confirmation`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("droplets, prune-packages, revisions"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("neither an app name nor --all-apps is provided", func() {
+		It("tells the user that the app name is required, prints help text, and exits 1", func() {
+			session := helpers.CF("prune-droplets", "--keep", "5")
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required argument `APP_NAME` was not provided"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
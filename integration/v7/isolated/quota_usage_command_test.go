@@ -0,0 +1,48 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("quota-usage command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("quota-usage", "ORG ADMIN", "Compare the usage of an org and its spaces, or of every org, with their quota limits"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("quota-usage", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("quota-usage - Compare the usage of an org and its spaces, or of every org, with their quota limits"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf quota-usage \[--all-orgs\] \[--threshold PERCENT\]`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("cf quota-usage --all-orgs --threshold 90"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--all-orgs\s+Report on every org instead of the targeted org and its spaces`))
+				Eventually(session).Should(Say(`--threshold\s+Percentage of a quota limit at which an org or space is flagged as near its limit \(1-100\) \(Default: 80\)`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("org-quota, space-quota, update-org-quota, update-space-quota"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the threshold is above 100", func() {
+		It("tells the user the threshold is invalid and exits 1", func() {
+			session := helpers.CF("quota-usage", "--threshold", "150")
+			Eventually(session.Err).Should(Say("Incorrect usage: Value for --threshold must be an integer between 1 and 100"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
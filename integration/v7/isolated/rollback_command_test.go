@@ -32,12 +32,15 @@ var _ = Describe("rollback command", func() {
 				Expect(session).To(Say("NAME:"))
 				Expect(session).To(Say("rollback - Rollback to the specified revision of an app"))
 				Expect(session).To(Say("USAGE:"))
-				Expect(session).To(Say(`cf rollback APP_NAME \[--version VERSION\]`))
+				Expect(session).To(Say(`cf rollback APP_NAME \[--version VERSION\] \[--strategy STRATEGY\] \[--no-wait\] \[-f\]`))
+				Expect(session).To(Say("Before rolling back, the changes from the deployed revision are displayed for confirmation."))
 				Expect(session).To(Say("OPTIONS:"))
-				Expect(session).To(Say("-f             Force rollback without confirmation"))
-				Expect(session).To(Say("--version      Roll back to the specified revision"))
+				Expect(session).To(Say(`-f\s+Force rollback without confirmation`))
+				Expect(session).To(Say(`--version\s+Roll back to the specified revision`))
+				Expect(session).To(Say(`--strategy\s+Deployment strategy, either rolling or null. A null strategy stops the app before rolling back. \(Default: rolling\)`))
+				Expect(session).To(Say(`--no-wait\s+Exit when the first instance of the web process is healthy`))
 				Expect(session).To(Say("SEE ALSO:"))
-				Expect(session).To(Say("revisions"))
+				Expect(session).To(Say("diff-revisions, revisions"))
 			})
 		})
 	})
@@ -0,0 +1,55 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("route-health command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("route-health", "ROUTES", "Show the instance health of a route's destinations and optionally probe the route"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("route-health", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("route-health - Show the instance health of a route's destinations and optionally probe the route"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`Check the health of an HTTP route:`))
+				Eventually(session).Should(Say(`cf route-health DOMAIN \[--hostname HOSTNAME\] \[--path PATH\] \[--probe \[--probe-timeout SECONDS\] \[--skip-ssl-validation\]\]`))
+				Eventually(session).Should(Say(`Check the health of a TCP route:`))
+				Eventually(session).Should(Say(`cf route-health DOMAIN --port PORT`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say(`cf route-health example.com -n myhost --probe\s+# also request https://myhost.example.com`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--hostname, -n\s+Hostname used to identify the HTTP route`))
+				Eventually(session).Should(Say(`--path\s+Path used to identify the HTTP route`))
+				Eventually(session).Should(Say(`--port\s+Port used to identify the TCP route`))
+				Eventually(session).Should(Say(`--probe\s+Make an HTTPS request to the route and show the response status, latency and certificate expiry`))
+				Eventually(session).Should(Say(`--probe-timeout\s+Time \(in seconds\) to wait for the probe response \(Default: 10\)`))
+				Eventually(session).Should(Say(`--skip-ssl-validation\s+Do not verify the route's certificate when probing it`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("app, map-route, route, routes"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("no arguments are provided", func() {
+		It("tells the user that the argument is required, prints help text, and exits 1", func() {
+			session := helpers.CF("route-health")
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required argument `DOMAIN` was not provided"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
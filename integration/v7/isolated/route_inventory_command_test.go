@@ -0,0 +1,50 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("route-inventory command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("route-inventory", "ROUTES", "Report unmapped, shared, route service and colliding routes in an org, and optionally delete unmapped routes"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("route-inventory", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("route-inventory - Report unmapped, shared, route service and colliding routes in an org, and optionally delete unmapped routes"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf route-inventory \[--all-orgs\] \[--delete-unmapped \[--keep-label LABEL\] \[-f\]\]`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("cf route-inventory --delete-unmapped --keep-label do-not-delete"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--all-orgs\s+Report on the routes of every org instead of the targeted org`))
+				Eventually(session).Should(Say(`--delete-unmapped\s+Delete the unmapped routes after the report, except the ones with the keep label or a route service`))
+				Eventually(session).Should(Say(`--keep-label\s+Label that keeps an unmapped route from being deleted \(Default: keep\)`))
+				Eventually(session).Should(Say(`-f\s+Force deletion without confirmation`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("delete-orphaned-routes, delete-route, routes, set-label"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("-f is given without --delete-unmapped", func() {
+		It("tells the user that the flags must be used together and exits 1", func() {
+			session := helpers.CF("route-inventory", "-f")
+			Eventually(session.Err).Should(Say("Incorrect Usage: '-f' and '--delete-unmapped' must be used together."))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
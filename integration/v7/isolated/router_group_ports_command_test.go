@@ -0,0 +1,43 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("router-group-ports command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("router-group-ports", "DOMAINS", "Show which ports of a TCP router group are in use, reserved by quotas or free"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("router-group-ports", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("router-group-ports - Show which ports of a TCP router group are in use, reserved by quotas or free"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say("cf router-group-ports ROUTER_GROUP"))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("org-quota, router-groups, space-quota, update-router-group"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("no arguments are provided", func() {
+		It("tells the user that the argument is required, prints help text, and exits 1", func() {
+			session := helpers.CF("router-group-ports")
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required argument `ROUTER_GROUP` was not provided"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
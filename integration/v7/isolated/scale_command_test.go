@@ -45,7 +45,7 @@ var _ = Describe("scale command", func() {
 				Eventually(session).Should(Say("scale - Change or view the instance count, disk space limit, memory limit, and log rate limit for an app"))
 
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say(`cf scale APP_NAME \[--process PROCESS\] \[-i INSTANCES\] \[-k DISK\] \[-m MEMORY\] \[-l LOG_RATE_LIMIT\]`))
+				Eventually(session).Should(Say(`cf scale APP_NAME \[--process PROCESS\] \[-i INSTANCES\] \[-k DISK\] \[-m MEMORY\] \[-l LOG_RATE_LIMIT\] \[-f\] \[--skip-quota-check\]`))
 				Eventually(session).Should(Say("Modifying the app's disk, memory, or log rate will cause the app to restart."))
 
 				Eventually(session).Should(Say("OPTIONS:"))
@@ -55,6 +55,7 @@ var _ = Describe("scale command", func() {
 				Eventually(session).Should(Say(`-l\s+Log rate limit per second, in bytes \(e\.g\. 128B, 4K, 1M\). -l=-1 represents unlimited`))
 				Eventually(session).Should(Say(`-m\s+Memory limit \(e\.g\. 256M, 1024M, 1G\)`))
 				Eventually(session).Should(Say(`--process\s+App process to scale \(Default: web\)`))
+				Eventually(session).Should(Say(`--skip-quota-check\s+Scale even if the app would exceed the memory, app instance or log rate limit of the org or space quota`))
 
 				Eventually(session).Should(Say("ENVIRONMENT:"))
 				Eventually(session).Should(Say(`CF_STARTUP_TIMEOUT=5\s+Max wait time for app instance startup, in minutes`))
@@ -0,0 +1,53 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("service-access-matrix command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("service-access-matrix", "SERVICE ADMIN", "Show the access of every org to every service plan"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("service-access-matrix", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("service-access-matrix - Show the access of every org to every service plan"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf service-access-matrix \[-b BROKER\] \[-e SERVICE\]`))
+				Eventually(session).Should(Say("Each cell shows whether the plan is public, enabled for the org, from a space-scoped broker"))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("cf service-access-matrix -b my-broker"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`-b\s+Access for plans of a particular broker`))
+				Eventually(session).Should(Say(`-e\s+Access for plans of a particular service offering`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("apply-service-access, disable-service-access, enable-service-access, service-access"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("not logged in", func() {
+		BeforeEach(func() {
+			helpers.LogoutCF()
+		})
+
+		It("displays an informative error that the user must be logged in", func() {
+			session := helpers.CF("service-access-matrix")
+			Eventually(session).Should(Say("FAILED"))
+			Eventually(session.Err).Should(Say("Not logged in. Use 'cf login' or 'cf login --sso' to log in."))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
@@ -17,7 +17,9 @@ var _ = Describe("service-brokers command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("service-brokers - List service brokers"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf service-brokers"))
+				Eventually(session).Should(Say(`cf service-brokers \[--labels SELECTOR\]`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--labels\s+Selector to filter service brokers by labels`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("delete-service-broker, disable-service-access, enable-service-access"))
 				Eventually(session).Should(Exit(0))
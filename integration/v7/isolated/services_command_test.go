@@ -24,11 +24,12 @@ var _ = Describe("services command", func() {
 			Say(`NAME:\n`),
 			Say(`services - List all service instances in the target space\n`),
 			Say(`USAGE:\n`),
-			Say(`cf services\n`),
+			Say(`cf services \[--labels SELECTOR\]\n`),
 			Say(`ALIAS:\n`),
 			Say(`s\n`),
 			Say(`OPTIONS:\n`),
 			Say(`--no-apps\s+Do not retrieve bound apps information\.\n`),
+			Say(`--labels\s+Selector to filter service instances by labels\n`),
 			Say(`SEE ALSO:\n`),
 			Say(`create-service, marketplace\n`),
 		)
@@ -30,6 +30,7 @@ var _ = Describe("set-label command", func() {
 				Eventually(session).Should(Say(`\s+set-label - Set a label \(key-value pairs\) for an API resource`))
 				Eventually(session).Should(Say("USAGE:"))
 				Eventually(session).Should(Say(`\s+cf set-label RESOURCE RESOURCE_NAME KEY=VALUE\.\.\.`))
+				Eventually(session).Should(Say(`\s+cf set-label RESOURCE --selector SELECTOR \[--scope \(space \| org \| all\)\] \[-f\] KEY=VALUE\.\.\.`))
 				Eventually(session).Should(Say("EXAMPLES:"))
 				Eventually(session).Should(Say(`\s+cf set-label app dora env=production`))
 				Eventually(session).Should(Say(`\s+cf set-label org business pci=true public-facing=false`))
@@ -50,6 +51,9 @@ var _ = Describe("set-label command", func() {
 				Eventually(session).Should(Say(`\s+--stack, -s\s+Specify stack to disambiguate buildpacks with the same name`))
 				Eventually(session).Should(Say(`\s+--broker, -b\s+Specify a service broker to disambiguate service offerings or service plans with the same name`))
 				Eventually(session).Should(Say(`\s+--offering, -e\s+Specify a service offering to disambiguate service plans with the same name`))
+				Eventually(session).Should(Say(`\s+--selector\s+Set the labels on every resource of this type matching a label selector, instead of a named resource`))
+				Eventually(session).Should(Say(`\s+--scope\s+Where to look for resources matching --selector: space, org or all`))
+				Eventually(session).Should(Say(`\s+-f\s+Update resources matching --selector without confirmation`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say(`\s+labels, unset-label`))
 
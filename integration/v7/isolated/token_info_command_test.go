@@ -0,0 +1,61 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("token-info command", func() {
+	Context("help", func() {
+		It("appears in cf help -a", func() {
+			session := helpers.CF("help", "-a")
+			Eventually(session).Should(Exit(0))
+			Expect(session).To(HaveCommandInCategoryWithDescription("token-info", "ADVANCED", "Decode the current access and refresh tokens and display their claims"))
+		})
+
+		It("displays the help information", func() {
+			session := helpers.CF("token-info", "--help")
+
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Say("token-info - Decode the current access and refresh tokens and display their claims"))
+			Eventually(session).Should(Say("USAGE:"))
+			Eventually(session).Should(Say(`cf token-info \[--validate\]`))
+			Eventually(session).Should(Say("EXAMPLES:"))
+			Eventually(session).Should(Say("cf token-info --validate"))
+			Eventually(session).Should(Say("OPTIONS:"))
+			Eventually(session).Should(Say(`--validate\s+Verify the token signatures against the signing keys published by UAA`))
+			Eventually(session).Should(Say("SEE ALSO:"))
+			Eventually(session).Should(Say("auth, login, oauth-token"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+
+	When("the environment is not setup correctly", func() {
+		It("fails with the appropriate errors", func() {
+			helpers.CheckEnvironmentTargetedCorrectly(false, false, ReadOnlyOrg, "token-info")
+		})
+	})
+
+	When("logged in", func() {
+		BeforeEach(func() {
+			helpers.LoginCF()
+		})
+
+		It("displays the decoded access token", func() {
+			session := helpers.CF("token-info", "--validate")
+
+			Eventually(session).Should(Say("Access token:"))
+			Eventually(session).Should(Say(`issuer:\s+https?://.+/oauth/token`))
+			Eventually(session).Should(Say(`scopes:\s+.*cloud_controller`))
+			Eventually(session).Should(Say(`time remaining:\s+\d`))
+			Eventually(session).Should(Say(`signature:\s+valid`))
+			Eventually(session).Should(Say("Refresh token:"))
+			Eventually(session).Should(Exit(0))
+		})
+	})
+})
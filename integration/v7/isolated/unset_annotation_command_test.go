@@ -0,0 +1,55 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("unset-annotation command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("unset-annotation", "METADATA", "Unset an annotation (key-value pairs) for an API resource"))
+			})
+
+			It("Displays command usage to output", func() {
+				session := helpers.CF("unset-annotation", "--help")
+
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say(`\s+unset-annotation - Unset an annotation \(key-value pairs\) for an API resource`))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`\s+cf unset-annotation RESOURCE RESOURCE_NAME KEY\.\.\.`))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say(`\s+cf unset-annotation app dora contact`))
+				Eventually(session).Should(Say(`\s+cf unset-annotation org business description cost-center`))
+				Eventually(session).Should(Say(`\s+cf unset-annotation buildpack go_buildpack owner -s cflinuxfs3`))
+				Eventually(session).Should(Say("RESOURCES:"))
+				Eventually(session).Should(Say(`\s+app`))
+				Eventually(session).Should(Say(`\s+buildpack`))
+				Eventually(session).Should(Say(`\s+domain`))
+				Eventually(session).Should(Say(`\s+org`))
+				Eventually(session).Should(Say(`\s+route`))
+				Eventually(session).Should(Say(`\s+service-broker`))
+				Eventually(session).Should(Say(`\s+service-instance`))
+				Eventually(session).Should(Say(`\s+service-offering`))
+				Eventually(session).Should(Say(`\s+service-plan`))
+				Eventually(session).Should(Say(`\s+space`))
+				Eventually(session).Should(Say(`\s+stack`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`\s+--stack, -s\s+Specify stack to disambiguate buildpacks with the same name`))
+				Eventually(session).Should(Say(`\s+--broker, -b\s+Specify a service broker to disambiguate service offerings or service plans with the same name`))
+				Eventually(session).Should(Say(`\s+--offering, -e\s+Specify a service offering to disambiguate service plans with the same name`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say(`\s+annotations, set-annotation, unset-label`))
+
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+})
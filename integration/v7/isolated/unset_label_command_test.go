@@ -28,6 +28,7 @@ var _ = Describe("unset-label command", func() {
 			Eventually(session).Should(Say(`\s+unset-label - Unset a label \(key-value pairs\) for an API resource`))
 			Eventually(session).Should(Say("USAGE:"))
 			Eventually(session).Should(Say(`\s+cf unset-label RESOURCE RESOURCE_NAME KEY...`))
+			Eventually(session).Should(Say(`\s+cf unset-label RESOURCE --selector SELECTOR \[--scope \(space \| org \| all\)\] \[-f\] KEY\.\.\.`))
 			Eventually(session).Should(Say("EXAMPLES:"))
 			Eventually(session).Should(Say(`\s+cf unset-label app dora ci_signature_sha2`))
 			Eventually(session).Should(Say(`\s+cf unset-label org business pci public-facing`))
@@ -48,6 +49,9 @@ var _ = Describe("unset-label command", func() {
 			Eventually(session).Should(Say(`\s+--stack, -s\s+Specify stack to disambiguate buildpacks with the same name`))
 			Eventually(session).Should(Say(`\s+--broker, -b\s+Specify a service broker to disambiguate service offerings or service plans with the same name`))
 			Eventually(session).Should(Say(`\s+--offering, -e\s+Specify a service offering to disambiguate service plans with the same name`))
+			Eventually(session).Should(Say(`\s+--selector\s+Unset the labels on every resource of this type matching a label selector, instead of a named resource`))
+			Eventually(session).Should(Say(`\s+--scope\s+Where to look for resources matching --selector: space, org or all`))
+			Eventually(session).Should(Say(`\s+-f\s+Update resources matching --selector without confirmation`))
 			Eventually(session).Should(Say("SEE ALSO:"))
 			Eventually(session).Should(Say(`\s+labels, set-label`))
 			Eventually(session).Should(Exit(0))
@@ -16,29 +16,33 @@ var _ = Describe("update destination command", func() {
 			session := helpers.CF("help", "-a")
 
 			Eventually(session).Should(Exit(0))
-			Expect(session).To(HaveCommandInCategoryWithDescription("update-destination", "ROUTES", "Updates the destination protocol for a route"))
+			Expect(session).To(HaveCommandInCategoryWithDescription("update-destination", "ROUTES", "Updates the destination protocol or weight for a route"))
 		})
 
 		It("displays the help information", func() {
 			session := helpers.CF("update-destination", "--help")
 			Eventually(session).Should(Say(`NAME:`))
-			Eventually(session).Should(Say(`update-destination - Updates the destination protocol for a route`))
+			Eventually(session).Should(Say(`update-destination - Updates the destination protocol or weight for a route`))
 			Eventually(session).Should(Say(`\n`))
 
 			Eventually(session).Should(Say(`USAGE:`))
 			Eventually(session).Should(Say(`Edit an existing HTTP route`))
 			Eventually(session).Should(Say(`cf update-destination APP_NAME DOMAIN \[--hostname HOSTNAME\] \[--app-protocol PROTOCOL\] \[--path PATH\]\n`))
+			Eventually(session).Should(Say(`Change the share of an HTTP route's traffic sent to an app:`))
+			Eventually(session).Should(Say(`cf update-destination APP_NAME DOMAIN \[--hostname HOSTNAME\] \[--path PATH\] --weight WEIGHT\n`))
 			Eventually(session).Should(Say(`\n`))
 
 			Eventually(session).Should(Say(`EXAMPLES:`))
 			Eventually(session).Should(Say(`cf update-destination my-app example.com --hostname myhost --app-protocol http2                   # myhost.example.com`))
 			Eventually(session).Should(Say(`cf update destination my-app example.com --hostname myhost --path foo --app-protocol http2        # myhost.example.com/foo`))
+			Eventually(session).Should(Say(`cf update-destination my-app example.com --hostname myhost --weight 50                            # myhost.example.com, 50% of traffic to my-app`))
 			Eventually(session).Should(Say(`\n`))
 
 			Eventually(session).Should(Say(`OPTIONS:`))
 			Eventually(session).Should(Say(`--hostname, -n\s+Hostname for the HTTP route \(required for shared domains\)`))
 			Eventually(session).Should(Say(`--app-protocol\s+New Protocol for the route destination \(http1 or http2\). Only applied to HTTP routes`))
 			Eventually(session).Should(Say(`--path\s+Path for the HTTP route`))
+			Eventually(session).Should(Say(`--weight\s+New percentage of the route's traffic to send to the app \(1-100\). The route's other destinations share the rest`))
 			Eventually(session).Should(Say(`\n`))
 
 			Eventually(session).Should(Say(`SEE ALSO:`))
@@ -0,0 +1,47 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("update-router-group command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("update-router-group", "DOMAINS", "Update the reservable ports of a TCP router group"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("update-router-group", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("update-router-group - Update the reservable ports of a TCP router group"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say("cf update-router-group ROUTER_GROUP --reservable-ports PORTS"))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("cf update-router-group default-tcp --reservable-ports 1024-1199"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--reservable-ports\s+Comma-separated list of ports and port ranges that TCP routes can use, for example 1024-1199,1300`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("router-group-ports, router-groups"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the --reservable-ports flag is not provided", func() {
+		It("tells the user that the flag is required, prints help text, and exits 1", func() {
+			session := helpers.CF("update-router-group", "default-tcp")
+			Eventually(session.Err).Should(Say("Incorrect Usage: the required flag `--reservable-ports' was not specified"))
+			Eventually(session).Should(Say("NAME:"))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
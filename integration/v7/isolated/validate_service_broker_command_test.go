@@ -0,0 +1,52 @@
+package isolated
+
+import (
+	. "code.cloudfoundry.org/cli/cf/util/testhelpers/matchers"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("validate-service-broker command", func() {
+	Describe("help", func() {
+		When("--help flag is set", func() {
+			It("appears in cf help -a", func() {
+				session := helpers.CF("help", "-a")
+				Eventually(session).Should(Exit(0))
+				Expect(session).To(HaveCommandInCategoryWithDescription("validate-service-broker", "SERVICE ADMIN", "Check a service broker's catalog and compare it with the offerings and plans registered in Cloud Foundry"))
+			})
+
+			It("displays command usage to output", func() {
+				session := helpers.CF("validate-service-broker", "--help")
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("validate-service-broker - Check a service broker's catalog and compare it with the offerings and plans registered in Cloud Foundry"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say("cf validate-service-broker SERVICE_BROKER USERNAME PASSWORD URL"))
+				Eventually(session).Should(Say(`cf validate-service-broker SERVICE_BROKER USERNAME URL \(omit password to specify interactively or via environment variable\)`))
+				Eventually(session).Should(Say("WARNING:"))
+				Eventually(session).Should(Say("Providing your password as a command line option is highly discouraged"))
+				Eventually(session).Should(Say("ENVIRONMENT:"))
+				Eventually(session).Should(Say(`CF_BROKER_PASSWORD=password\s+Password associated with user. Overridden if PASSWORD argument is provided`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("create-service-broker, service-access, update-service-broker"))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("not logged in", func() {
+		BeforeEach(func() {
+			helpers.LogoutCF()
+		})
+
+		It("displays an informative error that the user must be logged in", func() {
+			session := helpers.CF("validate-service-broker", "broker", "user", "pass", "http://example.com")
+			Eventually(session).Should(Say("FAILED"))
+			Eventually(session.Err).Should(Say("Not logged in. Use 'cf login' or 'cf login --sso' to log in."))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+})
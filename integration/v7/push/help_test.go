@@ -38,6 +38,11 @@ var _ = Describe("help", func() {
 				"[--task TASK]",
 				"[-u (process | port | http)]",
 				"[--no-route | --random-route]",
+				"[--explain-upload]",
+				"[--force]",
+				"[--blue-green",
+				"[--blue-green-timeout SECONDS]",
+				"[--keep-old-app]]",
 				"[--var KEY=VALUE]",
 				"[--vars-file VARS_FILE_PATH]...",
 			}
@@ -63,6 +68,10 @@ var _ = Describe("help", func() {
 				"[--task TASK]",
 				"[-u (process | port | http)]",
 				"[--no-route | --random-route ]",
+				"[--force]",
+				"[--blue-green",
+				"[--blue-green-timeout SECONDS]",
+				"[--keep-old-app]]",
 				"[--var KEY=VALUE]",
 				"[--vars-file VARS_FILE_PATH]...",
 			}
@@ -71,14 +80,19 @@ var _ = Describe("help", func() {
 
 			Eventually(session).Should(Say("OPTIONS:"))
 			Eventually(session).Should(Say(`--app-start-timeout, -t`))
+			Eventually(session).Should(Say(`--blue-green\s+Push the app as APP_NAME-new`))
+			Eventually(session).Should(Say(`--blue-green-timeout\s+Time \(in seconds\) the new app must stay healthy in a blue-green push before the old app is retired \(Default: 60\)`))
 			Eventually(session).Should(Say(`--buildpack, -b`))
 			Eventually(session).Should(Say(`--disk, -k`))
 			Eventually(session).Should(Say(`--docker-image, -o`))
 			Eventually(session).Should(Say(`--docker-username`))
 			Eventually(session).Should(Say(`--droplet`))
 			Eventually(session).Should(Say(`--endpoint`))
+			Eventually(session).Should(Say(`--explain-upload`))
+			Eventually(session).Should(Say(`--force\s+Push even if the apps would exceed the memory, app instance, route or log rate limit of the org or space quota`))
 			Eventually(session).Should(Say(`--health-check-type, -u`))
 			Eventually(session).Should(Say(`--instances, -i`))
+			Eventually(session).Should(Say(`--keep-old-app`))
 			Eventually(session).Should(Say(`--log-rate-limit, -l\s+Log rate limit per second, in bytes \(e.g. 128B, 4K, 1M\). -l=-1 represents unlimited`))
 			Eventually(session).Should(Say(`--manifest, -f`))
 			Eventually(session).Should(Say(`--memory, -m`))
@@ -1,11 +1,27 @@
 package resources
 
 type Revision struct {
-	GUID        string  `json:"guid"`
-	Version     int     `json:"version"`
-	Deployable  bool    `json:"deployable"`
-	Description string  `json:"description"`
-	Droplet     Droplet `json:"droplet"`
-	CreatedAt   string  `json:"created_at"`
-	UpdatedAt   string  `json:"updated_at"`
+	GUID        string                     `json:"guid"`
+	Version     int                        `json:"version"`
+	Deployable  bool                       `json:"deployable"`
+	Description string                     `json:"description"`
+	Droplet     Droplet                    `json:"droplet"`
+	Processes   map[string]RevisionProcess `json:"processes,omitempty"`
+	Sidecars    []RevisionSidecar          `json:"sidecars,omitempty"`
+	Metadata    *Metadata                  `json:"metadata,omitempty"`
+	CreatedAt   string                     `json:"created_at"`
+	UpdatedAt   string                     `json:"updated_at"`
+}
+
+// RevisionProcess is how a revision runs one of the app's process types.
+type RevisionProcess struct {
+	Command string `json:"command"`
+}
+
+// RevisionSidecar is a sidecar that a revision runs alongside its processes.
+type RevisionSidecar struct {
+	Name         string   `json:"name"`
+	Command      string   `json:"command"`
+	ProcessTypes []string `json:"process_types"`
+	MemoryInMB   uint64   `json:"memory_in_mb"`
 }
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/types"
 )
 
 type RouteDestinationApp struct {
@@ -18,6 +19,9 @@ type RouteDestination struct {
 	App      RouteDestinationApp
 	Port     int
 	Protocol string
+	// Weight is the percentage of the route's traffic sent to the destination.
+	// It is only set when the route splits traffic by weight.
+	Weight types.NullInt
 }
 
 type Route struct {
@@ -31,6 +35,8 @@ type Route struct {
 	URL          string
 	Destinations []RouteDestination
 	Metadata     *Metadata
+	// CreatedAt is the time with zone when the route was created.
+	CreatedAt string
 }
 
 func (r Route) MarshalJSON() ([]byte, error) {
@@ -85,6 +91,7 @@ func (r *Route) UnmarshalJSON(data []byte) error {
 		URL          string             `json:"url,omitempty"`
 		Destinations []RouteDestination `json:"destinations,omitempty"`
 		Metadata     *Metadata          `json:"metadata,omitempty"`
+		CreatedAt    string             `json:"created_at,omitempty"`
 
 		Relationships struct {
 			Space struct {
@@ -115,6 +122,7 @@ func (r *Route) UnmarshalJSON(data []byte) error {
 	r.URL = alias.URL
 	r.Destinations = alias.Destinations
 	r.Metadata = alias.Metadata
+	r.CreatedAt = alias.CreatedAt
 
 	return nil
 }
@@ -14,6 +14,8 @@ type ServiceOffering struct {
 	Description string `json:"description"`
 	// DocumentationURL of the service offering
 	DocumentationURL string `json:"documentation_url"`
+	// BrokerCatalogID is the ID of the service offering in the broker catalog
+	BrokerCatalogID string `jsonry:"broker_catalog.id"`
 	// Tags are used by apps to identify service instances.
 	Tags types.OptionalStringSlice `jsonry:"tags"`
 	// ServiceBrokerGUID is the guid of the service broker
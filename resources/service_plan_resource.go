@@ -23,6 +23,8 @@ type ServicePlan struct {
 	Free bool `json:"free"`
 	// Cost shows the cost of a paid service plan
 	Costs []ServicePlanCost `json:"costs"`
+	// BrokerCatalogID is the ID of the service plan in the broker catalog
+	BrokerCatalogID string `jsonry:"broker_catalog.id"`
 	// ServicePlanGUID is the GUID of the service offering
 	ServiceOfferingGUID string `jsonry:"relationships.service_offering.data.guid"`
 	// SpaceGUID is the space that a plan from a space-scoped broker relates to
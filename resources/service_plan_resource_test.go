@@ -36,6 +36,11 @@ var _ = Describe("service plan resource", func() {
 				}
 			}`,
 		),
+		Entry(
+			"broker catalog id",
+			ServicePlan{BrokerCatalogID: "fake-catalog-id"},
+			`{"broker_catalog": {"id": "fake-catalog-id"}}`,
+		),
 		Entry(
 			"detailed",
 			ServicePlan{
@@ -0,0 +1,16 @@
+package resources
+
+// UsageSummary is the usage of the quota-limited resources of an org or a
+// space.
+type UsageSummary struct {
+	// StartedInstances is the number of instances of started apps.
+	StartedInstances int `json:"started_instances"`
+	// MemoryInMB is the memory used by the instances of started apps.
+	MemoryInMB int `json:"memory_in_mb"`
+	// Routes is the number of routes.
+	Routes int `json:"routes"`
+	// ServiceInstances is the number of managed service instances.
+	ServiceInstances int `json:"service_instances"`
+	// ReservedPorts is the number of ports used by TCP routes.
+	ReservedPorts int `json:"reserved_ports"`
+}
@@ -37,13 +37,24 @@ type LocalPortForward struct {
 	RemoteAddress string
 }
 
+type RemotePortForward struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+type DynamicPortForward struct {
+	LocalAddress string
+}
+
 type SecureShell struct {
 	secureDialer    SecureDialer
 	secureClient    SecureClient
+	clientMutex     sync.RWMutex
 	terminalHelper  TerminalHelper
 	listenerFactory ListenerFactory
 
 	localListeners    []net.Listener
+	remoteListeners   []net.Listener
 	keepAliveInterval time.Duration
 }
 
@@ -79,7 +90,8 @@ func (c *SecureShell) Close() error {
 	for _, listener := range c.localListeners {
 		listener.Close()
 	}
-	return c.secureClient.Close()
+	c.closeRemoteListeners()
+	return c.client().Close()
 }
 
 func (c *SecureShell) Connect(username string, passcode string, appSSHEndpoint string, appSSHHostKeyFingerprint string, skipHostValidation bool) error {
@@ -99,12 +111,24 @@ func (c *SecureShell) Connect(username string, passcode string, appSSHEndpoint s
 		return err
 	}
 
+	c.clientMutex.Lock()
+	previousClient := c.secureClient
 	c.secureClient = secureClient
+	c.clientMutex.Unlock()
+
+	// When reconnecting, local listeners stay bound and start dialing through
+	// the new client. Remote forwards belong to the previous connection and
+	// have to be requested again by the caller.
+	if previousClient != nil {
+		c.closeRemoteListeners()
+		previousClient.Close()
+	}
+
 	return nil
 }
 
 func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYRequest) error {
-	session, err := c.secureClient.NewSession()
+	session, err := c.client().NewSession()
 	if err != nil {
 		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
 	}
@@ -198,7 +222,7 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
 
-	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+	go keepalive(c.client().Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
 
 	result := session.Wait()
 	wg.Wait()
@@ -219,13 +243,58 @@ func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward)
 	return nil
 }
 
+// RemotePortForward asks the SSH server to listen on each remote address and
+// forwards every connection it accepts to the matching local address.
+func (c *SecureShell) RemotePortForward(remotePortForwardSpecs []RemotePortForward) error {
+	for _, spec := range remotePortForwardSpecs {
+		listener, err := c.client().Listen("tcp", spec.RemoteAddress)
+		if err != nil {
+			return fmt.Errorf("remote port forwarding on %s failed: %s", spec.RemoteAddress, err.Error())
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		go c.remoteForwardAcceptLoop(listener, spec.LocalAddress)
+	}
+
+	return nil
+}
+
+// DynamicPortForward starts a SOCKS5 proxy on each local address. The
+// destination requested by the SOCKS client is dialed through the SSH server.
+func (c *SecureShell) DynamicPortForward(dynamicPortForwardSpecs []DynamicPortForward) error {
+	for _, spec := range dynamicPortForwardSpecs {
+		listener, err := c.listenerFactory.Listen("tcp", spec.LocalAddress)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go c.acceptLoop(listener, c.handleSOCKSConnection)
+	}
+
+	return nil
+}
+
 func (c *SecureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
 
-	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+	go keepalive(c.client().Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	return c.client().Wait()
+}
+
+func (c *SecureShell) client() SecureClient {
+	c.clientMutex.RLock()
+	defer c.clientMutex.RUnlock()
+	return c.secureClient
+}
 
-	return c.secureClient.Wait()
+func (c *SecureShell) closeRemoteListeners() {
+	for _, listener := range c.remoteListeners {
+		listener.Close()
+	}
+	c.remoteListeners = nil
 }
 
 func (c *SecureShell) getWindowDimensions(terminalFd uintptr) (width int, height int) {
@@ -241,9 +310,39 @@ func (c *SecureShell) getWindowDimensions(terminalFd uintptr) (width int, height
 }
 
 func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string) {
+	c.forwardConnection(conn, targetAddr, c.client().Dial)
+}
+
+func (c *SecureShell) handleRemoteForwardConnection(conn net.Conn, targetAddr string) {
+	c.forwardConnection(conn, targetAddr, net.Dial)
+}
+
+func (c *SecureShell) handleSOCKSConnection(conn net.Conn) {
+	targetAddr, err := socksHandshake(conn)
+	if err != nil {
+		log.Errorln("socks handshake:", err)
+		conn.Close()
+		return
+	}
+
+	c.forwardConnection(conn, targetAddr, func(network string, address string) (net.Conn, error) {
+		target, dialErr := c.client().Dial(network, address)
+		if dialErr != nil {
+			_ = socksReply(conn, socksReplyHostUnreachable)
+			return nil, dialErr
+		}
+		if replyErr := socksReply(conn, socksReplySucceeded); replyErr != nil {
+			target.Close()
+			return nil, replyErr
+		}
+		return target, nil
+	})
+}
+
+func (c *SecureShell) forwardConnection(conn net.Conn, targetAddr string, dial func(network string, address string) (net.Conn, error)) {
 	defer conn.Close()
 
-	target, err := c.secureClient.Dial("tcp", targetAddr)
+	target, err := dial("tcp", targetAddr)
 	if err != nil {
 		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
 		return
@@ -259,6 +358,18 @@ func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string)
 }
 
 func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+	c.acceptLoop(listener, func(conn net.Conn) {
+		c.handleForwardConnection(conn, addr)
+	})
+}
+
+func (c *SecureShell) remoteForwardAcceptLoop(listener net.Listener, addr string) {
+	c.acceptLoop(listener, func(conn net.Conn) {
+		c.handleRemoteForwardConnection(conn, addr)
+	})
+}
+
+func (c *SecureShell) acceptLoop(listener net.Listener, handle func(net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -271,7 +382,7 @@ func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
 	}
 }
 
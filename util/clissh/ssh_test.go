@@ -8,6 +8,8 @@
 package clissh_test
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	"io"
@@ -20,18 +22,22 @@ import (
 	. "code.cloudfoundry.org/cli/util/clissh"
 	"code.cloudfoundry.org/cli/util/clissh/clisshfakes"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
+	"code.cloudfoundry.org/diego-ssh/handlers/globalrequest"
+	"code.cloudfoundry.org/diego-ssh/helpers"
 	"code.cloudfoundry.org/diego-ssh/server"
 	fake_server "code.cloudfoundry.org/diego-ssh/server/fakes"
 	"code.cloudfoundry.org/diego-ssh/test_helpers"
 	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_io"
 	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_net"
 	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_ssh"
+	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/lager/v3/lagertest"
 	"github.com/creack/pty"
 	"github.com/moby/term"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
 )
 
 func BlockAcceptOnClose(fake *fake_net.FakeListener) {
@@ -50,6 +56,141 @@ func BlockAcceptOnClose(fake *fake_net.FakeListener) {
 	}
 }
 
+func serveEcho(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			io.Copy(conn, conn) //nolint:errcheck
+			conn.Close()
+		}()
+	}
+}
+
+func validateEchoConn(conn net.Conn) {
+	msg := fmt.Sprintf("Hello from %s\n", conn.LocalAddr())
+	n, err := conn.Write([]byte(msg))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(n).To(Equal(len(msg)))
+
+	response := make([]byte, len(msg))
+	_, err = io.ReadFull(conn, response)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(response).To(Equal([]byte(msg)))
+
+	Expect(conn.Close()).To(Succeed())
+}
+
+func validateEcho(addr string) {
+	conn, err := net.Dial("tcp", addr)
+	Expect(err).NotTo(HaveOccurred())
+	validateEchoConn(conn)
+}
+
+func validateSOCKSEcho(proxyAddr string, targetAddr string) {
+	dialer, err := proxy.SOCKS5("tcp", proxyAddr, nil, proxy.Direct)
+	Expect(err).NotTo(HaveOccurred())
+
+	conn, err := dialer.Dial("tcp", targetAddr)
+	Expect(err).NotTo(HaveOccurred())
+	validateEchoConn(conn)
+}
+
+func freeLocalAddress() string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+	defer listener.Close()
+	return listener.Addr().String()
+}
+
+// forwardingDaemon is a minimal SSH server supporting the global requests and
+// channel types used by port forwarding.
+type forwardingDaemon struct {
+	logger       lager.Logger
+	serverConfig *ssh.ServerConfig
+}
+
+func (d *forwardingDaemon) HandleConnection(netConn net.Conn) {
+	defer netConn.Close()
+
+	serverConn, serverChannels, serverRequests, err := ssh.NewServerConn(netConn, d.serverConfig)
+	if err != nil {
+		return
+	}
+
+	lnStore := helpers.NewListenerStore()
+	defer lnStore.RemoveAll()
+
+	go func() {
+		for req := range serverRequests {
+			switch req.Type {
+			case globalrequest.TCPIPForward:
+				new(globalrequest.TCPIPForwardHandler).HandleRequest(d.logger, req, serverConn, lnStore)
+			case globalrequest.CancelTCPIPForward:
+				new(globalrequest.CancelTCPIPForwardHandler).HandleRequest(d.logger, req, serverConn, lnStore)
+			default:
+				if req.WantReply {
+					req.Reply(false, nil) //nolint:errcheck
+				}
+			}
+		}
+	}()
+
+	go func() {
+		for newChannel := range serverChannels {
+			if newChannel.ChannelType() != "direct-tcpip" {
+				newChannel.Reject(ssh.UnknownChannelType, newChannel.ChannelType()) //nolint:errcheck
+				continue
+			}
+			go handleDirectTCPIP(newChannel)
+		}
+	}()
+
+	serverConn.Wait() //nolint:errcheck
+}
+
+func handleDirectTCPIP(newChannel ssh.NewChannel) {
+	var directTCPIP struct {
+		TargetAddr string
+		TargetPort uint32
+		OriginAddr string
+		OriginPort uint32
+	}
+	if err := ssh.Unmarshal(newChannel.ExtraData(), &directTCPIP); err != nil {
+		newChannel.Reject(ssh.ConnectionFailed, err.Error()) //nolint:errcheck
+		return
+	}
+
+	target, err := net.Dial("tcp", net.JoinHostPort(directTCPIP.TargetAddr, fmt.Sprint(directTCPIP.TargetPort)))
+	if err != nil {
+		newChannel.Reject(ssh.ConnectionFailed, err.Error()) //nolint:errcheck
+		return
+	}
+	defer target.Close()
+
+	channel, requests, err := newChannel.Accept()
+	if err != nil {
+		return
+	}
+	defer channel.Close()
+	go ssh.DiscardRequests(requests)
+
+	done := make(chan struct{}, 2)
+	go func() { io.Copy(channel, target); done <- struct{}{} }() //nolint:errcheck
+	go func() { io.Copy(target, channel); done <- struct{}{} }() //nolint:errcheck
+	<-done
+}
+
+func generateHostKey() (ssh.Signer, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return ssh.NewSignerFromKey(privateKey)
+}
+
 var _ = Describe("CLI SSH", Serial, func() {
 	var (
 		fakeSecureDialer    *clisshfakes.FakeSecureDialer
@@ -175,6 +316,28 @@ var _ = Describe("CLI SSH", Serial, func() {
 		})
 	})
 
+	Describe("Connect when already connected", Serial, func() {
+		var previousSecureClient *clisshfakes.FakeSecureClient
+
+		JustBeforeEach(func() {
+			Expect(secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)).To(Succeed())
+
+			previousSecureClient = fakeSecureClient
+			fakeSecureClient = new(clisshfakes.FakeSecureClient)
+			fakeSecureDialer.DialReturns(fakeSecureClient, nil)
+
+			Expect(secureShell.Connect(username, "some-new-passcode", sshEndpoint, sshEndpointFingerprint, skipHostValidation)).To(Succeed())
+		})
+
+		It("closes the previous client and uses the new one", func() {
+			Expect(previousSecureClient.CloseCallCount()).To(Equal(1))
+
+			Expect(secureShell.Wait()).To(Succeed())
+			Expect(fakeSecureClient.WaitCallCount()).To(Equal(1))
+			Expect(previousSecureClient.WaitCallCount()).To(Equal(0))
+		})
+	})
+
 	Describe("InteractiveSession", Serial, func() {
 		var (
 			stdin          *fake_io.FakeReadCloser
@@ -186,6 +349,9 @@ var _ = Describe("CLI SSH", Serial, func() {
 
 		BeforeEach(func() {
 			stdin = new(fake_io.FakeReadCloser)
+			stdin.ReadStub = func(p []byte) (int, error) {
+				return 0, io.EOF
+			}
 			stdout = new(fake_io.FakeWriter)
 			stderr = new(fake_io.FakeWriter)
 
@@ -1149,6 +1315,272 @@ var _ = Describe("CLI SSH", Serial, func() {
 		})
 	})
 
+	Describe("RemotePortForward", Serial, func() {
+		var (
+			forwardErr error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+			remoteAddress  string
+
+			forwardSpecs []RemotePortForward
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go serveEcho(echoListener)
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			remoteAddress = remoteListener.Addr().String()
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			forwardSpecs = []RemotePortForward{{
+				RemoteAddress: "localhost:9999",
+				LocalAddress:  echoListener.Addr().String(),
+			}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+			echoListener.Close()
+		})
+
+		It("asks the server to listen on the remote address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9999"))
+		})
+
+		It("copies data between the remote and local connections", func() {
+			validateEcho(remoteAddress)
+		})
+
+		It("closes the remote listener when the client is closed", func() {
+			Expect(secureShell.Close()).To(Succeed())
+
+			_, err := net.Dial("tcp", remoteAddress)
+			Expect(err).To(HaveOccurred())
+		})
+
+		When("listening on the remote address fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("remote port forwarding on localhost:9999 failed: tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", Serial, func() {
+		var (
+			forwardErr error
+
+			echoListener  net.Listener
+			localListener net.Listener
+			localAddress  string
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go serveEcho(echoListener)
+
+			localListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			localAddress = localListener.Addr().String()
+			fakeListenerFactory.ListenReturns(localListener, nil)
+
+			fakeSecureClient.DialStub = net.Dial
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward([]DynamicPortForward{{LocalAddress: "localhost:1080"}})
+		})
+
+		AfterEach(func() {
+			err := secureShell.Close()
+			Expect(err).NotTo(HaveOccurred())
+			echoListener.Close()
+		})
+
+		It("listens on the local address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("dials the address requested by the SOCKS client through the secure client", func() {
+			validateSOCKSEcho(localAddress, echoListener.Addr().String())
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoListener.Addr().String()))
+		})
+
+		When("dialing the requested address fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialStub = nil
+				fakeSecureClient.DialReturns(nil, errors.New("boom"))
+			})
+
+			It("reports the failure to the SOCKS client", func() {
+				dialer, err := proxy.SOCKS5("tcp", localAddress, nil, proxy.Direct)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = dialer.Dial("tcp", "example.com:80")
+				Expect(err).To(MatchError(ContainSubstring("host unreachable")))
+			})
+		})
+
+		When("listen fails", func() {
+			BeforeEach(func() {
+				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("failure is an option"))
+			})
+		})
+	})
+
+	Describe("forwarding against a local SSH server", Serial, func() {
+		var (
+			sshServer    *server.Server
+			echoListener net.Listener
+		)
+
+		BeforeEach(func() {
+			logger := lagertest.NewTestLogger("test")
+
+			hostKey, err := generateHostKey()
+			Expect(err).NotTo(HaveOccurred())
+
+			serverConfig := &ssh.ServerConfig{
+				PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+					if conn.User() == username && string(password) == passcode {
+						return &ssh.Permissions{}, nil
+					}
+					return nil, errors.New("invalid credentials")
+				},
+			}
+			serverConfig.AddHostKey(hostKey)
+
+			sshDaemon := &forwardingDaemon{logger: logger, serverConfig: serverConfig}
+
+			serverListener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			sshEndpoint = serverListener.Addr().String()
+			skipHostValidation = true
+
+			sshServer = server.NewServer(logger, "", sshDaemon, 500*time.Millisecond)
+			Expect(sshServer.SetListener(serverListener)).To(Succeed())
+			go sshServer.Serve()
+
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go serveEcho(echoListener)
+		})
+
+		JustBeforeEach(func() {
+			secureShell = NewSecureShell(
+				DefaultSecureDialer(),
+				fakeTerminalHelper,
+				DefaultListenerFactory(),
+				keepAliveDuration,
+			)
+
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			sshServer.Shutdown()
+			echoListener.Close()
+		})
+
+		It("forwards connections made on the server back to the local address", func() {
+			remoteAddress := freeLocalAddress()
+
+			err := secureShell.RemotePortForward([]RemotePortForward{{
+				RemoteAddress: remoteAddress,
+				LocalAddress:  echoListener.Addr().String(),
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			validateEcho(remoteAddress)
+		})
+
+		It("keeps local listeners bound when reconnecting", func() {
+			localAddress := freeLocalAddress()
+
+			err := secureShell.LocalPortForward([]LocalPortForward{{
+				LocalAddress:  localAddress,
+				RemoteAddress: echoListener.Addr().String(),
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			validateEcho(localAddress)
+
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			validateEcho(localAddress)
+		})
+
+		It("drops remote forwards of the previous connection when reconnecting", func() {
+			remoteAddress := freeLocalAddress()
+
+			err := secureShell.RemotePortForward([]RemotePortForward{{
+				RemoteAddress: remoteAddress,
+				LocalAddress:  echoListener.Addr().String(),
+			}})
+			Expect(err).NotTo(HaveOccurred())
+
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			err = secureShell.RemotePortForward([]RemotePortForward{{
+				RemoteAddress: remoteAddress,
+				LocalAddress:  echoListener.Addr().String(),
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			validateEcho(remoteAddress)
+		})
+
+		It("proxies SOCKS connections through the server", func() {
+			localAddress := freeLocalAddress()
+
+			err := secureShell.DynamicPortForward([]DynamicPortForward{{LocalAddress: localAddress}})
+			Expect(err).NotTo(HaveOccurred())
+
+			validateSOCKSEcho(localAddress, echoListener.Addr().String())
+		})
+	})
+
 	Describe("Wait", Serial, func() {
 		var waitErr error
 
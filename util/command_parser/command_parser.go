@@ -64,6 +64,12 @@ func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) err
 		return p.handleError(err)
 	}
 
+	if credentialStoreErr := cfConfig.CredentialStoreError(); credentialStoreErr != nil {
+		p.UI.DisplayWarning("Continuing without the stored credentials: {{.Error}}", map[string]interface{}{
+			"Error": credentialStoreErr.Error(),
+		})
+	}
+
 	err = cfConfig.CreatePluginHome()
 	if err != nil {
 		return p.handleError(err)
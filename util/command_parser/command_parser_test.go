@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
 	"io/ioutil"
 )
 
@@ -77,4 +78,31 @@ var _ = Describe("Command 'Parser'", func() {
 		})
 
 	})
+
+	When("the credential store could not be read", func() {
+		var errBuffer *Buffer
+
+		BeforeEach(func() {
+			GinkgoT().Setenv("CF_HOME", GinkgoT().TempDir())
+			GinkgoT().Setenv("CF_CREDENTIAL_STORE", "missing")
+
+			var err error
+			v3Config, err = configv3.LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			errBuffer = NewBuffer()
+			pluginUI, err = ui.NewPluginUI(v3Config, ioutil.Discard, errBuffer)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("warns and runs the command", func() {
+			parser, err := command_parser.NewCommandParser(v3Config)
+			Expect(err).ToNot(HaveOccurred())
+
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"help"})
+			Expect(exitCode).To(Equal(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(errBuffer).To(Say("Continuing without the stored credentials: unable to read credentials from credential store 'missing'"))
+		})
+	})
 })
@@ -32,6 +32,14 @@ type Config struct {
 
 	pluginsConfig PluginsConfig
 
+	// credentialKey is the key the secrets were last loaded from or saved to
+	// in the credential store.
+	credentialKey string
+
+	// credentialStoreErr is set when the secrets could not be read from the
+	// credential store.
+	credentialStoreErr error
+
 	UserConfig
 }
 
@@ -0,0 +1,109 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// CredentialHelperPrefix is prepended to the name of a credential helper to
+// find its executable on the PATH.
+const CredentialHelperPrefix = "cf-credential-"
+
+// CredentialHelper delegates credential storage to an external program, in
+// the style of git and docker credential helpers. The program is invoked as
+// 'cf-credential-NAME ACTION' with one of the following actions:
+//
+//	get    reads the key from stdin and writes the credentials to stdout as
+//	       JSON. If nothing is stored for the key it exits non-zero and
+//	       writes "credentials not found" to stdout.
+//	store  reads a JSON object with a "Key" field and the credentials from
+//	       stdin.
+//	erase  reads the key from stdin.
+//
+// Any other non-zero exit is reported as an error, along with what the
+// program wrote to stderr.
+type CredentialHelper struct {
+	Name string
+}
+
+// NewCredentialHelper returns a credential store backed by the
+// 'cf-credential-NAME' program.
+func NewCredentialHelper(name string) *CredentialHelper {
+	return &CredentialHelper{Name: name}
+}
+
+type credentialHelperStoreRequest struct {
+	Key string `json:"Key"`
+	Credentials
+}
+
+// Get returns the credentials the helper holds for key.
+func (helper *CredentialHelper) Get(key string) (Credentials, error) {
+	output, err := helper.run("get", []byte(key))
+	if err != nil {
+		if strings.TrimSpace(string(output)) == ErrCredentialsNotFound.Error() {
+			return Credentials{}, ErrCredentialsNotFound
+		}
+		return Credentials{}, err
+	}
+
+	var credentials Credentials
+	err = json.Unmarshal(output, &credentials)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("%s returned invalid credentials: %s", helper.program(), err)
+	}
+	return credentials, nil
+}
+
+// Store passes credentials for key to the helper.
+func (helper *CredentialHelper) Store(key string, credentials Credentials) error {
+	input, err := json.Marshal(credentialHelperStoreRequest{
+		Key:         key,
+		Credentials: credentials,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = helper.run("store", input)
+	return err
+}
+
+// Erase asks the helper to remove the credentials for key.
+func (helper *CredentialHelper) Erase(key string) error {
+	output, err := helper.run("erase", []byte(key))
+	if err != nil && strings.TrimSpace(string(output)) == ErrCredentialsNotFound.Error() {
+		return nil
+	}
+	return err
+}
+
+func (helper *CredentialHelper) program() string {
+	return CredentialHelperPrefix + helper.Name
+}
+
+func (helper *CredentialHelper) run(action string, input []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(helper.program(), action)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = strings.TrimSpace(stdout.String())
+		}
+		if message != "" {
+			return stdout.Bytes(), fmt.Errorf("%s %s: %s: %s", helper.program(), action, err, message)
+		}
+		return stdout.Bytes(), fmt.Errorf("%s %s: %s", helper.program(), action, err)
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,216 @@
+//go:build !windows
+// +build !windows
+
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeCredentialHelper is a cf-credential-NAME helper that keeps each key's
+// credentials in a file named after the key, and records every request.
+const fakeCredentialHelper = `#!/bin/sh
+dir=$(dirname "$0")/store
+mkdir -p "$dir"
+input=$(cat)
+echo "$1 $input" >> "$(dirname "$0")/requests"
+case "$1" in
+get)
+  file="$dir/$(echo "$input" | tr -c 'a-zA-Z0-9\n' '_')"
+  if [ ! -f "$file" ]; then
+    echo "credentials not found"
+    exit 1
+  fi
+  cat "$file"
+  ;;
+store)
+  key=$(echo "$input" | sed 's/.*"Key":"\([^"]*\)".*/\1/')
+  echo "$input" > "$dir/$(echo "$key" | tr -c 'a-zA-Z0-9\n' '_')"
+  ;;
+erase)
+  file="$dir/$(echo "$input" | tr -c 'a-zA-Z0-9\n' '_')"
+  if [ ! -f "$file" ]; then
+    echo "credentials not found"
+    exit 1
+  fi
+  rm "$file"
+  ;;
+*)
+  echo "unknown action $1" >&2
+  exit 2
+  ;;
+esac
+`
+
+func installFakeCredentialHelper(name string) string {
+	helperDir := GinkgoT().TempDir()
+	helperPath := filepath.Join(helperDir, CredentialHelperPrefix+name)
+	Expect(ioutil.WriteFile(helperPath, []byte(fakeCredentialHelper), 0700)).To(Succeed())
+
+	GinkgoT().Setenv("PATH", helperDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return helperDir
+}
+
+var _ = Describe("CredentialHelper", func() {
+	var (
+		helper      *CredentialHelper
+		credentials Credentials
+	)
+
+	BeforeEach(func() {
+		installFakeCredentialHelper("fake")
+		helper = NewCredentialHelper("fake")
+		credentials = Credentials{
+			AccessToken:          "bearer some-access-token",
+			RefreshToken:         "some-refresh-token",
+			UAAOAuthClientSecret: "some-client-secret",
+		}
+	})
+
+	It("stores, gets and erases credentials through the helper", func() {
+		_, err := helper.Get("https://api.example.com")
+		Expect(err).To(Equal(ErrCredentialsNotFound))
+
+		Expect(helper.Store("https://api.example.com", credentials)).To(Succeed())
+		Expect(helper.Get("https://api.example.com")).To(Equal(credentials))
+
+		Expect(helper.Erase("https://api.example.com")).To(Succeed())
+		_, err = helper.Get("https://api.example.com")
+		Expect(err).To(Equal(ErrCredentialsNotFound))
+	})
+
+	It("does not error when erasing credentials that are not stored", func() {
+		Expect(helper.Erase("https://api.example.com")).To(Succeed())
+	})
+
+	When("the helper is not installed", func() {
+		BeforeEach(func() {
+			helper = NewCredentialHelper("missing")
+		})
+
+		It("returns an error naming the helper", func() {
+			_, err := helper.Get("https://api.example.com")
+			Expect(err).To(MatchError(ContainSubstring("cf-credential-missing get")))
+		})
+	})
+
+	When("the helper fails", func() {
+		BeforeEach(func() {
+			helperDir := installFakeCredentialHelper("broken")
+			Expect(ioutil.WriteFile(filepath.Join(helperDir, CredentialHelperPrefix+"broken"), []byte("#!/bin/sh\necho 'keychain locked' >&2\nexit 3\n"), 0700)).To(Succeed())
+			helper = NewCredentialHelper("broken")
+		})
+
+		It("returns the error with the helper's stderr", func() {
+			err := helper.Store("https://api.example.com", credentials)
+			Expect(err).To(MatchError("cf-credential-broken store: exit status 3: keychain locked"))
+		})
+	})
+})
+
+var _ = Describe("Config with a credential helper", func() {
+	var (
+		homeDir   string
+		helperDir string
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		helperDir = installFakeCredentialHelper("fake")
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	It("keeps the secrets in the helper instead of config.json", func() {
+		config, err := LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+
+		config.SetCredentialStore("fake")
+		config.SetTargetInformation(TargetInformationArgs{Api: "https://api.example.com"})
+		config.SetTokenInformation("bearer some-access-token", "some-refresh-token", "ssh-client")
+		Expect(config.WriteConfig()).To(Succeed())
+
+		rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(rawConfig)).ToNot(ContainSubstring("some-access-token"))
+		Expect(string(rawConfig)).ToNot(ContainSubstring("some-refresh-token"))
+
+		requests, err := ioutil.ReadFile(filepath.Join(helperDir, "requests"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(requests)).To(ContainSubstring(`store {"Key":"https://api.example.com","AccessToken":"bearer some-access-token"`))
+
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.AccessToken()).To(Equal("bearer some-access-token"))
+		Expect(config.RefreshToken()).To(Equal("some-refresh-token"))
+	})
+
+	When("the helper cannot read the credentials", func() {
+		BeforeEach(func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+
+			config.SetCredentialStore("fake")
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.example.com"})
+			config.SetTokenInformation("bearer some-access-token", "some-refresh-token", "ssh-client")
+			Expect(config.WriteConfig()).To(Succeed())
+
+			brokenHelper := "#!/bin/sh\necho \"$1\" >> \"$(dirname \"$0\")/requests\"\necho 'keychain locked' >&2\nexit 3\n"
+			Expect(ioutil.WriteFile(filepath.Join(helperDir, CredentialHelperPrefix+"fake"), []byte(brokenHelper), 0700)).To(Succeed())
+			Expect(os.Remove(filepath.Join(helperDir, "requests"))).To(Succeed())
+		})
+
+		It("loads the config without the secrets and reports the error", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.RefreshToken()).To(BeEmpty())
+			Expect(config.CredentialStoreError()).To(MatchError(ContainSubstring("unable to read credentials from credential store 'fake'")))
+			Expect(config.CredentialStoreError()).To(MatchError(ContainSubstring("keychain locked")))
+		})
+
+		It("does not erase the stored secrets when the config is written", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.WriteConfig()).To(Succeed())
+
+			requests, err := ioutil.ReadFile(filepath.Join(helperDir, "requests"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(requests)).To(Equal("get\n"))
+		})
+
+		It("can still switch the credential store off", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			config.SetCredentialStore(NoCredentialStore)
+			Expect(config.WriteConfig()).To(Succeed())
+
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.CredentialStore()).To(BeEmpty())
+			Expect(config.CredentialStoreError()).ToNot(HaveOccurred())
+		})
+	})
+
+	When("the configured helper is not installed", func() {
+		BeforeEach(func() {
+			GinkgoT().Setenv("CF_CREDENTIAL_STORE", "missing")
+		})
+
+		It("loads the config without the secrets and reports the error", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.CredentialStoreError()).To(MatchError(ContainSubstring("unable to read credentials from credential store 'missing'")))
+		})
+	})
+})
@@ -0,0 +1,176 @@
+package configv3
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// FileCredentialStore is the name of the credential store that keeps
+	// secrets in an encrypted file in the .cf directory.
+	FileCredentialStore = "file"
+
+	// NoCredentialStore disables the credential store, keeping secrets in
+	// config.json.
+	NoCredentialStore = "none"
+)
+
+// ErrCredentialsNotFound is returned by a CredentialStore when it holds no
+// credentials for the requested key.
+var ErrCredentialsNotFound = errors.New("credentials not found")
+
+// CredentialStore persists the secrets of the CLI configuration outside of
+// config.json. Credentials are keyed by the API endpoint they belong to.
+type CredentialStore interface {
+	// Get returns the credentials stored for key, or ErrCredentialsNotFound.
+	Get(key string) (Credentials, error)
+	// Store saves credentials under key, replacing any previous value.
+	Store(key string, credentials Credentials) error
+	// Erase removes the credentials stored under key. Erasing a key that has no
+	// credentials is not an error.
+	Erase(key string) error
+}
+
+// Credentials are the secret values of the CLI configuration.
+type Credentials struct {
+	AccessToken          string `json:"AccessToken"`
+	RefreshToken         string `json:"RefreshToken"`
+	UAAOAuthClientSecret string `json:"UAAOAuthClientSecret"`
+}
+
+// IsEmpty returns true if none of the credentials are set.
+func (credentials Credentials) IsEmpty() bool {
+	return credentials == Credentials{}
+}
+
+// CredentialStore returns the name of the credential store used to persist
+// secrets. This is based off of:
+//   1. The $CF_CREDENTIAL_STORE environment variable if set
+//   2. The value set in the config file
+// An empty name or "none" means secrets are written to config.json.
+func (config *Config) CredentialStore() string {
+	return credentialStoreName(config.ConfigFile.CredentialStore, config.ENV)
+}
+
+// CredentialStoreError returns the error encountered reading secrets from the
+// credential store when the config was loaded, or nil if they were read.
+func (config *Config) CredentialStoreError() error {
+	return config.credentialStoreErr
+}
+
+// SetCredentialStore sets the name of the credential store used to persist
+// secrets. Use "file" for the encrypted file store, the name of a credential
+// helper, or "none" to keep secrets in config.json.
+func (config *Config) SetCredentialStore(name string) {
+	if name == NoCredentialStore {
+		name = ""
+	}
+	config.ConfigFile.CredentialStore = name
+}
+
+// NewCredentialStore returns the credential store with the given name, or nil
+// if name is empty or "none". The passphrase is only used by the encrypted
+// file store.
+func NewCredentialStore(name string, passphrase string) CredentialStore {
+	switch name {
+	case "", NoCredentialStore:
+		return nil
+	case FileCredentialStore:
+		return NewEncryptedFileCredentialStore(configDirectory(), passphrase)
+	default:
+		return NewCredentialHelper(name)
+	}
+}
+
+// SelectCredentialStore returns the credential store for a config file that
+// names configuredName, or nil when secrets are kept in the config file. The
+// $CF_CREDENTIAL_STORE environment variable takes precedence over the config
+// file.
+func SelectCredentialStore(configuredName string, env EnvOverride) CredentialStore {
+	return NewCredentialStore(credentialStoreName(configuredName, env), env.CFCredentialStorePassphrase)
+}
+
+func credentialStoreName(configuredName string, env EnvOverride) string {
+	if env.CFCredentialStore != "" {
+		return env.CFCredentialStore
+	}
+	return configuredName
+}
+
+// newCredentialStore returns the configured credential store, or nil when
+// secrets are kept in config.json.
+func (config *Config) newCredentialStore() CredentialStore {
+	return SelectCredentialStore(config.ConfigFile.CredentialStore, config.ENV)
+}
+
+// loadCredentials fills in the secrets of the config file from the credential
+// store, if one is configured. When the store cannot be read the secrets are
+// left empty and the error is kept for CredentialStoreError, so that commands
+// such as 'cf config --credential-store none' still run.
+func (config *Config) loadCredentials() {
+	store := config.newCredentialStore()
+	if store == nil {
+		return
+	}
+
+	key := config.ConfigFile.Target
+	credentials, err := store.Get(key)
+	if err == ErrCredentialsNotFound {
+		return
+	}
+	if err != nil {
+		config.credentialStoreErr = fmt.Errorf("unable to read credentials from credential store '%s': %s", config.CredentialStore(), err)
+		return
+	}
+
+	config.ConfigFile.AccessToken = credentials.AccessToken
+	config.ConfigFile.RefreshToken = credentials.RefreshToken
+	config.ConfigFile.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	config.credentialKey = key
+}
+
+// storeCredentials moves the secrets of the config file into the credential
+// store, if one is configured, and returns the config file with the secrets
+// removed.
+func (config *Config) storeCredentials() (JSONConfig, error) {
+	configFile := config.ConfigFile
+
+	store := config.newCredentialStore()
+	if store == nil {
+		return configFile, nil
+	}
+
+	key := configFile.Target
+	if config.credentialKey != "" && config.credentialKey != key {
+		err := store.Erase(config.credentialKey)
+		if err != nil {
+			return JSONConfig{}, fmt.Errorf("unable to erase credentials from credential store '%s': %s", config.CredentialStore(), err)
+		}
+	}
+
+	credentials := Credentials{
+		AccessToken:          configFile.AccessToken,
+		RefreshToken:         configFile.RefreshToken,
+		UAAOAuthClientSecret: configFile.UAAOAuthClientSecret,
+	}
+
+	var err error
+	if credentials.IsEmpty() {
+		// Secrets that could not be read are not erased just because this run
+		// went without them.
+		if config.credentialStoreErr == nil {
+			err = store.Erase(key)
+		}
+	} else {
+		err = store.Store(key, credentials)
+	}
+	if err != nil {
+		return JSONConfig{}, fmt.Errorf("unable to save credentials to credential store '%s': %s", config.CredentialStore(), err)
+	}
+	config.credentialKey = key
+
+	configFile.AccessToken = ""
+	configFile.RefreshToken = ""
+	configFile.UAAOAuthClientSecret = ""
+	return configFile, nil
+}
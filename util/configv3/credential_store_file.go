@@ -0,0 +1,217 @@
+package configv3
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	credentialsFileName    = "credentials.enc"
+	credentialsKeyFileName = "credentials.key"
+
+	credentialsKeySize  = 32
+	credentialsSaltSize = 16
+)
+
+// EncryptedFileCredentialStore keeps credentials in a file encrypted with
+// AES-256-GCM. The encryption key is derived from a passphrase when one is
+// provided, otherwise it is a random key kept in a separate file that only the
+// current user can read.
+type EncryptedFileCredentialStore struct {
+	path       string
+	keyPath    string
+	passphrase string
+}
+
+// NewEncryptedFileCredentialStore returns a credential store that keeps its
+// files in dir.
+func NewEncryptedFileCredentialStore(dir string, passphrase string) *EncryptedFileCredentialStore {
+	return &EncryptedFileCredentialStore{
+		path:       filepath.Join(dir, credentialsFileName),
+		keyPath:    filepath.Join(dir, credentialsKeyFileName),
+		passphrase: passphrase,
+	}
+}
+
+// Get returns the credentials stored for key.
+func (store *EncryptedFileCredentialStore) Get(key string) (Credentials, error) {
+	allCredentials, err := store.read()
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	credentials, ok := allCredentials[key]
+	if !ok {
+		return Credentials{}, ErrCredentialsNotFound
+	}
+	return credentials, nil
+}
+
+// Store saves credentials under key.
+func (store *EncryptedFileCredentialStore) Store(key string, credentials Credentials) error {
+	allCredentials, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	allCredentials[key] = credentials
+	return store.write(allCredentials)
+}
+
+// Erase removes the credentials stored under key.
+func (store *EncryptedFileCredentialStore) Erase(key string) error {
+	allCredentials, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := allCredentials[key]; !ok {
+		return nil
+	}
+
+	delete(allCredentials, key)
+	return store.write(allCredentials)
+}
+
+func (store *EncryptedFileCredentialStore) read() (map[string]Credentials, error) {
+	allCredentials := map[string]Credentials{}
+
+	contents, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		return allCredentials, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contents) < credentialsSaltSize {
+		return nil, errors.New("credentials file is corrupt")
+	}
+	salt, sealed := contents[:credentialsSaltSize], contents[credentialsSaltSize:]
+
+	gcm, err := store.cipher(salt, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < gcm.NonceSize() {
+		return nil, errors.New("credentials file is corrupt")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, errors.New("unable to decrypt credentials file; the key or passphrase may have changed")
+	}
+
+	err = json.Unmarshal(plaintext, &allCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return allCredentials, nil
+}
+
+func (store *EncryptedFileCredentialStore) write(allCredentials map[string]Credentials) error {
+	plaintext, err := json.Marshal(allCredentials)
+	if err != nil {
+		return err
+	}
+
+	salt := make([]byte, credentialsSaltSize)
+	if _, err = io.ReadFull(rand.Reader, salt); err != nil {
+		return err
+	}
+
+	gcm, err := store.cipher(salt, true)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	contents := append(salt, gcm.Seal(nonce, nonce, plaintext, nil)...)
+
+	err = os.MkdirAll(filepath.Dir(store.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(store.path), "temp-credentials")
+	if err != nil {
+		return err
+	}
+	tempFile.Close()
+
+	err = ioutil.WriteFile(tempFile.Name(), contents, 0600)
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+		return err
+	}
+
+	return os.Rename(tempFile.Name(), store.path)
+}
+
+// cipher returns the AEAD used to seal the credentials file. When no
+// passphrase is set the key file is created if create is true.
+func (store *EncryptedFileCredentialStore) cipher(salt []byte, create bool) (cipher.AEAD, error) {
+	var (
+		key []byte
+		err error
+	)
+
+	if store.passphrase != "" {
+		key, err = scrypt.Key([]byte(store.passphrase), salt, 1<<15, 8, 1, credentialsKeySize)
+	} else {
+		key, err = store.readOrCreateKey(create)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (store *EncryptedFileCredentialStore) readOrCreateKey(create bool) ([]byte, error) {
+	key, err := ioutil.ReadFile(store.keyPath)
+	if err == nil {
+		if len(key) != credentialsKeySize {
+			return nil, errors.New("credentials key file is corrupt")
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) || !create {
+		return nil, err
+	}
+
+	key = make([]byte, credentialsKeySize)
+	if _, err = io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(filepath.Dir(store.keyPath), 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ioutil.WriteFile(store.keyPath, key, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -0,0 +1,121 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EncryptedFileCredentialStore", func() {
+	var (
+		dir         string
+		passphrase  string
+		store       *EncryptedFileCredentialStore
+		credentials Credentials
+	)
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		passphrase = ""
+		credentials = Credentials{
+			AccessToken:          "bearer some-access-token",
+			RefreshToken:         "some-refresh-token",
+			UAAOAuthClientSecret: "some-client-secret",
+		}
+	})
+
+	JustBeforeEach(func() {
+		store = NewEncryptedFileCredentialStore(dir, passphrase)
+	})
+
+	When("nothing has been stored", func() {
+		It("returns ErrCredentialsNotFound", func() {
+			_, err := store.Get("https://api.example.com")
+			Expect(err).To(Equal(ErrCredentialsNotFound))
+		})
+
+		It("does not error when erasing", func() {
+			Expect(store.Erase("https://api.example.com")).To(Succeed())
+		})
+	})
+
+	When("credentials have been stored", func() {
+		JustBeforeEach(func() {
+			Expect(store.Store("https://api.example.com", credentials)).To(Succeed())
+		})
+
+		It("returns them by key", func() {
+			Expect(store.Get("https://api.example.com")).To(Equal(credentials))
+
+			_, err := store.Get("https://api.other.com")
+			Expect(err).To(Equal(ErrCredentialsNotFound))
+		})
+
+		It("does not write the secrets in plain text", func() {
+			contents, err := ioutil.ReadFile(filepath.Join(dir, "credentials.enc"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).ToNot(ContainSubstring("some-access-token"))
+			Expect(string(contents)).ToNot(ContainSubstring("some-client-secret"))
+		})
+
+		It("creates a key file readable only by the user", func() {
+			info, err := os.Stat(filepath.Join(dir, "credentials.key"))
+			Expect(err).ToNot(HaveOccurred())
+			if runtime.GOOS != "windows" {
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			}
+		})
+
+		It("can be read by a new store using the same directory", func() {
+			Expect(NewEncryptedFileCredentialStore(dir, "").Get("https://api.example.com")).To(Equal(credentials))
+		})
+
+		It("keeps credentials for other keys when erasing", func() {
+			Expect(store.Store("https://api.other.com", credentials)).To(Succeed())
+			Expect(store.Erase("https://api.example.com")).To(Succeed())
+
+			_, err := store.Get("https://api.example.com")
+			Expect(err).To(Equal(ErrCredentialsNotFound))
+			Expect(store.Get("https://api.other.com")).To(Equal(credentials))
+		})
+
+		When("the key file is replaced", func() {
+			JustBeforeEach(func() {
+				Expect(ioutil.WriteFile(filepath.Join(dir, "credentials.key"), []byte("0123456789abcdef0123456789abcdef"), 0600)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				_, err := store.Get("https://api.example.com")
+				Expect(err).To(MatchError(ContainSubstring("unable to decrypt credentials file")))
+			})
+		})
+	})
+
+	When("a passphrase is used", func() {
+		BeforeEach(func() {
+			passphrase = "some-passphrase"
+		})
+
+		JustBeforeEach(func() {
+			Expect(store.Store("https://api.example.com", credentials)).To(Succeed())
+		})
+
+		It("does not create a key file", func() {
+			_, err := os.Stat(filepath.Join(dir, "credentials.key"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+
+		It("can only be read with the same passphrase", func() {
+			Expect(NewEncryptedFileCredentialStore(dir, "some-passphrase").Get("https://api.example.com")).To(Equal(credentials))
+
+			_, err := NewEncryptedFileCredentialStore(dir, "wrong-passphrase").Get("https://api.example.com")
+			Expect(err).To(MatchError(ContainSubstring("unable to decrypt credentials file")))
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package configv3
 
 import (
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -8,23 +10,49 @@ import (
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPassword       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	CFUsername       string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName                  string
+	CFColor                     string
+	CFCredentialStore           string
+	CFCredentialStorePassphrase string
+	CFDialTimeout               string
+	CFHome                      string
+	CFLogLevel                  string
+	CFPassword                  string
+	CFPluginHome                string
+	CFStagingTimeout            string
+	CFStartupTimeout            string
+	CFTrace                     string
+	CFUsername                  string
+	DockerPassword              string
+	Experimental                string
+	ForceTTY                    string
+	HTTPSProxy                  string
+	Lang                        string
+	LCAll                       string
+}
+
+// ReadEnvOverride returns the environment variables read by the CF CLI.
+func ReadEnvOverride() EnvOverride {
+	return EnvOverride{
+		BinaryName:                  filepath.Base(os.Args[0]),
+		CFColor:                     os.Getenv("CF_COLOR"),
+		CFCredentialStore:           os.Getenv("CF_CREDENTIAL_STORE"),
+		CFCredentialStorePassphrase: os.Getenv("CF_CREDENTIAL_STORE_PASSPHRASE"),
+		CFDialTimeout:               os.Getenv("CF_DIAL_TIMEOUT"),
+		CFLogLevel:                  os.Getenv("CF_LOG_LEVEL"),
+		CFPassword:                  os.Getenv("CF_PASSWORD"),
+		CFPluginHome:                os.Getenv("CF_PLUGIN_HOME"),
+		CFStagingTimeout:            os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:            os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:                     os.Getenv("CF_TRACE"),
+		CFUsername:                  os.Getenv("CF_USERNAME"),
+		DockerPassword:              os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:                os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:                    os.Getenv("FORCE_TTY"),
+		HTTPSProxy:                  os.Getenv("https_proxy"),
+		Lang:                        os.Getenv("LANG"),
+		LCAll:                       os.Getenv("LC_ALL"),
+	}
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -13,6 +13,7 @@ type JSONConfig struct {
 	CFOnK8s                  CFOnK8s            `json:"CFOnK8s"`
 	ColorEnabled             string             `json:"ColorEnabled"`
 	ConfigVersion            int                `json:"ConfigVersion"`
+	CredentialStore          string             `json:"CredentialStore"`
 	DopplerEndpoint          string             `json:"DopplerEndPoint"`
 	Locale                   string             `json:"Locale"`
 	LogCacheEndpoint         string             `json:"LogCacheEndPoint"`
@@ -31,6 +32,7 @@ type JSONConfig struct {
 	UAAEndpoint              string             `json:"UaaEndpoint"`
 	UAAGrantType             string             `json:"UAAGrantType"`
 	UAAOAuthClient           string             `json:"UAAOAuthClient"`
+	UAAOAuthClientKeyFile    string             `json:"UAAOAuthClientKeyFile"`
 	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
 }
 
@@ -221,6 +223,12 @@ func (config *Config) SetUAAClientCredentials(client string, clientSecret string
 	config.ConfigFile.UAAOAuthClientSecret = clientSecret
 }
 
+// SetUAAClientKeyFile sets the path to the private key used to sign client
+// assertions when authenticating the UAA client.
+func (config *Config) SetUAAClientKeyFile(keyFile string) {
+	config.ConfigFile.UAAOAuthClientKeyFile = keyFile
+}
+
 // SetUAAEndpoint sets the UAA endpoint that is obtained from hitting
 // <AuthorizationEndpoint>/login.
 func (config *Config) SetUAAEndpoint(uaaEndpoint string) {
@@ -285,6 +293,12 @@ func (config *Config) UAAOAuthClientSecret() string {
 	return config.ConfigFile.UAAOAuthClientSecret
 }
 
+// UAAOAuthClientKeyFile returns the path to the private key used to sign
+// client assertions, if the CLI's UAA client authenticates with one.
+func (config *Config) UAAOAuthClientKeyFile() string {
+	return config.ConfigFile.UAAOAuthClientKeyFile
+}
+
 // UnsetOrganizationAndSpaceInformation resets the organization and space
 // values to default.
 func (config *Config) UnsetOrganizationAndSpaceInformation() {
@@ -304,6 +318,7 @@ func (config *Config) UnsetUserInformation() {
 	config.SetRefreshToken("")
 	config.SetUAAGrantType("")
 	config.SetUAAClientCredentials(DefaultUAAOAuthClient, DefaultUAAOAuthClientSecret)
+	config.SetUAAClientKeyFile("")
 	config.SetKubernetesAuthInfo("")
 
 	config.UnsetOrganizationAndSpaceInformation()
@@ -255,6 +255,29 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("SetCredentialStore", func() {
+		It("sets the credential store", func() {
+			config = new(Config)
+			config.SetCredentialStore("some-helper")
+			Expect(config.ConfigFile.CredentialStore).To(Equal("some-helper"))
+			Expect(config.CredentialStore()).To(Equal("some-helper"))
+		})
+
+		It("clears the credential store when set to none", func() {
+			config = new(Config)
+			config.SetCredentialStore("file")
+			config.SetCredentialStore(NoCredentialStore)
+			Expect(config.ConfigFile.CredentialStore).To(BeEmpty())
+		})
+
+		It("is overridden by $CF_CREDENTIAL_STORE", func() {
+			config = new(Config)
+			config.SetCredentialStore("file")
+			config.ENV.CFCredentialStore = "some-helper"
+			Expect(config.CredentialStore()).To(Equal("some-helper"))
+		})
+	})
+
 	Describe("SetTrace", func() {
 		It("sets the trace field", func() {
 			config = new(Config)
@@ -272,6 +295,14 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("SetUAAClientKeyFile", func() {
+		It("sets the UAA client key file", func() {
+			config = new(Config)
+			config.SetUAAClientKeyFile("/some/client-key.pem")
+			Expect(config.ConfigFile.UAAOAuthClientKeyFile).To(Equal("/some/client-key.pem"))
+		})
+	})
+
 	Describe("SetUAAEndpoint", func() {
 		It("sets the UAA endpoint", func() {
 			config = new(Config)
@@ -437,6 +468,27 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("UAAOAuthClientKeyFile", func() {
+		BeforeEach(func() {
+			rawConfig := fmt.Sprintf(`
+					{
+						"UAAOAuthClient": "some-client-id",
+						"UAAOAuthClientKeyFile": "/some/client-key.pem",
+						"ConfigVersion": %d
+					}`, CurrentConfigVersion)
+			setConfig(homeDir, rawConfig)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+		})
+
+		It("returns the client key file", func() {
+			Expect(config.UAAOAuthClientKeyFile()).To(Equal("/some/client-key.pem"))
+		})
+	})
+
 	Describe("UnsetOrganizationAndSpaceInformation", func() {
 		BeforeEach(func() {
 			config = new(Config)
@@ -477,6 +529,7 @@ var _ = Describe("JSONConfig", func() {
 			config.SetRefreshToken("some-refresh-token")
 			config.SetUAAGrantType("client-credentials")
 			config.SetUAAClientCredentials("some-client", "some-client-secret")
+			config.SetUAAClientKeyFile("/some/client-key.pem")
 			config.SetOrganizationInformation("some-org-guid", "some-org")
 			config.SetSpaceInformation("guid-value-1", "my-org-name", true)
 			config.SetKubernetesAuthInfo("some-auth-info")
@@ -495,6 +548,7 @@ var _ = Describe("JSONConfig", func() {
 			Expect(config.ConfigFile.UAAGrantType).To(BeEmpty())
 			Expect(config.ConfigFile.UAAOAuthClient).To(Equal(DefaultUAAOAuthClient))
 			Expect(config.ConfigFile.UAAOAuthClientSecret).To(Equal(DefaultUAAOAuthClientSecret))
+			Expect(config.ConfigFile.UAAOAuthClientKeyFile).To(BeEmpty())
 			Expect(config.ConfigFile.CFOnK8s.AuthInfo).To(BeEmpty())
 		})
 	})
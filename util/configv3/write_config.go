@@ -11,9 +11,15 @@ import (
 
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
-// directory.
+// directory. When a credential store is configured, the access token, refresh
+// token and client secret are saved to the store instead of config.json.
 func (c *Config) WriteConfig() error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	configFile, err := c.storeCredentials()
+	if err != nil {
+		return err
+	}
+
+	rawConfig, err := json.MarshalIndent(configFile, "", "  ")
 	if err != nil {
 		return err
 	}
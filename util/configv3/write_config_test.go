@@ -73,5 +73,95 @@ var _ = Describe("Config", func() {
 			caseInsensitive := func(i, j int) bool { return strings.ToLower(keys[i]) < strings.ToLower(keys[j]) }
 			Expect(sort.SliceIsSorted(keys, caseInsensitive)).To(BeTrue())
 		})
+
+		When("a credential store is configured", func() {
+			BeforeEach(func() {
+				config = &configv3.Config{
+					ConfigFile: configv3.JSONConfig{
+						ConfigVersion:        configv3.CurrentConfigVersion,
+						CredentialStore:      configv3.FileCredentialStore,
+						Target:               "https://api.foo.com",
+						AccessToken:          "bearer some-access-token",
+						RefreshToken:         "some-refresh-token",
+						UAAOAuthClient:       "some-client",
+						UAAOAuthClientSecret: "some-client-secret",
+					},
+				}
+				Expect(config.WriteConfig()).To(Succeed())
+
+				var err error
+				file, err = ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not write the secrets to config.json", func() {
+				var writtenCFConfig configv3.JSONConfig
+				Expect(json.Unmarshal(file, &writtenCFConfig)).To(Succeed())
+
+				Expect(writtenCFConfig.AccessToken).To(BeEmpty())
+				Expect(writtenCFConfig.RefreshToken).To(BeEmpty())
+				Expect(writtenCFConfig.UAAOAuthClientSecret).To(BeEmpty())
+				Expect(writtenCFConfig.UAAOAuthClient).To(Equal("some-client"))
+				Expect(writtenCFConfig.CredentialStore).To(Equal(configv3.FileCredentialStore))
+			})
+
+			It("keeps the secrets in memory", func() {
+				Expect(config.AccessToken()).To(Equal("bearer some-access-token"))
+			})
+
+			It("loads the secrets back from the store", func() {
+				loadedConfig, err := configv3.LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(loadedConfig.AccessToken()).To(Equal("bearer some-access-token"))
+				Expect(loadedConfig.RefreshToken()).To(Equal("some-refresh-token"))
+				Expect(loadedConfig.UAAOAuthClientSecret()).To(Equal("some-client-secret"))
+			})
+
+			When("the target changes", func() {
+				It("moves the secrets to the new target and erases the old ones", func() {
+					loadedConfig, err := configv3.LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+
+					loadedConfig.ConfigFile.Target = "https://api.bar.com"
+					Expect(loadedConfig.WriteConfig()).To(Succeed())
+
+					store := configv3.NewEncryptedFileCredentialStore(filepath.Join(homeDir, ".cf"), "")
+					_, err = store.Get("https://api.foo.com")
+					Expect(err).To(Equal(configv3.ErrCredentialsNotFound))
+					credentials, err := store.Get("https://api.bar.com")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(credentials.AccessToken).To(Equal("bearer some-access-token"))
+				})
+			})
+
+			When("the user logs out", func() {
+				It("erases the secrets from the store", func() {
+					loadedConfig, err := configv3.LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+
+					loadedConfig.UnsetUserInformation()
+					Expect(loadedConfig.WriteConfig()).To(Succeed())
+
+					store := configv3.NewEncryptedFileCredentialStore(filepath.Join(homeDir, ".cf"), "")
+					_, err = store.Get("https://api.foo.com")
+					Expect(err).To(Equal(configv3.ErrCredentialsNotFound))
+				})
+			})
+
+			When("the credential store is disabled", func() {
+				It("writes the secrets to config.json again", func() {
+					loadedConfig, err := configv3.LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+
+					loadedConfig.SetCredentialStore(configv3.NoCredentialStore)
+					Expect(loadedConfig.WriteConfig()).To(Succeed())
+
+					file, err = ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(file)).To(ContainSubstring("bearer some-access-token"))
+				})
+			})
+		})
 	})
 })